@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -114,6 +115,7 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      *pr.LastMergeSourceCommit.CommitId,
 				Labels:       azureDevOpsLabels,
 				Author:       strings.Split(*pr.CreatedBy.UniqueName, "@")[0], // Get the part before the @ in the email-address
+				URL:          getAzureDevOpsPRURL(pr),
 			})
 		}
 	}
@@ -121,6 +123,73 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (a *AzureDevOpsService) Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	sourceRefName := "refs/heads/" + opts.Branch
+	targetRefName := "refs/heads/" + opts.TargetBranch
+	pr, err := client.CreatePullRequest(ctx, git.CreatePullRequestArgs{
+		GitPullRequestToCreate: &git.GitPullRequest{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Title:         &opts.Title,
+			Description:   &opts.Body,
+		},
+		RepositoryId: &a.repo,
+		Project:      &a.project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	if pr.PullRequestId == nil {
+		return nil, errors.New("created pull request has no ID")
+	}
+
+	pullRequest := &PullRequest{
+		Number:       int64(*pr.PullRequestId),
+		Title:        opts.Title,
+		Branch:       opts.Branch,
+		TargetBranch: opts.TargetBranch,
+		Labels:       convertLabels(pr.Labels),
+		URL:          getAzureDevOpsPRURL(*pr),
+	}
+	if pr.LastMergeSourceCommit != nil && pr.LastMergeSourceCommit.CommitId != nil {
+		pullRequest.HeadSHA = *pr.LastMergeSourceCommit.CommitId
+	}
+	if pr.CreatedBy != nil && pr.CreatedBy.UniqueName != nil {
+		pullRequest.Author = strings.Split(*pr.CreatedBy.UniqueName, "@")[0]
+	}
+	return pullRequest, nil
+}
+
+func (a *AzureDevOpsService) Merged(ctx context.Context, number int64) (bool, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	id := int(number)
+	pr, err := client.GetPullRequestById(ctx, git.GetPullRequestByIdArgs{
+		PullRequestId: &id,
+		Project:       &a.project,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get pull request %d: %w", number, err)
+	}
+	return pr.Status != nil && *pr.Status == git.PullRequestStatusValues.Completed, nil
+}
+
+// getAzureDevOpsPRURL returns the web URL of the pull request, based on the web URL of its repository.
+func getAzureDevOpsPRURL(pr git.GitPullRequest) string {
+	if pr.Repository == nil || pr.Repository.WebUrl == nil || pr.PullRequestId == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", *pr.Repository.WebUrl, *pr.PullRequestId)
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
@@ -2,6 +2,10 @@ package pull_request
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
@@ -246,3 +250,94 @@ func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+// newAzureDevOpsTestServer returns an Azure DevOps server for the "org" organization, which serves the resource locations
+// the client looks up before calling the git API, and hands the git API requests to the given handler.
+func newAzureDevOpsTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/org/_apis", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodOptions, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		location := `{"id": %q, "area": %q, "resourceName": %q, "routeTemplate": %q, "minVersion": "1.0", "maxVersion": "7.1", "releasedVersion": "7.0", "resourceVersion": 1}`
+		_, err := io.WriteString(w, `{"count": 3, "value": [`+
+			fmt.Sprintf(location, "e81700f7-3be2-46de-8624-2eb35882fcaa", "Location", "ResourceAreas", "_apis/{resource}/{areaId}")+","+
+			fmt.Sprintf(location, "9946fd70-0d40-406e-b686-b4744cbbcc37", "git", "pullRequests", "{project}/_apis/git/repositories/{repositoryId}/pullRequests")+","+
+			fmt.Sprintf(location, "01a46dea-7d46-4d40-bc84-319e7c260d99", "git", "pullRequests", "{project}/_apis/git/pullRequests/{pullRequestId}")+
+			`]}`)
+		assert.NoError(t, err)
+	})
+	// on premise servers have no resource areas, so that the git API is served by the organization URL
+	mux.HandleFunc("/org/_apis/ResourceAreas", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, `{"count": 0, "value": []}`)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("/org/project/_apis/git/", handler)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestAzureDevOpsCreate(t *testing.T) {
+	ts := newAzureDevOpsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullRequests", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"sourceRefName":"refs/heads/env/dev-next"`)
+		assert.Contains(t, string(body), `"targetRefName":"refs/heads/env/dev"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = io.WriteString(w, `{
+			"pullRequestId": 7,
+			"title": "title",
+			"labels": [{"name": "label1"}],
+			"createdBy": {"uniqueName": "argocd@example.com"},
+			"lastMergeSourceCommit": {"commitId": "abc123"},
+			"repository": {"webUrl": "https://dev.azure.com/org/project/_git/repo"}
+		}`)
+		assert.NoError(t, err)
+	})
+
+	svc, err := NewAzureDevOpsService("", ts.URL, "org", "project", "repo", nil)
+	require.NoError(t, err)
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Number:       7,
+		Title:        "title",
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		HeadSHA:      "abc123",
+		Labels:       []string{"label1"},
+		Author:       "argocd",
+		URL:          "https://dev.azure.com/org/project/_git/repo/pullrequest/7",
+	}, pr)
+}
+
+func TestAzureDevOpsMerged(t *testing.T) {
+	ts := newAzureDevOpsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.URL.Path {
+		case "/org/project/_apis/git/pullRequests/1":
+			_, err = io.WriteString(w, `{"pullRequestId": 1, "status": "completed"}`)
+		case "/org/project/_apis/git/pullRequests/2":
+			_, err = io.WriteString(w, `{"pullRequestId": 2, "status": "abandoned"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		assert.NoError(t, err)
+	})
+
+	svc, err := NewAzureDevOpsService("", ts.URL, "org", "project", "repo", nil)
+	require.NoError(t, err)
+	merged, err := svc.Merged(t.Context(), 1)
+	require.NoError(t, err)
+	assert.True(t, merged)
+	merged, err = svc.Merged(t.Context(), 2)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/ktrysmt/go-bitbucket"
@@ -23,6 +24,16 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Links       BitbucketCloudPullRequestLinks       `json:"links"`
+	State       string                               `json:"state"`
+}
+
+type BitbucketCloudPullRequestLinks struct {
+	HTML BitbucketCloudPullRequestLink `json:"html"`
+}
+
+type BitbucketCloudPullRequestLink struct {
+	Href string `json:"href"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -162,8 +173,67 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 			TargetBranch: pull.Destination.Branch.Name,
 			HeadSHA:      pull.Source.Commit.Hash,
 			Author:       pull.Author.Nickname,
+			URL:          pull.Links.HTML.Href,
 		})
 	}
 
 	return pullRequests, nil
 }
+
+func (b *BitbucketCloudService) Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error) {
+	prOpts := &bitbucket.PullRequestsOptions{
+		Owner:             b.owner,
+		RepoSlug:          b.repositorySlug,
+		Title:             opts.Title,
+		Description:       opts.Body,
+		SourceBranch:      opts.Branch,
+		DestinationBranch: opts.TargetBranch,
+	}
+	response, err := b.client.Repositories.PullRequests.Create(prOpts.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.owner, b.repositorySlug, err)
+	}
+
+	jsonStr, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+
+	var pull BitbucketCloudPullRequest
+	if err := json.Unmarshal(jsonStr, &pull); err != nil {
+		return nil, fmt.Errorf("error unmarshalling json to type 'BitbucketCloudPullRequest': %w", err)
+	}
+
+	return &PullRequest{
+		Number:       int64(pull.ID),
+		Title:        pull.Title,
+		Branch:       pull.Source.Branch.Name,
+		TargetBranch: pull.Destination.Branch.Name,
+		HeadSHA:      pull.Source.Commit.Hash,
+		Author:       pull.Author.Nickname,
+		URL:          pull.Links.HTML.Href,
+	}, nil
+}
+
+func (b *BitbucketCloudService) Merged(ctx context.Context, number int64) (bool, error) {
+	prOpts := &bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		ID:       strconv.FormatInt(number, 10),
+	}
+	response, err := b.client.Repositories.PullRequests.Get(prOpts.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("error getting pull request %d for %s/%s: %w", number, b.owner, b.repositorySlug, err)
+	}
+
+	jsonStr, err := json.Marshal(response)
+	if err != nil {
+		return false, fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+
+	var pull BitbucketCloudPullRequest
+	if err := json.Unmarshal(jsonStr, &pull); err != nil {
+		return false, fmt.Errorf("error unmarshalling json to type 'BitbucketCloudPullRequest': %w", err)
+	}
+	return pull.State == "MERGED", nil
+}
@@ -518,3 +518,48 @@ func TestBitbucketCloudListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestCreatePullRequestCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repositories/OWNER/REPO/pullrequests/", r.RequestURI)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err := io.WriteString(w, `{
+				"id": 101,
+				"title": "title",
+				"source": {"branch": {"name": "env/dev-next"}, "commit": {"hash": "abc123"}},
+				"destination": {"branch": {"name": "env/dev"}},
+				"author": {"nickname": "argocd"},
+				"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/101"}}
+			}`)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+	require.NoError(t, err)
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), pr.Number)
+	assert.Equal(t, "env/dev-next", pr.Branch)
+	assert.Equal(t, "env/dev", pr.TargetBranch)
+	assert.Equal(t, "abc123", pr.HeadSHA)
+	assert.Equal(t, "argocd", pr.Author)
+	assert.Equal(t, "https://bitbucket.org/OWNER/REPO/pull-requests/101", pr.URL)
+}
+
+func TestMergedPullRequestCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/repositories/OWNER/REPO/pullrequests/101", r.RequestURI)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, `{"id": 101, "state": "DECLINED"}`)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+	require.NoError(t, err)
+	merged, err := svc.Merged(t.Context(), 101)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
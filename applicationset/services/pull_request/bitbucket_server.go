@@ -89,6 +89,7 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 				HeadSHA:      pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
 				Labels:       []string{},                // Not supported by library
 				Author:       pull.Author.User.Name,
+				URL:          getBitbucketPRURL(pull),
 			})
 		}
 
@@ -100,3 +101,58 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (b *BitbucketService) Create(_ context.Context, opts *CreateOptions) (*PullRequest, error) {
+	repository := bitbucketv1.Repository{
+		Slug:    b.repositorySlug,
+		Project: &bitbucketv1.Project{Key: b.projectKey},
+	}
+	response, err := b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+		Title:       opts.Title,
+		Description: opts.Body,
+		State:       "OPEN",
+		Open:        true,
+		FromRef:     bitbucketv1.PullRequestRef{ID: "refs/heads/" + opts.Branch, Repository: repository},
+		ToRef:       bitbucketv1.PullRequestRef{ID: "refs/heads/" + opts.TargetBranch, Repository: repository},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pullRequest := &PullRequest{
+		Number:       int64(pull.ID),
+		Title:        pull.Title,
+		Branch:       pull.FromRef.DisplayID,
+		TargetBranch: pull.ToRef.DisplayID,
+		HeadSHA:      pull.FromRef.LatestCommit,
+		Labels:       []string{},
+		URL:          getBitbucketPRURL(pull),
+	}
+	if pull.Author != nil {
+		pullRequest.Author = pull.Author.User.Name
+	}
+	return pullRequest, nil
+}
+
+func (b *BitbucketService) Merged(_ context.Context, number int64) (bool, error) {
+	response, err := b.client.DefaultApi.GetPullRequest(b.projectKey, b.repositorySlug, int(number))
+	if err != nil {
+		return false, fmt.Errorf("error getting pull request %d for %s/%s: %w", number, b.projectKey, b.repositorySlug, err)
+	}
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return false, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	return pull.State == "MERGED", nil
+}
+
+// getBitbucketPRURL returns the web URL of the Bitbucket Server pull request, if the server returned one.
+func getBitbucketPRURL(pull bitbucketv1.PullRequest) string {
+	if len(pull.Links.Self) == 0 {
+		return ""
+	}
+	return pull.Links.Self[0].Href
+}
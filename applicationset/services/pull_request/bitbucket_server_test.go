@@ -536,3 +536,52 @@ func TestBitbucketServerListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestBitbucketServerCreatePullRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests", r.RequestURI)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"id":"refs/heads/env/dev-next"`)
+		assert.Contains(t, string(body), `"id":"refs/heads/env/dev"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = io.WriteString(w, `{
+				"id": 101,
+				"title": "title",
+				"fromRef": {"id": "refs/heads/env/dev-next", "displayId": "env/dev-next", "latestCommit": "abc123"},
+				"toRef": {"id": "refs/heads/env/dev", "displayId": "env/dev"},
+				"author": {"user": {"name": "argocd"}},
+				"links": {"self": [{"href": "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/101"}]}
+			}`)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceNoAuth(t.Context(), ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), pr.Number)
+	assert.Equal(t, "env/dev-next", pr.Branch)
+	assert.Equal(t, "env/dev", pr.TargetBranch)
+	assert.Equal(t, "abc123", pr.HeadSHA)
+	assert.Equal(t, "argocd", pr.Author)
+	assert.Equal(t, "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/101", pr.URL)
+}
+
+func TestBitbucketServerMergedPullRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101", r.RequestURI)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, `{"id": 101, "state": "MERGED"}`)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceNoAuth(t.Context(), ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+	merged, err := svc.Merged(t.Context(), 101)
+	require.NoError(t, err)
+	assert.True(t, merged)
+}
@@ -21,3 +21,18 @@ func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError
 func (g *FakeService) List(_ context.Context) ([]*PullRequest, error) {
 	return g.listPullReuests, g.listError
 }
+
+func (g *FakeService) Create(_ context.Context, opts *CreateOptions) (*PullRequest, error) {
+	pullRequest := &PullRequest{
+		Number:       int64(len(g.listPullReuests) + 1),
+		Title:        opts.Title,
+		Branch:       opts.Branch,
+		TargetBranch: opts.TargetBranch,
+	}
+	g.listPullReuests = append(g.listPullReuests, pullRequest)
+	return pullRequest, nil
+}
+
+func (g *FakeService) Merged(_ context.Context, _ int64) (bool, error) {
+	return false, nil
+}
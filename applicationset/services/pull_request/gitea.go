@@ -3,6 +3,7 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
@@ -75,11 +76,51 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 			HeadSHA:      pr.Head.Sha,
 			Labels:       getGiteaPRLabelNames(pr.Labels),
 			Author:       pr.Poster.UserName,
+			URL:          pr.HTMLURL,
 		})
 	}
 	return list, nil
 }
 
+func (g *GiteaService) Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error) {
+	g.client.SetContext(ctx)
+	pr, _, err := g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+		Head:  opts.Branch,
+		Base:  opts.TargetBranch,
+		Title: opts.Title,
+		Body:  opts.Body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	pullRequest := &PullRequest{
+		Number: pr.Index,
+		Title:  pr.Title,
+		Labels: getGiteaPRLabelNames(pr.Labels),
+		URL:    pr.HTMLURL,
+	}
+	if pr.Head != nil {
+		pullRequest.Branch = pr.Head.Ref
+		pullRequest.HeadSHA = pr.Head.Sha
+	}
+	if pr.Base != nil {
+		pullRequest.TargetBranch = pr.Base.Ref
+	}
+	if pr.Poster != nil {
+		pullRequest.Author = pr.Poster.UserName
+	}
+	return pullRequest, nil
+}
+
+func (g *GiteaService) Merged(ctx context.Context, number int64) (bool, error) {
+	g.client.SetContext(ctx)
+	pr, _, err := g.client.GetPullRequest(g.owner, g.repo, number)
+	if err != nil {
+		return false, fmt.Errorf("error getting pull request %d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	return pr.HasMerged, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
@@ -371,3 +371,79 @@ func TestGiteaListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGiteaCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"1.17.0+dev-452-g1f0541780"}`))
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"head":"env/dev-next"`)
+		assert.Contains(t, string(body), `"base":"env/dev"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = io.WriteString(w, `{
+			"number": 2,
+			"title": "title",
+			"html_url": "https://gitea.com/test-argocd/pr-test/pulls/2",
+			"labels": [{"name": "label1"}],
+			"user": {"login": "argocd"},
+			"head": {"ref": "env/dev-next", "sha": "abc123"},
+			"base": {"ref": "env/dev"}
+		}`)
+		assert.NoError(t, err)
+	})
+
+	svc, err := NewGiteaService("", server.URL, "test-argocd", "pr-test", nil, false)
+	require.NoError(t, err)
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Number:       2,
+		Title:        "title",
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		HeadSHA:      "abc123",
+		Labels:       []string{"label1"},
+		Author:       "argocd",
+		URL:          "https://gitea.com/test-argocd/pr-test/pulls/2",
+	}, pr)
+}
+
+func TestGiteaMerged(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"1.17.0+dev-452-g1f0541780"}`))
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, `{"number": 1, "merged": true}`)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/pulls/2", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(w, `{"number": 2, "merged": false}`)
+		assert.NoError(t, err)
+	})
+
+	svc, err := NewGiteaService("", server.URL, "test-argocd", "pr-test", nil, false)
+	require.NoError(t, err)
+	merged, err := svc.Merged(t.Context(), 1)
+	require.NoError(t, err)
+	assert.True(t, merged)
+	merged, err = svc.Merged(t.Context(), 2)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
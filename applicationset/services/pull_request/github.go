@@ -83,6 +83,7 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      *pull.Head.SHA,
 				Labels:       getGithubPRLabelNames(pull.Labels),
 				Author:       *pull.User.Login,
+				URL:          pull.GetHTMLURL(),
 			})
 		}
 		if resp.NextPage == 0 {
@@ -93,6 +94,36 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error) {
+	pull, _, err := g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+		Title: &opts.Title,
+		Body:  &opts.Body,
+		Head:  &opts.Branch,
+		Base:  &opts.TargetBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	return &PullRequest{
+		Number:       int64(pull.GetNumber()),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       getGithubPRLabelNames(pull.Labels),
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}, nil
+}
+
+func (g *GithubService) Merged(ctx context.Context, number int64) (bool, error) {
+	merged, _, err := g.client.PullRequests.IsMerged(ctx, g.owner, g.repo, int(number))
+	if err != nil {
+		return false, fmt.Errorf("error getting pull request %d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	return merged, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
@@ -1,6 +1,7 @@
 package pull_request
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -115,3 +116,67 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body github.NewPullRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "title", body.GetTitle())
+		assert.Equal(t, "body", body.GetBody())
+		assert.Equal(t, "env/dev-next", body.GetHead())
+		assert.Equal(t, "env/dev", body.GetBase())
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"number": 42,
+			"title": "title",
+			"html_url": "https://github.com/owner/repo/pull/42",
+			"head": {"ref": "env/dev-next", "sha": "abc123"},
+			"base": {"ref": "env/dev"},
+			"user": {"login": "argocd"}
+		}`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", nil, nil)
+	require.NoError(t, err)
+
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Number:       42,
+		Title:        "title",
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		HeadSHA:      "abc123",
+		Author:       "argocd",
+		URL:          "https://github.com/owner/repo/pull/42",
+	}, pr)
+}
+
+func TestGitHubMerged(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls/42/merge", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls/43/merge", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", nil, nil)
+	require.NoError(t, err)
+
+	merged, err := svc.Merged(t.Context(), 42)
+	require.NoError(t, err)
+	assert.True(t, merged)
+	merged, err = svc.Merged(t.Context(), 43)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
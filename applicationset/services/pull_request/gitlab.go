@@ -96,6 +96,7 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      mr.SHA,
 				Labels:       mr.Labels,
 				Author:       mr.Author.Username,
+				URL:          mr.WebURL,
 			})
 		}
 		if resp.NextPage == 0 {
@@ -105,3 +106,36 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error) {
+	mr, _, err := g.client.MergeRequests.CreateMergeRequest(g.project, &gitlab.CreateMergeRequestOptions{
+		Title:        &opts.Title,
+		Description:  &opts.Body,
+		SourceBranch: &opts.Branch,
+		TargetBranch: &opts.TargetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+	}
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest, nil
+}
+
+func (g *GitLabService) Merged(ctx context.Context, number int64) (bool, error) {
+	mr, _, err := g.client.MergeRequests.GetMergeRequest(g.project, number, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("error getting merge request %d for project '%s': %w", number, g.project, err)
+	}
+	return mr.State == "merged", nil
+}
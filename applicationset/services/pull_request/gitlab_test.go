@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"io"
 	"net/http"
@@ -217,3 +218,65 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "title", body["title"])
+		assert.Equal(t, "body", body["description"])
+		assert.Equal(t, "env/dev-next", body["source_branch"])
+		assert.Equal(t, "env/dev", body["target_branch"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"iid": 42,
+			"title": "title",
+			"source_branch": "env/dev-next",
+			"target_branch": "env/dev",
+			"sha": "abc123",
+			"web_url": "https://gitlab.com/group/project/-/merge_requests/42",
+			"author": {"username": "argocd"}
+		}`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+	require.NoError(t, err)
+
+	pr, err := svc.Create(t.Context(), &CreateOptions{Title: "title", Body: "body", Branch: "env/dev-next", TargetBranch: "env/dev"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), pr.Number)
+	assert.Equal(t, "env/dev-next", pr.Branch)
+	assert.Equal(t, "env/dev", pr.TargetBranch)
+	assert.Equal(t, "abc123", pr.HeadSHA)
+	assert.Equal(t, "argocd", pr.Author)
+	assert.Equal(t, "https://gitlab.com/group/project/-/merge_requests/42", pr.URL)
+}
+
+func TestGitLabMerged(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/42", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = w.Write([]byte(`{"iid": 42, "state": "merged"}`))
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/43", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"iid": 43, "state": "closed"}`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+	require.NoError(t, err)
+
+	merged, err := svc.Merged(t.Context(), 42)
+	require.NoError(t, err)
+	assert.True(t, merged)
+	merged, err = svc.Merged(t.Context(), 43)
+	require.NoError(t, err)
+	assert.False(t, merged)
+}
@@ -21,11 +21,29 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request.
+	URL string
+}
+
+// CreateOptions holds the details of a pull request to be opened.
+type CreateOptions struct {
+	// Title of the pull request.
+	Title string
+	// Body is the description of the pull request.
+	Body string
+	// Branch is the name of the branch the pull request merges from.
+	Branch string
+	// TargetBranch is the name of the branch the pull request merges into.
+	TargetBranch string
 }
 
 type PullRequestService interface {
 	// List gets a list of pull requests.
 	List(ctx context.Context) ([]*PullRequest, error)
+	// Create opens a pull request.
+	Create(ctx context.Context, opts *CreateOptions) (*PullRequest, error)
+	// Merged returns whether the pull request with the given number was merged.
+	Merged(ctx context.Context, number int64) (bool, error)
 }
 
 type Filter struct {
@@ -16,6 +16,7 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/commitserver"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/askpass"
@@ -33,6 +34,11 @@ func NewCommand() *cobra.Command {
 		listenPort  int
 		metricsPort int
 		metricsHost string
+
+		signingKeyFormat string
+		signingKeyPath   string
+
+		validationConfigPath string
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
@@ -57,7 +63,14 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			signingOpts, cleanupSigningOpts, err := commit.NewCommitSigningOpts(signingKeyFormat, signingKeyPath)
+			errors.CheckError(err)
+			defer cleanupSigningOpts()
+
+			manifestValidator, err := commit.NewManifestValidator(validationConfigPath)
+			errors.CheckError(err)
+
+			server := commitserver.NewServer(askPassServer, metricsServer, signingOpts, manifestValidator)
 			grpc := server.CreateGRPC()
 			ctx := cmd.Context()
 
@@ -114,6 +127,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyFormat, "signing-key-format", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_FORMAT", ""), "Format of the key used to sign hydrated commits. One of: openpgp|ssh. Commits are not signed if empty")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", "/app/config/signing/signing.key"), "Path to the private key used to sign hydrated commits")
+	command.Flags().StringVar(&validationConfigPath, "validation-config-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_VALIDATION_CONFIG_PATH", ""), "Path to the config of the stages validating hydrated manifests before they are committed. Manifests are not validated if empty")
 
 	return command
 }
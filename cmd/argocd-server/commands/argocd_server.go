@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -70,6 +71,7 @@ func NewCommand() *cobra.Command {
 		baseHRef                 string
 		rootPath                 string
 		repoServerAddress        string
+		commitServerAddress      string
 		dexServerAddress         string
 		disableAuth              bool
 		contentTypes             string
@@ -230,6 +232,7 @@ func NewCommand() *cobra.Command {
 				KubeClientset:           kubeclientset,
 				AppClientset:            appClientSet,
 				RepoClientset:           repoclientset,
+				CommitClientset:         commitclient.NewCommitServerClientset(commitServerAddress),
 				DexServerAddr:           dexServerAddress,
 				DexTLSConfig:            dexTLSConfig,
 				DisableAuth:             disableAuth,
@@ -303,6 +306,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_SERVER_LOG_LEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER", common.DefaultRepoServerAddr), "Repo server address")
+	command.Flags().StringVar(&commitServerAddress, "commit-server", env.StringFromEnv("ARGOCD_SERVER_COMMIT_SERVER", common.DefaultCommitServerAddr), "Commit server address. Only used to preview hydration if the hydrator is enabled")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", env.StringFromEnv("ARGOCD_SERVER_DEX_SERVER", common.DefaultDexServerAddr), "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", env.ParseBoolFromEnv("ARGOCD_SERVER_DISABLE_AUTH", false), "Disable client authentication")
 	command.Flags().StringVar(&contentTypes, "api-content-types", env.StringFromEnv("ARGOCD_API_CONTENT_TYPES", "application/json", env.StringFromEnvOpts{AllowEmpty: true}), "Semicolon separated list of allowed content types for non GET api requests. Any content type is allowed if empty.")
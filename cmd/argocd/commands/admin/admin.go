@@ -75,6 +75,7 @@ $ argocd admin initial-password reset
 	command.AddCommand(NewSettingsCommand())
 	command.AddCommand(NewAppCommand(clientOpts))
 	command.AddCommand(NewRepoCommand())
+	command.AddCommand(NewHydratorCommand())
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
 	command.AddCommand(NewDashboardCommand(clientOpts))
@@ -3,9 +3,11 @@ package admin
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -15,6 +17,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -80,48 +83,11 @@ type ClusterWithInfo struct {
 }
 
 func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
-	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
-
-	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
-	clustersList, err := argoDB.ListClusters(ctx)
-	if err != nil {
-		return nil, err
-	}
-	appItems, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	argoDB, clustersList, appItems, err := loadClustersInfo(ctx, kubeClient, appClient, namespace, portForwardRedis, cacheSrc, redisName, redisHaProxyName, redisCompressionStr)
 	if err != nil {
 		return nil, err
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
-	clusterShardingCache.Init(clustersList, appItems)
-	clusterShards := clusterShardingCache.GetDistribution()
-
-	var cache *appstatecache.Cache
-	if portForwardRedis {
-		overrides := clientcmd.ConfigOverrides{}
-		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
-		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
-		port, err := kubeutil.PortForward(6379, namespace, &overrides,
-			redisHaProxyPodLabelSelector, redisPodLabelSelector)
-		if err != nil {
-			return nil, err
-		}
-
-		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
-		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
-			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
-		}
-		client := redis.NewClient(redisOptions)
-		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
-		if err != nil {
-			return nil, err
-		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
-	} else {
-		cache, err = cacheSrc()
-		if err != nil {
-			return nil, err
-		}
-	}
+	clusterShards := distributeClusters(argoDB, clustersList, appItems, replicas, shardingAlgorithm)
 
 	apps := appItems.Items
 	clusters := make([]ClusterWithInfo, len(clustersList.Items))
@@ -160,7 +126,6 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 			for ns := range nsSet {
 				namespaces = append(namespaces, ns)
 			}
-			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
 			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
 			return nil
 		})
@@ -168,6 +133,70 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	return clusters, nil
 }
 
+// loadClustersInfo returns the clusters, along with the info the controllers cached about them, and the applications.
+func loadClustersInfo(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), redisName string, redisHaProxyName string, redisCompressionStr string) (db.ArgoDB, *v1alpha1.ClusterList, *v1alpha1.ApplicationList, error) {
+	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+
+	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+	clustersList, err := argoDB.ListClusters(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	appItems, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var cache *appstatecache.Cache
+	if portForwardRedis {
+		overrides := clientcmd.ConfigOverrides{}
+		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
+		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
+		port, err := kubeutil.PortForward(6379, namespace, &overrides,
+			redisHaProxyPodLabelSelector, redisPodLabelSelector)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
+		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
+			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+		}
+		client := redis.NewClient(redisOptions)
+		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
+	} else {
+		cache, err = cacheSrc()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	_ = kube.RunAllAsync(len(clustersList.Items), func(i int) error {
+		_ = cache.GetClusterInfo(clustersList.Items[i].Server, &clustersList.Items[i].Info)
+		return nil
+	})
+	return argoDB, clustersList, appItems, nil
+}
+
+// distributeClusters returns the shard of the clusters by server as distributed by the given sharding algorithm,
+// using the info cached about the clusters for the resource-weighted algorithm.
+func distributeClusters(argoDB db.ArgoDB, clustersList *v1alpha1.ClusterList, appItems *v1alpha1.ApplicationList, replicas int, shardingAlgorithm string) map[string]int {
+	infoByServer := make(map[string]*v1alpha1.ClusterInfo, len(clustersList.Items))
+	for i := range clustersList.Items {
+		infoByServer[clustersList.Items[i].Server] = &clustersList.Items[i].Info
+	}
+	clusterShardingCache := sharding.NewClusterSharding(argoDB, 0, replicas, shardingAlgorithm)
+	clusterShardingCache.Init(clustersList, appItems)
+	clusterShardingCache.UpdateClusterWeights(func(server string) (*v1alpha1.ClusterInfo, error) {
+		return infoByServer[server], nil
+	})
+	return clusterShardingCache.GetDistribution()
+}
+
 func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, appControllerName string) (int, error) {
 	appControllerPodLabelSelector := common.LabelKeyAppName + "=" + appControllerName
 	controllerPods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
@@ -187,10 +216,18 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 		clientConfig      clientcmd.ClientConfig
 		cacheSrc          func() (*appstatecache.Cache, error)
 		portForwardRedis  bool
+		simulate          bool
 	)
 	command := cobra.Command{
 		Use:   "shards",
 		Short: "Print information about each controller shard and the estimated portion of Kubernetes resources it is responsible for.",
+		Example: `
+# Display the portion of Kubernetes resources each controller shard is responsible for
+argocd admin cluster shards
+
+# Simulate how the clusters would be distributed with the resource-weighted sharding method, compared to the sharding
+# method configured in the argocd-cmd-params-cm ConfigMap
+argocd admin cluster shards --simulate --sharding-method resource-weighted`,
 		Run: func(cmd *cobra.Command, _ []string) {
 			ctx := cmd.Context()
 
@@ -210,6 +247,17 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			if replicas == 0 {
 				return
 			}
+			if simulate {
+				currentShardingAlgorithm, err := getShardingAlgorithm(ctx, kubeClient, namespace)
+				errors.CheckError(err)
+				argoDB, clustersList, appItems, err := loadClustersInfo(ctx, kubeClient, appClient, namespace, portForwardRedis, cacheSrc, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+				errors.CheckError(err)
+				currentShards := distributeClusters(argoDB, clustersList, appItems, replicas, currentShardingAlgorithm)
+				simulatedShards := distributeClusters(argoDB, clustersList, appItems, replicas, shardingAlgorithm)
+				_, _ = fmt.Fprintf(os.Stdout, "Simulating sharding method %s against current sharding method %s with %d replicas\n\n", shardingAlgorithm, currentShardingAlgorithm, replicas)
+				printShardsSimulation(os.Stdout, clustersList.Items, currentShards, simulatedShards, replicas)
+				return
+			}
 			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
 			errors.CheckError(err)
 			if len(clusters) == 0 {
@@ -222,8 +270,9 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	command.Flags().BoolVar(&simulate, "simulate", false, "Compare the distribution of the sharding method with the one of the sharding method configured in the argocd-cmd-params-cm ConfigMap, without changing it")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -253,6 +302,77 @@ func printStatsSummary(clusters []ClusterWithInfo) {
 	_ = w.Flush()
 }
 
+// getShardingAlgorithm returns the sharding algorithm configured for the application controller
+func getShardingAlgorithm(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (string, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDCmdParamsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.DefaultShardingAlgorithm, nil
+		}
+		return "", fmt.Errorf("error getting the %s ConfigMap: %w", common.ArgoCDCmdParamsConfigMapName, err)
+	}
+	if algorithm := cm.Data["controller.sharding.algorithm"]; algorithm != "" {
+		return algorithm, nil
+	}
+	return common.DefaultShardingAlgorithm, nil
+}
+
+// printShardsSimulation prints the load of each shard with the current and the simulated distribution of the clusters,
+// followed by the clusters which would move to another shard.
+func printShardsSimulation(out io.Writer, clusters []v1alpha1.Cluster, currentShards, simulatedShards map[string]int, replicas int) {
+	currentClusters := make([]int, replicas)
+	currentResources := make([]int64, replicas)
+	simulatedClusters := make([]int, replicas)
+	simulatedResources := make([]int64, replicas)
+	var totalResources int64
+	var moved []v1alpha1.Cluster
+	for _, c := range clusters {
+		resources := c.Info.CacheInfo.ResourcesCount
+		totalResources += resources
+		current, simulated := currentShards[c.Server], simulatedShards[c.Server]
+		if current >= 0 && current < replicas {
+			currentClusters[current]++
+			currentResources[current] += resources
+		}
+		if simulated >= 0 && simulated < replicas {
+			simulatedClusters[simulated]++
+			simulatedResources[simulated] += resources
+		}
+		if current != simulated {
+			moved = append(moved, c)
+		}
+	}
+	avgResources := float64(totalResources) / float64(replicas)
+	formatResources := func(count int64) string {
+		if avgResources == 0 {
+			return strconv.FormatInt(count, 10)
+		}
+		return fmt.Sprintf("%d (%.0f%%)", count, float64(count)/avgResources*100.0)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SHARD\tCURRENT CLUSTERS\tCURRENT RESOURCES\tSIMULATED CLUSTERS\tSIMULATED RESOURCES\n")
+	for shard := 0; shard < replicas; shard++ {
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%s\n", shard, currentClusters[shard], formatResources(currentResources[shard]), simulatedClusters[shard], formatResources(simulatedResources[shard]))
+	}
+	_ = w.Flush()
+
+	if len(moved) == 0 {
+		_, _ = fmt.Fprintf(out, "\nNo cluster would move to another shard\n")
+		return
+	}
+	sort.Slice(moved, func(i, j int) bool {
+		return moved[i].Server < moved[j].Server
+	})
+	_, _ = fmt.Fprintf(out, "\n")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tRESOURCES COUNT\tCURRENT SHARD\tSIMULATED SHARD\n")
+	for _, c := range moved {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", c.Server, c.Info.CacheInfo.ResourcesCount, currentShards[c.Server], simulatedShards[c.Server])
+	}
+	_ = w.Flush()
+}
+
 func runClusterNamespacesCommand(ctx context.Context, clientConfig clientcmd.ClientConfig, action func(appClient *versioned.Clientset, argoDB db.ArgoDB, clusters map[string][]string) error) error {
 	clientCfg, err := clientConfig.ClientConfig()
 	if err != nil {
@@ -509,7 +629,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -678,6 +798,9 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 			if clusterOpts.Shard >= 0 {
 				clst.Shard = &clusterOpts.Shard
 			}
+			if clusterOpts.SyncConcurrencyLimit >= 0 {
+				clst.SyncConcurrencyLimit = &clusterOpts.SyncConcurrencyLimit
+			}
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, ArgoCDNamespace)
 			argoDB := db.NewDB(ArgoCDNamespace, settingsMgr, kubeClientset)
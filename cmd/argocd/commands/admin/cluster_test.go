@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -79,3 +80,47 @@ func Test_loadClusters(t *testing.T) {
 	}}
 	assert.Equal(t, expected, clusters)
 }
+
+func Test_printShardsSimulation(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://cluster-01", Info: v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 300}}},
+		{Server: "https://cluster-02", Info: v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 50}}},
+		{Server: "https://cluster-03", Info: v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 50}}},
+	}
+
+	t.Run("clusters moved", func(t *testing.T) {
+		var out bytes.Buffer
+		printShardsSimulation(&out, clusters,
+			map[string]int{"https://cluster-01": 0, "https://cluster-02": 0, "https://cluster-03": 1},
+			map[string]int{"https://cluster-01": 0, "https://cluster-02": 1, "https://cluster-03": 1}, 2)
+		assert.Equal(t, `SHARD  CURRENT CLUSTERS  CURRENT RESOURCES  SIMULATED CLUSTERS  SIMULATED RESOURCES
+0      2                 350 (175%)         1                   300 (150%)
+1      1                 50 (25%)           2                   100 (50%)
+
+SERVER              RESOURCES COUNT  CURRENT SHARD  SIMULATED SHARD
+https://cluster-02  50               0              1
+`, out.String())
+	})
+
+	t.Run("no cluster moved", func(t *testing.T) {
+		var out bytes.Buffer
+		shards := map[string]int{"https://cluster-01": 0, "https://cluster-02": 1, "https://cluster-03": 1}
+		printShardsSimulation(&out, clusters, shards, shards, 2)
+		assert.Contains(t, out.String(), "No cluster would move to another shard")
+	})
+}
+
+func Test_getShardingAlgorithm(t *testing.T) {
+	ctx := t.Context()
+	algorithm, err := getShardingAlgorithm(ctx, fake.NewClientset(), "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", algorithm)
+
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cmd-params-cm", Namespace: "argocd"},
+		Data:       map[string]string{"controller.sharding.algorithm": "round-robin"},
+	})
+	algorithm, err = getShardingAlgorithm(ctx, kubeClient, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "round-robin", algorithm)
+}
@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// NewHydratorCommand returns a new instance of the argocd admin hydrator command
+func NewHydratorCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "hydrator",
+		Short: "Manage the output of the source hydrator",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewHydratorVerifyCommand())
+	return command
+}
+
+// NewHydratorVerifyCommand returns a new instance of the argocd admin hydrator verify command
+func NewHydratorVerifyCommand() *cobra.Command {
+	var (
+		repoURL string
+		drySHA  string
+	)
+	command := &cobra.Command{
+		Use:   "verify PATH",
+		Short: "Verify the manifests of a hydrated path against its provenance",
+		Long: `Verify the manifests of a hydrated path against the hydrator.provenance file the source hydrator wrote next to them.
+
+The command fails if a manifest is missing, was modified, or was added after the hydration, or if the provenance doesn't
+match the expected dry source repository or commit.`,
+		Example: `  # Verify the manifests of a hydrated path in a checkout of the hydrated branch
+  argocd admin hydrator verify ./guestbook
+
+  # Also verify the manifests were hydrated from the given dry commit
+  argocd admin hydrator verify ./guestbook --repo-url https://github.com/argoproj/argocd-example-apps --dry-sha 3ff41cc5247197a6caf50216c4c76cc29d78a97d`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			verified, err := verifyHydratedPath(args[0], repoURL, drySHA, c.OutOrStdout())
+			errors.CheckError(err)
+			if !verified {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&repoURL, "repo-url", "", "Expected URL of the dry source repository")
+	command.Flags().StringVar(&drySHA, "dry-sha", "", "Expected SHA of the dry commit")
+	return command
+}
+
+// verifyHydratedPath verifies the hydrated path dir against its provenance, and against the expected repo URL and dry
+// SHA if they are not empty. It prints the provenance and the problems found to out, and returns true if there are none.
+func verifyHydratedPath(dir, expectedRepoURL, expectedDrySHA string, out io.Writer) (bool, error) {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return false, fmt.Errorf("failed to open %q: %w", dir, err)
+	}
+	defer utilio.Close(root)
+
+	statement, problems, err := hydrator.VerifyProvenance(root, ".")
+	if err != nil {
+		return false, err
+	}
+
+	repoURL, drySHA := statement.DryRevision()
+	if expectedRepoURL != "" && repoURL != expectedRepoURL {
+		problems = append(problems, fmt.Sprintf("dry source repository %q does not match %q", repoURL, expectedRepoURL))
+	}
+	if expectedDrySHA != "" && drySHA != expectedDrySHA {
+		problems = append(problems, fmt.Sprintf("dry SHA %q does not match %q", drySHA, expectedDrySHA))
+	}
+
+	_, _ = fmt.Fprintf(out, "Repo URL:  %s\n", repoURL)
+	_, _ = fmt.Fprintf(out, "Dry SHA:   %s\n", drySHA)
+	versions := statement.Predicate.RunDetails.Builder.Version
+	tools := make([]string, 0, len(versions))
+	for tool := range versions {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+	for _, tool := range tools {
+		_, _ = fmt.Fprintf(out, "Version:   %s %s\n", tool, versions[tool])
+	}
+	_, _ = fmt.Fprintf(out, "Manifests: %d\n", len(statement.Subject))
+
+	if len(problems) > 0 {
+		_, _ = fmt.Fprintln(out, "Verification failed:")
+		for _, problem := range problems {
+			_, _ = fmt.Fprintf(out, "  %s\n", problem)
+		}
+		return false, nil
+	}
+	_, _ = fmt.Fprintln(out, "Verified")
+	return true, nil
+}
@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func writeHydratedPath(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+	root, err := os.OpenRoot(dir)
+	require.NoError(t, err)
+	defer utilio.Close(root)
+	subjects, err := hydrator.DigestFiles(root, ".", []string{"manifest.yaml"})
+	require.NoError(t, err)
+	metadata := hydrator.HydratorCommitMetadata{RepoURL: "https://github.com/argoproj/argocd-example-apps", DrySHA: "abc123"}
+	require.NoError(t, hydrator.WriteProvenance(root, ".", hydrator.NewProvenance(metadata, nil, nil, map[string]string{"helm": "v3.18.4"}, subjects)))
+	return dir
+}
+
+func TestVerifyHydratedPath(t *testing.T) {
+	t.Run("verified", func(t *testing.T) {
+		dir := writeHydratedPath(t)
+
+		var out bytes.Buffer
+		verified, err := verifyHydratedPath(dir, "https://github.com/argoproj/argocd-example-apps", "abc123", &out)
+		require.NoError(t, err)
+		assert.True(t, verified)
+		assert.Contains(t, out.String(), "Dry SHA:   abc123\n")
+		assert.Contains(t, out.String(), "Version:   helm v3.18.4\n")
+		assert.Contains(t, out.String(), "Verified\n")
+	})
+
+	t.Run("modified manifest", func(t *testing.T) {
+		dir := writeHydratedPath(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte("kind: Secret\n"), 0o644))
+
+		var out bytes.Buffer
+		verified, err := verifyHydratedPath(dir, "", "", &out)
+		require.NoError(t, err)
+		assert.False(t, verified)
+		assert.Contains(t, out.String(), "manifest.yaml: sha256 digest")
+	})
+
+	t.Run("unexpected dry SHA", func(t *testing.T) {
+		dir := writeHydratedPath(t)
+
+		var out bytes.Buffer
+		verified, err := verifyHydratedPath(dir, "", "def456", &out)
+		require.NoError(t, err)
+		assert.False(t, verified)
+		assert.Contains(t, out.String(), `dry SHA "abc123" does not match "def456"`)
+	})
+
+	t.Run("no provenance", func(t *testing.T) {
+		var out bytes.Buffer
+		_, err := verifyHydratedPath(t.TempDir(), "", "", &out)
+		require.ErrorContains(t, err, "failed to read hydrator provenance")
+	})
+}
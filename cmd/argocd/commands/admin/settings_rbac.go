@@ -88,6 +88,7 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionAction:   rbacTrait{allowPath: true},
 	rbac.ActionOverride: rbacTrait{},
 	rbac.ActionSync:     rbacTrait{},
+	rbac.ActionApprove:  rbacTrait{},
 }
 
 var accountsActions = actionTraitMap{
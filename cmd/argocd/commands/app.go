@@ -96,12 +96,15 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateRollbackCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationGetResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
+	command.AddCommand(NewApplicationAdoptCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
@@ -2458,6 +2461,58 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.AddCommand(NewApplicationSyncApproveCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSyncApproveCommand returns a new instance of an `argocd app sync approve` command
+func NewApplicationSyncApproveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		phase        string
+		wave         int64
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "approve APPNAME",
+		Short: "Approve the sync wave the running operation of an application is waiting for",
+		Example: `  # Approve the sync wave my-app is waiting for
+  argocd app sync approve my-app
+
+  # Approve the sync wave my-app is waiting for, only if it is wave 2 of the Sync phase
+  argocd app sync approve my-app --phase Sync --wave 2`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			approveReq := application.ApplicationApproveSyncRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			}
+			if c.Flags().Changed("phase") {
+				approveReq.Phase = &phase
+			}
+			if c.Flags().Changed("wave") {
+				approveReq.Wave = &wave
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			app, err := appIf.ApproveSync(ctx, &approveReq)
+			errors.CheckError(err)
+			if app.Status.OperationState != nil && app.Status.OperationState.PendingApproval != nil {
+				approval := app.Status.OperationState.PendingApproval
+				fmt.Printf("Approved wave %d of phase %s of application '%s'\n", approval.Wave, approval.Phase, appName)
+			} else {
+				fmt.Printf("Application '%s' sync approved\n", appName)
+			}
+		},
+	}
+	command.Flags().StringVar(&phase, "phase", "", "Only approve if the operation is waiting for approval of a wave of this sync phase")
+	command.Flags().Int64Var(&wave, "wave", 0, "Only approve if the operation is waiting for approval of this wave")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
 	return command
 }
 
@@ -2981,11 +3036,32 @@ func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	_ = w.Flush()
 }
 
+// printHydrateHistoryTable prints a table of the hydrate history of an application
+func printHydrateHistoryTable(w io.Writer, hydrateHistory []argoappv1.HydrateHistory) {
+	shortSHA := func(sha string) string {
+		if len(sha) > 7 {
+			return sha[:7]
+		}
+		return sha
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "ID\tDATE\tPHASE\tDRY REVISION\tHYDRATED REVISION\tMESSAGE\n")
+	for _, h := range hydrateHistory {
+		date := h.StartedAt
+		if h.FinishedAt != nil {
+			date = *h.FinishedAt
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", h.ID, date.String(), h.Phase, shortSHA(h.DrySHA), shortSHA(h.HydratedSHA), h.Message)
+	}
+	_ = tw.Flush()
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		output       string
 		appNamespace string
+		hydrate      bool
 	)
 	command := &cobra.Command{
 		Use:   "history APPNAME",
@@ -3006,15 +3082,23 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			})
 			errors.CheckError(err)
 
-			if output == "id" {
+			switch {
+			case hydrate && output == "id":
+				for _, h := range app.Status.SourceHydrator.History {
+					fmt.Println(h.ID)
+				}
+			case hydrate:
+				printHydrateHistoryTable(os.Stdout, app.Status.SourceHydrator.History)
+			case output == "id":
 				printApplicationHistoryIDs(app.Status.History)
-			} else {
+			default:
 				printApplicationHistoryTable(app.Status.History)
 			}
 		},
 	}
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show application deployment history in namespace")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
+	command.Flags().BoolVar(&hydrate, "hydrate", false, "Show the hydrate history of the source hydrator instead of the deployment history")
 	return command
 }
 
@@ -3285,6 +3369,159 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		dryRun   bool
+		revision string
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Hydrate the manifests of an application using the source hydrator",
+		Example: templates.Examples(`
+  # Request hydration of the application at the target revision of its dry source
+  argocd app hydrate my-app
+
+  # Show which hydrated files would change, without committing them
+  argocd app hydrate my-app --dry-run
+
+  # Show which hydrated files would change if a specific dry revision was hydrated
+  argocd app hydrate my-app --dry-run --revision my-feature-branch
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if revision != "" && !dryRun {
+				errors.Fatal(errors.ErrorGeneric, "--revision can only be used together with --dry-run")
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			if !dryRun {
+				// Refreshing an application through the API server also requests its hydration.
+				_, err := appIf.Get(ctx, &application.ApplicationQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					Refresh:      getRefreshType(true, false),
+				})
+				errors.CheckError(err)
+				fmt.Printf("Application '%s' hydration requested\n", appName)
+				return
+			}
+
+			resp, err := appIf.HydratePreview(ctx, &application.ApplicationHydratePreviewQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Revision:     &revision,
+			})
+			errors.CheckError(err)
+			printHydratePreview(os.Stdout, resp)
+		},
+	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the diff of the hydrated files against the hydrated branch, and the orphaned hydrated paths that would be removed, instead of committing them")
+	command.Flags().StringVar(&revision, "revision", "", "Revision of the dry source to hydrate. Defaults to the target revision of the dry source. Requires --dry-run")
+	return command
+}
+
+// printHydratePreview prints the diff of each hydrated file that would change, and the orphaned paths that would be removed
+func printHydratePreview(w io.Writer, resp *application.ApplicationHydratePreviewResponse) {
+	if len(resp.Files) == 0 {
+		_, _ = fmt.Fprintf(w, "No hydrated files would change for dry revision %s\n", resp.GetDrySha())
+	} else {
+		_, _ = fmt.Fprintf(w, "Hydrating dry revision %s would change %d file(s):\n", resp.GetDrySha(), len(resp.Files))
+		for _, file := range resp.Files {
+			_, _ = fmt.Fprintf(w, "\n%s\n", strings.TrimSuffix(file.GetDiff(), "\n"))
+		}
+	}
+	if len(resp.OrphanedPaths) > 0 {
+		_, _ = fmt.Fprintf(w, "\nThe following hydrated paths are no longer hydrated by any application and would be removed:\n")
+		for _, path := range resp.OrphanedPaths {
+			_, _ = fmt.Fprintf(w, "  %s\n", path)
+		}
+	}
+}
+
+// findHydrateHistory returns the hydrate history entry with the given ID. If the ID is -1, the successful hydrate
+// operation preceding the most recent successful one is returned.
+func findHydrateHistory(app *argoappv1.Application, historyID int64) (*argoappv1.HydrateHistory, error) {
+	if historyID == -1 {
+		var successful []argoappv1.HydrateHistory
+		for _, h := range app.Status.SourceHydrator.History {
+			if h.Phase == argoappv1.HydrateOperationPhaseHydrated {
+				successful = append(successful, h)
+			}
+		}
+		if len(successful) < 2 {
+			return nil, fmt.Errorf("application '%s' should have at least two successful hydrations", app.Name)
+		}
+		return &successful[len(successful)-2], nil
+	}
+	if h := app.Status.SourceHydrator.FindHydrateHistory(historyID); h != nil {
+		return h, nil
+	}
+	return nil, fmt.Errorf("application '%s' does not have hydration id '%d' in hydrate history", app.Name, historyID)
+}
+
+// NewApplicationHydrateRollbackCommand returns a new instance of an `argocd app hydrate-rollback` command
+func NewApplicationHydrateRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appNamespace string
+	command := &cobra.Command{
+		Use:   "hydrate-rollback APPNAME [ID]",
+		Short: "Restore the hydrated manifests of an application to a previous hydration by hydrate history ID, omitted will restore the previous hydration",
+		Example: templates.Examples(`
+  # List the hydrate history of the application
+  argocd app history my-app --hydrate
+
+  # Restore the hydrated manifests of the previous hydration
+  argocd app hydrate-rollback my-app
+
+  # Restore the hydrated manifests of a specific hydration
+  argocd app hydrate-rollback my-app 3
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) == 0 || len(args) > 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			var err error
+			historyID := -1
+			if len(args) > 1 {
+				historyID, err = strconv.Atoi(args[1])
+				errors.CheckError(err)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			hydrateInfo, err := findHydrateHistory(app, int64(historyID))
+			errors.CheckError(err)
+
+			_, err = appIf.HydrateRollback(ctx, &application.ApplicationHydrateRollbackRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           ptr.To(hydrateInfo.ID),
+			})
+			errors.CheckError(err)
+			// The rollback is recorded in the hydrate history by the application controller, shortly after.
+			fmt.Printf("Application '%s' hydrated manifests restored to hydration %d\n", appName, hydrateInfo.ID)
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Rollback application in namespace")
+	return command
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
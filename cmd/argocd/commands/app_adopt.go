@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationAdoptCommand returns a new instance of an `argocd app adopt` command
+func NewApplicationAdoptCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		resources    []string
+		all          bool
+		dryRun       bool
+		export       string
+		project      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "adopt APPNAME",
+		Short: "Adopt orphaned resources into an application",
+		Long:  "Adopt orphaned resources into an application by setting the application tracking label or annotation on them, and optionally export their manifests so that they can be committed to the source repository of the application",
+		Example: templates.Examples(`
+	# Adopt an orphaned ConfigMap into an application
+	argocd app adopt my-app --resource :ConfigMap:default/my-map
+
+	# Adopt all the orphaned resources of an application and export their manifests to a file
+	argocd app adopt my-app --all --export adopted.yaml
+
+	# Export the manifests of orphaned resources to stdout without adopting them
+	argocd app adopt my-app --resource apps:Deployment:default/my-deployment --dry-run --export -
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if all == (len(resources) > 0) {
+				errors.Fatal(errors.ErrorGeneric, "Exactly one of --resource or --all must be specified")
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			var selectedResources []*v1alpha1.SyncOperationResource
+			if all {
+				tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
+					ApplicationName: &appName,
+					AppNamespace:    &appNs,
+					Project:         &project,
+				})
+				errors.CheckError(err)
+				for _, node := range tree.OrphanedNodes {
+					selectedResources = append(selectedResources, &v1alpha1.SyncOperationResource{
+						Group:     node.Group,
+						Kind:      node.Kind,
+						Namespace: node.Namespace,
+						Name:      node.Name,
+					})
+				}
+				if len(selectedResources) == 0 {
+					log.Infof("Application '%s' has no orphaned resources", appName)
+					return
+				}
+			} else {
+				var err error
+				selectedResources, err = parseSelectedResources(resources)
+				errors.CheckError(err)
+			}
+
+			res, err := appIf.AdoptResources(ctx, &applicationpkg.ApplicationAdoptResourcesRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+				Resources:    selectedResources,
+				DryRun:       &dryRun,
+			})
+			errors.CheckError(err)
+
+			switch export {
+			case "":
+			case "-":
+				errors.CheckError(writeAdoptedManifests(os.Stdout, res.Manifests))
+			default:
+				f, err := os.Create(export)
+				errors.CheckError(err)
+				defer utilio.Close(f)
+				errors.CheckError(writeAdoptedManifests(f, res.Manifests))
+			}
+			if !dryRun {
+				for _, r := range selectedResources {
+					log.Infof("Resource %s/%s %s/%s adopted into application '%s'", r.Group, r.Kind, r.Namespace, r.Name, appName)
+				}
+			}
+		},
+	}
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Orphaned resource to adopt, in the format of GROUP%sKIND%sNAMESPACE%sNAME. The flag can be repeated", resourceFieldDelimiter, resourceFieldDelimiter, resourceFieldNamespaceDelimiter))
+	command.Flags().BoolVar(&all, "all", false, "Adopt all the orphaned resources of the application")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Export the manifests of the resources without adopting them")
+	command.Flags().StringVar(&export, "export", "", `Write the manifests of the resources, stripped of their status and server-populated fields, to the given file ("-" for stdout)`)
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// writeAdoptedManifests writes the given JSON manifests to w as a multi-document YAML stream
+func writeAdoptedManifests(w io.Writer, manifests []string) error {
+	for _, manifest := range manifests {
+		data, err := yaml.JSONToYAML([]byte(manifest))
+		if err != nil {
+			return fmt.Errorf("error converting manifest to YAML: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
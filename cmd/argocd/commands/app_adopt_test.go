@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAdoptedManifests(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeAdoptedManifests(&buf, []string{
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-map","namespace":"default"},"data":{"foo":"bar"}}`,
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret","namespace":"default"},"data":{"password":"++++++++"}}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `---
+apiVersion: v1
+data:
+  foo: bar
+kind: ConfigMap
+metadata:
+  name: my-map
+  namespace: default
+---
+apiVersion: v1
+data:
+  password: ++++++++
+kind: Secret
+metadata:
+  name: my-secret
+  namespace: default
+`, buf.String())
+
+	err = writeAdoptedManifests(&buf, []string{`{"kind":`})
+	require.Error(t, err)
+}
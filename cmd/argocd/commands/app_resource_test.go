@@ -6,9 +6,11 @@ import (
 	"strings"
 	"testing"
 	"text/tabwriter"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -121,6 +123,26 @@ func TestPrintResourcesTree(t *testing.T) {
 	assert.Equal(t, expectation, output)
 }
 
+func TestPrintResourceDriftHistory(t *testing.T) {
+	detected := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	resolved := metav1.NewTime(time.Date(2024, 1, 2, 3, 9, 5, 0, time.UTC))
+	var buf bytes.Buffer
+	printResourceDriftHistory(&buf, []*v1alpha1.ResourceDriftHistory{{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: "ns",
+		Name:      "guestbook",
+		Events: []v1alpha1.ResourceDriftEvent{
+			{DetectedAt: detected, ResolvedAt: &resolved, FieldPaths: []string{".spec.replicas"}, Managers: []string{"kubectl-scale"}, SelfHealed: true},
+			{DetectedAt: resolved, FieldPaths: []string{".spec.template.spec.containers", ".spec.replicas"}, Managers: []string{"helm", "kubectl-edit"}},
+		},
+	}})
+	assert.Equal(t, `GROUP  KIND        NAMESPACE  NAME       DETECTED                       RESOLVED                       SELF-HEALED  MANAGERS           FIELDS
+apps   Deployment  ns         guestbook  2024-01-02 03:04:05 +0000 UTC  2024-01-02 03:09:05 +0000 UTC  Yes          kubectl-scale      .spec.replicas
+apps   Deployment  ns         guestbook  2024-01-02 03:09:05 +0000 UTC  -                              No           helm,kubectl-edit  .spec.template.spec.containers,.spec.replicas
+`, buf.String())
+}
+
 func TestFilterFieldsFromObject(t *testing.T) {
 	tests := []struct {
 		name             string
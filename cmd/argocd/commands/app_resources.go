@@ -3,6 +3,7 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -525,9 +526,30 @@ func printResources(listAll bool, orphaned bool, appResourceTree *v1alpha1.Appli
 	errors.CheckError(err)
 }
 
+// printResourceDriftHistory prints a table of the drifts of the managed resources of an application
+func printResourceDriftHistory(w io.Writer, driftHistory []*v1alpha1.ResourceDriftHistory) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "GROUP\tKIND\tNAMESPACE\tNAME\tDETECTED\tRESOLVED\tSELF-HEALED\tMANAGERS\tFIELDS\n")
+	for _, h := range driftHistory {
+		for _, event := range h.Events {
+			resolved := "-"
+			if event.ResolvedAt != nil {
+				resolved = event.ResolvedAt.String()
+			}
+			selfHealed := "No"
+			if event.SelfHealed {
+				selfHealed = "Yes"
+			}
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", h.Group, h.Kind, h.Namespace, h.Name, event.DetectedAt.String(), resolved, selfHealed, strings.Join(event.Managers, ","), strings.Join(event.FieldPaths, ","))
+		}
+	}
+	_ = tw.Flush()
+}
+
 func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		orphaned bool
+		drift    bool
 		output   string
 		project  string
 	)
@@ -544,6 +566,16 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
+			if drift {
+				driftHistory, err := appIf.ResourceDriftHistory(ctx, &applicationpkg.ResourcesQuery{
+					ApplicationName: &appName,
+					AppNamespace:    &appNs,
+					Project:         &project,
+				})
+				errors.CheckError(err)
+				printResourceDriftHistory(os.Stdout, driftHistory.Items)
+				return
+			}
 			appResourceTree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
 				ApplicationName: &appName,
 				AppNamespace:    &appNs,
@@ -554,6 +586,7 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 		},
 	}
 	command.Flags().BoolVar(&orphaned, "orphaned", false, "Lists only orphaned resources")
+	command.Flags().BoolVar(&drift, "drift", false, "Lists the drift history of the managed resources: when their live state diverged from their desired state, which fields and field managers were involved, and whether the drift was self-healed")
 	command.Flags().StringVar(&output, "output", "", "Provides the tree view of the resources")
 	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
 	return command
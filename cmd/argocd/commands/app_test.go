@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
@@ -1047,6 +1049,88 @@ func TestPrintApplicationNames(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print params output %q, should be %q", output, expectation)
 }
 
+func TestPrintHydratePreview(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		var buf bytes.Buffer
+		printHydratePreview(&buf, &applicationpkg.ApplicationHydratePreviewResponse{DrySha: ptr.To("abc123")})
+		assert.Equal(t, "No hydrated files would change for dry revision abc123\n", buf.String())
+	})
+
+	t.Run("changed files", func(t *testing.T) {
+		var buf bytes.Buffer
+		printHydratePreview(&buf, &applicationpkg.ApplicationHydratePreviewResponse{
+			DrySha: ptr.To("abc123"),
+			Files: []*applicationpkg.HydratedFileDiff{
+				{Path: ptr.To("app/manifest.yaml"), Diff: ptr.To("diff --git a/app/manifest.yaml b/app/manifest.yaml\n-old\n+new")},
+				{Path: ptr.To("app/README.md"), Diff: ptr.To("diff --git a/app/README.md b/app/README.md\n+readme\n")},
+			},
+		})
+		assert.Equal(t, `Hydrating dry revision abc123 would change 2 file(s):
+
+diff --git a/app/manifest.yaml b/app/manifest.yaml
+-old
++new
+
+diff --git a/app/README.md b/app/README.md
++readme
+`, buf.String())
+	})
+
+	t.Run("orphaned paths", func(t *testing.T) {
+		var buf bytes.Buffer
+		printHydratePreview(&buf, &applicationpkg.ApplicationHydratePreviewResponse{
+			DrySha:        ptr.To("abc123"),
+			OrphanedPaths: []string{"deleted", "moved"},
+		})
+		assert.Equal(t, `No hydrated files would change for dry revision abc123
+
+The following hydrated paths are no longer hydrated by any application and would be removed:
+  deleted
+  moved
+`, buf.String())
+	})
+}
+
+func TestPrintHydrateHistoryTable(t *testing.T) {
+	date := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	var buf bytes.Buffer
+	printHydrateHistoryTable(&buf, []v1alpha1.HydrateHistory{
+		{ID: 0, StartedAt: date, Phase: v1alpha1.HydrateOperationPhaseFailed, DrySHA: "abc1234567", Message: "Failed to hydrate: error"},
+		{ID: 1, StartedAt: date, FinishedAt: &date, Phase: v1alpha1.HydrateOperationPhaseHydrated, DrySHA: "abc1234567", HydratedSHA: "def1234567", Message: "Rolled back to hydrate history 0"},
+	})
+	assert.Equal(t, `ID  DATE                           PHASE     DRY REVISION  HYDRATED REVISION  MESSAGE
+0   2024-01-02 03:04:05 +0000 UTC  Failed    abc1234                          Failed to hydrate: error
+1   2024-01-02 03:04:05 +0000 UTC  Hydrated  abc1234       def1234            Rolled back to hydrate history 0
+`, buf.String())
+}
+
+func TestFindHydrateHistory(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{History: v1alpha1.HydrateHistories{
+			{ID: 1, Phase: v1alpha1.HydrateOperationPhaseHydrated},
+			{ID: 2, Phase: v1alpha1.HydrateOperationPhaseHydrated},
+			{ID: 3, Phase: v1alpha1.HydrateOperationPhaseFailed},
+			{ID: 4, Phase: v1alpha1.HydrateOperationPhaseHydrated},
+		}}},
+	}
+
+	history, err := findHydrateHistory(app, -1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), history.ID)
+
+	history, err = findHydrateHistory(app, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), history.ID)
+
+	_, err = findHydrateHistory(app, 5)
+	require.ErrorContains(t, err, "does not have hydration id '5'")
+
+	app.Status.SourceHydrator.History = app.Status.SourceHydrator.History[2:]
+	_, err = findHydrateHistory(app, -1)
+	require.ErrorContains(t, err, "should have at least two successful hydrations")
+}
+
 func Test_unset(t *testing.T) {
 	kustomizeSource := &v1alpha1.ApplicationSource{
 		Kustomize: &v1alpha1.ApplicationSourceKustomize{
@@ -2156,6 +2240,10 @@ func (c *fakeAppServiceClient) GetApplicationSyncWindows(_ context.Context, _ *a
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) GetDependencyGraph(_ context.Context, _ *applicationpkg.ApplicationDependencyGraphQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationDependencyGraphResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetOCIMetadata(_ context.Context, _ *applicationpkg.RevisionMetadataQuery, _ ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
 	return nil, nil
 }
@@ -2200,6 +2288,14 @@ func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) AdoptResources(_ context.Context, _ *applicationpkg.ApplicationAdoptResourcesRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationAdoptResourcesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ResourceDriftHistory(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ResourceDriftHistoryResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2216,6 +2312,10 @@ func (c *fakeAppServiceClient) TerminateOperation(_ context.Context, _ *applicat
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ApproveSync(_ context.Context, _ *applicationpkg.ApplicationApproveSyncRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
 	return nil, nil
 }
@@ -2257,6 +2357,14 @@ func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationp
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) HydratePreview(_ context.Context, _ *applicationpkg.ApplicationHydratePreviewQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydratePreviewResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) HydrateRollback(_ context.Context, _ *applicationpkg.ApplicationHydrateRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 type fakeAcdClient struct {
 	simulateTimeout uint
 }
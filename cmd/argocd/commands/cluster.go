@@ -42,6 +42,8 @@ const (
 	clusterFieldLabel = "labels"
 	// cluster field is 'annotations'
 	clusterFieldAnnotation = "annotations"
+	// cluster field is 'syncConcurrencyLimit'
+	clusterFieldSyncConcurrencyLimit = "syncConcurrencyLimit"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -183,6 +185,9 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if clusterOpts.Shard >= 0 {
 				clst.Shard = &clusterOpts.Shard
 			}
+			if clusterOpts.SyncConcurrencyLimit >= 0 {
+				clst.SyncConcurrencyLimit = &clusterOpts.SyncConcurrencyLimit
+			}
 			if clusterOpts.Project != "" {
 				clst.Project = clusterOpts.Project
 			}
@@ -244,7 +249,10 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 		Short: "Set cluster information",
 		Example: `  # Set cluster information
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Limit the number of concurrent sync operations to the cluster
+  argocd cluster set CLUSTER_NAME --sync-concurrency-limit 10`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) != 1 {
@@ -268,13 +276,18 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			// parse the annotations you're receiving from the annotation flag
 			annotationsMap, err := label.Parse(annotations)
 			errors.CheckError(err)
+			var syncConcurrencyLimit *int64
+			if clusterOptions.SyncConcurrencyLimit >= 0 {
+				syncConcurrencyLimit = &clusterOptions.SyncConcurrencyLimit
+			}
 			if updatedFields != nil {
 				clusterUpdateRequest := clusterpkg.ClusterUpdateRequest{
 					Cluster: &argoappv1.Cluster{
-						Name:        clusterOptions.Name,
-						Namespaces:  namespaces,
-						Labels:      labelsMap,
-						Annotations: annotationsMap,
+						Name:                 clusterOptions.Name,
+						Namespaces:           namespaces,
+						Labels:               labelsMap,
+						Annotations:          annotationsMap,
+						SyncConcurrencyLimit: syncConcurrencyLimit,
 					},
 					UpdatedFields: updatedFields,
 					Id: &clusterpkg.ClusterID{
@@ -299,6 +312,7 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	command.Flags().StringArrayVar(&clusterOptions.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage. Specify '*' to manage all namespaces")
 	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
 	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
+	command.Flags().Int64Var(&clusterOptions.SyncConcurrencyLimit, "sync-concurrency-limit", -1, "Maximum number of concurrent sync operations to the cluster, 0 means unlimited")
 	return command
 }
 
@@ -317,6 +331,9 @@ func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string,
 	if annotations != nil {
 		updatedFields = append(updatedFields, clusterFieldAnnotation)
 	}
+	if clusterOptions.SyncConcurrencyLimit >= 0 {
+		updatedFields = append(updatedFields, clusterFieldSyncConcurrencyLimit)
+	}
 	return updatedFields
 }
 
@@ -162,6 +162,7 @@ type ClusterOptions struct {
 	Name                    string
 	Project                 string
 	Shard                   int64
+	SyncConcurrencyLimit    int64
 	ExecProviderCommand     string
 	ExecProviderArgs        []string
 	ExecProviderEnv         map[string]string
@@ -188,6 +189,7 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringVar(&opts.Project, "project", "", "project of the cluster")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
+	command.Flags().Int64Var(&opts.SyncConcurrencyLimit, "sync-concurrency-limit", -1, "Maximum number of concurrent sync operations to the cluster, 0 means unlimited; uses the global limit if not set")
 	command.Flags().StringVar(&opts.ExecProviderCommand, "exec-command", "", "Command to run to provide client credentials to the cluster. You may need to build a custom ArgoCD image to ensure the command is available at runtime.")
 	command.Flags().StringArrayVar(&opts.ExecProviderArgs, "exec-command-args", nil, "Arguments to supply to the --exec-command executable")
 	command.Flags().StringToStringVar(&opts.ExecProviderEnv, "exec-command-env", nil, "Environment vars to set when running the --exec-command executable")
@@ -43,10 +43,27 @@ type CommitHydratedManifestsRequest struct {
 	// Paths contains the paths to write hydrated manifests to, along with the manifests and commands to execute.
 	Paths []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
 	// DryCommitMetadata contains metadata about the DRY commit, such as the author and committer.
-	DryCommitMetadata    *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	DryCommitMetadata *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
+	// PullRequest configures the pull request to open from the target branch into the sync branch. If not set, no pull
+	// request is opened.
+	PullRequest *v1alpha1.HydrateToPullRequest `protobuf:"bytes,8,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// DryRepoURL is the URL of the dry source repository. If empty, it is assumed to be the repo's URL. If the repo is an
+	// OCI repository, the hydrated manifests are pushed as an OCI artifact tagged with the target branch, and with the
+	// target branch followed by the dry SHA.
+	DryRepoURL string `protobuf:"bytes,9,opt,name=dryRepoURL,proto3" json:"dryRepoURL,omitempty"`
+	// ActivePaths contains the paths of all applications hydrating to the target branch, including the ones not being
+	// hydrated by this request. Paths previously written to the target branch by the hydrator which are not active
+	// anymore are removed in a separate commit. If empty, no paths are removed.
+	ActivePaths []string `protobuf:"bytes,10,rep,name=activePaths,proto3" json:"activePaths,omitempty"`
+	// PreviousPullRequest is the pull request previously recorded for the target branch, if any. If it is no longer open,
+	// the response reports whether it was merged or closed.
+	PreviousPullRequest *v1alpha1.HydratePullRequestStatus `protobuf:"bytes,11,opt,name=previousPullRequest,proto3" json:"previousPullRequest,omitempty"`
+	// SensitiveAnnotations are the annotations whose values are hidden from the diffs returned by DiffHydratedManifests,
+	// along with the data of Secrets. It is ignored when committing.
+	SensitiveAnnotations []string `protobuf:"bytes,12,rep,name=sensitiveAnnotations,proto3" json:"sensitiveAnnotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -131,6 +148,41 @@ func (m *CommitHydratedManifestsRequest) GetDryCommitMetadata() *v1alpha1.Revisi
 	return nil
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydrateToPullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDryRepoURL() string {
+	if m != nil {
+		return m.DryRepoURL
+	}
+	return ""
+}
+
+func (m *CommitHydratedManifestsRequest) GetActivePaths() []string {
+	if m != nil {
+		return m.ActivePaths
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetPreviousPullRequest() *v1alpha1.HydratePullRequestStatus {
+	if m != nil {
+		return m.PreviousPullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetSensitiveAnnotations() []string {
+	if m != nil {
+		return m.SensitiveAnnotations
+	}
+	return nil
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -139,7 +191,18 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// ManifestLayout determines how the manifests are split into files. Defaults to a single manifest.yaml file.
+	ManifestLayout string `protobuf:"bytes,4,opt,name=manifestLayout,proto3" json:"manifestLayout,omitempty"`
+	// ToolVersions holds the versions of the tools used to hydrate the manifests, keyed by tool name.
+	ToolVersions map[string]string `protobuf:"bytes,5,rep,name=toolVersions,proto3" json:"toolVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// DrySource is the dry source the manifests were hydrated from, including its rendering parameters.
+	DrySource *v1alpha1.DrySource `protobuf:"bytes,6,opt,name=drySource,proto3" json:"drySource,omitempty"`
+	// DrySources are the additional dry sources the manifests were hydrated from, including their rendering parameters.
+	DrySources []*v1alpha1.DrySource `protobuf:"bytes,7,rep,name=drySources,proto3" json:"drySources,omitempty"`
+	// DryRevisions are the resolved revisions of the DrySource followed by the DrySources. It is only set if there are
+	// additional dry sources.
+	DryRevisions         []string `protobuf:"bytes,8,rep,name=dryRevisions,proto3" json:"dryRevisions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -199,6 +262,41 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetManifestLayout() string {
+	if m != nil {
+		return m.ManifestLayout
+	}
+	return ""
+}
+
+func (m *PathDetails) GetToolVersions() map[string]string {
+	if m != nil {
+		return m.ToolVersions
+	}
+	return nil
+}
+
+func (m *PathDetails) GetDrySource() *v1alpha1.DrySource {
+	if m != nil {
+		return m.DrySource
+	}
+	return nil
+}
+
+func (m *PathDetails) GetDrySources() []*v1alpha1.DrySource {
+	if m != nil {
+		return m.DrySources
+	}
+	return nil
+}
+
+func (m *PathDetails) GetDryRevisions() []string {
+	if m != nil {
+		return m.DryRevisions
+	}
+	return nil
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -251,7 +349,14 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
 	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequest is the pull request promoting the hydrated manifests from the target branch to the sync branch. It is
+	// the open pull request if there is one, otherwise the previous pull request with its final state. It is only set if
+	// a pull request was requested.
+	PullRequest *v1alpha1.HydratePullRequestStatus `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// PromotionError is the error which occurred while promoting the target branch after the hydrated manifests were
+	// successfully pushed. The push is not reverted, so the hydration itself succeeded.
+	PromotionError       string   `protobuf:"bytes,3,opt,name=promotionError,proto3" json:"promotionError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -297,47 +402,398 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequest() *v1alpha1.HydratePullRequestStatus {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsResponse) GetPromotionError() string {
+	if m != nil {
+		return m.PromotionError
+	}
+	return ""
+}
+
+// DiffHydratedManifestsResponse is the response to a DiffHydratedManifests request.
+type DiffHydratedManifestsResponse struct {
+	// Files contains the diff of each hydrated file that would change, sorted by path.
+	Files []*HydratedFileDiff `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// OrphanedPaths contains the hydrated paths which are no longer hydrated by any application and would be removed,
+	// sorted.
+	OrphanedPaths        []string `protobuf:"bytes,2,rep,name=orphanedPaths,proto3" json:"orphanedPaths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffHydratedManifestsResponse) Reset()         { *m = DiffHydratedManifestsResponse{} }
+func (m *DiffHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffHydratedManifestsResponse) ProtoMessage()    {}
+func (*DiffHydratedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{4}
+}
+func (m *DiffHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffHydratedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffHydratedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiffHydratedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffHydratedManifestsResponse.Merge(m, src)
+}
+func (m *DiffHydratedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffHydratedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffHydratedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffHydratedManifestsResponse proto.InternalMessageInfo
+
+func (m *DiffHydratedManifestsResponse) GetFiles() []*HydratedFileDiff {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *DiffHydratedManifestsResponse) GetOrphanedPaths() []string {
+	if m != nil {
+		return m.OrphanedPaths
+	}
+	return nil
+}
+
+// HydratedFileDiff is the diff of a hydrated file against the current contents of the target branch.
+type HydratedFileDiff struct {
+	// Path is the path of the file, relative to the repository root.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Diff is the unified diff of the file.
+	Diff                 string   `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HydratedFileDiff) Reset()         { *m = HydratedFileDiff{} }
+func (m *HydratedFileDiff) String() string { return proto.CompactTextString(m) }
+func (*HydratedFileDiff) ProtoMessage()    {}
+func (*HydratedFileDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{5}
+}
+func (m *HydratedFileDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratedFileDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HydratedFileDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HydratedFileDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratedFileDiff.Merge(m, src)
+}
+func (m *HydratedFileDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratedFileDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratedFileDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratedFileDiff proto.InternalMessageInfo
+
+func (m *HydratedFileDiff) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HydratedFileDiff) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
+// RevertHydratedManifestsRequest is the request to restore the hydrated manifests of a path to their contents at a
+// previous hydrated commit.
+type RevertHydratedManifestsRequest struct {
+	// Repo contains repository information including, at minimum, the URL of the repository. Generally it will contain
+	// repo credentials.
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// TargetBranch is the branch to commit the restored manifests to. If it doesn't exist, it is created from the sync
+	// branch.
+	TargetBranch string `protobuf:"bytes,2,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	// Path is the hydrated path to restore.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// HydratedSha is the SHA of the hydrated commit to restore the path from.
+	HydratedSha string `protobuf:"bytes,4,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// CommitMessage is the commit message to use when committing the restored manifests.
+	CommitMessage string `protobuf:"bytes,5,opt,name=commitMessage,proto3" json:"commitMessage,omitempty"`
+	// SyncBranch is the branch Argo CD syncs from, i.e. the hydrated branch. If empty, it is assumed to be the target
+	// branch.
+	SyncBranch string `protobuf:"bytes,6,opt,name=syncBranch,proto3" json:"syncBranch,omitempty"`
+	// PullRequest configures the pull request to open from the target branch into the sync branch, like for
+	// CommitHydratedManifests. If not set, no pull request is opened.
+	PullRequest *v1alpha1.HydrateToPullRequest `protobuf:"bytes,7,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// PreviousPullRequest is the pull request previously recorded for the target branch, if any. If it is no longer open,
+	// the response reports whether it was merged or closed.
+	PreviousPullRequest  *v1alpha1.HydratePullRequestStatus `protobuf:"bytes,8,opt,name=previousPullRequest,proto3" json:"previousPullRequest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *RevertHydratedManifestsRequest) Reset()         { *m = RevertHydratedManifestsRequest{} }
+func (m *RevertHydratedManifestsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertHydratedManifestsRequest) ProtoMessage()    {}
+func (*RevertHydratedManifestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{6}
+}
+func (m *RevertHydratedManifestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertHydratedManifestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevertHydratedManifestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevertHydratedManifestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertHydratedManifestsRequest.Merge(m, src)
+}
+func (m *RevertHydratedManifestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertHydratedManifestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertHydratedManifestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertHydratedManifestsRequest proto.InternalMessageInfo
+
+func (m *RevertHydratedManifestsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RevertHydratedManifestsRequest) GetTargetBranch() string {
+	if m != nil {
+		return m.TargetBranch
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsRequest) GetHydratedSha() string {
+	if m != nil {
+		return m.HydratedSha
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsRequest) GetCommitMessage() string {
+	if m != nil {
+		return m.CommitMessage
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsRequest) GetSyncBranch() string {
+	if m != nil {
+		return m.SyncBranch
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydrateToPullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *RevertHydratedManifestsRequest) GetPreviousPullRequest() *v1alpha1.HydratePullRequestStatus {
+	if m != nil {
+		return m.PreviousPullRequest
+	}
+	return nil
+}
+
+// RevertHydratedManifestsResponse is the response to a RevertHydratedManifests request.
+type RevertHydratedManifestsResponse struct {
+	// HydratedSha is the commit SHA of the revert commit, or the SHA of the target branch if the path was already at its
+	// contents at the requested hydrated commit.
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequest is the pull request promoting the restored manifests from the target branch to the sync branch, like
+	// for CommitHydratedManifests. It is only set if a pull request was requested.
+	PullRequest *v1alpha1.HydratePullRequestStatus `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// PromotionError is the error which occurred while promoting the target branch after the restored manifests were
+	// successfully pushed. The push is not reverted, so the rollback itself succeeded.
+	PromotionError       string   `protobuf:"bytes,3,opt,name=promotionError,proto3" json:"promotionError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertHydratedManifestsResponse) Reset()         { *m = RevertHydratedManifestsResponse{} }
+func (m *RevertHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevertHydratedManifestsResponse) ProtoMessage()    {}
+func (*RevertHydratedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{7}
+}
+func (m *RevertHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertHydratedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevertHydratedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevertHydratedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertHydratedManifestsResponse.Merge(m, src)
+}
+func (m *RevertHydratedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertHydratedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertHydratedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertHydratedManifestsResponse proto.InternalMessageInfo
+
+func (m *RevertHydratedManifestsResponse) GetHydratedSha() string {
+	if m != nil {
+		return m.HydratedSha
+	}
+	return ""
+}
+
+func (m *RevertHydratedManifestsResponse) GetPullRequest() *v1alpha1.HydratePullRequestStatus {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *RevertHydratedManifestsResponse) GetPromotionError() string {
+	if m != nil {
+		return m.PromotionError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
+	proto.RegisterMapType((map[string]string)(nil), "PathDetails.ToolVersionsEntry")
 	proto.RegisterType((*HydratedManifestDetails)(nil), "HydratedManifestDetails")
 	proto.RegisterType((*CommitHydratedManifestsResponse)(nil), "CommitHydratedManifestsResponse")
+	proto.RegisterType((*DiffHydratedManifestsResponse)(nil), "DiffHydratedManifestsResponse")
+	proto.RegisterType((*HydratedFileDiff)(nil), "HydratedFileDiff")
+	proto.RegisterType((*RevertHydratedManifestsRequest)(nil), "RevertHydratedManifestsRequest")
+	proto.RegisterType((*RevertHydratedManifestsResponse)(nil), "RevertHydratedManifestsResponse")
 }
 
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xeb, 0x34, 0x90, 0x49, 0x7b, 0x60, 0x0f, 0xd4, 0xca, 0xc1, 0xb5, 0x2c, 0x0e, 0xb9,
-	0xb0, 0x56, 0x13, 0xc1, 0x8d, 0x4b, 0xc3, 0xa1, 0x42, 0xb4, 0x20, 0xe7, 0x86, 0x2a, 0xa1, 0xa9,
-	0xbd, 0xd8, 0x4b, 0x63, 0xef, 0xb2, 0xbb, 0xb1, 0x64, 0x89, 0xaf, 0xe1, 0x6b, 0x38, 0xf2, 0x09,
-	0x28, 0x5f, 0x82, 0xbc, 0xb6, 0x69, 0x02, 0x0a, 0x39, 0x70, 0xf2, 0xce, 0xbc, 0xf1, 0x7b, 0xb3,
-	0xf3, 0x76, 0x20, 0x48, 0x44, 0x51, 0x70, 0xa3, 0x99, 0xaa, 0x98, 0x8a, 0xda, 0xa0, 0xfb, 0x50,
-	0xa9, 0x84, 0x11, 0x93, 0xb7, 0x19, 0x37, 0xf9, 0xfa, 0x8e, 0x26, 0xa2, 0x88, 0x50, 0x65, 0x42,
-	0x2a, 0xf1, 0xd9, 0x1e, 0x9e, 0x27, 0x69, 0x54, 0xcd, 0x23, 0x79, 0x9f, 0x45, 0x28, 0xb9, 0x8e,
-	0x50, 0xca, 0x15, 0x4f, 0xd0, 0x70, 0x51, 0x46, 0xd5, 0x05, 0xae, 0x64, 0x8e, 0x17, 0x51, 0xc6,
-	0x4a, 0xa6, 0xd0, 0xb0, 0xb4, 0x65, 0x0b, 0xbf, 0xb9, 0xe0, 0x2f, 0x2c, 0xfd, 0x55, 0x9d, 0x5a,
-	0xe0, 0x1a, 0x4b, 0xfe, 0x89, 0x69, 0xa3, 0x63, 0xf6, 0x65, 0xcd, 0xb4, 0x21, 0xb7, 0x30, 0x50,
-	0x4c, 0x0a, 0xcf, 0x09, 0x9c, 0xe9, 0x78, 0x76, 0x45, 0x1f, 0xf4, 0x69, 0xaf, 0x6f, 0x0f, 0x1f,
-	0x93, 0x94, 0x56, 0x73, 0x2a, 0xef, 0x33, 0xda, 0xe8, 0xd3, 0x2d, 0x7d, 0xda, 0xeb, 0xd3, 0x98,
-	0x49, 0xa1, 0xb9, 0x11, 0xaa, 0x8e, 0x2d, 0x2b, 0xf1, 0x01, 0x74, 0x5d, 0x26, 0x97, 0x0a, 0xcb,
-	0x24, 0xf7, 0x8e, 0x02, 0x67, 0x3a, 0x8a, 0xb7, 0x32, 0x24, 0x84, 0x13, 0x83, 0x2a, 0x63, 0xa6,
-	0xab, 0x70, 0x6d, 0xc5, 0x4e, 0x8e, 0x3c, 0x85, 0x61, 0xaa, 0xea, 0x65, 0x8e, 0xde, 0xc0, 0xa2,
-	0x5d, 0x44, 0x9e, 0xc1, 0x69, 0x3b, 0xba, 0x6b, 0xa6, 0x35, 0x66, 0xcc, 0x3b, 0xb6, 0xf0, 0x6e,
-	0x92, 0x84, 0x70, 0x2c, 0xd1, 0xe4, 0xda, 0x1b, 0x06, 0xee, 0x74, 0x3c, 0x3b, 0xa1, 0xef, 0xd1,
-	0xe4, 0xaf, 0x99, 0x41, 0xbe, 0xd2, 0x71, 0x0b, 0x91, 0xaf, 0xf0, 0x24, 0x55, 0xf5, 0xa2, 0xfb,
-	0xcf, 0x60, 0x8a, 0x06, 0xbd, 0x47, 0x76, 0x20, 0x37, 0xff, 0x3b, 0x90, 0x8a, 0x6b, 0x2e, 0xca,
-	0x9e, 0x35, 0xfe, 0x5b, 0x28, 0x5c, 0xc3, 0x78, 0xab, 0x27, 0x42, 0x60, 0xd0, 0x74, 0x65, 0x0d,
-	0x19, 0xc5, 0xf6, 0x4c, 0x5e, 0xc2, 0xa8, 0xe8, 0x8d, 0xf3, 0x8e, 0xec, 0x45, 0x3c, 0xfa, 0xa7,
-	0xa5, 0xfd, 0xa5, 0x1e, 0x4a, 0xc9, 0x04, 0x1e, 0x37, 0xd3, 0xc0, 0x32, 0xd5, 0x9e, 0x1b, 0xb8,
-	0xd3, 0x51, 0xfc, 0x3b, 0x0e, 0x5f, 0xc1, 0xd9, 0x1e, 0x86, 0xc6, 0x95, 0x9e, 0xe3, 0xcd, 0xf2,
-	0xdd, 0x4d, 0xd7, 0xca, 0x4e, 0x2e, 0x5c, 0xc0, 0xf9, 0xde, 0x97, 0xa5, 0xa5, 0x28, 0x35, 0x23,
-	0x01, 0x8c, 0xf3, 0x0e, 0x6c, 0xdc, 0x6b, 0x59, 0xb6, 0x53, 0xb3, 0x02, 0x4e, 0x5b, 0x92, 0x25,
-	0x53, 0x15, 0x4f, 0x18, 0xb9, 0x85, 0xb3, 0x3d, 0xac, 0xe4, 0x9c, 0xfe, 0xfb, 0x25, 0x4f, 0x02,
-	0x7a, 0xa0, 0xa1, 0xcb, 0xc5, 0xf7, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0xcf, 0x8d, 0xef, 0x7c,
-	0x78, 0x71, 0x60, 0xd5, 0x76, 0x76, 0x15, 0x25, 0x4f, 0x56, 0x9c, 0x95, 0xe6, 0x6e, 0x68, 0x57,
-	0x6b, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21, 0x3b, 0xa8, 0x3c, 0xcc, 0x03, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xdd, 0x6a, 0x24, 0x45,
+	0x14, 0xa6, 0xe7, 0x2f, 0x99, 0x33, 0xd9, 0x65, 0x53, 0xae, 0xa6, 0x18, 0x70, 0x32, 0x34, 0xb2,
+	0xe6, 0xc6, 0x1a, 0x36, 0x41, 0x91, 0x05, 0x11, 0x93, 0xac, 0x2e, 0x92, 0x5d, 0x43, 0x67, 0x5d,
+	0x44, 0x02, 0x52, 0xdb, 0x5d, 0x33, 0x53, 0xa6, 0xa7, 0xab, 0xac, 0xaa, 0x6e, 0x6c, 0xf0, 0x01,
+	0x7c, 0x02, 0xc1, 0x2b, 0xaf, 0x7c, 0x17, 0x2f, 0x7d, 0x04, 0x09, 0xf8, 0x1e, 0xd2, 0xd5, 0xdd,
+	0x3b, 0xdd, 0x93, 0x99, 0x8c, 0x90, 0x35, 0xc2, 0x5e, 0x75, 0xd5, 0x39, 0xd5, 0xe7, 0xab, 0xaa,
+	0xef, 0x9c, 0xef, 0x14, 0x0c, 0x7d, 0x31, 0x9b, 0x71, 0xa3, 0x99, 0x4a, 0x98, 0x1a, 0xe5, 0x93,
+	0xe2, 0x43, 0xa4, 0x12, 0x46, 0xf4, 0x4f, 0x26, 0xdc, 0x4c, 0xe3, 0x97, 0xc4, 0x17, 0xb3, 0x11,
+	0x55, 0x13, 0x21, 0x95, 0xf8, 0xde, 0x0e, 0x3e, 0xf0, 0x83, 0x51, 0x72, 0x30, 0x92, 0x17, 0x93,
+	0x11, 0x95, 0x5c, 0x8f, 0xa8, 0x94, 0x21, 0xf7, 0xa9, 0xe1, 0x22, 0x1a, 0x25, 0x0f, 0x69, 0x28,
+	0xa7, 0xf4, 0xe1, 0x68, 0xc2, 0x22, 0xa6, 0xa8, 0x61, 0x41, 0x1e, 0xcd, 0xfd, 0xb5, 0x03, 0x83,
+	0x23, 0x1b, 0xfe, 0x49, 0x1a, 0x58, 0xc7, 0x53, 0x1a, 0xf1, 0x31, 0xd3, 0x46, 0x7b, 0xec, 0x87,
+	0x98, 0x69, 0x83, 0xce, 0xa1, 0xa5, 0x98, 0x14, 0xd8, 0x19, 0x3a, 0x7b, 0xbd, 0xfd, 0x27, 0x64,
+	0x8e, 0x4f, 0x4a, 0x7c, 0x3b, 0xf8, 0xce, 0x0f, 0x48, 0x72, 0x40, 0xe4, 0xc5, 0x84, 0x64, 0xf8,
+	0xa4, 0x82, 0x4f, 0x4a, 0x7c, 0xe2, 0x31, 0x29, 0x34, 0x37, 0x42, 0xa5, 0x9e, 0x8d, 0x8a, 0x06,
+	0x00, 0x3a, 0x8d, 0xfc, 0x43, 0x45, 0x23, 0x7f, 0x8a, 0x1b, 0x43, 0x67, 0xaf, 0xeb, 0x55, 0x2c,
+	0xc8, 0x85, 0x2d, 0x43, 0xd5, 0x84, 0x99, 0x62, 0x45, 0xd3, 0xae, 0xa8, 0xd9, 0xd0, 0x3b, 0xd0,
+	0x09, 0x54, 0x7a, 0x36, 0xa5, 0xb8, 0x65, 0xbd, 0xc5, 0x0c, 0xbd, 0x07, 0x77, 0xf2, 0xab, 0x7b,
+	0xca, 0xb4, 0xa6, 0x13, 0x86, 0xdb, 0xd6, 0x5d, 0x37, 0x22, 0x17, 0xda, 0x92, 0x9a, 0xa9, 0xc6,
+	0x9d, 0x61, 0x73, 0xaf, 0xb7, 0xbf, 0x45, 0x4e, 0xa9, 0x99, 0x1e, 0x33, 0x43, 0x79, 0xa8, 0xbd,
+	0xdc, 0x85, 0x7e, 0x82, 0xed, 0x40, 0xa5, 0x47, 0xc5, 0x7f, 0x86, 0x06, 0xd4, 0x50, 0xbc, 0x61,
+	0x2f, 0xe4, 0xd9, 0x4d, 0x2f, 0x24, 0xe1, 0x9a, 0x8b, 0xa8, 0x8c, 0xea, 0x5d, 0x05, 0x42, 0x06,
+	0x7a, 0x32, 0x0e, 0xc3, 0x82, 0x10, 0xbc, 0x69, 0x71, 0xbd, 0x9b, 0xe1, 0x16, 0x74, 0x3f, 0x17,
+	0xa7, 0xf3, 0xc8, 0x5e, 0x15, 0x26, 0x63, 0x26, 0x50, 0x69, 0x46, 0xd8, 0xd7, 0xde, 0x09, 0xee,
+	0xe6, 0xcc, 0xcc, 0x2d, 0x68, 0x08, 0x3d, 0xea, 0x1b, 0x9e, 0xb0, 0x53, 0x7b, 0x7b, 0x30, 0x6c,
+	0xee, 0x75, 0xbd, 0xaa, 0x09, 0xfd, 0xec, 0xc0, 0x5b, 0x52, 0xb1, 0x84, 0x8b, 0x58, 0x57, 0x60,
+	0x70, 0xcf, 0x1e, 0xe0, 0xc5, 0x6b, 0x39, 0x40, 0x25, 0xee, 0x99, 0xa1, 0x26, 0xd6, 0xde, 0x32,
+	0x48, 0xb4, 0x0f, 0xf7, 0x35, 0x8b, 0x34, 0xcf, 0x36, 0xf7, 0x59, 0x14, 0x09, 0x63, 0xa3, 0x69,
+	0xbc, 0x65, 0x77, 0xbd, 0xd4, 0xe7, 0xfe, 0xd2, 0x82, 0x5e, 0x25, 0x17, 0x10, 0x82, 0x56, 0x96,
+	0x0d, 0xb6, 0x10, 0xba, 0x9e, 0x1d, 0xa3, 0x8f, 0xa0, 0x3b, 0x2b, 0x0b, 0x06, 0x37, 0x6c, 0x02,
+	0x61, 0xb2, 0x58, 0x4a, 0x65, 0x32, 0xcd, 0x97, 0xa2, 0x3e, 0x6c, 0x66, 0x59, 0x48, 0xa3, 0x40,
+	0xe3, 0xa6, 0xdd, 0xc3, 0xab, 0x39, 0x7a, 0x00, 0x77, 0xcb, 0x85, 0x27, 0x34, 0x15, 0xb1, 0x29,
+	0xd2, 0x7a, 0xc1, 0x8a, 0x0e, 0x61, 0xcb, 0x08, 0x11, 0xbe, 0x60, 0x4a, 0xdb, 0xb3, 0xb4, 0x2d,
+	0xfc, 0xa0, 0x9a, 0xbf, 0xe4, 0x79, 0x65, 0xc1, 0xe3, 0xc8, 0xa8, 0xd4, 0xab, 0xfd, 0x83, 0x18,
+	0x74, 0xb3, 0x62, 0x11, 0xb1, 0xf2, 0x19, 0xee, 0x58, 0x5e, 0xbe, 0xb8, 0x19, 0x2f, 0xc7, 0x65,
+	0x38, 0x6f, 0x1e, 0x19, 0x4d, 0x6c, 0x2e, 0xe5, 0x13, 0x8d, 0x37, 0xec, 0x46, 0x5f, 0x1b, 0x4e,
+	0x25, 0x74, 0x26, 0x17, 0x36, 0x45, 0xf3, 0xa2, 0xd2, 0x78, 0xd3, 0xde, 0x6d, 0xcd, 0xd6, 0xff,
+	0x14, 0xb6, 0xaf, 0x5c, 0x0b, 0xba, 0x07, 0xcd, 0x0b, 0x96, 0x16, 0xdc, 0x66, 0x43, 0x74, 0x1f,
+	0xda, 0x09, 0x0d, 0x63, 0x56, 0x88, 0x52, 0x3e, 0x79, 0xd4, 0xf8, 0xd8, 0x71, 0x3f, 0x81, 0x9d,
+	0x15, 0x14, 0x67, 0xf8, 0x25, 0x4b, 0x5f, 0x9e, 0x7d, 0xf5, 0xac, 0x88, 0x57, 0xb3, 0xb9, 0x7f,
+	0x3b, 0xb0, 0xbb, 0x52, 0x73, 0xb5, 0x14, 0x91, 0x66, 0x59, 0x71, 0x4d, 0x0b, 0x67, 0xa6, 0x6b,
+	0x79, 0x98, 0xaa, 0x09, 0xfd, 0x58, 0x17, 0x85, 0xc6, 0x7f, 0x5a, 0x53, 0x35, 0x61, 0x78, 0x00,
+	0x77, 0xa5, 0x12, 0x33, 0x91, 0xfd, 0xfb, 0x58, 0x29, 0xa1, 0x0a, 0x51, 0x5e, 0xb0, 0xba, 0x11,
+	0xbc, 0x7b, 0xcc, 0xc7, 0xe3, 0xd5, 0x87, 0x7c, 0x1f, 0xda, 0x63, 0x1e, 0x32, 0x8d, 0x1d, 0x9b,
+	0x10, 0xdb, 0xaf, 0x0a, 0xe7, 0x73, 0x1e, 0xb2, 0xec, 0x57, 0x2f, 0xf7, 0x67, 0x42, 0x2e, 0x94,
+	0x9c, 0xd2, 0x88, 0x05, 0xb9, 0xd8, 0x34, 0x2c, 0xad, 0x75, 0xa3, 0xfb, 0x08, 0xee, 0x2d, 0x06,
+	0x58, 0x5a, 0xb3, 0x08, 0x5a, 0x01, 0x1f, 0x8f, 0x0b, 0x5e, 0xed, 0xd8, 0xfd, 0xad, 0x05, 0x03,
+	0x8f, 0x25, 0x4c, 0xfd, 0x5f, 0x7d, 0x70, 0xb1, 0xcf, 0x35, 0x96, 0xf4, 0xb9, 0xf2, 0x30, 0xcd,
+	0xca, 0x61, 0x16, 0x12, 0xa5, 0x75, 0x35, 0x51, 0xfe, 0x5d, 0x17, 0xac, 0xf7, 0xe1, 0xce, 0x95,
+	0x3e, 0xbc, 0xd0, 0x83, 0x36, 0x6e, 0xa7, 0x07, 0xad, 0xea, 0x20, 0x9b, 0xb7, 0xde, 0x41, 0x6c,
+	0xd5, 0xae, 0xcc, 0x90, 0x37, 0xa7, 0x6a, 0xf7, 0x7f, 0x6f, 0xc0, 0x9d, 0x5c, 0x9d, 0xce, 0x98,
+	0x4a, 0xb8, 0xcf, 0xd0, 0x39, 0xec, 0xac, 0x90, 0x2b, 0xb4, 0x4b, 0xae, 0x7f, 0x3c, 0xf6, 0x87,
+	0x64, 0x9d, 0xd2, 0x7d, 0x03, 0x6f, 0x2f, 0x55, 0x89, 0xf5, 0xb1, 0x07, 0xe4, 0x7a, 0x79, 0x39,
+	0x87, 0x9d, 0x15, 0x84, 0xa1, 0x5d, 0x72, 0x7d, 0xb1, 0xf7, 0x87, 0x64, 0x0d, 0xd7, 0x87, 0x47,
+	0x7f, 0x5c, 0x0e, 0x9c, 0x3f, 0x2f, 0x07, 0xce, 0x5f, 0x97, 0x03, 0xe7, 0xdb, 0x0f, 0xd7, 0xbc,
+	0xca, 0x6b, 0xcf, 0x7a, 0x2a, 0xb9, 0x1f, 0x72, 0x16, 0x99, 0x97, 0x1d, 0xfb, 0x0a, 0x3f, 0xf8,
+	0x27, 0x00, 0x00, 0xff, 0xff, 0x85, 0xbf, 0x26, 0xbf, 0xf7, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -354,6 +810,13 @@ const _ = grpc.SupportPackageIsVersion4
 type CommitServiceClient interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*CommitHydratedManifestsResponse, error)
+	// DiffHydratedManifests writes hydrated manifests to a repository without committing them, and returns the diff of
+	// each changed file against the target branch.
+	DiffHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*DiffHydratedManifestsResponse, error)
+	// RevertHydratedManifests restores the hydrated manifests of a path to their contents at a previous hydrated commit,
+	// and commits the result to the target branch. If a pull request is configured, it then promotes the target branch
+	// to the sync branch.
+	RevertHydratedManifests(ctx context.Context, in *RevertHydratedManifestsRequest, opts ...grpc.CallOption) (*RevertHydratedManifestsResponse, error)
 }
 
 type commitServiceClient struct {
@@ -373,10 +836,35 @@ func (c *commitServiceClient) CommitHydratedManifests(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *commitServiceClient) DiffHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*DiffHydratedManifestsResponse, error) {
+	out := new(DiffHydratedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/CommitService/DiffHydratedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commitServiceClient) RevertHydratedManifests(ctx context.Context, in *RevertHydratedManifestsRequest, opts ...grpc.CallOption) (*RevertHydratedManifestsResponse, error) {
+	out := new(RevertHydratedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/CommitService/RevertHydratedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommitServiceServer is the server API for CommitService service.
 type CommitServiceServer interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(context.Context, *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error)
+	// DiffHydratedManifests writes hydrated manifests to a repository without committing them, and returns the diff of
+	// each changed file against the target branch.
+	DiffHydratedManifests(context.Context, *CommitHydratedManifestsRequest) (*DiffHydratedManifestsResponse, error)
+	// RevertHydratedManifests restores the hydrated manifests of a path to their contents at a previous hydrated commit,
+	// and commits the result to the target branch. If a pull request is configured, it then promotes the target branch
+	// to the sync branch.
+	RevertHydratedManifests(context.Context, *RevertHydratedManifestsRequest) (*RevertHydratedManifestsResponse, error)
 }
 
 // UnimplementedCommitServiceServer can be embedded to have forward compatible implementations.
@@ -386,6 +874,12 @@ type UnimplementedCommitServiceServer struct {
 func (*UnimplementedCommitServiceServer) CommitHydratedManifests(ctx context.Context, req *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitHydratedManifests not implemented")
 }
+func (*UnimplementedCommitServiceServer) DiffHydratedManifests(ctx context.Context, req *CommitHydratedManifestsRequest) (*DiffHydratedManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffHydratedManifests not implemented")
+}
+func (*UnimplementedCommitServiceServer) RevertHydratedManifests(ctx context.Context, req *RevertHydratedManifestsRequest) (*RevertHydratedManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertHydratedManifests not implemented")
+}
 
 func RegisterCommitServiceServer(s *grpc.Server, srv CommitServiceServer) {
 	s.RegisterService(&_CommitService_serviceDesc, srv)
@@ -409,7 +903,43 @@ func _CommitService_CommitHydratedManifests_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _CommitService_serviceDesc = grpc.ServiceDesc{
+func _CommitService_DiffHydratedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitHydratedManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServiceServer).DiffHydratedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CommitService/DiffHydratedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServiceServer).DiffHydratedManifests(ctx, req.(*CommitHydratedManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommitService_RevertHydratedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertHydratedManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServiceServer).RevertHydratedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CommitService/RevertHydratedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServiceServer).RevertHydratedManifests(ctx, req.(*RevertHydratedManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CommitService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "CommitService",
 	HandlerType: (*CommitServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -417,6 +947,14 @@ var _CommitService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommitHydratedManifests",
 			Handler:    _CommitService_CommitHydratedManifests_Handler,
 		},
+		{
+			MethodName: "DiffHydratedManifests",
+			Handler:    _CommitService_DiffHydratedManifests_Handler,
+		},
+		{
+			MethodName: "RevertHydratedManifests",
+			Handler:    _CommitService_RevertHydratedManifests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commitserver/commit/commit.proto",
@@ -446,6 +984,55 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SensitiveAnnotations) > 0 {
+		for iNdEx := len(m.SensitiveAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SensitiveAnnotations[iNdEx])
+			copy(dAtA[i:], m.SensitiveAnnotations[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.SensitiveAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PreviousPullRequest != nil {
+		{
+			size, err := m.PreviousPullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ActivePaths) > 0 {
+		for iNdEx := len(m.ActivePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePaths[iNdEx])
+			copy(dAtA[i:], m.ActivePaths[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.ActivePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DryRepoURL) > 0 {
+		i -= len(m.DryRepoURL)
+		copy(dAtA[i:], m.DryRepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRepoURL)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.DryCommitMetadata != nil {
 		{
 			size, err := m.DryCommitMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -539,6 +1126,67 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DrySources) > 0 {
+		for iNdEx := len(m.DrySources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrySources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DrySource != nil {
+		{
+			size, err := m.DrySource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToolVersions) > 0 {
+		for k := range m.ToolVersions {
+			v := m.ToolVersions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCommit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCommit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCommit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ManifestLayout) > 0 {
+		i -= len(m.ManifestLayout)
+		copy(dAtA[i:], m.ManifestLayout)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.ManifestLayout)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -630,6 +1278,25 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PromotionError) > 0 {
+		i -= len(m.PromotionError)
+		copy(dAtA[i:], m.PromotionError)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.PromotionError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -640,126 +1307,1869 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DiffHydratedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CommitHydratedManifestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.SyncBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.TargetBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.DrySha)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.CommitMessage)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	if len(m.Paths) > 0 {
-		for _, e := range m.Paths {
-			l = e.Size()
-			n += 1 + l + sovCommit(uint64(l))
-		}
-	}
-	if m.DryCommitMetadata != nil {
-		l = m.DryCommitMetadata.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *DiffHydratedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PathDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DiffHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Manifests) > 0 {
-		for _, e := range m.Manifests {
-			l = e.Size()
-			n += 1 + l + sovCommit(uint64(l))
+	if len(m.OrphanedPaths) > 0 {
+		for iNdEx := len(m.OrphanedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrphanedPaths[iNdEx])
+			copy(dAtA[i:], m.OrphanedPaths[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.OrphanedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Commands) > 0 {
-		for _, s := range m.Commands {
-			l = len(s)
-			n += 1 + l + sovCommit(uint64(l))
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HydratedManifestDetails) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HydratedFileDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratedFileDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratedFileDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ManifestJSON)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Diff) > 0 {
+		i -= len(m.Diff)
+		copy(dAtA[i:], m.Diff)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Diff)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitHydratedManifestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevertHydratedManifestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertHydratedManifestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HydratedSha)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PreviousPullRequest != nil {
+		{
+			size, err := m.PreviousPullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SyncBranch) > 0 {
+		i -= len(m.SyncBranch)
+		copy(dAtA[i:], m.SyncBranch)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.SyncBranch)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CommitMessage) > 0 {
+		i -= len(m.CommitMessage)
+		copy(dAtA[i:], m.CommitMessage)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.CommitMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HydratedSha) > 0 {
+		i -= len(m.HydratedSha)
+		copy(dAtA[i:], m.HydratedSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HydratedSha)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetBranch) > 0 {
+		i -= len(m.TargetBranch)
+		copy(dAtA[i:], m.TargetBranch)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.TargetBranch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertHydratedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertHydratedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PromotionError) > 0 {
+		i -= len(m.PromotionError)
+		copy(dAtA[i:], m.PromotionError)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.PromotionError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HydratedSha) > 0 {
+		i -= len(m.HydratedSha)
+		copy(dAtA[i:], m.HydratedSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HydratedSha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.SyncBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DrySha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.DryCommitMetadata != nil {
+		l = m.DryCommitMetadata.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DryRepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.ActivePaths) > 0 {
+		for _, s := range m.ActivePaths {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.PreviousPullRequest != nil {
+		l = m.PreviousPullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.SensitiveAnnotations) > 0 {
+		for _, s := range m.SensitiveAnnotations {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PathDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Manifests) > 0 {
+		for _, e := range m.Manifests {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if len(m.Commands) > 0 {
+		for _, s := range m.Commands {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	l = len(m.ManifestLayout)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.ToolVersions) > 0 {
+		for k, v := range m.ToolVersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommit(uint64(len(k))) + 1 + len(v) + sovCommit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommit(uint64(mapEntrySize))
+		}
+	}
+	if m.DrySource != nil {
+		l = m.DrySource.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.DrySources) > 0 {
+		for _, e := range m.DrySources {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HydratedManifestDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ManifestJSON)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.PromotionError)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if len(m.OrphanedPaths) > 0 {
+		for _, s := range m.OrphanedPaths {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HydratedFileDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Diff)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.SyncBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.PreviousPullRequest != nil {
+		l = m.PreviousPullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.PromotionError)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCommit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommit(x uint64) (n int) {
+	return sovCommit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitHydratedManifestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitHydratedManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, &PathDetails{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryCommitMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DryCommitMetadata == nil {
+				m.DryCommitMetadata = &v1alpha1.RevisionMetadata{}
+			}
+			if err := m.DryCommitMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydrateToPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePaths = append(m.ActivePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousPullRequest == nil {
+				m.PreviousPullRequest = &v1alpha1.HydratePullRequestStatus{}
+			}
+			if err := m.PreviousPullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitiveAnnotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SensitiveAnnotations = append(m.SensitiveAnnotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, &HydratedManifestDetails{})
+			if err := m.Manifests[len(m.Manifests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestLayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestLayout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToolVersions == nil {
+				m.ToolVersions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCommit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ToolVersions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrySource == nil {
+				m.DrySource = &v1alpha1.DrySource{}
+			}
+			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySources = append(m.DrySources, &v1alpha1.DrySource{})
+			if err := m.DrySources[len(m.DrySources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydratedManifestDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydratedManifestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestJSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitHydratedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratePullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromotionError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffHydratedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &HydratedFileDiff{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedPaths = append(m.OrphanedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydratedFileDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydratedFileDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydratedFileDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCommit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCommit(x uint64) (n int) {
-	return sovCommit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
+func (m *RevertHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -782,10 +3192,10 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitHydratedManifestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertHydratedManifestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitHydratedManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertHydratedManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -826,7 +3236,7 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -854,11 +3264,11 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncBranch = string(dAtA[iNdEx:postIndex])
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -886,11 +3296,11 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,7 +3328,7 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySha = string(dAtA[iNdEx:postIndex])
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -954,9 +3364,9 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommit
@@ -966,29 +3376,27 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Paths = append(m.Paths, &PathDetails{})
-			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryCommitMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1015,99 +3423,16 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DryCommitMetadata == nil {
-				m.DryCommitMetadata = &v1alpha1.RevisionMetadata{}
-			}
-			if err := m.DryCommitMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydrateToPullRequest{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PathDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PathDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PathDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1134,42 +3459,12 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, &HydratedManifestDetails{})
-			if err := m.Manifests[len(m.Manifests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommit
+			if m.PreviousPullRequest == nil {
+				m.PreviousPullRequest = &v1alpha1.HydratePullRequestStatus{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PreviousPullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1193,7 +3488,7 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
+func (m *RevertHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1216,15 +3511,15 @@ func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HydratedManifestDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertHydratedManifestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydratedManifestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestJSON", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,62 +3547,47 @@ func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManifestJSON = string(dAtA[iNdEx:postIndex])
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommit
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratePullRequestStatus{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitHydratedManifestsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1335,7 +3615,7 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSha = string(dAtA[iNdEx:postIndex])
+			m.PromotionError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
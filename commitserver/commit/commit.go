@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -24,15 +27,22 @@ const (
 
 // Service is the service that handles commit requests.
 type Service struct {
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	metricsServer             *metrics.Server
+	repoClientFactory         RepoClientFactory
+	pullRequestServiceFactory PullRequestServiceFactory
+	ociRepositoryFactory      OCIRepositoryFactory
+	manifestValidator         *ManifestValidator
 }
 
-// NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+// NewService returns a new instance of the commit service. If signingOpts is not nil, hydrated commits are signed. If
+// manifestValidator is not nil, hydrated manifests are validated before they are committed.
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingOpts *git.CommitSigningOpts, manifestValidator *ManifestValidator) *Service {
 	return &Service{
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		metricsServer:             metricsServer,
+		repoClientFactory:         NewRepoClientFactory(gitCredsStore, metricsServer, signingOpts),
+		pullRequestServiceFactory: NewPullRequestServiceFactory(),
+		ociRepositoryFactory:      NewOCIRepositoryFactory(),
+		manifestValidator:         manifestValidator,
 	}
 }
 
@@ -55,8 +65,16 @@ type hydratorMetadataFile struct {
 // stored in the custom note namespace by the hydrator.
 type CommitNote struct {
 	DrySHA string `json:"drySha"` // SHA of original commit that triggerd the hydrator
+	// Paths are the hydrated paths of the branch written by the hydrator, sorted. They are carried over from the note
+	// of the previous hydrated commit, so that paths which are no longer hydrated by any application can be removed.
+	Paths []string `json:"paths,omitempty"`
 }
 
+// pullRequestBody is the description of pull requests opened to promote hydrated manifests.
+const pullRequestBody = `This pull request was opened by the Argo CD source hydrator to promote hydrated manifests.
+
+It is updated automatically when new manifests are hydrated. Merge it to deploy the changes.`
+
 // TODO: make this configurable via ConfigMap.
 var manifestHydrationReadmeTemplate = `# Manifest Hydration
 
@@ -69,6 +87,14 @@ git checkout {{ .DrySHA }}
 {{ range $command := .Commands -}}
 {{ $command }}
 {{ end -}}` + "```" + `
+{{ if .DrySources -}}
+
+## Dry Sources
+
+{{ range $source := .DrySources -}}
+* {{ $source.RepoURL }}{{ if $source.Chart }} chart {{ $source.Chart }}{{ else if $source.Path }} path {{ $source.Path }}{{ end }}{{ if $source.Ref }} (ref {{ $source.Ref }}){{ end }} at {{ $source.Revision }}
+{{ end -}}
+{{ end -}}
 {{ if .References -}}
 
 ## References
@@ -82,8 +108,9 @@ git checkout {{ .DrySHA }}
 
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+// pushes the changes. If a pull request is configured, it then opens a pull request from the target branch to the sync
+// branch. It returns the hydrated revision SHA, the pull request status and an error if one occurred.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -96,11 +123,13 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 	}
 
 	var err error
+	var promotionErr *promotionError
 	s.metricsServer.IncPendingCommitRequest(repoURL)
 	defer func() {
 		s.metricsServer.DecPendingCommitRequest(repoURL)
 		commitResponseType := metrics.CommitResponseTypeSuccess
-		if err != nil {
+		// A failed promotion does not fail the commit request, see below.
+		if err != nil && !errors.As(err, &promotionErr) {
 			commitResponseType = metrics.CommitResponseTypeFailure
 		}
 		s.metricsServer.IncCommitRequest(repoURL, commitResponseType)
@@ -109,7 +138,17 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	out, sha, pullRequest, err := s.handleCommitRequest(ctx, logCtx, r)
+	if errors.As(err, &promotionErr) {
+		// The manifests were pushed, so the request succeeded. The promotion is reported separately, so that the
+		// hydration is not retried.
+		logCtx.WithError(err).Error("failed to promote target branch")
+		return &apiclient.CommitHydratedManifestsResponse{
+			HydratedSha:    sha,
+			PullRequest:    r.PreviousPullRequest,
+			PromotionError: promotionErr.Error(),
+		}, nil
+	}
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -120,37 +159,44 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 	logCtx.Info("Successfully handled commit request")
 	return &apiclient.CommitHydratedManifestsResponse{
 		HydratedSha: sha,
+		PullRequest: pullRequest,
 	}, nil
 }
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
 // target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
-	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
-	}
-	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
-	}
-	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+// the changes. If a pull request is configured, it then promotes the target branch to the sync branch. It returns the
+// output of the git commands, the hydrated SHA, the pull request status and an error if one occurred.
+//
+// If the repository is an OCI repository, the manifests are pushed as an OCI artifact instead, and the digest of the
+// artifact is returned as the hydrated SHA.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, *v1alpha1.HydratePullRequestStatus, error) {
+	err := validateRequest(r)
+	if err != nil {
+		return "", "", nil, err
 	}
-	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+
+	// Validate the manifests before anything is written, so that invalid manifests never reach the repository.
+	err = s.manifestValidator.Validate(ctx, r)
+	if err != nil {
+		return "", "", nil, err
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	if isOCIRequest(r) {
+		digest, err := s.handleOCIRequest(ctx, logCtx, r)
+		return "", digest, nil, err
+	}
 	logCtx.Debug("Initiating git client")
-	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
+	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r.Repo)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open root dir: %w", err)
+		return "", "", nil, fmt.Errorf("failed to open root dir: %w", err)
 	}
 	defer io.Close(root)
 
@@ -158,15 +204,207 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout sync branch: %w", err)
+	}
+
+	// The sync branch SHA is only needed to compare it with the target branch when promoting via a pull request.
+	var syncSha string
+	promote := r.PullRequest != nil && r.TargetBranch != r.SyncBranch
+	if promote {
+		syncSha, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", nil, fmt.Errorf("failed to get sync branch commit SHA: %w", err)
+		}
 	}
 
 	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
 	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout target branch: %w", err)
+	}
+
+	out, sha, err := s.commitManifests(logCtx, r, root, gitClient)
+	if err != nil {
+		return out, "", nil, err
+	}
+	if !promote {
+		return "", sha, nil, nil
+	}
+
+	pullRequest, err := s.promote(ctx, logCtx, r, gitClient, syncSha)
+	if err != nil {
+		return "", sha, nil, &promotionError{err: err}
+	}
+	return "", sha, pullRequest, nil
+}
+
+// promotionError is returned by handleCommitRequest when the manifests were pushed but the target branch could not be
+// promoted to the sync branch.
+type promotionError struct {
+	err error
+}
+
+func (e *promotionError) Error() string {
+	return fmt.Sprintf("failed to promote target branch: %v", e.err)
+}
+
+func (e *promotionError) Unwrap() error {
+	return e.err
+}
+
+// DiffHydratedManifests handles a diff request. Like CommitHydratedManifests, it clones the repository, checks out the
+// sync branch and the target branch, and writes the manifests to the repository. Instead of committing and pushing the
+// changes, it returns the diff of each changed file against the target branch.
+func (s *Service) DiffHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.DiffHydratedManifestsResponse, error) {
+	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
+
+	out, resp, err := s.handleDiffRequest(logCtx, r)
+	if err != nil {
+		logCtx.WithError(err).WithField("output", out).Error("failed to handle diff request")
+
+		// No need to wrap this error, sufficient context is build in handleDiffRequest.
+		return &apiclient.DiffHydratedManifestsResponse{}, err
+	}
+
+	logCtx.Debug("Successfully handled diff request")
+	return resp, nil
+}
+
+// handleDiffRequest handles the diff request. It returns the output of the git commands, a response with the diff of
+// each file changed by the hydrated manifests, sorted by path, and the orphaned paths which would be removed, and an
+// error if one occurred.
+func (s *Service) handleDiffRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, *apiclient.DiffHydratedManifestsResponse, error) {
+	err := validateRequest(r)
+	if err != nil {
+		return "", nil, err
+	}
+	if isOCIRequest(r) {
+		return "", nil, errors.New("previewing hydration is not supported when hydrating to an OCI repository")
+	}
+
+	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	logCtx.Debug("Initiating git client")
+	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r.Repo)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	logCtx.Debugf("Checking out sync branch %s", r.SyncBranch)
+	out, err := gitClient.CheckoutOrOrphan(r.SyncBranch, false)
+	if err != nil {
+		return out, nil, fmt.Errorf("failed to checkout sync branch: %w", err)
+	}
+
+	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
+	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
+	if err != nil {
+		return out, nil, fmt.Errorf("failed to checkout target branch: %w", err)
+	}
+
+	// Like a commit, the diff includes the removal of the paths which are no longer hydrated by any application.
+	var owned []string
+	if len(r.ActivePaths) > 0 {
+		owned, err = getOwnedPaths(gitClient)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	// Keep the current manifests of the paths which may change, to hide the data of their Secrets from the diff.
+	dirs := slices.Clone(owned)
+	for _, p := range r.Paths {
+		dirs = append(dirs, p.Path)
+	}
+	previous, err := readManifestFiles(root, dirs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	logCtx.Debug("Writing manifests")
+	changed, err := WriteForPaths(root, getDryRepoURL(r), r.DrySha, r.DryCommitMetadata, r.Paths, gitClient)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	var orphans []string
+	if len(r.ActivePaths) > 0 {
+		var removed bool
+		_, orphans, removed, err = pruneOrphanedPaths(root, owned, r.ActivePaths)
+		if err != nil {
+			return "", nil, err
+		}
+		changed = changed || removed
+	}
+	if !changed {
+		// Nothing would be committed, so there is nothing to diff.
+		return "", &apiclient.DiffHydratedManifestsResponse{OrphanedPaths: orphans}, nil
+	}
+
+	logCtx.Debug("Diffing changes")
+	diffs, err := gitClient.DiffWorkingTree()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to diff manifests: %w", err)
+	}
+	sensitiveAnnotations := make(map[string]bool, len(r.SensitiveAnnotations))
+	for _, annotation := range r.SensitiveAnnotations {
+		sensitiveAnnotations[annotation] = true
+	}
+	err = hideSecretDiffs(root, previous, diffs, sensitiveAnnotations)
+	if err != nil {
+		return "", nil, err
+	}
+	files := make([]*apiclient.HydratedFileDiff, 0, len(diffs))
+	for _, path := range slices.Sorted(maps.Keys(diffs)) {
+		files = append(files, &apiclient.HydratedFileDiff{Path: path, Diff: diffs[path]})
+	}
+	return "", &apiclient.DiffHydratedManifestsResponse{Files: files, OrphanedPaths: orphans}, nil
+}
+
+// getOwnedPaths returns the hydrated paths owned by the hydrator, as recorded in the note of the checked out commit.
+func getOwnedPaths(gitClient git.Client) ([]string, error) {
+	sha, err := gitClient.CommitSHA()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	note, err := GetNote(gitClient, sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notes from git %w", err)
+	}
+	if note == nil {
+		return nil, nil
+	}
+	return note.Paths, nil
+}
+
+// validateRequest checks that the request contains the fields required to check out the sync and target branches.
+func validateRequest(r *apiclient.CommitHydratedManifestsRequest) error {
+	if r.Repo == nil {
+		return errors.New("repo is required")
 	}
+	if r.Repo.Repo == "" {
+		return errors.New("repo URL is required")
+	}
+	if r.TargetBranch == "" {
+		return errors.New("target branch is required")
+	}
+	if r.SyncBranch == "" {
+		return errors.New("sync branch is required")
+	}
+	return nil
+}
 
+// commitManifests writes the manifests to the checked out target branch, commits and pushes them, removes the paths
+// which are no longer hydrated by any application in a separate commit, and records the hydrated commit in a git note.
+// It returns the output of the git commands, the hydrated SHA (empty if nothing was committed) and an error if one
+// occurred.
+func (s *Service) commitManifests(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest, root *os.Root, gitClient git.Client) (string, string, error) {
 	hydratedSha, err := gitClient.CommitSHA()
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
@@ -178,57 +416,174 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	3. If not, get the last manifest from git  for every path, compare it with the hydrated manifest
 	3a. If manifest has no changes, continue.. no need to commit it
 	3b. Else, hydrate the manifest.
-	3c. Push the updated note
+	3c. Remove the orphaned paths listed in the note
+	3d. Push the updated note
 	*/
-	isHydrated, err := IsHydrated(gitClient, r.DrySha, hydratedSha)
+	note, err := GetNote(gitClient, hydratedSha)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get notes from git %w", err)
 	}
-	// short-circuit if already hydrated
-	if isHydrated {
-		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
-		return "", "", nil
+	// Skip writing the manifests if already hydrated. Paths with additional dry sources may have changed without a new dry
+	// commit, so they are always written, and only committed if their manifests changed. Orphaned paths are removed
+	// either way, since the applications hydrating to the branch may have changed without a new dry commit.
+	alreadyHydrated := note != nil && note.DrySHA == r.DrySha && !hasAdditionalDrySources(r.Paths)
+	var ownedPaths []string
+	if note != nil {
+		ownedPaths = note.Paths
 	}
+	ownedPaths = addOwnedPaths(ownedPaths, r.Paths)
 
-	logCtx.Debug("Writing manifests")
-	shouldCommit, err := WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient)
-	// When there are no new manifests to commit, err will be nil and success will be false as nothing to commit. Else or every other error err will not be nil
-	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+	shouldCommit := false
+	if alreadyHydrated {
+		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
+	} else {
+		logCtx.Debug("Writing manifests")
+		shouldCommit, err = WriteForPaths(root, getDryRepoURL(r), r.DrySha, r.DryCommitMetadata, r.Paths, gitClient)
+		// When there are no new manifests to commit, err will be nil and success will be false as nothing to commit. Else or every other error err will not be nil
+		if err != nil {
+			return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		}
 	}
-	if !shouldCommit {
-		// add the note and return
-		logCtx.Debug("Adding commit note")
-		err = AddNote(gitClient, r.DrySha, hydratedSha)
+	var sha string
+	if shouldCommit {
+		logCtx.Debug("Committing and pushing changes")
+		out, err := gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to add commit note: %w", err)
+			return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		}
+
+		logCtx.Debug("Getting commit SHA")
+		sha, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
 		}
-		return "", "", nil
 	}
-	logCtx.Debug("Committing and pushing changes")
-	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
+
+	// Orphaned paths are removed in their own commit, so that the removal is easy to spot and to revert.
+	ownedPaths, orphans, removed, err := pruneOrphanedPaths(root, ownedPaths, r.ActivePaths)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return "", "", err
+	}
+	if alreadyHydrated && !removed {
+		return "", "", nil
+	}
+	if removed {
+		logCtx.WithField("paths", orphans).Info("Removing orphaned hydrated paths")
+		out, err := gitClient.CommitAndPush(r.TargetBranch, getOrphanedPathsCommitMessage(orphans))
+		if err != nil {
+			return out, "", fmt.Errorf("failed to commit and push removal of orphaned paths: %w", err)
+		}
+		sha, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		}
 	}
 
-	logCtx.Debug("Getting commit SHA")
-	sha, err := gitClient.CommitSHA()
-	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	// add the commit note, to the existing commit if nothing was committed
+	noteSha := sha
+	if noteSha == "" {
+		noteSha = hydratedSha
 	}
-	// add the commit note
 	logCtx.Debug("Adding commit note")
-	err = AddNote(gitClient, r.DrySha, sha)
+	err = AddNote(gitClient, CommitNote{DrySHA: r.DrySha, Paths: ownedPaths}, noteSha)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to add commit note: %w", err)
 	}
 	return "", sha, nil
 }
 
+// hasAdditionalDrySources returns true if any of the given paths was hydrated from additional dry sources.
+func hasAdditionalDrySources(paths []*apiclient.PathDetails) bool {
+	return slices.ContainsFunc(paths, func(p *apiclient.PathDetails) bool {
+		return len(p.DrySources) > 0
+	})
+}
+
+// promote opens a pull request from the target branch to the sync branch, unless one is already open or the target
+// branch has no changes compared to the sync branch. It returns the status of the open pull request. If there is none,
+// it returns the previous pull request of the request with its final state, or nil if there is no previous pull request.
+func (s *Service) promote(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest, gitClient git.Client, syncSha string) (*v1alpha1.HydratePullRequestStatus, error) {
+	prService, err := s.pullRequestServiceFactory.NewService(ctx, r.Repo, r.PullRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request service: %w", err)
+	}
+
+	logCtx.Debug("Listing open pull requests")
+	pullRequests, err := prService.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	for _, pr := range pullRequests {
+		if pr.Branch == r.TargetBranch && pr.TargetBranch == r.SyncBranch {
+			logCtx.Debugf("Pull request %d is already open", pr.Number)
+			return pullRequestStatus(pr), nil
+		}
+	}
+
+	previous, err := previousPullRequestStatus(ctx, prService, r.PreviousPullRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	targetSha, err := gitClient.CommitSHA()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target branch commit SHA: %w", err)
+	}
+	changedFiles, err := gitClient.ChangedFiles(syncSha, targetSha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare target branch with sync branch: %w", err)
+	}
+	if len(changedFiles) == 0 {
+		logCtx.Debug("Target branch has no changes compared to the sync branch, not opening a pull request")
+		return previous, nil
+	}
+
+	logCtx.Info("Opening pull request")
+	pr, err := prService.Create(ctx, &pull_request.CreateOptions{
+		Title:        fmt.Sprintf("Promote hydrated manifests from %s to %s", r.TargetBranch, r.SyncBranch),
+		Body:         pullRequestBody,
+		Branch:       r.TargetBranch,
+		TargetBranch: r.SyncBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	return pullRequestStatus(pr), nil
+}
+
+// previousPullRequestStatus returns the given previous pull request, which is no longer open, with its final state. It
+// returns nil if there is no previous pull request.
+func previousPullRequestStatus(ctx context.Context, prService pull_request.PullRequestService, previous *v1alpha1.HydratePullRequestStatus) (*v1alpha1.HydratePullRequestStatus, error) {
+	if previous == nil {
+		return nil, nil
+	}
+	status := previous.DeepCopy()
+	if status.State != v1alpha1.HydratePullRequestStateOpen {
+		return status, nil
+	}
+	merged, err := prService.Merged(ctx, previous.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the state of pull request %d: %w", previous.Number, err)
+	}
+	status.State = v1alpha1.HydratePullRequestStateClosed
+	if merged {
+		status.State = v1alpha1.HydratePullRequestStateMerged
+	}
+	return status, nil
+}
+
+func pullRequestStatus(pr *pull_request.PullRequest) *v1alpha1.HydratePullRequestStatus {
+	return &v1alpha1.HydratePullRequestStatus{
+		Number: pr.Number,
+		URL:    pr.URL,
+		State:  v1alpha1.HydratePullRequestStateOpen,
+	}
+}
+
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
 // the repository is cloned, a cleanup function that should be called when the directory is no longer needed, and an error
 // if one occurred.
-func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (git.Client, string, func(), error) {
+func (s *Service) initGitClient(logCtx *log.Entry, repo *v1alpha1.Repository) (git.Client, string, func(), error) {
 	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
 	if err != nil {
 		return nil, "", nil, fmt.Errorf("failed to create temp dir: %w", err)
@@ -241,20 +596,20 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		}
 	}
 
-	gitClient, err := s.repoClientFactory.NewClient(r.Repo, dirPath)
+	gitClient, err := s.repoClientFactory.NewClient(repo, dirPath)
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to create git client: %w", err)
 	}
 
-	logCtx.Debugf("Initializing repo %s", r.Repo.Repo)
+	logCtx.Debugf("Initializing repo %s", repo.Repo)
 	err = gitClient.Init()
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 
-	logCtx.Debugf("Fetching repo %s", r.Repo.Repo)
+	logCtx.Debugf("Fetching repo %s", repo.Repo)
 	err = gitClient.Fetch("", 0)
 	if err != nil {
 		cleanupOrLog()
@@ -263,10 +618,10 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 
 	// FIXME: make it work for GHE
 	// logCtx.Debugf("Getting user info for repo credentials")
-	// gitCreds := r.Repo.GetGitCreds(s.gitCredsStore)
+	// gitCreds := repo.GetGitCreds(s.gitCredsStore)
 	// startTime := time.Now()
 	// authorName, authorEmail, err := gitCreds.GetUserInfo(ctx)
-	// s.metricsServer.ObserveUserInfoRequestDuration(r.Repo.Repo, getCredentialType(r.Repo), time.Since(startTime))
+	// s.metricsServer.ObserveUserInfoRequestDuration(repo.Repo, getCredentialType(repo), time.Since(startTime))
 	// if err != nil {
 	//	 cleanupOrLog()
 	//	 return nil, "", nil, fmt.Errorf("failed to get github app info: %w", err)
@@ -1,13 +1,18 @@
 package commit
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
@@ -332,6 +337,54 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		assert.Empty(t, resp.HydratedSha) // changes introduced by commit note. hydration won't happen if there are no new manifest|s to commit
 	})
 
+	t.Run("dry sha already hydrated with additional dry sources", func(t *testing.T) {
+		t.Parallel()
+
+		// An additional dry source may have changed since the dry SHA was hydrated, so the manifests are written again.
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything).Return(`{"drySha":"abc123","paths":["app"]}`, nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "test commit message").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("new-hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote("new-hydrated-sha", NoteNamespace, `{"drySha":"abc123","paths":["app"]}`).Return(nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			DrySha:        "abc123",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{
+				{
+					Path: "app",
+					Manifests: []*apiclient.HydratedManifestDetails{
+						{
+							ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test-app"}}`,
+						},
+					},
+					DrySource:    &v1alpha1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+					DrySources:   []*v1alpha1.DrySource{{RepoURL: "https://charts.example.com", Chart: "guestbook"}},
+					DryRevisions: []string{"abc123", "1.2.4"},
+				},
+			},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "new-hydrated-sha", resp.HydratedSha)
+	})
+
 	t.Run("root path with dot - no changes to manifest - should commit note only", func(t *testing.T) {
 		t.Parallel()
 
@@ -372,6 +425,104 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Empty(t, resp.HydratedSha)
 	})
+
+	t.Run("orphaned paths are removed in a separate commit", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("hydrated-sha", NoteNamespace).Return(`{"drySha":"old-dry-sha","paths":["apps/deleted","apps/staging"]}`, nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything).Return(false, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "Remove orphaned hydrated paths\n\nThe following paths are no longer hydrated by any application:\n\n- apps/deleted").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("cleanup-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote("cleanup-sha", NoteNamespace, `{"drySha":"abc123","paths":["apps/staging"]}`).Return(nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, rootPath string) (git.Client, error) {
+			// The hydrated branch holds the manifests of a deleted application.
+			require.NoError(t, os.MkdirAll(filepath.Join(rootPath, "apps/deleted"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(rootPath, "apps/deleted", ManifestYaml), []byte("kind: ConfigMap"), 0o644))
+			return mockGitClient, nil
+		}).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			DrySha:        "abc123",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{
+				{
+					Path: "apps/staging",
+					Manifests: []*apiclient.HydratedManifestDetails{
+						{
+							ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"staging"}}`,
+						},
+					},
+				},
+			},
+			ActivePaths: []string{"apps/staging"},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "cleanup-sha", resp.HydratedSha)
+	})
+
+	t.Run("orphaned paths are removed when the dry sha is already hydrated", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("hydrated-sha", NoteNamespace).Return(`{"drySha":"abc123","paths":["apps/deleted","apps/staging"]}`, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "Remove orphaned hydrated paths\n\nThe following paths are no longer hydrated by any application:\n\n- apps/deleted").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("cleanup-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote("cleanup-sha", NoteNamespace, `{"drySha":"abc123","paths":["apps/staging"]}`).Return(nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, rootPath string) (git.Client, error) {
+			require.NoError(t, os.MkdirAll(filepath.Join(rootPath, "apps/deleted"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(rootPath, "apps/deleted", ManifestYaml), []byte("kind: ConfigMap"), 0o644))
+			return mockGitClient, nil
+		}).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			DrySha:        "abc123",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{
+				{
+					Path: "apps/staging",
+					Manifests: []*apiclient.HydratedManifestDetails{
+						{
+							ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"staging"}}`,
+						},
+					},
+				},
+			},
+			ActivePaths: []string{"apps/staging"},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "cleanup-sha", resp.HydratedSha)
+	})
 }
 
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
@@ -379,9 +530,318 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, nil, nil)
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
 
 	return service, mockRepoClientFactory
 }
+
+func Test_CommitHydratedManifests_PullRequest(t *testing.T) {
+	t.Parallel()
+
+	pullRequestRequest := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		DrySha:        "dry-sha",
+		TargetBranch:  "env/test-next",
+		SyncBranch:    "env/test",
+		CommitMessage: "test commit message",
+		PullRequest: &v1alpha1.HydrateToPullRequest{
+			Provider: v1alpha1.PullRequestProviderGitHub,
+		},
+	}
+
+	// newMockGitClient returns a git client that finds the dry SHA already hydrated on the target branch, so that
+	// only the promotion logic is exercised.
+	newMockGitClient := func(t *testing.T, syncSha, targetSha string) *gitmocks.Client {
+		t.Helper()
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return(syncSha, nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("env/test-next", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return(targetSha, nil)
+		mockGitClient.EXPECT().GetCommitNote(targetSha, NoteNamespace).Return(`{"drySha":"dry-sha"}`, nil).Once()
+		return mockGitClient
+	}
+
+	t.Run("opens a pull request when the target branch has changes", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := newMockGitClient(t, "sync-sha", "target-sha")
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "target-sha").Return([]string{"manifest.yaml"}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), nil, nil)
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, pullRequestRequest.Repo, pullRequestRequest.PullRequest).Return(prService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp.PullRequest)
+		assert.Equal(t, int64(1), resp.PullRequest.Number)
+		assert.Equal(t, v1alpha1.HydratePullRequestStateOpen, resp.PullRequest.State)
+
+		pullRequests, err := prService.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, "env/test-next", pullRequests[0].Branch)
+		assert.Equal(t, "env/test", pullRequests[0].TargetBranch)
+	})
+
+	t.Run("reuses an already open pull request", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := newMockGitClient(t, "sync-sha", "target-sha")
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), []*pull_request.PullRequest{
+			{Number: 7, Branch: "feature", TargetBranch: "env/test", URL: "https://github.com/argoproj/argocd-example-apps/pull/7"},
+			{Number: 42, Branch: "env/test-next", TargetBranch: "env/test", URL: "https://github.com/argoproj/argocd-example-apps/pull/42"},
+		}, nil)
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, mock.Anything, mock.Anything).Return(prService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HydratePullRequestStatus{
+			Number: 42,
+			URL:    "https://github.com/argoproj/argocd-example-apps/pull/42",
+			State:  v1alpha1.HydratePullRequestStateOpen,
+		}, resp.PullRequest)
+	})
+
+	t.Run("does not open a pull request when the branches are identical", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := newMockGitClient(t, "sync-sha", "target-sha")
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "target-sha").Return([]string{}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), nil, nil)
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, mock.Anything, mock.Anything).Return(prService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		assert.Nil(t, resp.PullRequest)
+	})
+
+	t.Run("reports the final state of the previous pull request", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := newMockGitClient(t, "sync-sha", "target-sha")
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "target-sha").Return([]string{}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), nil, nil)
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, mock.Anything, mock.Anything).Return(&mergedPullRequestService{PullRequestService: prService}, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		request := *pullRequestRequest
+		request.PreviousPullRequest = &v1alpha1.HydratePullRequestStatus{Number: 42, State: v1alpha1.HydratePullRequestStateOpen}
+		resp, err := service.CommitHydratedManifests(t.Context(), &request)
+		require.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HydratePullRequestStatus{Number: 42, State: v1alpha1.HydratePullRequestStateMerged}, resp.PullRequest)
+	})
+
+	t.Run("reports a promotion failure separately", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := newMockGitClient(t, "sync-sha", "target-sha")
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), nil, errors.New("rate limited"))
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, mock.Anything, mock.Anything).Return(prService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		// the manifests were pushed, so the request succeeds
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		assert.Contains(t, resp.PromotionError, "rate limited")
+	})
+}
+
+// mergedPullRequestService is a pull request service whose pull requests were all merged.
+type mergedPullRequestService struct {
+	pull_request.PullRequestService
+}
+
+func (s *mergedPullRequestService) Merged(_ context.Context, _ int64) (bool, error) {
+	return true, nil
+}
+
+func Test_DiffHydratedManifests(t *testing.T) {
+	t.Parallel()
+
+	request := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "main",
+		SyncBranch:    "env/test",
+		CommitMessage: "test commit message",
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "app",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{
+						ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`,
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("missing repo", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.DiffHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{})
+		require.ErrorContains(t, err, "repo is required")
+	})
+
+	t.Run("returns the diffs sorted by path without committing", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged("app/manifest.yaml").Return(true, nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{
+			"app/manifest.yaml":     "manifest diff",
+			"app/hydrator.metadata": "metadata diff",
+		}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.DiffHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.HydratedFileDiff{
+			{Path: "app/hydrator.metadata", Diff: "metadata diff"},
+			{Path: "app/manifest.yaml", Diff: "manifest diff"},
+		}, resp.Files)
+	})
+
+	t.Run("no manifest changes", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged("app/manifest.yaml").Return(false, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.DiffHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Files)
+	})
+
+	t.Run("lists the orphaned paths", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged("app/manifest.yaml").Return(false, nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("hydrated-sha", NoteNamespace).Return(`{"drySha":"dry-sha","paths":["app","deleted"]}`, nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{
+			"deleted/manifest.yaml": "manifest diff",
+		}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, rootPath string) (git.Client, error) {
+			require.NoError(t, os.MkdirAll(filepath.Join(rootPath, "deleted"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(rootPath, "deleted", ManifestYaml), []byte("kind: ConfigMap"), 0o644))
+			return mockGitClient, nil
+		}).Once()
+
+		pruneRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          request.Repo,
+			TargetBranch:  request.TargetBranch,
+			SyncBranch:    request.SyncBranch,
+			CommitMessage: request.CommitMessage,
+			Paths:         request.Paths,
+			ActivePaths:   []string{"app"},
+		}
+		resp, err := service.DiffHydratedManifests(t.Context(), pruneRequest)
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.HydratedFileDiff{{Path: "deleted/manifest.yaml", Diff: "manifest diff"}}, resp.Files)
+		assert.Equal(t, []string{"deleted"}, resp.OrphanedPaths)
+	})
+
+	t.Run("hides the data of Secrets on both sides", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged("app/manifest.yaml").Return(true, nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{
+			"app/manifest.yaml": "plain text diff",
+		}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, rootPath string) (git.Client, error) {
+			require.NoError(t, os.MkdirAll(filepath.Join(rootPath, "app"), 0o755))
+			previous := "apiVersion: v1\ndata:\n  password: b2xk\n  username: YWRtaW4=\nkind: Secret\nmetadata:\n  annotations:\n    token: old-token\n  name: test\n"
+			require.NoError(t, os.WriteFile(filepath.Join(rootPath, "app", ManifestYaml), []byte(previous), 0o644))
+			return mockGitClient, nil
+		}).Once()
+
+		secretRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          request.Repo,
+			TargetBranch:  request.TargetBranch,
+			SyncBranch:    request.SyncBranch,
+			CommitMessage: request.CommitMessage,
+			Paths: []*apiclient.PathDetails{{
+				Path: "app",
+				Manifests: []*apiclient.HydratedManifestDetails{{
+					ManifestJSON: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"test","annotations":{"token":"new-token"}},"data":{"password":"bmV3","username":"YWRtaW4="}}`,
+				}},
+			}},
+			SensitiveAnnotations: []string{"token"},
+		}
+		resp, err := service.DiffHydratedManifests(t.Context(), secretRequest)
+		require.NoError(t, err)
+		require.Len(t, resp.Files, 1)
+		// The unchanged username is hidden identically on both sides, while the changed password and token still show up
+		// as changed.
+		assert.Equal(t, `diff --git a/app/manifest.yaml b/app/manifest.yaml
+--- a/app/manifest.yaml
++++ b/app/manifest.yaml
+@@ -1,9 +1,9 @@
+ apiVersion: v1
+ data:
+-  password: ++++++++++++
++  password: ++++++++
+   username: ++++++++
+ kind: Secret
+ metadata:
+   annotations:
+-    token: ++++++++++++
++    token: ++++++++
+   name: test`, resp.Files[0].Diff)
+	})
+}
@@ -1,11 +1,15 @@
 package commit
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -24,7 +28,8 @@ import (
 var sprigFuncMap = sprig.GenericFuncMap() // a singleton for better performance
 
 const gitAttributesContents = `*/README.md linguist-generated=true
-*/hydrator.metadata linguist-generated=true`
+*/hydrator.metadata linguist-generated=true
+*/hydrator.provenance linguist-generated=true`
 
 func init() {
 	// Avoid allowing the user to learn things about the environment.
@@ -33,7 +38,7 @@ func init() {
 	delete(sprigFuncMap, "getHostByName")
 }
 
-// WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
+// WriteForPaths writes the manifests, hydrator.metadata, hydrator.provenance, and README.md files for each path in the provided paths. It
 // also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
 func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails, gitClient git.Client) (bool, error) { //nolint:revive //FIXME(var-naming)
 	hydratorMetadata, err := hydrator.GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
@@ -68,14 +73,25 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 		}
 
 		// Write the manifests
-		err := writeManifests(root, hydratePath, p.Manifests)
+		layout := appv1.HydratedManifestLayout(p.ManifestLayout)
+		files, err := writeManifestsForLayout(root, hydratePath, layout, p.Manifests)
 		if err != nil {
 			return false, fmt.Errorf("failed to write manifests: %w", err)
 		}
-		// Check if the manifest file has been modified compared to the git index
-		changed, err := gitClient.HasFileChanged(filepath.Join(hydratePath, ManifestYaml))
+		// Remove manifest files written by a previous hydration which are no longer part of the output.
+		changed, err := removeStaleManifests(root, hydratePath, files)
 		if err != nil {
-			return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+			return false, fmt.Errorf("failed to remove stale manifests: %w", err)
+		}
+		// Check if any manifest file has been modified compared to the git index
+		for _, file := range files {
+			if changed {
+				break
+			}
+			changed, err = gitClient.HasFileChanged(filepath.Join(hydratePath, file))
+			if err != nil {
+				return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+			}
 		}
 
 		if !changed {
@@ -86,15 +102,25 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 
 		// Write hydrator.metadata containing information about the hydration process.
 		hydratorMetadata := hydrator.HydratorCommitMetadata{
-			Commands: p.Commands,
-			DrySHA:   drySha,
-			RepoURL:  repoUrl,
+			Commands:   p.Commands,
+			DrySHA:     drySha,
+			RepoURL:    repoUrl,
+			DrySources: getDrySourcesMetadata(p),
+		}
+		if isPerResourceLayout(layout) {
+			hydratorMetadata.Files = files
 		}
 		err = writeMetadata(root, hydratePath, hydratorMetadata)
 		if err != nil {
 			return false, fmt.Errorf("failed to write hydrator metadata: %w", err)
 		}
 
+		// Write hydrator.provenance attesting how the manifests were hydrated.
+		err = writeProvenance(root, hydratePath, hydratorMetadata, p, files)
+		if err != nil {
+			return false, fmt.Errorf("failed to write hydrator provenance: %w", err)
+		}
+
 		// Write README
 		err = writeReadme(root, hydratePath, hydratorMetadata)
 		if err != nil {
@@ -127,6 +153,24 @@ func writeMetadata(root *os.Root, dirPath string, metadata hydrator.HydratorComm
 	return nil
 }
 
+// writeProvenance writes the provenance of the given manifest files of a hydrated path to its hydrator.provenance file.
+func writeProvenance(root *os.Root, dirPath string, metadata hydrator.HydratorCommitMetadata, p *apiclient.PathDetails, files []string) error {
+	subjects, err := hydrator.DigestFiles(root, dirPath, files)
+	if err != nil {
+		return fmt.Errorf("failed to digest manifests: %w", err)
+	}
+	return hydrator.WriteProvenance(root, dirPath, hydrator.NewProvenance(metadata, p.DrySource, p.DrySources, p.ToolVersions, subjects))
+}
+
+// getDrySourcesMetadata returns the metadata of all the dry sources the given path was hydrated from, or nil if it was
+// hydrated from a single dry source.
+func getDrySourcesMetadata(p *apiclient.PathDetails) []hydrator.DrySourceMetadata {
+	if len(p.DrySources) == 0 {
+		return nil
+	}
+	return hydrator.GetDrySourcesMetadata(append([]*appv1.DrySource{p.DrySource}, p.DrySources...), p.DryRevisions)
+}
+
 // writeReadme writes the readme to the README.md file.
 func writeReadme(root *os.Root, dirPath string, metadata hydrator.HydratorCommitMetadata) error {
 	readmeTemplate, err := template.New("readme").Funcs(sprigFuncMap).Parse(manifestHydrationReadmeTemplate)
@@ -175,6 +219,131 @@ func writeGitAttributes(root *os.Root) error {
 	return nil
 }
 
+// isPerResourceLayout returns true if the given layout writes each manifest to its own file.
+func isPerResourceLayout(layout appv1.HydratedManifestLayout) bool {
+	return layout == appv1.HydratedManifestLayoutPerResource || layout == appv1.HydratedManifestLayoutPerResourceByKind
+}
+
+// writeManifestsForLayout writes the manifests to the given directory according to the given layout and returns the
+// sorted paths of the written files, relative to the directory. An empty layout is treated as SingleFile.
+func writeManifestsForLayout(root *os.Root, dirPath string, layout appv1.HydratedManifestLayout, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	switch layout {
+	case "", appv1.HydratedManifestLayoutSingleFile:
+		return []string{ManifestYaml}, writeManifests(root, dirPath, manifests)
+	case appv1.HydratedManifestLayoutPerResource, appv1.HydratedManifestLayoutPerResourceByKind:
+		return writePerResourceManifests(root, dirPath, layout == appv1.HydratedManifestLayoutPerResourceByKind, manifests)
+	default:
+		return nil, fmt.Errorf("unknown manifest layout %q", layout)
+	}
+}
+
+// writePerResourceManifests writes each manifest to its own file, named <kind>.<group>_<namespace>_<name>.yaml. If
+// byKind is true, the files are placed in one subdirectory per kind. Files are written in sorted order, so the output
+// does not depend on the order of the manifests.
+func writePerResourceManifests(root *os.Root, dirPath string, byKind bool, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	objs := make(map[string]*unstructured.Unstructured, len(manifests))
+	for _, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		file := perResourceFileName(obj, byKind)
+		if _, ok := objs[file]; ok {
+			return nil, fmt.Errorf("multiple manifests map to the same file %q", file)
+		}
+		objs[file] = obj
+	}
+
+	files := slices.Sorted(maps.Keys(objs))
+	for _, file := range files {
+		// No need to use SecureJoin here, as the path is already sanitized.
+		manifestPath := filepath.Join(dirPath, file)
+		if dir := filepath.Dir(manifestPath); dir != "." {
+			err := root.MkdirAll(dir, 0o755)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create directory for manifest %q: %w", file, err)
+			}
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		err := enc.Encode(&objs[file].Object)
+		if err == nil {
+			err = enc.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode manifest %q: %w", file, err)
+		}
+		err = root.WriteFile(manifestPath, buf.Bytes(), os.ModePerm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write manifest %q: %w", file, err)
+		}
+	}
+	return files, nil
+}
+
+// perResourceFileName returns the file name of the given object, relative to the hydrated path. The group of core
+// resources and the namespace of cluster-scoped resources are omitted. Kinds can't contain dots, and none of the
+// segments can contain underscores, so distinct objects never map to the same file name.
+func perResourceFileName(obj *unstructured.Unstructured, byKind bool) string {
+	kind := strings.ToLower(obj.GetKind())
+	groupKind := kind
+	if group := obj.GroupVersionKind().Group; group != "" {
+		groupKind += "." + strings.ToLower(group)
+	}
+	segments := []string{groupKind}
+	if namespace := obj.GetNamespace(); namespace != "" {
+		segments = append(segments, strings.ToLower(namespace))
+	}
+	segments = append(segments, strings.ToLower(obj.GetName()))
+	file := strings.Join(segments, "_") + ".yaml"
+	if byKind {
+		return filepath.Join(kind, file)
+	}
+	return file
+}
+
+// removeStaleManifests removes the manifest files which were written to the given directory by a previous hydration,
+// but are not among the given files. The previous files are read from the directory's hydrator.metadata file. A
+// manifest.yaml file is always considered, so that switching to a per-resource layout removes it. Returns true if any
+// file was removed.
+func removeStaleManifests(root *os.Root, dirPath string, files []string) (bool, error) {
+	previous := []string{ManifestYaml}
+	data, err := root.ReadFile(filepath.Join(dirPath, "hydrator.metadata"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("failed to read hydrator metadata: %w", err)
+	}
+	if err == nil {
+		var metadata hydrator.HydratorCommitMetadata
+		err = json.Unmarshal(data, &metadata)
+		if err != nil {
+			return false, fmt.Errorf("failed to unmarshal hydrator metadata: %w", err)
+		}
+		previous = append(previous, metadata.Files...)
+	}
+
+	var removed bool
+	for _, file := range previous {
+		if slices.Contains(files, file) {
+			continue
+		}
+		// The metadata file is committed to the repository, so don't trust it to stay within the directory.
+		if !filepath.IsLocal(file) {
+			return false, fmt.Errorf("invalid manifest file path %q in hydrator metadata", file)
+		}
+		err = root.Remove(filepath.Join(dirPath, file))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to remove stale manifest %q: %w", file, err)
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
 // writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
 // manifests in the order they are provided.
 func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
@@ -216,33 +385,31 @@ func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.Hydrat
 	return nil
 }
 
-// IsHydrated checks whether the given commit (commitSha) has already been hydrated with the specified Dry SHA (drySha).
-// It does this by retrieving the commit note in the NoteNamespace and examining the DrySHA value.
-// Returns true if the stored DrySHA matches the provided drySha, false if not or if no note exists.
-// Gracefully handles missing notes as a normal outcome (not an error), but returns an error on retrieval or parse failures.
-func IsHydrated(gitClient git.Client, drySha, commitSha string) (bool, error) {
+// GetNote retrieves the commit note of the given commit (commitSha) in the NoteNamespace.
+// Returns nil if no note exists. Gracefully handles missing notes as a normal outcome (not an error), but returns an
+// error on retrieval or parse failures.
+func GetNote(gitClient git.Client, commitSha string) (*CommitNote, error) {
 	note, err := gitClient.GetCommitNote(commitSha, NoteNamespace)
 	if err != nil {
-		// note not found is a valid and acceptable outcome in this context so returning false and nil to let the hydration continue
+		// note not found is a valid and acceptable outcome in this context so returning nil to let the hydration continue
 		unwrappedError := errors.Unwrap(err)
 		if unwrappedError != nil && errors.Is(unwrappedError, git.ErrNoNoteFound) {
-			return false, nil
+			return nil, nil
 		}
-		return false, err
+		return nil, err
 	}
 	var commitNote CommitNote
 	err = json.Unmarshal([]byte(note), &commitNote)
 	if err != nil {
-		return false, fmt.Errorf("json unmarshal failed %w", err)
+		return nil, fmt.Errorf("json unmarshal failed %w", err)
 	}
-	return commitNote.DrySHA == drySha, nil
+	return &commitNote, nil
 }
 
-// AddNote attaches a commit note containing the specified dry SHA (`drySha`) to the given commit (`commitSha`)
-// in the configured note namespace. The note is marshaled as JSON and pushed to the remote repository using
-// the provided gitClient. Returns an error if marshalling or note addition fails.
-func AddNote(gitClient git.Client, drySha, commitSha string) error {
-	note := CommitNote{DrySHA: drySha}
+// AddNote attaches the given commit note to the given commit (`commitSha`) in the configured note namespace. The note
+// is marshaled as JSON and pushed to the remote repository using the provided gitClient. Returns an error if
+// marshalling or note addition fails.
+func AddNote(gitClient git.Client, note CommitNote, commitSha string) error {
 	jsonBytes, err := json.Marshal(note)
 	if err != nil {
 		return fmt.Errorf("failed to marshal commit note: %w", err)
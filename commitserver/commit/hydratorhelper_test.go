@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -343,6 +344,227 @@ func TestWriteManifests(t *testing.T) {
 	assert.Contains(t, string(manifestBytes), "kind")
 }
 
+func TestWriteForPaths_PerResourceLayout(t *testing.T) {
+	root := tempRoot(t)
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"svc","namespace":"ns"}}`},
+				{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"app","namespace":"ns"}}`},
+				{ManifestJSON: `{"kind":"ClusterRole","apiVersion":"rbac.authorization.k8s.io/v1","metadata":{"name":"Role"}}`},
+			},
+			ManifestLayout: string(appsv1.HydratedManifestLayoutPerResource),
+		},
+	}
+	// A manifest.yaml left behind by the SingleFile layout is removed, so there is no need to check the other files.
+	require.NoError(t, root.MkdirAll("path1", 0o755))
+	require.NoError(t, root.WriteFile("path1/manifest.yaml", []byte("kind: Pod"), 0o644))
+
+	mockGitClient := gitmocks.NewClient(t)
+	shouldCommit, err := WriteForPaths(root, "https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths, mockGitClient)
+	require.NoError(t, err)
+	require.True(t, shouldCommit)
+
+	expectedFiles := []string{
+		"clusterrole.rbac.authorization.k8s.io_role.yaml",
+		"deployment.apps_ns_app.yaml",
+		"service_ns_svc.yaml",
+	}
+	for _, file := range expectedFiles {
+		assert.FileExists(t, filepath.Join(root.Name(), "path1", file))
+	}
+	assert.NoFileExists(t, filepath.Join(root.Name(), "path1", "manifest.yaml"))
+	manifestBytes, err := os.ReadFile(filepath.Join(root.Name(), "path1", "service_ns_svc.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nkind: Service\nmetadata:\n  name: svc\n  namespace: ns\n", string(manifestBytes))
+
+	metadataBytes, err := os.ReadFile(filepath.Join(root.Name(), "path1", "hydrator.metadata"))
+	require.NoError(t, err)
+	var readMetadata hydrator.HydratorCommitMetadata
+	require.NoError(t, json.Unmarshal(metadataBytes, &readMetadata))
+	assert.Equal(t, expectedFiles, readMetadata.Files)
+
+	// Hydrate again without the Service. Its file is removed, and the remaining files are only checked for changes if
+	// nothing was removed.
+	paths[0].Manifests = paths[0].Manifests[1:]
+	shouldCommit, err = WriteForPaths(root, "https://github.com/example/repo", "def456", &appsv1.RevisionMetadata{}, paths, mockGitClient)
+	require.NoError(t, err)
+	require.True(t, shouldCommit)
+	assert.NoFileExists(t, filepath.Join(root.Name(), "path1", "service_ns_svc.yaml"))
+
+	// Hydrate again without changes.
+	mockGitClient.EXPECT().HasFileChanged(mock.Anything).Return(false, nil).Twice()
+	shouldCommit, err = WriteForPaths(root, "https://github.com/example/repo", "def456", &appsv1.RevisionMetadata{}, paths, mockGitClient)
+	require.NoError(t, err)
+	require.False(t, shouldCommit)
+
+	// Switch back to the SingleFile layout.
+	paths[0].ManifestLayout = string(appsv1.HydratedManifestLayoutSingleFile)
+	shouldCommit, err = WriteForPaths(root, "https://github.com/example/repo", "def456", &appsv1.RevisionMetadata{}, paths, mockGitClient)
+	require.NoError(t, err)
+	require.True(t, shouldCommit)
+	entries, err := os.ReadDir(filepath.Join(root.Name(), "path1"))
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"README.md", "hydrator.metadata", "hydrator.provenance", "manifest.yaml"}, names)
+
+	// The provenance attests the manifests of the latest hydration.
+	statement, problems, err := hydrator.VerifyProvenance(root, "path1")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, ManifestYaml, statement.Subject[0].Name)
+	repoURL, drySHA := statement.DryRevision()
+	assert.Equal(t, "https://github.com/example/repo", repoURL)
+	assert.Equal(t, "def456", drySHA)
+}
+
+func TestWriteForPaths_MultipleDrySources(t *testing.T) {
+	root := tempRoot(t)
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "guestbook",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"svc","namespace":"ns"}}`},
+			},
+			Commands:  []string{"helm template . --values values.yaml"},
+			DrySource: &appsv1.DrySource{RepoURL: "https://github.com/example/repo", TargetRevision: "main", Ref: "values"},
+			DrySources: []*appsv1.DrySource{{
+				RepoURL:        "https://charts.example.com",
+				TargetRevision: "1.*",
+				Chart:          "guestbook",
+				Helm:           &appsv1.ApplicationSourceHelm{ValueFiles: []string{"$values/guestbook/values.yaml"}},
+			}},
+			DryRevisions: []string{"abc123", "1.2.3"},
+		},
+	}
+
+	mockGitClient := gitmocks.NewClient(t)
+	mockGitClient.EXPECT().HasFileChanged("guestbook/manifest.yaml").Return(true, nil).Once()
+
+	shouldCommit, err := WriteForPaths(root, "https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths, mockGitClient)
+	require.NoError(t, err)
+	require.True(t, shouldCommit)
+
+	metadataBytes, err := os.ReadFile(filepath.Join(root.Name(), "guestbook", "hydrator.metadata"))
+	require.NoError(t, err)
+	var readMetadata hydrator.HydratorCommitMetadata
+	require.NoError(t, json.Unmarshal(metadataBytes, &readMetadata))
+	assert.Equal(t, []hydrator.DrySourceMetadata{
+		{RepoURL: "https://github.com/example/repo", Ref: "values", Revision: "abc123"},
+		{RepoURL: "https://charts.example.com", Chart: "guestbook", Revision: "1.2.3"},
+	}, readMetadata.DrySources)
+
+	readmeBytes, err := os.ReadFile(filepath.Join(root.Name(), "guestbook", "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(readmeBytes), `## Dry Sources
+
+* https://github.com/example/repo (ref values) at abc123
+* https://charts.example.com chart guestbook at 1.2.3
+`)
+
+	statement, problems, err := hydrator.VerifyProvenance(root, "guestbook")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	repoURL, drySHA := statement.DryRevision()
+	assert.Equal(t, "https://github.com/example/repo", repoURL)
+	assert.Equal(t, "abc123", drySHA)
+	assert.Equal(t, paths[0].DrySources, statement.Predicate.BuildDefinition.ExternalParameters.DrySources)
+	assert.Equal(t, []hydrator.ResourceDescriptor{
+		{URI: "git+https://github.com/example/repo", Digest: map[string]string{"gitCommit": "abc123"}},
+		{Name: "guestbook", URI: "https://charts.example.com", Annotations: map[string]string{"version": "1.2.3"}},
+	}, statement.Predicate.BuildDefinition.ResolvedDependencies)
+}
+
+func TestWriteForPaths_PerResourceLayoutInvalidMetadata(t *testing.T) {
+	root := tempRoot(t)
+
+	require.NoError(t, root.MkdirAll("path1", 0o755))
+	require.NoError(t, root.WriteFile("path1/hydrator.metadata", []byte(`{"files":["../outside.yaml"]}`), 0o644))
+	paths := []*apiclient.PathDetails{
+		{
+			Path:           "path1",
+			ManifestLayout: string(appsv1.HydratedManifestLayoutPerResource),
+		},
+	}
+
+	_, err := WriteForPaths(root, "https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths, gitmocks.NewClient(t))
+	require.ErrorContains(t, err, `invalid manifest file path "../outside.yaml"`)
+}
+
+func TestWritePerResourceManifests(t *testing.T) {
+	t.Run("by kind", func(t *testing.T) {
+		root := tempRoot(t)
+
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"b","namespace":"ns"}}`},
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"a","namespace":"ns"}}`},
+			{ManifestJSON: `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"ns"}}`},
+		}
+
+		files, err := writePerResourceManifests(root, "", true, manifests)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"configmap/configmap_ns_a.yaml", "configmap/configmap_ns_b.yaml", "namespace/namespace_ns.yaml"}, files)
+		for _, file := range files {
+			assert.FileExists(t, filepath.Join(root.Name(), file))
+		}
+	})
+
+	t.Run("duplicate file", func(t *testing.T) {
+		root := tempRoot(t)
+
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"a","namespace":"ns"}}`},
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"A","namespace":"ns"}}`},
+		}
+
+		_, err := writePerResourceManifests(root, "", false, manifests)
+		require.ErrorContains(t, err, `multiple manifests map to the same file "configmap_ns_a.yaml"`)
+	})
+}
+
+func TestPerResourceFileName(t *testing.T) {
+	fileName := func(manifest string) string {
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, json.Unmarshal([]byte(manifest), obj))
+		return perResourceFileName(obj, false)
+	}
+
+	t.Run("segments", func(t *testing.T) {
+		assert.Equal(t, "deployment.apps_default_guestbook.yaml", fileName(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"}}`))
+		assert.Equal(t, "configmap_default_guestbook.yaml", fileName(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook","namespace":"default"}}`))
+		assert.Equal(t, "clusterrole.rbac.authorization.k8s.io_admin.yaml", fileName(`{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"admin"}}`))
+	})
+
+	t.Run("no collisions", func(t *testing.T) {
+		for _, manifests := range [][2]string{
+			// The dash can't separate the namespace from the name.
+			{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b-c","namespace":"a"}}`,
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"c","namespace":"a-b"}}`,
+			},
+			// A namespaced core resource and a cluster-scoped resource of another group.
+			{
+				`{"apiVersion":"v1","kind":"Role","metadata":{"name":"b","namespace":"a"}}`,
+				`{"apiVersion":"a/v1","kind":"Role","metadata":{"name":"b"}}`,
+			},
+			// The dash can't separate the group from the name.
+			{
+				`{"apiVersion":"a/v1","kind":"Role","metadata":{"name":"b-c"}}`,
+				`{"apiVersion":"a-b/v1","kind":"Role","metadata":{"name":"c"}}`,
+			},
+		} {
+			assert.NotEqual(t, fileName(manifests[0]), fileName(manifests[1]))
+		}
+	})
+}
+
 func TestWriteGitAttributes(t *testing.T) {
 	root := tempRoot(t)
 
@@ -354,34 +576,34 @@ func TestWriteGitAttributes(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, string(gitAttributesBytes), "*/README.md linguist-generated=true")
 	assert.Contains(t, string(gitAttributesBytes), "*/hydrator.metadata linguist-generated=true")
+	assert.Contains(t, string(gitAttributesBytes), "*/hydrator.provenance linguist-generated=true")
 }
 
-func TestIsHydrated(t *testing.T) {
+func TestGetNote(t *testing.T) {
 	mockGitClient := gitmocks.NewClient(t)
-	drySha := "abc123"
 	commitSha := "fff456"
 	commitShaNoNoteFoundErr := "abc456"
 	commitShaErr := "abc999"
-	strnote := "{\"drySha\":\"abc123\"}"
+	strnote := "{\"drySha\":\"abc123\",\"paths\":[\"guestbook\"]}"
 	mockGitClient.On("GetCommitNote", commitSha, mock.Anything).Return(strnote, nil).Once()
 	mockGitClient.On("GetCommitNote", commitShaNoNoteFoundErr, mock.Anything).Return("", fmt.Errorf("wrapped error %w", git.ErrNoNoteFound)).Once()
 	// an existing note
-	isHydrated, err := IsHydrated(mockGitClient, drySha, commitSha)
+	note, err := GetNote(mockGitClient, commitSha)
 	require.NoError(t, err)
-	assert.True(t, isHydrated)
+	assert.Equal(t, &CommitNote{DrySHA: "abc123", Paths: []string{"guestbook"}}, note)
 
 	// no note found treated as success.. no error returned
-	isHydrated, err = IsHydrated(mockGitClient, drySha, commitShaNoNoteFoundErr)
+	note, err = GetNote(mockGitClient, commitShaNoNoteFoundErr)
 	require.NoError(t, err)
-	assert.False(t, isHydrated)
+	assert.Nil(t, note)
 
 	// Test that non-ErrNoNoteFound errors are propagated: when GetCommitNote fails with
-	// an error other than "no note found", IsHydrated should return that error to the caller
+	// an error other than "no note found", GetNote should return that error to the caller
 	err = errors.New("some other error")
 	mockGitClient.On("GetCommitNote", commitShaErr, mock.Anything).Return("", fmt.Errorf("wrapped error %w", err)).Once()
-	isHydrated, err = IsHydrated(mockGitClient, drySha, commitShaErr)
+	note, err = GetNote(mockGitClient, commitShaErr)
 	require.Error(t, err)
-	assert.False(t, isHydrated)
+	assert.Nil(t, note)
 }
 
 func TestAddNote(t *testing.T) {
@@ -390,14 +612,14 @@ func TestAddNote(t *testing.T) {
 	commitSha := "fff456"
 	commitShaErr := "abc456"
 	err := errors.New("test error")
-	mockGitClient.On("AddAndPushNote", commitSha, mock.Anything, mock.Anything).Return(nil).Once()
+	mockGitClient.On("AddAndPushNote", commitSha, NoteNamespace, `{"drySha":"abc123","paths":["guestbook"]}`).Return(nil).Once()
 	mockGitClient.On("AddAndPushNote", commitShaErr, mock.Anything, mock.Anything).Return(err).Once()
 
 	// success
-	err = AddNote(mockGitClient, drySha, commitSha)
+	err = AddNote(mockGitClient, CommitNote{DrySHA: drySha, Paths: []string{"guestbook"}}, commitSha)
 	require.NoError(t, err)
 
 	// failure
-	err = AddNote(mockGitClient, drySha, commitShaErr)
+	err = AddNote(mockGitClient, CommitNote{DrySHA: drySha}, commitShaErr)
 	require.Error(t, err)
 }
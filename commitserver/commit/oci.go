@@ -0,0 +1,239 @@
+package commit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// ociAnnotationPrefix is the prefix of the annotations holding the hydrator metadata on hydrated OCI artifacts. The
+// repo URL and dry SHA are stored in the standard org.opencontainers.image.source and revision annotations instead.
+const ociAnnotationPrefix = "argocd.argoproj.io/hydrator."
+
+// ociTagRegexp matches valid OCI tags, as defined by the OCI distribution spec.
+var ociTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+
+// ociTagInvalidCharsRegexp matches the characters which are not allowed in OCI tags.
+var ociTagInvalidCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// maxOCITagLength is the maximum length of an OCI tag, as defined by the OCI distribution spec.
+const maxOCITagLength = 128
+
+// OCIRepositoryFactory is a factory for creating OCI repositories to push hydrated manifests to.
+type OCIRepositoryFactory interface {
+	NewRepository(repo *v1alpha1.Repository) (oras.Target, error)
+}
+
+type ociRepositoryFactory struct{}
+
+// NewOCIRepositoryFactory returns a new instance of the OCI repository factory.
+func NewOCIRepositoryFactory() OCIRepositoryFactory {
+	return &ociRepositoryFactory{}
+}
+
+// NewRepository creates a remote OCI repository, authenticating with the repository's write credentials.
+func (f *ociRepositoryFactory) NewRepository(repo *v1alpha1.Repository) (oras.Target, error) {
+	return oci.NewRepository(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy)
+}
+
+// isOCIRequest returns true if the hydrated manifests of the request are pushed to an OCI repository instead of
+// committed to a git repository.
+func isOCIRequest(r *apiclient.CommitHydratedManifestsRequest) bool {
+	return r.Repo != nil && (&v1alpha1.ApplicationSource{RepoURL: r.Repo.Repo}).IsOCI()
+}
+
+// getDryRepoURL returns the URL of the dry source repository of the request.
+func getDryRepoURL(r *apiclient.CommitHydratedManifestsRequest) string {
+	if r.DryRepoURL != "" {
+		return r.DryRepoURL
+	}
+	return r.Repo.Repo
+}
+
+// hydratedTag returns the tag of the artifact holding the manifests hydrated from the given dry SHA for the given target
+// branch. The artifacts of the target branches differ, since each one holds the manifests of the applications hydrating
+// to that branch. Characters of the dry SHA which aren't allowed in tags, like the colon of an OCI digest, are replaced
+// by dashes. If the tag would be too long, the target branch is replaced by its hash.
+func hydratedTag(targetBranch, drySha string) string {
+	revision := ociTagInvalidCharsRegexp.ReplaceAllString(drySha, "-")
+	tag := targetBranch + "-" + revision
+	if len(tag) > maxOCITagLength {
+		sum := sha256.Sum256([]byte(targetBranch))
+		tag = hex.EncodeToString(sum[:8]) + "-" + revision
+	}
+	return tag
+}
+
+// handleOCIRequest handles a commit request for an OCI repository. It writes the manifests to a temporary directory and
+// pushes it as an OCI artifact tagged with the target branch, and with a tag recording the dry SHA it was hydrated from
+// for that branch. If an artifact was already pushed for the dry SHA and target branch, it is tagged with the target
+// branch again instead. It returns the digest of the artifact and an error if one occurred.
+func (s *Service) handleOCIRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	if r.PullRequest != nil {
+		return "", errors.New("pull requests are not supported when hydrating to an OCI repository")
+	}
+	// Artifacts are tagged with the dry SHA, which doesn't identify the revisions of additional dry sources.
+	if hasAdditionalDrySources(r.Paths) {
+		return "", errors.New("additional dry sources are not supported when hydrating to an OCI repository")
+	}
+	if !ociTagRegexp.MatchString(r.TargetBranch) {
+		return "", fmt.Errorf("target branch %q is not a valid OCI tag", r.TargetBranch)
+	}
+	hydrated := hydratedTag(r.TargetBranch, r.DrySha)
+	if !ociTagRegexp.MatchString(hydrated) {
+		return "", fmt.Errorf("dry SHA %q can't be converted to a valid OCI tag", r.DrySha)
+	}
+
+	target, err := s.ociRepositoryFactory.NewRepository(r.Repo)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI repository: %w", err)
+	}
+
+	// Like the git notes for commits, the hydrated tag records which dry SHA has already been hydrated to the target
+	// branch.
+	desc, err := target.Resolve(ctx, hydrated)
+	if err == nil {
+		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
+		err = target.Tag(ctx, desc, r.TargetBranch)
+		if err != nil {
+			return "", fmt.Errorf("failed to tag hydrated artifact: %w", err)
+		}
+		return desc.Digest.String(), nil
+	}
+	if !errors.Is(err, errdef.ErrNotFound) {
+		return "", fmt.Errorf("failed to resolve hydrated tag: %w", err)
+	}
+
+	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(dirPath)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	logCtx.Debug("Writing manifests")
+	hydratorMetadata, err := hydrator.GetCommitMetadata(getDryRepoURL(r), r.DrySha, r.DryCommitMetadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
+	}
+	err = writeOCIContents(root, hydratorMetadata, r.Paths)
+	if err != nil {
+		return "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+	annotations, err := ociAnnotations(hydratorMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	logCtx.Debug("Pushing hydrated artifact")
+	digest, err := oci.PushDirectory(ctx, target, dirPath, oci.HydratedManifestsArtifactType, annotations, hydrated, r.TargetBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to push hydrated artifact: %w", err)
+	}
+	return digest, nil
+}
+
+// writeOCIContents writes the manifests, hydrator.metadata, hydrator.provenance, and README.md files for each path, and a top-level
+// hydrator.metadata file, like WriteForPaths does for a commit. Since every artifact is pushed from scratch, there are
+// no stale files to remove.
+func writeOCIContents(root *os.Root, metadata hydrator.HydratorCommitMetadata, paths []*apiclient.PathDetails) error {
+	err := writeMetadata(root, "", metadata)
+	if err != nil {
+		return fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
+	}
+	for _, p := range paths {
+		hydratePath := p.Path
+		if hydratePath == "." {
+			hydratePath = ""
+		}
+		if hydratePath != "" {
+			err = root.MkdirAll(hydratePath, 0o755)
+			if err != nil {
+				return fmt.Errorf("failed to create path: %w", err)
+			}
+		}
+
+		layout := v1alpha1.HydratedManifestLayout(p.ManifestLayout)
+		manifestFiles, err := writeManifestsForLayout(root, hydratePath, layout, p.Manifests)
+		if err != nil {
+			return err
+		}
+
+		pathMetadata := hydrator.HydratorCommitMetadata{
+			Commands:   p.Commands,
+			DrySHA:     metadata.DrySHA,
+			RepoURL:    metadata.RepoURL,
+			DrySources: getDrySourcesMetadata(p),
+		}
+		if isPerResourceLayout(layout) {
+			pathMetadata.Files = manifestFiles
+		}
+		err = writeMetadata(root, hydratePath, pathMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to write hydrator metadata: %w", err)
+		}
+		err = writeProvenance(root, hydratePath, pathMetadata, p, manifestFiles)
+		if err != nil {
+			return fmt.Errorf("failed to write hydrator provenance: %w", err)
+		}
+		err = writeReadme(root, hydratePath, pathMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to write readme: %w", err)
+		}
+	}
+	return nil
+}
+
+// ociAnnotations returns the annotations holding the given hydrator metadata on a hydrated OCI artifact. They carry the
+// same information as the top-level hydrator.metadata file of a hydrated commit.
+func ociAnnotations(metadata hydrator.HydratorCommitMetadata) (map[string]string, error) {
+	annotations := map[string]string{
+		imagev1.AnnotationSource:   metadata.RepoURL,
+		imagev1.AnnotationRevision: metadata.DrySHA,
+	}
+	optional := map[string]string{
+		"author":  metadata.Author,
+		"date":    metadata.Date,
+		"subject": metadata.Subject,
+		"body":    metadata.Body,
+	}
+	for key, value := range optional {
+		if value != "" {
+			annotations[ociAnnotationPrefix+key] = value
+		}
+	}
+	if len(metadata.References) > 0 {
+		references, err := json.Marshal(metadata.References)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal references: %w", err)
+		}
+		annotations[ociAnnotationPrefix+"references"] = string(references)
+	}
+	return annotations, nil
+}
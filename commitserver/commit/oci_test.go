@@ -0,0 +1,192 @@
+package commit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// memoryOCIRepositoryFactory returns an in-process OCI store instead of a remote repository.
+type memoryOCIRepositoryFactory struct {
+	store *memory.Store
+}
+
+func (f *memoryOCIRepositoryFactory) NewRepository(_ *v1alpha1.Repository) (oras.Target, error) {
+	return f.store, nil
+}
+
+func newServiceWithOCIStore(t *testing.T) (*Service, *memory.Store) {
+	t.Helper()
+
+	service := NewService(git.NoopCredsStore{}, metrics.NewMetricsServer(), nil, nil)
+	store := memory.New()
+	service.ociRepositoryFactory = &memoryOCIRepositoryFactory{store: store}
+	return service, store
+}
+
+func newOCIRequest() *apiclient.CommitHydratedManifestsRequest {
+	return &apiclient.CommitHydratedManifestsRequest{
+		Repo:         &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated"},
+		DryRepoURL:   "https://github.com/argoproj/argocd-example-apps.git",
+		SyncBranch:   "env-dev",
+		TargetBranch: "env-dev",
+		DrySha:       "abc123",
+		DryCommitMetadata: &v1alpha1.RevisionMetadata{
+			Author:  "test-author",
+			Message: "test-subject\n\ntest-body",
+		},
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm"}}`},
+				},
+				Commands: []string{"helm template ."},
+			},
+		},
+	}
+}
+
+func Test_CommitHydratedManifests_OCI(t *testing.T) {
+	t.Parallel()
+
+	service, store := newServiceWithOCIStore(t)
+
+	resp, err := service.CommitHydratedManifests(t.Context(), newOCIRequest())
+	require.NoError(t, err)
+
+	var desc imagev1.Descriptor
+	for _, tag := range []string{"env-dev-abc123", "env-dev"} {
+		desc, err = store.Resolve(t.Context(), tag)
+		require.NoError(t, err)
+		assert.Equal(t, resp.HydratedSha, desc.Digest.String())
+	}
+
+	manifestBytes, err := content.FetchAll(t.Context(), store, desc)
+	require.NoError(t, err)
+	var manifest imagev1.Manifest
+	require.NoError(t, json.Unmarshal(manifestBytes, &manifest))
+	assert.Equal(t, oci.HydratedManifestsArtifactType, manifest.ArtifactType)
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps.git", manifest.Annotations[imagev1.AnnotationSource])
+	assert.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+	assert.Equal(t, "test-author", manifest.Annotations[ociAnnotationPrefix+"author"])
+	assert.Equal(t, "test-subject", manifest.Annotations[ociAnnotationPrefix+"subject"])
+	assert.Equal(t, "test-body\n", manifest.Annotations[ociAnnotationPrefix+"body"])
+
+	require.Len(t, manifest.Layers, 1)
+	layer, err := store.Fetch(t.Context(), manifest.Layers[0])
+	require.NoError(t, err)
+	defer layer.Close()
+	dir := t.TempDir()
+	require.NoError(t, files.Untgz(dir, layer, 1024*1024, false))
+	assert.FileExists(t, filepath.Join(dir, "hydrator.metadata"))
+	assert.FileExists(t, filepath.Join(dir, "guestbook", "README.md"))
+	assert.FileExists(t, filepath.Join(dir, "guestbook", hydrator.ProvenanceFile))
+	manifestYaml, err := os.ReadFile(filepath.Join(dir, "guestbook", ManifestYaml))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifestYaml), "name: cm")
+}
+
+func Test_CommitHydratedManifests_OCIAlreadyHydrated(t *testing.T) {
+	t.Parallel()
+
+	service, store := newServiceWithOCIStore(t)
+	first, err := service.CommitHydratedManifests(t.Context(), newOCIRequest())
+	require.NoError(t, err)
+
+	// Hydrating the same dry SHA to the same target branch again reuses the artifact.
+	second, err := service.CommitHydratedManifests(t.Context(), newOCIRequest())
+	require.NoError(t, err)
+	assert.Equal(t, first.HydratedSha, second.HydratedSha)
+	desc, err := store.Resolve(t.Context(), "env-dev")
+	require.NoError(t, err)
+	assert.Equal(t, first.HydratedSha, desc.Digest.String())
+
+	// Another target branch holds other manifests, so the artifact of the dry SHA isn't reused.
+	request := newOCIRequest()
+	request.TargetBranch = "env-prod"
+	request.Paths[0].Manifests = []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"prod"}}`},
+	}
+	third, err := service.CommitHydratedManifests(t.Context(), request)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.HydratedSha, third.HydratedSha)
+	for _, tag := range []string{"env-prod", "env-prod-abc123"} {
+		desc, err = store.Resolve(t.Context(), tag)
+		require.NoError(t, err)
+		assert.Equal(t, third.HydratedSha, desc.Digest.String())
+	}
+}
+
+func Test_hydratedTag(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "env-dev-abc123", hydratedTag("env-dev", "abc123"))
+	digest := "sha256:" + strings.Repeat("a", 64)
+	assert.Equal(t, "env-dev-sha256-"+strings.Repeat("a", 64), hydratedTag("env-dev", digest))
+
+	long := hydratedTag(strings.Repeat("b", 100), digest)
+	assert.Regexp(t, ociTagRegexp, long)
+	assert.NotEqual(t, long, hydratedTag(strings.Repeat("b", 101), digest))
+}
+
+func Test_CommitHydratedManifests_OCIInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		modify        func(r *apiclient.CommitHydratedManifestsRequest)
+		expectedError string
+	}{
+		{
+			name: "pull request",
+			modify: func(r *apiclient.CommitHydratedManifestsRequest) {
+				r.PullRequest = &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub}
+			},
+			expectedError: "pull requests are not supported when hydrating to an OCI repository",
+		},
+		{
+			name: "invalid tag",
+			modify: func(r *apiclient.CommitHydratedManifestsRequest) {
+				r.TargetBranch = "environments/dev"
+			},
+			expectedError: `target branch "environments/dev" is not a valid OCI tag`,
+		},
+		{
+			name: "additional dry sources",
+			modify: func(r *apiclient.CommitHydratedManifestsRequest) {
+				r.Paths[0].DrySources = []*v1alpha1.DrySource{{RepoURL: "https://charts.example.com", Chart: "guestbook"}}
+			},
+			expectedError: "additional dry sources are not supported when hydrating to an OCI repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _ := newServiceWithOCIStore(t)
+			request := newOCIRequest()
+			tt.modify(request)
+
+			_, err := service.CommitHydratedManifests(t.Context(), request)
+			require.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+)
+
+// orphanedPathsCommitMessage is the subject of the commit removing the hydrated paths which are no longer hydrated by
+// any application.
+const orphanedPathsCommitMessage = "Remove orphaned hydrated paths"
+
+// hydratorFiles are the files the hydrator writes to each hydrated path next to the manifests.
+var hydratorFiles = []string{"hydrator.metadata", hydrator.ProvenanceFile, "README.md"}
+
+// normalizeHydratedPath returns the given hydrated path in a canonical, slash-separated form, so that paths can be
+// compared regardless of how the application spells them. The repository root is returned as ".".
+func normalizeHydratedPath(p string) string {
+	return path.Clean(filepath.ToSlash(p))
+}
+
+// addOwnedPaths returns the sorted union of the paths owned by the hydrator and the paths being hydrated. The
+// repository root is never owned, since it can't be removed.
+func addOwnedPaths(owned []string, paths []*apiclient.PathDetails) []string {
+	result := slices.Clone(owned)
+	for _, p := range paths {
+		result = append(result, normalizeHydratedPath(p.Path))
+	}
+	slices.Sort(result)
+	result = slices.Compact(result)
+	return slices.DeleteFunc(result, func(p string) bool {
+		return p == "."
+	})
+}
+
+// findOrphanedPaths returns the owned paths which are not active, sorted. Paths overlapping an active path, i.e.
+// containing it or being contained in it, are never orphaned, since removing them would remove files of the active
+// path.
+func findOrphanedPaths(owned, active []string) []string {
+	normalizedActive := make([]string, 0, len(active))
+	for _, p := range active {
+		normalizedActive = append(normalizedActive, normalizeHydratedPath(p))
+	}
+	var orphans []string
+	for _, p := range owned {
+		overlaps := slices.ContainsFunc(normalizedActive, func(a string) bool {
+			return a == "." || a == p || strings.HasPrefix(a, p+"/") || strings.HasPrefix(p, a+"/")
+		})
+		if !overlaps {
+			orphans = append(orphans, p)
+		}
+	}
+	slices.Sort(orphans)
+	return orphans
+}
+
+// pruneOrphanedPaths removes the files written by the hydrator from the owned paths which are not active anymore. It
+// returns the paths still owned, the orphaned paths, and true if any file was removed. If active is empty, pruning is
+// disabled and nothing is removed.
+func pruneOrphanedPaths(root *os.Root, owned, active []string) ([]string, []string, bool, error) {
+	if len(active) == 0 {
+		return owned, nil, false, nil
+	}
+	orphans := findOrphanedPaths(owned, active)
+	var removed bool
+	for _, p := range orphans {
+		pathRemoved, err := removeHydratedPath(root, p)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to remove orphaned path %q: %w", p, err)
+		}
+		removed = removed || pathRemoved
+	}
+	remaining := slices.DeleteFunc(slices.Clone(owned), func(p string) bool {
+		return slices.Contains(orphans, p)
+	})
+	return remaining, orphans, removed, nil
+}
+
+// removeHydratedPath removes the manifests and the other files written by the hydrator from the given hydrated path.
+// Other files, e.g. added manually, are left untouched. Returns true if any file was removed.
+func removeHydratedPath(root *os.Root, dirPath string) (bool, error) {
+	// The owned paths are read from git notes, so don't trust them to stay within the repository.
+	if !filepath.IsLocal(dirPath) {
+		return false, errors.New("path is not local to the repository")
+	}
+	removed, err := removeStaleManifests(root, dirPath, nil)
+	if err != nil {
+		return false, err
+	}
+	for _, file := range hydratorFiles {
+		err = root.Remove(filepath.Join(dirPath, file))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to remove %q: %w", file, err)
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
+// getOrphanedPathsCommitMessage returns the message of the commit removing the given orphaned paths.
+func getOrphanedPathsCommitMessage(orphans []string) string {
+	var sb strings.Builder
+	sb.WriteString(orphanedPathsCommitMessage)
+	sb.WriteString("\n\nThe following paths are no longer hydrated by any application:\n")
+	for _, p := range orphans {
+		sb.WriteString("\n- " + p)
+	}
+	return sb.String()
+}
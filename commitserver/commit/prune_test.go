@@ -0,0 +1,94 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+)
+
+func TestAddOwnedPaths(t *testing.T) {
+	owned := addOwnedPaths([]string{"b", "a"}, []*apiclient.PathDetails{{Path: "./c"}, {Path: "a/"}, {Path: "."}, {Path: ""}})
+	assert.Equal(t, []string{"a", "b", "c"}, owned)
+}
+
+func TestFindOrphanedPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		owned    []string
+		active   []string
+		expected []string
+	}{
+		{name: "all active", owned: []string{"a", "b"}, active: []string{"b", "./a"}},
+		{name: "orphaned", owned: []string{"a", "b", "c"}, active: []string{"b"}, expected: []string{"a", "c"}},
+		{name: "containing an active path", owned: []string{"apps", "apps/guestbook"}, active: []string{"apps/guestbook/prod"}},
+		{name: "contained in an active path", owned: []string{"apps/guestbook"}, active: []string{"apps"}},
+		{name: "sibling with a common prefix", owned: []string{"apps/guestbook"}, active: []string{"apps/guest"}, expected: []string{"apps/guestbook"}},
+		{name: "active root", owned: []string{"a"}, active: []string{"."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, findOrphanedPaths(tt.owned, tt.active))
+		})
+	}
+}
+
+func TestPruneOrphanedPaths(t *testing.T) {
+	root := tempRoot(t)
+	for _, file := range []string{
+		"active/manifest.yaml", "active/hydrator.metadata",
+		"orphan/manifest.yaml", "orphan/hydrator.metadata", "orphan/hydrator.provenance", "orphan/README.md", "orphan/notes.txt",
+		"per-resource/kind/configmap_cm.yaml", "per-resource/hydrator.metadata",
+	} {
+		require.NoError(t, root.MkdirAll(filepath.Dir(file), 0o755))
+		content := "content"
+		switch file {
+		case "active/hydrator.metadata", "orphan/hydrator.metadata":
+			content = `{"drySha":"abc123"}`
+		case "per-resource/hydrator.metadata":
+			content = `{"drySha":"abc123","files":["kind/configmap_cm.yaml"]}`
+		}
+		require.NoError(t, root.WriteFile(file, []byte(content), 0o644))
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		owned, orphans, removed, err := pruneOrphanedPaths(root, []string{"active", "orphan"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"active", "orphan"}, owned)
+		assert.Empty(t, orphans)
+		assert.False(t, removed)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		_, _, _, err := pruneOrphanedPaths(root, []string{"../outside"}, []string{"active"})
+		require.ErrorContains(t, err, `failed to remove orphaned path "../outside"`)
+	})
+
+	owned, orphans, removed, err := pruneOrphanedPaths(root, []string{"active", "missing", "orphan", "per-resource"}, []string{"active"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active"}, owned)
+	assert.Equal(t, []string{"missing", "orphan", "per-resource"}, orphans)
+	assert.True(t, removed)
+
+	for _, file := range []string{"active/manifest.yaml", "active/hydrator.metadata", "orphan/notes.txt"} {
+		_, err := root.Stat(file)
+		require.NoError(t, err, file)
+	}
+	for _, file := range []string{"orphan/manifest.yaml", "orphan/hydrator.metadata", "orphan/hydrator.provenance", "orphan/README.md", "per-resource/kind/configmap_cm.yaml", "per-resource/hydrator.metadata"} {
+		_, err := root.Stat(file)
+		require.ErrorIs(t, err, os.ErrNotExist, file)
+	}
+}
+
+func TestGetOrphanedPathsCommitMessage(t *testing.T) {
+	assert.Equal(t, `Remove orphaned hydrated paths
+
+The following paths are no longer hydrated by any application:
+
+- a
+- b/c`, getOrphanedPathsCommitMessage([]string{"a", "b/c"}))
+}
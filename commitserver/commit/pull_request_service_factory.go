@@ -0,0 +1,131 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestServiceFactory is a factory for creating SCM pull request services for a repository.
+type PullRequestServiceFactory interface {
+	NewService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestService, error)
+}
+
+type pullRequestServiceFactory struct{}
+
+// NewPullRequestServiceFactory returns a new instance of the pull request service factory.
+func NewPullRequestServiceFactory() PullRequestServiceFactory {
+	return &pullRequestServiceFactory{}
+}
+
+// NewService creates a pull request service for the repository, using the repository's write credentials to
+// authenticate against the SCM provider's API.
+func (f *pullRequestServiceFactory) NewService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestService, error) {
+	segments, err := repoPathSegments(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("repo URL %q does not contain an owner and a repository name", repo.Repo)
+	}
+	owner, name := segments[len(segments)-2], segments[len(segments)-1]
+
+	switch pullRequest.Provider {
+	case v1alpha1.PullRequestProviderGitHub:
+		if repo.GithubAppPrivateKey != "" {
+			auth := github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}
+			return pull_request.NewGithubAppService(ctx, auth, pullRequest.API, owner, name, nil)
+		}
+		return pull_request.NewGithubService(repo.Password, pullRequest.API, owner, name, nil)
+	case v1alpha1.PullRequestProviderGitLab:
+		return pull_request.NewGitLabService(repo.Password, pullRequest.API, strings.Join(segments, "/"), nil, "opened", "", repo.Insecure, nil)
+	case v1alpha1.PullRequestProviderGitea:
+		if pullRequest.API == "" {
+			return nil, errors.New("api is required for the Gitea pull request provider")
+		}
+		return pull_request.NewGiteaService(repo.Password, pullRequest.API, owner, name, nil, repo.Insecure)
+	case v1alpha1.PullRequestProviderBitbucketServer:
+		if pullRequest.API == "" {
+			return nil, errors.New("api is required for the Bitbucket Server pull request provider")
+		}
+		switch {
+		case repo.BearerToken != "":
+			return pull_request.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, pullRequest.API, owner, name, "", repo.Insecure, nil)
+		case repo.Username != "":
+			return pull_request.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, pullRequest.API, owner, name, "", repo.Insecure, nil)
+		default:
+			return pull_request.NewBitbucketServiceNoAuth(ctx, pullRequest.API, owner, name, "", repo.Insecure, nil)
+		}
+	case v1alpha1.PullRequestProviderBitbucketCloud:
+		if repo.BearerToken != "" {
+			return pull_request.NewBitbucketCloudServiceBearerToken(pullRequest.API, repo.BearerToken, owner, name)
+		}
+		return pull_request.NewBitbucketCloudServiceBasicAuth(pullRequest.API, repo.Username, repo.Password, owner, name)
+	case v1alpha1.PullRequestProviderAzureDevOps:
+		organization, project, err := azureDevOpsOrganizationAndProject(repo.Repo, segments)
+		if err != nil {
+			return nil, err
+		}
+		return pull_request.NewAzureDevOpsService(repo.Password, pullRequest.API, organization, project, name, nil)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", pullRequest.Provider)
+	}
+}
+
+// repoPathSegments returns the path segments of a repository URL, without the .git suffix. Unlike
+// git.NormalizeGitURL, it preserves the case of the path, since some SCM providers treat it as case-sensitive.
+func repoPathSegments(repoURL string) ([]string, error) {
+	repoURL = strings.TrimSpace(repoURL)
+	if isSSH, _ := git.IsSSHURL(repoURL); isSSH && !strings.HasPrefix(repoURL, "ssh://") {
+		// Replace the first colon of scp-like URLs (git@host:owner/repo.git) so that url.Parse doesn't read it as a port.
+		repoURL = "ssh://" + strings.Replace(repoURL, ":", "/", 1)
+	}
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repo URL: %w", err)
+	}
+	var segments []string
+	for _, segment := range strings.Split(strings.TrimSuffix(parsed.Path, ".git"), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments, nil
+}
+
+// azureDevOpsOrganizationAndProject extracts the organization and the project from an Azure DevOps repository URL,
+// e.g. https://dev.azure.com/org/project/_git/repo, https://org.visualstudio.com/project/_git/repo or
+// git@ssh.dev.azure.com:v3/org/project/repo.
+func azureDevOpsOrganizationAndProject(repoURL string, segments []string) (string, string, error) {
+	// Drop the repository name and the "_git" marker of HTTPS URLs, and the "v3" prefix of SSH URLs.
+	if len(segments) >= 2 && segments[len(segments)-2] == "_git" {
+		segments = segments[:len(segments)-2]
+	} else {
+		segments = segments[:len(segments)-1]
+	}
+	if len(segments) > 0 && segments[0] == "v3" {
+		segments = segments[1:]
+	}
+	switch len(segments) {
+	case 1:
+		parsed, err := url.Parse(repoURL)
+		if err == nil && strings.HasSuffix(parsed.Hostname(), ".visualstudio.com") {
+			return strings.TrimSuffix(parsed.Hostname(), ".visualstudio.com"), segments[0], nil
+		}
+	case 2:
+		return segments[0], segments[1], nil
+	}
+	return "", "", fmt.Errorf("failed to determine the Azure DevOps organization and project from repo URL %q", repoURL)
+}
@@ -0,0 +1,96 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_repoPathSegments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		repoURL  string
+		expected []string
+	}{
+		{repoURL: "https://github.com/argoproj/argocd-example-apps.git", expected: []string{"argoproj", "argocd-example-apps"}},
+		{repoURL: "https://github.com/ArgoProj/ArgoCD-Example-Apps", expected: []string{"ArgoProj", "ArgoCD-Example-Apps"}},
+		{repoURL: "git@github.com:argoproj/argocd-example-apps.git", expected: []string{"argoproj", "argocd-example-apps"}},
+		{repoURL: "ssh://git@gitlab.com:22/group/subgroup/project.git", expected: []string{"group", "subgroup", "project"}},
+		{repoURL: "https://dev.azure.com/org/project/_git/repo", expected: []string{"org", "project", "_git", "repo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			t.Parallel()
+			segments, err := repoPathSegments(tt.repoURL)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, segments)
+		})
+	}
+}
+
+func Test_azureDevOpsOrganizationAndProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		repoURL      string
+		organization string
+		project      string
+		expectedErr  string
+	}{
+		{repoURL: "https://dev.azure.com/org/project/_git/repo", organization: "org", project: "project"},
+		{repoURL: "https://org@dev.azure.com/org/project/_git/repo", organization: "org", project: "project"},
+		{repoURL: "https://org.visualstudio.com/project/_git/repo", organization: "org", project: "project"},
+		{repoURL: "git@ssh.dev.azure.com:v3/org/project/repo", organization: "org", project: "project"},
+		{repoURL: "https://example.com/project/repo", expectedErr: "failed to determine the Azure DevOps organization and project"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			t.Parallel()
+			segments, err := repoPathSegments(tt.repoURL)
+			require.NoError(t, err)
+			organization, project, err := azureDevOpsOrganizationAndProject(tt.repoURL, segments)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.organization, organization)
+			assert.Equal(t, tt.project, project)
+		})
+	}
+}
+
+func Test_pullRequestServiceFactory_NewService(t *testing.T) {
+	t.Parallel()
+
+	factory := NewPullRequestServiceFactory()
+
+	t.Run("supported provider", func(t *testing.T) {
+		t.Parallel()
+		service, err := factory.NewService(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "token"}, &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub})
+		require.NoError(t, err)
+		assert.NotNil(t, service)
+	})
+
+	t.Run("Gitea requires an API URL", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewService(t.Context(), &v1alpha1.Repository{Repo: "https://gitea.example.com/owner/repo.git"}, &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitea})
+		require.ErrorContains(t, err, "api is required")
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewService(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}, &v1alpha1.HydrateToPullRequest{Provider: "Unknown"})
+		require.ErrorContains(t, err, `unsupported pull request provider "Unknown"`)
+	})
+
+	t.Run("repo URL without owner", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewService(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/repo.git"}, &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub})
+		require.ErrorContains(t, err, "does not contain an owner and a repository name")
+	})
+}
@@ -14,19 +14,25 @@ type RepoClientFactory interface {
 type repoClientFactory struct {
 	gitCredsStore git.CredsStore
 	metricsServer *metrics.Server
+	signingOpts   *git.CommitSigningOpts
 }
 
-// NewRepoClientFactory returns a new instance of the repo client factory.
-func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server) RepoClientFactory {
+// NewRepoClientFactory returns a new instance of the repo client factory. If signingOpts is not nil, the git clients
+// sign the commits they create.
+func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingOpts *git.CommitSigningOpts) RepoClientFactory {
 	return &repoClientFactory{
 		gitCredsStore: gitCredsStore,
 		metricsServer: metricsServer,
+		signingOpts:   signingOpts,
 	}
 }
 
 // NewClient creates a new git client for the repository.
 func (r *repoClientFactory) NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error) {
 	gitCreds := repo.GetGitCreds(r.gitCredsStore)
-	opts := git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))
-	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts)
+	opts := []git.ClientOpts{git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))}
+	if r.signingOpts != nil {
+		opts = append(opts, git.WithCommitSigning(r.signingOpts))
+	}
+	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts...)
 }
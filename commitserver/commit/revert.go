@@ -0,0 +1,188 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// RevertHydratedManifests handles a revert request. It clones the repository, checks out the target branch, restores
+// the requested path to its contents at the requested hydrated commit, commits the changes, and pushes them. Other
+// paths of the target branch are left untouched. Like CommitHydratedManifests, it then opens a pull request from the
+// target branch to the sync branch if one is configured. It returns the SHA of the revert commit and the pull request
+// status.
+func (s *Service) RevertHydratedManifests(ctx context.Context, r *apiclient.RevertHydratedManifestsRequest) (*apiclient.RevertHydratedManifestsResponse, error) {
+	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "path": r.Path, "hydratedSHA": r.HydratedSha})
+
+	out, sha, pullRequest, err := s.handleRevertRequest(ctx, logCtx, r)
+	var promotionErr *promotionError
+	if errors.As(err, &promotionErr) {
+		// The restored manifests were pushed, so the request succeeded. The promotion is reported separately.
+		logCtx.WithError(err).Error("failed to promote target branch")
+		return &apiclient.RevertHydratedManifestsResponse{
+			HydratedSha:    sha,
+			PullRequest:    r.PreviousPullRequest,
+			PromotionError: promotionErr.Error(),
+		}, nil
+	}
+	if err != nil {
+		logCtx.WithError(err).WithField("output", out).Error("failed to handle revert request")
+
+		// No need to wrap this error, sufficient context is build in handleRevertRequest.
+		return &apiclient.RevertHydratedManifestsResponse{}, err
+	}
+
+	logCtx.Info("Successfully handled revert request")
+	return &apiclient.RevertHydratedManifestsResponse{HydratedSha: sha, PullRequest: pullRequest}, nil
+}
+
+// handleRevertRequest handles the revert request. It returns the output of the git commands, the SHA of the revert
+// commit, or the SHA of the target branch if there was nothing to revert, the pull request status and an error if one
+// occurred.
+//
+// Like for a commit request, the target branch is created from the sync branch if it doesn't exist, and is promoted to
+// the sync branch if a pull request is configured, even if there was nothing to revert, so that a rollback which was
+// already pushed but not promoted can be retried. The git note of the target branch is carried over to the revert
+// commit, so that the hydrated paths remain owned by the hydrator and the next hydration of the same dry commit is
+// still skipped.
+func (s *Service) handleRevertRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.RevertHydratedManifestsRequest) (string, string, *v1alpha1.HydratePullRequestStatus, error) {
+	err := validateRevertRequest(r)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if (&v1alpha1.ApplicationSource{RepoURL: r.Repo.Repo}).IsOCI() {
+		return "", "", nil, errors.New("reverting hydrated manifests is not supported when hydrating to an OCI repository")
+	}
+
+	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	logCtx.Debug("Initiating git client")
+	gitClient, _, cleanup, err := s.initGitClient(logCtx, r.Repo)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	syncBranch := r.SyncBranch
+	if syncBranch == "" {
+		syncBranch = r.TargetBranch
+	}
+	logCtx.Debugf("Checking out sync branch %s", syncBranch)
+	out, err := gitClient.Checkout(syncBranch, false)
+	if err != nil {
+		return out, "", nil, fmt.Errorf("failed to checkout sync branch: %w", err)
+	}
+
+	// The sync branch SHA is only needed to compare it with the target branch when promoting via a pull request.
+	var syncSha string
+	promote := r.PullRequest != nil && r.TargetBranch != syncBranch
+	if promote {
+		syncSha, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", nil, fmt.Errorf("failed to get sync branch commit SHA: %w", err)
+		}
+	}
+
+	if r.TargetBranch != syncBranch {
+		logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
+		out, err = gitClient.CheckoutOrNew(r.TargetBranch, syncBranch, false)
+		if err != nil {
+			return out, "", nil, fmt.Errorf("failed to checkout target branch: %w", err)
+		}
+	}
+
+	out, sha, err := revertPath(logCtx, r, gitClient)
+	if err != nil {
+		return out, "", nil, err
+	}
+	if !promote {
+		return "", sha, nil, nil
+	}
+
+	pullRequest, err := s.promote(ctx, logCtx, &apiclient.CommitHydratedManifestsRequest{
+		Repo:                r.Repo,
+		SyncBranch:          syncBranch,
+		TargetBranch:        r.TargetBranch,
+		PullRequest:         r.PullRequest,
+		PreviousPullRequest: r.PreviousPullRequest,
+	}, gitClient, syncSha)
+	if err != nil {
+		return "", sha, nil, &promotionError{err: err}
+	}
+	return "", sha, pullRequest, nil
+}
+
+// revertPath restores the requested path of the checked out target branch to its contents at the requested hydrated
+// commit, and commits and pushes the result. It returns the output of the git commands, the SHA of the revert commit,
+// or the SHA of the target branch if there was nothing to revert, and an error if one occurred.
+func revertPath(logCtx *log.Entry, r *apiclient.RevertHydratedManifestsRequest, gitClient git.Client) (string, string, error) {
+	headSha, err := gitClient.CommitSHA()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	note, err := GetNote(gitClient, headSha)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get notes from git %w", err)
+	}
+
+	logCtx.Debug("Restoring manifests")
+	out, err := gitClient.RestoreContents(r.HydratedSha, []string{normalizeHydratedPath(r.Path)})
+	if err != nil {
+		return out, "", fmt.Errorf("failed to restore manifests: %w", err)
+	}
+	diffs, err := gitClient.DiffWorkingTree()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to diff restored manifests: %w", err)
+	}
+	if len(diffs) == 0 {
+		logCtx.Debug("Path is already at the requested hydrated commit, nothing to revert")
+		return "", headSha, nil
+	}
+
+	logCtx.Debug("Committing and pushing changes")
+	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
+	if err != nil {
+		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+	}
+	sha, err := gitClient.CommitSHA()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	if note != nil {
+		logCtx.Debug("Adding commit note")
+		err = AddNote(gitClient, *note, sha)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to add commit note: %w", err)
+		}
+	}
+	return "", sha, nil
+}
+
+// validateRevertRequest checks that the request contains the fields required to revert a hydrated path.
+func validateRevertRequest(r *apiclient.RevertHydratedManifestsRequest) error {
+	if r.Repo == nil {
+		return errors.New("repo is required")
+	}
+	if r.Repo.Repo == "" {
+		return errors.New("repo URL is required")
+	}
+	if r.TargetBranch == "" {
+		return errors.New("target branch is required")
+	}
+	if r.HydratedSha == "" {
+		return errors.New("hydrated SHA is required")
+	}
+	// Reverting the root would also revert the paths of every other application hydrating to the target branch.
+	p := normalizeHydratedPath(r.Path)
+	if p == "." || !filepath.IsLocal(p) {
+		return fmt.Errorf("path %q is not a valid hydrated path", r.Path)
+	}
+	return nil
+}
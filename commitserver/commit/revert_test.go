@@ -0,0 +1,166 @@
+package commit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+)
+
+func Test_RevertHydratedManifests(t *testing.T) {
+	t.Parallel()
+
+	request := &apiclient.RevertHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "env/test",
+		Path:          "./app",
+		HydratedSha:   "previous-sha",
+		CommitMessage: "test commit message",
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			request  *apiclient.RevertHydratedManifestsRequest
+			expected string
+		}{
+			{name: "missing repo", request: &apiclient.RevertHydratedManifestsRequest{}, expected: "repo is required"},
+			{name: "missing target branch", request: &apiclient.RevertHydratedManifestsRequest{Repo: request.Repo}, expected: "target branch is required"},
+			{name: "missing hydrated SHA", request: &apiclient.RevertHydratedManifestsRequest{Repo: request.Repo, TargetBranch: "env/test"}, expected: "hydrated SHA is required"},
+			{name: "root path", request: &apiclient.RevertHydratedManifestsRequest{Repo: request.Repo, TargetBranch: "env/test", HydratedSha: "sha", Path: "."}, expected: `path "." is not a valid hydrated path`},
+			{name: "path outside the repository", request: &apiclient.RevertHydratedManifestsRequest{Repo: request.Repo, TargetBranch: "env/test", HydratedSha: "sha", Path: "../app"}, expected: `path "../app" is not a valid hydrated path`},
+			{name: "OCI repository", request: &apiclient.RevertHydratedManifestsRequest{Repo: &v1alpha1.Repository{Repo: "oci://registry.example.com/app"}, TargetBranch: "env/test", HydratedSha: "sha", Path: "app"}, expected: "not supported when hydrating to an OCI repository"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				service, _ := newServiceWithMocks(t)
+				_, err := service.RevertHydratedManifests(t.Context(), tt.request)
+				require.ErrorContains(t, err, tt.expected)
+			})
+		}
+	})
+
+	t.Run("commits the restored path and carries over the note", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("current-sha", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("current-sha", NoteNamespace).Return(`{"drySha":"dry-sha","paths":["app","other"]}`, nil).Once()
+		mockGitClient.EXPECT().RestoreContents("previous-sha", []string{"app"}).Return("", nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{"app/manifest.yaml": "manifest diff"}, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("env/test", "test commit message").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("revert-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote("revert-sha", NoteNamespace, `{"drySha":"dry-sha","paths":["app","other"]}`).Return(nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.RevertHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "revert-sha", resp.HydratedSha)
+	})
+
+	t.Run("nothing to revert", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("current-sha", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("current-sha", NoteNamespace).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockGitClient.EXPECT().RestoreContents("previous-sha", []string{"app"}).Return("", nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.RevertHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "current-sha", resp.HydratedSha)
+	})
+
+	t.Run("commits to the hydrateTo branch and opens a pull request", func(t *testing.T) {
+		t.Parallel()
+
+		pullRequestRequest := &apiclient.RevertHydratedManifestsRequest{
+			Repo:          request.Repo,
+			SyncBranch:    "env/test",
+			TargetBranch:  "env/test-next",
+			Path:          "app",
+			HydratedSha:   "previous-sha",
+			CommitMessage: "test commit message",
+			PullRequest:   &v1alpha1.HydrateToPullRequest{},
+		}
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("sync-sha", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("env/test-next", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("target-sha", NoteNamespace).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockGitClient.EXPECT().RestoreContents("previous-sha", []string{"app"}).Return("", nil).Once()
+		mockGitClient.EXPECT().DiffWorkingTree().Return(map[string]string{"app/manifest.yaml": "manifest diff"}, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("revert-sha", nil)
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "revert-sha").Return([]string{"app/manifest.yaml"}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		prService, err := pull_request.NewFakeService(t.Context(), nil, nil)
+		require.NoError(t, err)
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(mock.Anything, request.Repo, pullRequestRequest.PullRequest).Return(prService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		resp, err := service.RevertHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "revert-sha", resp.HydratedSha)
+		require.NotNil(t, resp.PullRequest)
+		assert.Equal(t, v1alpha1.HydratePullRequestStateOpen, resp.PullRequest.State)
+
+		pullRequests, err := prService.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, "env/test-next", pullRequests[0].Branch)
+		assert.Equal(t, "env/test", pullRequests[0].TargetBranch)
+	})
+
+	t.Run("restore failure", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("current-sha", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote("current-sha", NoteNamespace).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockGitClient.EXPECT().RestoreContents("previous-sha", []string{"app"}).Return("", assert.AnError).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.RevertHydratedManifests(t.Context(), request)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to restore manifests")
+	})
+}
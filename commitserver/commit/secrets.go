@@ -0,0 +1,216 @@
+package commit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// readManifestFiles returns the contents of the YAML files in the given directories and their subdirectories, keyed by
+// their path relative to the root. Directories which don't exist are skipped.
+func readManifestFiles(root *os.Root, dirs []string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, dir := range dirs {
+		err := fs.WalkDir(root.FS(), path.Clean(dir), func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if d == nil && errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() || path.Ext(file) != ".yaml" {
+				return nil
+			}
+			data, err := root.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %q: %w", file, err)
+			}
+			files[file] = data
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests in %q: %w", dir, err)
+		}
+	}
+	return files, nil
+}
+
+// hideSecretDiffs replaces the diffs of the files containing Secrets with diffs in which the data of the Secrets and
+// the values of the sensitive annotations are hidden. The previous contents of the files are read from previous, and
+// the current contents from the root. Both sides are hidden together, like the app controller does when diffing against
+// the live state, so that changed values still show up as changed.
+func hideSecretDiffs(root *os.Root, previous map[string][]byte, diffs map[string]string, sensitiveAnnotations map[string]bool) error {
+	for file := range diffs {
+		if path.Ext(file) != ".yaml" {
+			continue
+		}
+		current, err := root.ReadFile(file)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to read %q: %w", file, err)
+		}
+		before, after, hidden, err := hideSecretData(previous[file], current, sensitiveAnnotations)
+		if err != nil {
+			return fmt.Errorf("failed to hide secret data in %q: %w", file, err)
+		}
+		if !hidden {
+			continue
+		}
+		diffs[file], err = unifiedDiff(file, before, after)
+		if err != nil {
+			return fmt.Errorf("failed to diff %q: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// hideSecretData hides the data of the Secrets in the given YAML streams, matching the Secrets of both streams by
+// namespace and name. The streams are only re-encoded if either of them contains a Secret, in which case hidden is true.
+func hideSecretData(before, after []byte, sensitiveAnnotations map[string]bool) ([]byte, []byte, bool, error) {
+	beforeDocs, beforeSecrets, err := decodeSecrets(before)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	afterDocs, afterSecrets, err := decodeSecrets(after)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if len(beforeSecrets) == 0 && len(afterSecrets) == 0 {
+		return nil, nil, false, nil
+	}
+
+	for key, afterIndex := range afterSecrets {
+		var live *unstructured.Unstructured
+		beforeIndex, ok := beforeSecrets[key]
+		if ok {
+			live = beforeDocs[beforeIndex]
+		}
+		target, live, err := diff.HideSecretData(afterDocs[afterIndex], live, sensitiveAnnotations)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		afterDocs[afterIndex] = target
+		if ok {
+			beforeDocs[beforeIndex] = live
+		}
+	}
+	for key, beforeIndex := range beforeSecrets {
+		if _, ok := afterSecrets[key]; ok {
+			continue
+		}
+		_, live, err := diff.HideSecretData(nil, beforeDocs[beforeIndex], sensitiveAnnotations)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		beforeDocs[beforeIndex] = live
+	}
+
+	if before != nil {
+		if before, err = encodeDocs(beforeDocs); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	if after != nil {
+		if after, err = encodeDocs(afterDocs); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	return before, after, true, nil
+}
+
+// decodeSecrets decodes the documents of the given YAML stream, and returns them along with the indexes of the Secrets
+// among them, keyed by namespace and name.
+func decodeSecrets(data []byte) ([]*unstructured.Unstructured, map[string]int, error) {
+	var docs []*unstructured.Unstructured
+	secrets := make(map[string]int)
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc map[string]any
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if doc == nil {
+			continue
+		}
+		// Round-trip through JSON, so that the object only holds the types expected of an unstructured object.
+		jsonData, err := json.Marshal(doc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(jsonData); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			secrets[obj.GetNamespace()+"/"+obj.GetName()] = len(docs)
+		}
+		docs = append(docs, obj)
+	}
+	return docs, secrets, nil
+}
+
+// encodeDocs encodes the given documents into a YAML stream, like the manifests are written to the hydrated branch.
+func encodeDocs(docs []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		if err := enc.Encode(&doc.Object); err != nil {
+			return nil, fmt.Errorf("failed to encode manifest: %w", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode manifests: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// unifiedDiff returns the diff between the given contents of a file in the format of git diff. Nil contents stand for
+// a file which doesn't exist.
+func unifiedDiff(file string, before, after []byte) (string, error) {
+	fromFile, toFile := "a/"+file, "b/"+file
+	if before == nil {
+		fromFile = "/dev/null"
+	}
+	if after == nil {
+		toFile = "/dev/null"
+	}
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(fmt.Sprintf("diff --git a/%s b/%s\n%s", file, file, text), "\n"), nil
+}
+
+// splitLines splits the given contents into lines, keeping the line endings.
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
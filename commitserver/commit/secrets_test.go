@@ -0,0 +1,63 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hideSecretData(t *testing.T) {
+	t.Parallel()
+
+	configMap := "apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  name: test\n"
+	secret := "apiVersion: v1\ndata:\n  key: dmFsdWU=\nkind: Secret\nmetadata:\n  name: test\n"
+	hiddenSecret := "apiVersion: v1\ndata:\n  key: ++++++++\nkind: Secret\nmetadata:\n  name: test\n"
+
+	t.Run("no secrets", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, hidden, err := hideSecretData([]byte(configMap), []byte(configMap), nil)
+		require.NoError(t, err)
+		assert.False(t, hidden)
+	})
+
+	t.Run("removed secret", func(t *testing.T) {
+		t.Parallel()
+
+		before, after, hidden, err := hideSecretData([]byte(configMap+"---\n"+secret), []byte(configMap), nil)
+		require.NoError(t, err)
+		assert.True(t, hidden)
+		assert.Equal(t, configMap+"---\n"+hiddenSecret, string(before))
+		assert.Equal(t, configMap, string(after))
+	})
+
+	t.Run("new file", func(t *testing.T) {
+		t.Parallel()
+
+		before, after, hidden, err := hideSecretData(nil, []byte(secret), nil)
+		require.NoError(t, err)
+		assert.True(t, hidden)
+		assert.Nil(t, before)
+		assert.Equal(t, hiddenSecret, string(after))
+	})
+
+	t.Run("secrets are matched by namespace and name", func(t *testing.T) {
+		t.Parallel()
+
+		other := "apiVersion: v1\ndata:\n  key: dmFsdWU=\nkind: Secret\nmetadata:\n  name: other\n"
+		before, after, hidden, err := hideSecretData([]byte(secret), []byte(other), nil)
+		require.NoError(t, err)
+		assert.True(t, hidden)
+		assert.Equal(t, hiddenSecret, string(before))
+		assert.Equal(t, "apiVersion: v1\ndata:\n  key: ++++++++\nkind: Secret\nmetadata:\n  name: other\n", string(after))
+	})
+}
+
+func Test_unifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	diff, err := unifiedDiff("app/manifest.yaml", nil, []byte("a\nb\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "diff --git a/app/manifest.yaml b/app/manifest.yaml\n--- /dev/null\n+++ b/app/manifest.yaml\n@@ -0,0 +1,2 @@\n+a\n+b", diff)
+}
@@ -0,0 +1,85 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/gpg"
+)
+
+// NewCommitSigningOpts prepares the signing key at keyPath for use by the git client, and returns the options to sign
+// hydrated commits with it. It returns nil if format is empty, i.e. if commit signing is disabled. The returned cleanup
+// function removes the key material written by NewCommitSigningOpts, and must be called once the options are no longer used.
+//
+// For the openpgp format, the key must be an ASCII-armored GnuPG private key. It is imported into Argo CD's GnuPG
+// keyring. For the ssh format, the key must be an SSH private key. It is copied to a file only readable by the current
+// user, since ssh-keygen refuses to use keys with looser permissions.
+func NewCommitSigningOpts(format string, keyPath string) (*git.CommitSigningOpts, func(), error) {
+	switch git.CommitSigningFormat(format) {
+	case "":
+		return nil, func() {}, nil
+	case git.CommitSigningFormatOpenPGP:
+		opts, err := newOpenPGPSigningOpts(keyPath)
+		return opts, func() {}, err
+	case git.CommitSigningFormatSSH:
+		return newSSHSigningOpts(keyPath)
+	default:
+		return nil, func() {}, fmt.Errorf("unsupported signing key format %q, must be one of %q or %q", format, git.CommitSigningFormatOpenPGP, git.CommitSigningFormatSSH)
+	}
+}
+
+func newOpenPGPSigningOpts(keyPath string) (*git.CommitSigningOpts, error) {
+	err := gpg.InitializeGnuPG()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize GnuPG keyring: %w", err)
+	}
+	keys, err := gpg.ImportPGPKeys(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import signing key: %w", err)
+	}
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("signing key file must contain exactly one key, found %d", len(keys))
+	}
+	keyID := keys[0].KeyID
+	isSecret, err := gpg.IsSecretKey(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check signing key %s: %w", keyID, err)
+	}
+	if !isSecret {
+		return nil, fmt.Errorf("signing key %s is not a private key", keyID)
+	}
+	log.Infof("Signing hydrated commits with GnuPG key %s", keyID)
+	return &git.CommitSigningOpts{Format: git.CommitSigningFormatOpenPGP, Key: keyID}, nil
+}
+
+func newSSHSigningOpts(keyPath string) (*git.CommitSigningOpts, func(), error) {
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, func() {}, errors.New("signing key file is empty")
+	}
+	dir, err := os.MkdirTemp("", "commit-signing")
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("failed to create signing key directory: %w", err)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warnf("Failed to remove signing key directory %s: %v", dir, err)
+		}
+	}
+	path := filepath.Join(dir, "signing-key")
+	err = os.WriteFile(path, key, 0o600)
+	if err != nil {
+		cleanup()
+		return nil, func() {}, fmt.Errorf("failed to write signing key: %w", err)
+	}
+	log.Info("Signing hydrated commits with SSH key")
+	return &git.CommitSigningOpts{Format: git.CommitSigningFormatSSH, Key: path}, cleanup, nil
+}
@@ -0,0 +1,125 @@
+package commit
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func TestNewCommitSigningOpts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signing disabled", func(t *testing.T) {
+		t.Parallel()
+		opts, cleanup, err := NewCommitSigningOpts("", "/does/not/exist")
+		require.NoError(t, err)
+		cleanup()
+		assert.Nil(t, opts)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := NewCommitSigningOpts("x509", "/does/not/exist")
+		require.ErrorContains(t, err, `unsupported signing key format "x509"`)
+	})
+
+	t.Run("ssh key is copied with restricted permissions", func(t *testing.T) {
+		t.Parallel()
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("private key"), 0o644))
+
+		opts, cleanup, err := NewCommitSigningOpts("ssh", keyPath)
+		require.NoError(t, err)
+		assert.Equal(t, git.CommitSigningFormatSSH, opts.Format)
+		assert.NotEqual(t, keyPath, opts.Key)
+
+		info, err := os.Stat(opts.Key)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+		key, err := os.ReadFile(opts.Key)
+		require.NoError(t, err)
+		assert.Equal(t, "private key", string(key))
+
+		cleanup()
+		_, err = os.Stat(filepath.Dir(opts.Key))
+		assert.True(t, os.IsNotExist(err), "the copied key must be removed by the cleanup function")
+	})
+
+	t.Run("missing ssh key", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := NewCommitSigningOpts("ssh", filepath.Join(t.TempDir(), "signing.key"))
+		require.ErrorContains(t, err, "failed to read signing key")
+	})
+}
+
+// runCmd runs the given command in the given directory with the given additional environment, and returns its output
+func runCmd(t *testing.T, dir string, env []string, name string, args ...string) string {
+	t.Helper()
+	cmd := exec.CommandContext(t.Context(), name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s %v failed: %s", name, args, out)
+	return string(out)
+}
+
+func TestNewCommitSigningOpts_OpenPGPSignedCommit(t *testing.T) {
+	// GnuPG home directories are created outside of t.TempDir, whose long paths may exceed the maximum length of the
+	// socket paths used by gpg-agent.
+	newGnuPGHome := func() string {
+		dir, err := os.MkdirTemp("", "gpg")
+		require.NoError(t, err)
+		require.NoError(t, os.Chmod(dir, 0o700))
+		t.Cleanup(func() {
+			_ = exec.CommandContext(context.Background(), "gpgconf", "--homedir", dir, "--kill", "gpg-agent").Run()
+			_ = os.RemoveAll(dir)
+		})
+		return dir
+	}
+
+	// Generate the signing key in a separate keyring, and export it as the ASCII-armored key mounted in the commit server.
+	generationHome := newGnuPGHome()
+	generationEnv := []string{"GNUPGHOME=" + generationHome}
+	runCmd(t, generationHome, generationEnv, "gpg", "--batch", "--passphrase", "", "--quick-generate-key", "Hydrator <hydrator@example.com>", "ed25519", "sign", "never")
+	privateKey := runCmd(t, generationHome, generationEnv, "gpg", "--batch", "--armor", "--export-secret-keys", "hydrator@example.com")
+	keyPath := filepath.Join(t.TempDir(), "signing.key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(privateKey), 0o600))
+
+	argoCDHome := newGnuPGHome()
+	t.Setenv(common.EnvGnuPGHome, argoCDHome)
+	opts, cleanup, err := NewCommitSigningOpts("openpgp", keyPath)
+	require.NoError(t, err)
+	defer cleanup()
+	assert.Equal(t, git.CommitSigningFormatOpenPGP, opts.Format)
+
+	repoDir := t.TempDir()
+	runCmd(t, repoDir, nil, "git", "init", "--initial-branch", "main")
+	runCmd(t, repoDir, nil, "git", "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "Initial commit")
+	runCmd(t, repoDir, nil, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+
+	client, err := git.NewClient("file://"+repoDir, git.NopCreds{}, true, false, "", "", git.WithCommitSigning(opts))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	out, err := client.SetAuthor("Hydrator", "hydrator@example.com")
+	require.NoError(t, err, "error output: %s", out)
+	require.NoError(t, client.Fetch("main", 0))
+	out, err = client.Checkout("main", false)
+	require.NoError(t, err, "error output: %s", out)
+	require.NoError(t, os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+	out, err = client.CommitAndPush("main", "Hydrate manifests")
+	require.NoError(t, err, "error output: %s", out)
+
+	// The signature is verified against the keyring the key was generated in, which Argo CD never wrote to.
+	runCmd(t, repoDir, generationEnv, "git", "verify-commit", "HEAD")
+	status := runCmd(t, repoDir, generationEnv, "git", "log", "-1", "--format=%G?")
+	assert.Equal(t, "G", strings.TrimSpace(status))
+}
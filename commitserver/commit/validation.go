@@ -0,0 +1,306 @@
+package commit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	configutil "github.com/argoproj/argo-cd/v3/util/config"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// maxPluginOutputLength is the maximum length of the output of a validation plugin included in a validation failure.
+const maxPluginOutputLength = 1024
+
+// ValidationConfig configures the stages the hydrated manifests are validated with before they are committed.
+type ValidationConfig struct {
+	// Stages are run in order. All stages run, so that all failures are reported at once.
+	Stages []ValidationStage `json:"stages"`
+}
+
+// ValidationStage is a single validation stage. Exactly one of DeniedResources, Schema and Plugin must be set.
+type ValidationStage struct {
+	// Name identifies the stage in validation failures.
+	Name string `json:"name"`
+	// DeniedResources fails the validation if any manifest matches one of the filters.
+	DeniedResources []settings.FilteredResource `json:"deniedResources,omitempty"`
+	// Schema validates the manifests against JSON schemas.
+	Schema *SchemaValidation `json:"schema,omitempty"`
+	// Plugin validates the manifests with a command.
+	Plugin *PluginValidation `json:"plugin,omitempty"`
+}
+
+// SchemaValidation validates the manifests against standalone JSON schemas, e.g. the ones of
+// https://github.com/yannh/kubernetes-json-schema. The schema of a manifest is looked up in Dir as
+// <kind>-<group prefix>-<version>.json (<kind>-<version>.json for core resources), or as <group>/<kind>_<version>.json,
+// all lowercase.
+type SchemaValidation struct {
+	Dir string `json:"dir"`
+	// IgnoreMissingSchemas skips manifests without a schema instead of failing the validation.
+	IgnoreMissingSchemas bool `json:"ignoreMissingSchemas,omitempty"`
+}
+
+// PluginValidation validates the manifests of each hydrated path with a command, similar to a config management
+// plugin. The manifests are written to its stdin as a multi-document YAML stream, and the validation fails if the
+// command exits with a non-zero code.
+type PluginValidation struct {
+	Command []string `json:"command"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// ValidationFailure is a failure of a validation stage for a hydrated path.
+type ValidationFailure struct {
+	Stage   string
+	Path    string
+	Message string
+}
+
+// ValidationError is returned when the hydrated manifests fail validation. It is returned to the controller with the
+// FailedPrecondition gRPC code, so the controller can tell it apart from other commit failures.
+type ValidationError struct {
+	Failures []ValidationFailure
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		messages = append(messages, fmt.Sprintf("stage %q failed for path %q: %s", f.Stage, f.Path, f.Message))
+	}
+	return "manifest validation failed: " + strings.Join(messages, "; ")
+}
+
+// GRPCStatus returns the gRPC status of the error.
+func (e *ValidationError) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// ManifestValidator validates hydrated manifests before they are committed. A nil ManifestValidator doesn't validate
+// anything.
+type ManifestValidator struct {
+	stages []ValidationStage
+}
+
+// NewManifestValidator reads the validation config at configPath and returns a validator for it. It returns nil if
+// configPath is empty, i.e. if validation is disabled.
+func NewManifestValidator(configPath string) (*ManifestValidator, error) {
+	if configPath == "" {
+		return nil, nil
+	}
+	var config ValidationConfig
+	err := configutil.UnmarshalLocalFile(configPath, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation config: %w", err)
+	}
+	for i, stage := range config.Stages {
+		if stage.Name == "" {
+			return nil, fmt.Errorf("validation stage %d has no name", i)
+		}
+		set := 0
+		if len(stage.DeniedResources) > 0 {
+			set++
+		}
+		if stage.Schema != nil {
+			set++
+			if stage.Schema.Dir == "" {
+				return nil, fmt.Errorf("validation stage %q has no schema dir", stage.Name)
+			}
+		}
+		if stage.Plugin != nil {
+			set++
+			if len(stage.Plugin.Command) == 0 {
+				return nil, fmt.Errorf("validation stage %q has no plugin command", stage.Name)
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("validation stage %q must set exactly one of deniedResources, schema or plugin", stage.Name)
+		}
+	}
+	log.Infof("Validating hydrated manifests with %d stages", len(config.Stages))
+	return &ManifestValidator{stages: config.Stages}, nil
+}
+
+// Validate runs the validation stages against the manifests of each path of the request. It returns a
+// *ValidationError listing the failures if any stage failed, or another error if the validation couldn't be run.
+func (v *ManifestValidator) Validate(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) error {
+	if v == nil || len(v.stages) == 0 {
+		return nil
+	}
+	var failures []ValidationFailure
+	for _, p := range r.Paths {
+		objs := make([]*unstructured.Unstructured, 0, len(p.Manifests))
+		for _, m := range p.Manifests {
+			obj := &unstructured.Unstructured{}
+			err := json.Unmarshal([]byte(m.ManifestJSON), &obj.Object)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal manifest: %w", err)
+			}
+			objs = append(objs, obj)
+		}
+		for _, stage := range v.stages {
+			messages, err := runValidationStage(ctx, stage, r, p.Path, objs)
+			if err != nil {
+				return fmt.Errorf("failed to run validation stage %q: %w", stage.Name, err)
+			}
+			for _, message := range messages {
+				failures = append(failures, ValidationFailure{Stage: stage.Name, Path: p.Path, Message: message})
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &ValidationError{Failures: failures}
+	}
+	return nil
+}
+
+// runValidationStage runs the stage against the manifests of a hydrated path, and returns the failure messages.
+func runValidationStage(ctx context.Context, stage ValidationStage, r *apiclient.CommitHydratedManifestsRequest, path string, objs []*unstructured.Unstructured) ([]string, error) {
+	switch {
+	case len(stage.DeniedResources) > 0:
+		return validateDeniedResources(stage.DeniedResources, objs), nil
+	case stage.Schema != nil:
+		return validateSchemas(stage.Schema, objs)
+	case stage.Plugin != nil:
+		return runValidationPlugin(ctx, stage.Plugin, r, path, objs)
+	}
+	return nil, nil
+}
+
+func validateDeniedResources(denied []settings.FilteredResource, objs []*unstructured.Unstructured) []string {
+	var messages []string
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		for _, filter := range denied {
+			if filter.Match(gvk.Group, gvk.Kind, "") {
+				messages = append(messages, fmt.Sprintf("%s is denied", resourceName(obj)))
+				break
+			}
+		}
+	}
+	return messages
+}
+
+func validateSchemas(config *SchemaValidation, objs []*unstructured.Unstructured) ([]string, error) {
+	var messages []string
+	schemas := map[string]*spec.Schema{}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		key := gvk.String()
+		schema, ok := schemas[key]
+		if !ok {
+			var err error
+			schema, err = readSchema(config.Dir, gvk.Group, gvk.Version, gvk.Kind)
+			if err != nil {
+				return nil, err
+			}
+			schemas[key] = schema
+		}
+		if schema == nil {
+			if !config.IgnoreMissingSchemas {
+				messages = append(messages, fmt.Sprintf("%s: no schema found for %s", resourceName(obj), key))
+			}
+			continue
+		}
+		err := validate.AgainstSchema(schema, obj.Object, strfmt.Default)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("%s: %v", resourceName(obj), err))
+		}
+	}
+	return messages, nil
+}
+
+// readSchema reads the schema of the given resource type from dir. It returns nil if there is no schema for it.
+func readSchema(dir, group, version, kind string) (*spec.Schema, error) {
+	groupPrefix, _, _ := strings.Cut(group, ".")
+	names := []string{strings.ToLower(fmt.Sprintf("%s-%s-%s.json", kind, groupPrefix, version))}
+	if group == "" {
+		names = []string{strings.ToLower(fmt.Sprintf("%s-%s.json", kind, version))}
+	} else {
+		names = append(names, strings.ToLower(filepath.Join(group, fmt.Sprintf("%s_%s.json", kind, version))))
+	}
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema %q: %w", name, err)
+		}
+		schema := &spec.Schema{}
+		err = json.Unmarshal(data, schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal schema %q: %w", name, err)
+		}
+		if ref := schema.Ref.String(); ref != "" {
+			return nil, fmt.Errorf("schema %q is not standalone: references are not supported", name)
+		}
+		return schema, nil
+	}
+	return nil, nil
+}
+
+func runValidationPlugin(ctx context.Context, plugin *PluginValidation, r *apiclient.CommitHydratedManifestsRequest, path string, objs []*unstructured.Unstructured) ([]string, error) {
+	var stdin bytes.Buffer
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		stdin.WriteString("---\n")
+		stdin.Write(data)
+	}
+
+	cmd := exec.CommandContext(ctx, plugin.Command[0], append(plugin.Command[1:], plugin.Args...)...)
+	cmd.Stdin = &stdin
+	cmd.Env = append(os.Environ(),
+		"ARGOCD_HYDRATOR_REPO_URL="+getDryRepoURL(r),
+		"ARGOCD_HYDRATOR_DRY_SHA="+r.DrySha,
+		"ARGOCD_HYDRATOR_PATH="+path,
+		"ARGOCD_HYDRATOR_TARGET_BRANCH="+r.TargetBranch,
+	)
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{CaptureStderr: true, SkipErrorLogging: true})
+	if err == nil {
+		return nil, nil
+	}
+	var cmdErr *executil.CmdError
+	if !errors.As(err, &cmdErr) {
+		return nil, err
+	}
+	// Validation tools usually report failures on stdout, so the whole output is used as the message.
+	message := strings.TrimSpace(out)
+	if message == "" {
+		message = cmdErr.Error()
+	}
+	if len(message) > maxPluginOutputLength {
+		message = message[:maxPluginOutputLength] + "..."
+	}
+	return []string{message}, nil
+}
+
+func resourceName(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+	if gvk.Group == "" {
+		return fmt.Sprintf("%s %s", gvk.Kind, name)
+	}
+	return fmt.Sprintf("%s.%s %s", gvk.Kind, gvk.Group, name)
+}
@@ -0,0 +1,185 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	configMapManifest = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm","namespace":"default"},"data":{"key":"value"}}`
+	secretManifest    = `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"stringData":{"password":"plaintext"}}`
+)
+
+// configMapSchema only allows string values in the data of a ConfigMap.
+const configMapSchema = `{
+  "type": "object",
+  "required": ["metadata"],
+  "properties": {
+    "data": {"type": "object", "additionalProperties": {"type": "string"}}
+  }
+}`
+
+func newValidator(t *testing.T, config string) *ManifestValidator {
+	t.Helper()
+
+	configPath := filepath.Join(t.TempDir(), "validation.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(config), 0o644))
+	validator, err := NewManifestValidator(configPath)
+	require.NoError(t, err)
+	return validator
+}
+
+func newValidationRequest(manifests ...string) *apiclient.CommitHydratedManifestsRequest {
+	details := make([]*apiclient.HydratedManifestDetails, 0, len(manifests))
+	for _, m := range manifests {
+		details = append(details, &apiclient.HydratedManifestDetails{ManifestJSON: m})
+	}
+	return &apiclient.CommitHydratedManifestsRequest{
+		Repo:         &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"},
+		SyncBranch:   "main",
+		TargetBranch: "main",
+		DrySha:       "abc123",
+		Paths:        []*apiclient.PathDetails{{Path: "guestbook", Manifests: details}},
+	}
+}
+
+func TestNewManifestValidator(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		validator, err := NewManifestValidator("")
+		require.NoError(t, err)
+		assert.Nil(t, validator)
+		require.NoError(t, validator.Validate(t.Context(), newValidationRequest(secretManifest)))
+	})
+
+	tests := []struct {
+		name          string
+		config        string
+		expectedError string
+	}{
+		{
+			name:          "no name",
+			config:        "stages:\n- deniedResources: [{kinds: [Secret]}]\n",
+			expectedError: "validation stage 0 has no name",
+		},
+		{
+			name:          "no validation",
+			config:        "stages:\n- name: empty\n",
+			expectedError: `validation stage "empty" must set exactly one of deniedResources, schema or plugin`,
+		},
+		{
+			name:          "several validations",
+			config:        "stages:\n- name: both\n  deniedResources: [{kinds: [Secret]}]\n  plugin: {command: [/bin/true]}\n",
+			expectedError: `validation stage "both" must set exactly one of deniedResources, schema or plugin`,
+		},
+		{
+			name:          "no schema dir",
+			config:        "stages:\n- name: schema\n  schema: {}\n",
+			expectedError: `validation stage "schema" has no schema dir`,
+		},
+		{
+			name:          "no plugin command",
+			config:        "stages:\n- name: plugin\n  plugin: {}\n",
+			expectedError: `validation stage "plugin" has no plugin command`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "validation.yaml")
+			require.NoError(t, os.WriteFile(configPath, []byte(tt.config), 0o644))
+			_, err := NewManifestValidator(configPath)
+			require.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestManifestValidator_DeniedResources(t *testing.T) {
+	validator := newValidator(t, `
+stages:
+- name: no-plaintext-secrets
+  deniedResources:
+  - apiGroups: [""]
+    kinds: [Secret]
+`)
+
+	require.NoError(t, validator.Validate(t.Context(), newValidationRequest(configMapManifest)))
+
+	err := validator.Validate(t.Context(), newValidationRequest(configMapManifest, secretManifest))
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []ValidationFailure{{Stage: "no-plaintext-secrets", Path: "guestbook", Message: "Secret default/secret is denied"}}, validationErr.Failures)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, `manifest validation failed: stage "no-plaintext-secrets" failed for path "guestbook": Secret default/secret is denied`, err.Error())
+}
+
+func TestManifestValidator_Schema(t *testing.T) {
+	schemaDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(schemaDir, "configmap-v1.json"), []byte(configMapSchema), 0o644))
+
+	t.Run("valid", func(t *testing.T) {
+		validator := newValidator(t, "stages:\n- name: schema\n  schema:\n    dir: "+schemaDir+"\n")
+		require.NoError(t, validator.Validate(t.Context(), newValidationRequest(configMapManifest)))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		validator := newValidator(t, "stages:\n- name: schema\n  schema:\n    dir: "+schemaDir+"\n")
+		invalid := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"},"data":{"key":1}}`
+		err := validator.Validate(t.Context(), newValidationRequest(invalid))
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Failures, 1)
+		assert.Contains(t, validationErr.Failures[0].Message, "ConfigMap cm: ")
+		assert.Contains(t, validationErr.Failures[0].Message, "data.key")
+	})
+
+	t.Run("missing schema", func(t *testing.T) {
+		validator := newValidator(t, "stages:\n- name: schema\n  schema:\n    dir: "+schemaDir+"\n")
+		err := validator.Validate(t.Context(), newValidationRequest(secretManifest))
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "Secret default/secret: no schema found for /v1, Kind=Secret", validationErr.Failures[0].Message)
+	})
+
+	t.Run("ignore missing schemas", func(t *testing.T) {
+		validator := newValidator(t, "stages:\n- name: schema\n  schema:\n    dir: "+schemaDir+"\n    ignoreMissingSchemas: true\n")
+		require.NoError(t, validator.Validate(t.Context(), newValidationRequest(secretManifest)))
+	})
+}
+
+func TestManifestValidator_Plugin(t *testing.T) {
+	validator := newValidator(t, `
+stages:
+- name: policy
+  plugin:
+    command: [sh, -c]
+    args:
+    - 'if grep -q "kind: Secret"; then echo "secrets are not allowed in $ARGOCD_HYDRATOR_PATH"; exit 1; fi'
+`)
+
+	require.NoError(t, validator.Validate(t.Context(), newValidationRequest(configMapManifest)))
+
+	err := validator.Validate(t.Context(), newValidationRequest(configMapManifest, secretManifest))
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []ValidationFailure{{Stage: "policy", Path: "guestbook", Message: "secrets are not allowed in guestbook"}}, validationErr.Failures)
+}
+
+func Test_CommitHydratedManifests_ValidationFails(t *testing.T) {
+	t.Parallel()
+
+	// The repo client factory mock fails the test if the repository is cloned.
+	service, _ := newServiceWithMocks(t)
+	service.manifestValidator = newValidator(t, "stages:\n- name: no-secrets\n  deniedResources: [{kinds: [Secret]}]\n")
+
+	_, err := service.CommitHydratedManifests(t.Context(), newValidationRequest(secretManifest))
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
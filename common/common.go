@@ -142,6 +142,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// ResourceWeightedShardingAlgorithm weights clusters by the number of resources and APIs the controller watches in
+	// them. Clusters keep their legacy shard unless the load of a shard exceeds the average load by more than the
+	// imbalance threshold, in which case the fewest clusters needed are moved to the least loaded shards.
+	ResourceWeightedShardingAlgorithm = "resource-weighted"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -234,6 +239,11 @@ const (
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
 
+	// AnnotationKeyApplicationSharding tells the application controller to distribute the Applications of a cluster
+	// across all controller shards instead of processing them by the shard of the cluster. It is set on cluster secrets
+	// and takes effect if set to "true".
+	AnnotationKeyApplicationSharding = "argocd.argoproj.io/application-sharding"
+
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -278,6 +288,12 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingImbalanceThreshold is the percentage by which the load of a shard may exceed the average load
+	// before the resource-weighted sharding algorithm moves clusters to other shards
+	EnvControllerShardingImbalanceThreshold = "ARGOCD_CONTROLLER_SHARDING_IMBALANCE_THRESHOLD"
+	// EnvControllerApplicationShardingEnabled allows distributing the Applications of the clusters annotated with
+	// AnnotationKeyApplicationSharding across all shards
+	EnvControllerApplicationShardingEnabled = "ARGOCD_CONTROLLER_APPLICATION_SHARDING_ENABLED"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
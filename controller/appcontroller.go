@@ -70,6 +70,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/helm"
+	hydratorutil "github.com/argoproj/argo-cd/v3/util/hydrator"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -77,8 +78,12 @@ import (
 const (
 	updateOperationStateTimeout             = 1 * time.Second
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
+	// syncSlotsReconcileInterval is the interval at which the sync slots of the apps without an operation are freed
+	syncSlotsReconcileInterval = 1 * time.Minute
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by the qualified names of the applications they depend on
+	dependsOnIndex = "dependsOn"
 )
 
 type CompareWith int
@@ -111,10 +116,10 @@ type ApplicationController struct {
 	applicationClientset appclientset.Interface
 	auditLogger          *argo.AuditLogger
 	// queue contains app namespace/name
-	appRefreshQueue workqueue.TypedRateLimitingInterface[string]
+	appRefreshQueue *priorityQueue
 	// queue contains app namespace/name/comparisonType and used to request app refresh with the predefined comparison type
 	appComparisonTypeRefreshQueue workqueue.TypedRateLimitingInterface[string]
-	appOperationQueue             workqueue.TypedRateLimitingInterface[string]
+	appOperationQueue             *priorityQueue
 	projectRefreshQueue           workqueue.TypedRateLimitingInterface[string]
 	appHydrateQueue               workqueue.TypedRateLimitingInterface[string]
 	hydrationQueue                workqueue.TypedRateLimitingInterface[hydratortypes.HydrationQueueKey]
@@ -129,6 +134,7 @@ type ApplicationController struct {
 	selfHealTimeout               time.Duration
 	selfHealBackoff               *wait.Backoff
 	syncTimeout                   time.Duration
+	syncSlots                     *clusterSyncSlots
 	db                            db.ArgoDB
 	settingsMgr                   *settings_util.SettingsManager
 	refreshRequestedApps          map[string]CompareWith
@@ -146,6 +152,8 @@ type ApplicationController struct {
 	deploymentInformer                informerv1.DeploymentInformer
 
 	hydrator *hydrator.Hydrator
+	// hydratorManifestGenerator generates the manifests of the dry sources for the hydrator
+	hydratorManifestGenerator *hydratorutil.ManifestGenerator
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -193,8 +201,6 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
-		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
@@ -210,13 +216,17 @@ func NewApplicationController(
 		selfHealTimeout:                   selfHealTimeout,
 		selfHealBackoff:                   selfHealBackoff,
 		syncTimeout:                       syncTimeout,
+		syncSlots:                         newClusterSyncSlots(),
 		clusterSharding:                   clusterSharding,
 		projByNameCache:                   sync.Map{},
 		applicationNamespaces:             applicationNamespaces,
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
+		hydratorManifestGenerator:         hydratorutil.NewManifestGenerator(db, settingsMgr, repoClientset, namespace),
 	}
+	ctrl.appRefreshQueue = newPriorityQueue("app_reconciliation_queue", ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), queueMaxWait, ctrl.observeQueueWait)
+	ctrl.appOperationQueue = newPriorityQueue("app_operation_processing_queue", ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), queueMaxWait, ctrl.observeQueueWait)
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, repoClientset, db)
 	}
@@ -327,7 +337,7 @@ func NewApplicationController(
 		}
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, syncTimeout)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -951,6 +961,8 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}, time.Second, ctx.Done())
 
+	go wait.Until(ctrl.reconcileSyncSlots, syncSlotsReconcileInterval, ctx.Done())
+
 	if ctrl.hydrator != nil {
 		go wait.Until(func() {
 			for ctrl.processAppHydrateQueueItem() {
@@ -1374,6 +1386,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
 			return err
 		}
+
+		if err := ctrl.cache.SetAppDriftHistory(app.InstanceName(ctrl.namespace), nil); err != nil {
+			return err
+		}
 		ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
 	}
 
@@ -1425,6 +1441,12 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	var state *appv1.OperationState
+	// Free the sync slot of the destination cluster once the operation completes or waits for approval
+	defer func() {
+		if state != nil && (state.Phase.Completed() || isWaitingForApproval(state)) {
+			ctrl.releaseSyncSlot(app)
+		}
+	}()
 	// Recover from any unexpected panics and automatically set the status to be failed
 	defer func() {
 		if r := recover(); r != nil {
@@ -1446,18 +1468,30 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		logCtx = logCtx.WithField("time_ms", time.Since(ts.StartTime).Milliseconds())
 		logCtx.Debug("Finished processing requested app operation")
 	}()
+	// Errors getting the project are reported when syncing, the timeout of the controller is used until then.
+	proj, _ := ctrl.getAppProj(app)
+	syncTimeout, syncTimeoutSource := getSyncTimeout(app, proj, ctrl.syncTimeout)
 	terminatingCause := ""
 	if isOperationInProgress(app) {
 		state = app.Status.OperationState.DeepCopy()
+		// Sync waves are approved by adding approvals to the requested operation while it is in progress
+		if app.Operation.Sync != nil && state.Operation.Sync != nil {
+			state.Operation.Sync.Approvals = app.Operation.Sync.Approvals
+		}
 		switch {
 		case state.Phase == synccommon.OperationTerminating:
+			if isSyncTimedOut(state, syncTimeout) {
+				// Terminating may take several passes, e.g. to run the SyncFail hooks.
+				terminatingCause = syncTimeoutSource + " sync timeout"
+			}
 			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
-		case ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)):
+		case isSyncTimedOut(state, syncTimeout):
 			state.Phase = synccommon.OperationTerminating
 			state.Message = "operation is terminating due to timeout"
-			terminatingCause = "controller sync timeout"
+			terminatingCause = syncTimeoutSource + " sync timeout"
 			ctrl.setOperationState(app, state)
-			logCtx.Infof("Terminating in-progress operation due to timeout. Started at: %v, timeout: %v", state.StartedAt, ctrl.syncTimeout)
+			ctrl.metricsServer.IncSyncTimeout(app)
+			logCtx.Infof("Terminating in-progress operation due to %s. Started at: %v, timeout: %v", terminatingCause, state.StartedAt, syncTimeout)
 		case state.Phase == synccommon.OperationRunning && state.FinishedAt != nil:
 			// Failed operation with retry strategy might be in-progress and has completion time
 			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
@@ -1496,15 +1530,40 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	} else {
 		state = NewOperationState(*app.Operation)
 		ctrl.setOperationState(app, state)
-		if ctrl.syncTimeout != time.Duration(0) {
+		if syncTimeout != time.Duration(0) {
 			// Schedule a check during which the timeout would be checked.
-			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), ctrl.syncTimeout)
+			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), syncTimeout)
 		}
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 	ts.AddCheckpoint("initial_operation_stage_ms")
 
 	terminating := state.Phase == synccommon.OperationTerminating
+	// Syncs wait for the applications the app depends on before they start
+	if !terminating && state.SyncResult == nil && state.Operation.Sync != nil {
+		if notReady := ctrl.getDependenciesNotReady(app); notReady != "" {
+			message := "waiting for dependencies to be synced and healthy: " + notReady
+			if state.Message != message {
+				state.Message = message
+				ctrl.setOperationState(app, state)
+			}
+			logCtx.Infof("Operation %s", message)
+			return
+		}
+	}
+	// Syncs to a cluster beyond its concurrency limit wait for a slot to be freed. Syncs waiting for approval don't hold a
+	// slot, and wait for one again once approved.
+	if !terminating && state.Operation.Sync != nil && !isWaitingForApproval(state) {
+		started := state.SyncResult != nil && !state.Phase.WaitingForApproval()
+		if acquired, message := ctrl.acquireSyncSlot(app, started); !acquired {
+			if state.Message != message {
+				state.Message = message
+				ctrl.setOperationState(app, state)
+			}
+			logCtx.Info(message)
+			return
+		}
+	}
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
 		// Start or resume the sync
@@ -1516,7 +1575,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	ts.AddCheckpoint("sync_app_state_ms")
 
 	switch state.Phase {
-	case synccommon.OperationRunning:
+	case synccommon.OperationRunning, synccommon.OperationWaitingForApproval:
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
 		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
@@ -1604,6 +1663,13 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			return
 		}
 	}
+	if app.Status.OperationState != nil && app.Status.OperationState.PendingApproval != nil && state.PendingApproval == nil {
+		patchJSON, err = jsonpatch.MergeMergePatches(patchJSON, []byte(`{"status": {"operationState": {"pendingApproval": null}}}`))
+		if err != nil {
+			logCtx.WithError(err).Error("error merging operation state patch")
+			return
+		}
+	}
 
 	kube.RetryUntilSucceed(context.Background(), updateOperationStateTimeout, "Update application operation state", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		_, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patchJSON, metav1.PatchOptions{})
@@ -1621,6 +1687,10 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 	})
 
 	logCtx.Infof("updated '%s' operation (phase: %s)", app.QualifiedName(), state.Phase)
+	if state.Phase.WaitingForApproval() && state.PendingApproval != nil && (app.Status.OperationState == nil || !app.Status.OperationState.Phase.WaitingForApproval()) {
+		ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationWaitingForApproval, Type: corev1.EventTypeNormal},
+			fmt.Sprintf("Sync operation is waiting for approval of wave %d of phase %s", state.PendingApproval.Wave, state.PendingApproval.Phase))
+	}
 	if state.Phase.Completed() {
 		eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
 		var messages []string
@@ -1678,7 +1748,7 @@ func (ctrl *ApplicationController) PatchAppWithWriteBack(ctx context.Context, na
 func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext bool) {
 	patchDuration := time.Duration(0) // time spent in doing patch/update calls
 	setOpDuration := time.Duration(0) // time spent in doing Operation patch calls in autosync
-	appKey, shutdown := ctrl.appRefreshQueue.Get()
+	appKey, priority, shutdown := ctrl.appRefreshQueue.GetWithPriority()
 	if shutdown {
 		processNext = false
 		return processNext
@@ -1690,7 +1760,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		}
 		// We want to have app operation update happen after the sync, so there's no race condition
 		// and app updates not proceeding. See https://github.com/argoproj/argo-cd/issues/18500.
-		ctrl.appOperationQueue.AddRateLimited(appKey)
+		if priority > queuePriorityPeriodic {
+			ctrl.appOperationQueue.AddWithPriority(appKey, priority)
+		} else {
+			ctrl.appOperationQueue.AddRateLimited(appKey)
+		}
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
 	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
@@ -1847,9 +1921,32 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	if err := ctrl.updateDriftHistory(app, compareResult); err != nil {
+		logCtx.WithError(err).Warn("Failed to update drift history")
+	}
+	ts.AddCheckpoint("update_drift_history_ms")
+
+	setRevisionHistoryHealth(app, compareResult.healthStatus)
+
+	if notReady := ctrl.getDependenciesNotReady(app); notReady != "" {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionDependencyNotReady, Message: "Waiting for dependencies to be synced and healthy: " + notReady}},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyNotReady: true},
+		)
+	} else {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyNotReady: true},
+		)
+	}
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
 	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
+		var syncErrCond *appv1.ApplicationCondition
+		rolledBack, opDuration := ctrl.autoRollback(app, compareResult.healthStatus)
+		if !rolledBack {
+			syncErrCond, opDuration = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
+		}
 		setOpDuration = opDuration
 		if syncErrCond != nil {
 			app.Status.SetConditions(
@@ -2067,6 +2164,12 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 			errorConditions = append(errorConditions, specConditions...)
 		}
 	}
+	if err := argo.ValidateDependencies(app, ctrl.appLister); err != nil {
+		errorConditions = append(errorConditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+	}
 	app.Status.SetConditions(errorConditions, map[appv1.ApplicationConditionType]bool{
 		appv1.ApplicationConditionInvalidSpecError: true,
 		appv1.ApplicationConditionUnknownError:     true,
@@ -2110,7 +2213,7 @@ func createMergePatch(orig, newV any) ([]byte, bool, error) {
 }
 
 // persistAppStatus persists updates to application status. If no changes were made, it is a no-op
-func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) (patchDuration time.Duration) {
+func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus, removeAnnotations ...string) (patchDuration time.Duration) {
 	logCtx := log.WithFields(applog.GetAppLogFields(orig))
 	if orig.Status.Sync.Status != newStatus.Sync.Status {
 		message := fmt.Sprintf("Updated sync status: %s -> %s", orig.Status.Sync.Status, newStatus.Sync.Status)
@@ -2135,7 +2238,11 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 			newAnnotations[k] = v
 		}
 		delete(newAnnotations, appv1.AnnotationKeyRefresh)
+		delete(newAnnotations, appv1.AnnotationKeyRefreshSource)
 		delete(newAnnotations, appv1.AnnotationKeyHydrate)
+		for _, k := range removeAnnotations {
+			delete(newAnnotations, k)
+		}
 	}
 	patch, modified, err := createMergePatch(
 		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: orig.Status},
@@ -2193,6 +2300,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if notReady := ctrl.getDependenciesNotReady(app); notReady != "" {
+		logCtx.Infof("Skipping auto-sync: waiting for dependencies to be synced and healthy: %s", notReady)
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -2312,6 +2424,100 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil, setOpTime
 }
 
+// setRevisionHistoryHealth records the given health status on the revision history item of the deployed revision, so
+// that automatic rollbacks can tell which revisions were healthy. Only Healthy and Degraded statuses are recorded, and
+// only once the operation deploying the revision has completed. Once a revision has been observed Healthy it stays
+// recorded as Healthy, so that a later degradation unrelated to the revision does not disqualify it as a rollback target.
+func setRevisionHistoryHealth(app *appv1.Application, healthStatus health.HealthStatusCode) {
+	if app.Operation != nil || len(app.Status.History) == 0 {
+		return
+	}
+	if healthStatus != health.HealthStatusHealthy && healthStatus != health.HealthStatusDegraded {
+		return
+	}
+	item := &app.Status.History[len(app.Status.History)-1]
+	if item.HealthStatus == health.HealthStatusHealthy || item.HealthStatus == healthStatus {
+		return
+	}
+	item.HealthStatus = healthStatus
+}
+
+// autoRollback rolls the application back to its last healthy revision if it turned Degraded within the auto-rollback
+// window of the sync which deployed its current revision. Automated sync is paused first, so that it doesn't sync the
+// degraded revision again. It returns whether a rollback was initiated, and the time spent setting the operation.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus health.HealthStatusCode) (bool, time.Duration) {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.AutoRollback == nil || healthStatus != health.HealthStatusDegraded {
+		return false, 0
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return false, 0
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.Operation.Sync.DryRun || opState.Phase != synccommon.OperationSucceeded || opState.FinishedAt == nil {
+		return false, 0
+	}
+	if len(app.Status.History) == 0 {
+		return false, 0
+	}
+	deployed := app.Status.History.LastRevisionHistory()
+	if deployed.DeployStartedAt == nil || !deployed.DeployStartedAt.Equal(&opState.StartedAt) {
+		// The last sync did not deploy a new revision, e.g. because it only synced some resources.
+		return false, 0
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	if deployed.AutoRollbackFrom != nil {
+		// Rolling back again could walk through the whole history, so a failed rollback is left to the user.
+		logCtx.Warnf("Skipping auto-rollback: application turned Degraded after an automatic rollback")
+		return false, 0
+	}
+	window, err := app.Spec.SyncPolicy.AutoRollback.GetWindow()
+	if err != nil {
+		logCtx.WithError(err).Warn("Skipping auto-rollback: invalid window")
+		return false, 0
+	}
+	if time.Since(opState.FinishedAt.Time) > window {
+		return false, 0
+	}
+	target := app.Status.History.LastHealthyRevisionHistory()
+	if target == nil {
+		logCtx.Warnf("Skipping auto-rollback: no healthy revision to roll back to")
+		return false, 0
+	}
+
+	prune := app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune
+	op, err := argo.NewRollbackOperation(app, target, false, prune, appv1.OperationInitiator{Automated: true})
+	if err != nil {
+		logCtx.WithError(err).Warn("Skipping auto-rollback")
+		return false, 0
+	}
+	op.Sync.AutoRollbackFrom = ptr.To(deployed.ID)
+
+	start := time.Now()
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
+		patch := []byte(`{"spec":{"syncPolicy":{"automated":{"enabled":false}}}}`)
+		_, err = appIf.Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			logCtx.WithError(err).Error("Failed to pause automated sync for auto-rollback")
+			return false, time.Since(start)
+		}
+		message := "Paused automated sync: application turned Degraded after sync"
+		ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: corev1.EventTypeWarning}, message)
+	}
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		logCtx.WithError(err).Errorf("Failed to initiate auto-rollback to history %d", target.ID)
+		return false, setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Initiated automatic rollback from history %d to history %d: application turned Degraded within %v of sync", deployed.ID, target.ID, window)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return true, setOpTime
+}
+
 // alreadyAttemptedSync returns whether the most recently synced revision(s) exactly match the given desiredRevisions
 // and for the same application source. If the revision(s) have changed or the Application source configuration has been updated,
 // it will return false, indicating that a new sync should be attempted.
@@ -2422,7 +2628,7 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(destCluster)
+	return ctrl.clusterSharding.IsManagedApp(app, destCluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2498,6 +2704,17 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: func(obj any) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok || !ctrl.isAppNamespaceAllowed(app) {
+					return nil, nil
+				}
+				var deps []string
+				for _, dep := range app.GetDependsOn() {
+					deps = append(deps, dep.QualifiedName())
+				}
+				return deps, nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2528,6 +2745,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 
 				var compareWith *CompareWith
 				var delay *time.Duration
+				priority := queuePriorityPeriodic
 
 				oldApp, oldOK := old.(*appv1.Application)
 				newApp, newOK := new.(*appv1.Application)
@@ -2541,9 +2759,17 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
 						delay = &jitter
 					}
+					if argo.IsDependencyReady(oldApp) != argo.IsDependencyReady(newApp) {
+						ctrl.requestDependentAppsRefresh(newApp)
+					}
+					priority = getQueuePriority(oldApp, newApp)
 				}
 
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+				if priority > queuePriorityPeriodic {
+					// process interactive actions ahead of the periodic reconciliations
+					ctrl.appRefreshQueue.AddWithPriority(key, priority)
+				}
 				if !newOK || (delay != nil && *delay != time.Duration(0)) {
 					ctrl.appOperationQueue.AddRateLimited(key)
 				}
@@ -2566,6 +2792,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				delApp, delOK := obj.(*appv1.Application)
 				if err == nil && delOK {
 					ctrl.clusterSharding.DeleteApp(delApp)
+					ctrl.releaseSyncSlot(delApp)
 				}
 			},
 		},
@@ -2576,6 +2803,108 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	return informer, lister
 }
 
+// observeQueueWait records the time an application waited in one of the queues of the controller
+func (ctrl *ApplicationController) observeQueueWait(queue string, priority queuePriority, wait time.Duration) {
+	if ctrl.metricsServer != nil {
+		ctrl.metricsServer.ObserveAppQueueWait(queue, priority.String(), wait)
+	}
+}
+
+// requestDependentAppsRefresh refreshes the applications depending on the given application, and resumes their
+// operations waiting for the dependencies to be ready.
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, app.QualifiedName())
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to get dependent applications: %v", err)
+		return
+	}
+	for _, obj := range objs {
+		dependent, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(dependent.QualifiedName()))
+	}
+}
+
+// acquireSyncSlot returns whether the operation of the app may sync against its destination cluster, or a message
+// explaining that it waits for a sync slot of the cluster otherwise.
+func (ctrl *ApplicationController) acquireSyncSlot(app *appv1.Application, started bool) (bool, string) {
+	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		// The sync reports the invalid destination
+		return true, ""
+	}
+	limit, err := ctrl.settingsMgr.GetClusterSyncConcurrencyLimit()
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to get the cluster sync concurrency limit: %v", err)
+	}
+	if destCluster.SyncConcurrencyLimit != nil {
+		limit = int(*destCluster.SyncConcurrencyLimit)
+	}
+	limit = ctrl.clusterSharding.GetSyncConcurrencyLimit(destCluster, limit)
+	acquired, position := ctrl.syncSlots.acquire(destCluster.Server, ctrl.toAppKey(app.QualifiedName()), limit, started)
+	running, waiting := ctrl.syncSlots.count(destCluster.Server)
+	ctrl.metricsServer.SetClusterSyncOperations(destCluster.Server, running, waiting)
+	if acquired {
+		return true, ""
+	}
+	return false, fmt.Sprintf("Waiting for sync slot: %d of %d sync operations to cluster %s are running, %d operations ahead in queue", running, limit, destCluster.Server, position-1)
+}
+
+// releaseSyncSlot frees the sync slot held by the app and resumes the operations waiting for a slot of its cluster.
+func (ctrl *ApplicationController) releaseSyncSlot(app *appv1.Application) {
+	ctrl.releaseSyncSlotByKey(ctrl.toAppKey(app.QualifiedName()))
+}
+
+func (ctrl *ApplicationController) releaseSyncSlotByKey(appKey string) {
+	server, waiting := ctrl.syncSlots.release(appKey)
+	if server == "" {
+		return
+	}
+	running, _ := ctrl.syncSlots.count(server)
+	ctrl.metricsServer.SetClusterSyncOperations(server, running, len(waiting))
+	for _, key := range waiting {
+		ctrl.appOperationQueue.Add(key)
+	}
+}
+
+// reconcileSyncSlots frees the sync slots held, or waited for, by the apps which no longer have a requested operation,
+// e.g. because the app was deleted or its operation was removed before completing.
+func (ctrl *ApplicationController) reconcileSyncSlots() {
+	for _, appKey := range ctrl.syncSlots.keys() {
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+		if err != nil {
+			log.Warnf("Failed to get application %s to reconcile its sync slot: %v", appKey, err)
+			continue
+		}
+		if app, ok := obj.(*appv1.Application); exists && ok && app.Operation != nil {
+			continue
+		}
+		log.Infof("Freeing the sync slot of application %s which no longer has an operation", appKey)
+		ctrl.releaseSyncSlotByKey(appKey)
+	}
+}
+
+// isWaitingForApproval returns whether the operation waits for the approval of a sync wave which is not approved yet.
+func isWaitingForApproval(state *appv1.OperationState) bool {
+	if !state.Phase.WaitingForApproval() || state.PendingApproval == nil || state.Operation.Sync == nil {
+		return false
+	}
+	return !state.Operation.Sync.IsWaveApproved(state.PendingApproval.Phase, state.PendingApproval.Wave)
+}
+
+// getDependenciesNotReady returns the applications the app depends on which are not synced and healthy, formatted for
+// messages, or an empty string if all of them are.
+func (ctrl *ApplicationController) getDependenciesNotReady(app *appv1.Application) string {
+	notReady, err := argo.GetDependenciesNotReady(app, ctrl.appLister)
+	if err != nil {
+		return err.Error()
+	}
+	return strings.Join(notReady, ", ")
+}
+
 func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1.Application) appv1.ApplicationCondition {
 	var condition appv1.ApplicationCondition
 	if apierrors.IsNotFound(err) {
@@ -2590,7 +2919,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace, ctrl.clusterSharding.UpdateClusterWeights)
 	go updater.Run(ctx)
 }
 
@@ -650,6 +650,25 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncDependenciesNotReady(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "db"}}
+	db := newFakeApp()
+	db.Name = "db"
+	db.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	db.Status.Health.Status = health.HealthStatusHealthy
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, db}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 	app := newFakeApp()
 	enable := true
@@ -912,6 +931,121 @@ func TestAutoSyncIndicateError(t *testing.T) {
 	assert.Nil(t, app.Operation)
 }
 
+// newFakeDegradingApp returns an app with auto-rollback, whose last sync deployed revision history 2 within the
+// auto-rollback window, after the healthy revision history 1.
+func newFakeDegradingApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.Prune = true
+	app.Spec.SyncPolicy.AutoRollback = &v1alpha1.SyncPolicyAutoRollback{Window: "5m"}
+	startedAt := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	finishedAt := metav1.NewTime(time.Now().Add(-30 * time.Second).Truncate(time.Second))
+	app.Status.OperationState.StartedAt = startedAt
+	app.Status.OperationState.FinishedAt = &finishedAt
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 0, Revision: "cccccccccccccccccccccccccccccccccccccccc", Source: app.Spec.GetSource(), HealthStatus: health.HealthStatusHealthy},
+		{ID: 1, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Source: app.Spec.GetSource(), HealthStatus: health.HealthStatusHealthy},
+		{ID: 2, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: app.Spec.GetSource(), DeployStartedAt: &startedAt},
+	}
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	t.Run("RollsBackToLastHealthyRevision", func(t *testing.T) {
+		app := newFakeDegradingApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.True(t, rolledBack)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		require.NotNil(t, app.Operation.Sync)
+		assert.Equal(t, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.Sync.Prune)
+		assert.Equal(t, ptr.To(int64(2)), app.Operation.Sync.AutoRollbackFrom)
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+		// Automated sync is paused so that it doesn't sync the degraded revision again
+		assert.False(t, app.Spec.SyncPolicy.IsAutomatedSyncEnabled())
+	})
+
+	t.Run("RollsBackWithoutAutomatedSync", func(t *testing.T) {
+		app := newFakeDegradingApp()
+		app.Spec.SyncPolicy.Automated = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.True(t, rolledBack)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.False(t, app.Operation.Sync.Prune)
+		assert.Nil(t, app.Spec.SyncPolicy.Automated)
+	})
+
+	tests := []struct {
+		name   string
+		health health.HealthStatusCode
+		modify func(app *v1alpha1.Application)
+	}{
+		{name: "NotEnabled", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) { app.Spec.SyncPolicy.AutoRollback = nil }},
+		{name: "Healthy", health: health.HealthStatusHealthy, modify: func(_ *v1alpha1.Application) {}},
+		{name: "Progressing", health: health.HealthStatusProgressing, modify: func(_ *v1alpha1.Application) {}},
+		{name: "OperationInProgress", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		}},
+		{name: "LastSyncFailed", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Status.OperationState.Phase = synccommon.OperationFailed
+		}},
+		{name: "OutsideWindow", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy.AutoRollback.Window = "10s"
+		}},
+		{name: "LastSyncDidNotDeployRevision", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Status.History[2].DeployStartedAt = &metav1.Time{Time: app.Status.OperationState.StartedAt.Add(-time.Hour)}
+		}},
+		{name: "AlreadyRolledBack", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Status.History[2].AutoRollbackFrom = ptr.To(int64(1))
+		}},
+		{name: "NoHealthyRevision", health: health.HealthStatusDegraded, modify: func(app *v1alpha1.Application) {
+			app.Status.History[0].HealthStatus = health.HealthStatusDegraded
+			app.Status.History[1].HealthStatus = ""
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeDegradingApp()
+			tt.modify(app)
+			ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+			rolledBack, _ := ctrl.autoRollback(app, tt.health)
+			assert.False(t, rolledBack)
+			app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+			require.NoError(t, err)
+			if app.Operation != nil {
+				assert.Nil(t, app.Operation.Sync.AutoRollbackFrom)
+			}
+			if app.Spec.SyncPolicy.Automated != nil {
+				assert.True(t, app.Spec.SyncPolicy.IsAutomatedSyncEnabled())
+			}
+		})
+	}
+}
+
+func TestSetRevisionHistoryHealth(t *testing.T) {
+	app := newFakeDegradingApp()
+	setRevisionHistoryHealth(app, health.HealthStatusProgressing)
+	assert.Empty(t, app.Status.History[2].HealthStatus)
+	setRevisionHistoryHealth(app, health.HealthStatusDegraded)
+	assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+	assert.Equal(t, health.HealthStatusHealthy, app.Status.History[1].HealthStatus)
+
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	setRevisionHistoryHealth(app, health.HealthStatusHealthy)
+	assert.Equal(t, health.HealthStatusDegraded, app.Status.History[2].HealthStatus)
+
+	app.Operation = nil
+	setRevisionHistoryHealth(app, health.HealthStatusHealthy)
+	assert.Equal(t, health.HealthStatusHealthy, app.Status.History[2].HealthStatus)
+	setRevisionHistoryHealth(app, health.HealthStatusDegraded)
+	assert.Equal(t, health.HealthStatusHealthy, app.Status.History[2].HealthStatus, "a revision observed healthy must stay healthy")
+}
+
 // TestAutoSyncParameterOverrides verifies we auto-sync if revision is same but parameter overrides are different
 func TestAutoSyncParameterOverrides(t *testing.T) {
 	t.Run("Single source", func(t *testing.T) {
@@ -2546,15 +2680,279 @@ func TestProcessRequestedAppOperation_Successful(t *testing.T) {
 	assert.Equal(t, CompareWithLatestForceResolve, level)
 }
 
+func TestProcessRequestedAppOperation_WaveApproved(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:       *app.Operation.DeepCopy(),
+		Phase:           synccommon.OperationWaitingForApproval,
+		StartedAt:       metav1.Now(),
+		PendingApproval: &v1alpha1.SyncWaveApproval{Phase: synccommon.SyncPhaseSync, Wave: 1},
+	}
+	// the wave was approved after the operation started waiting for approval
+	app.Operation.Sync.Approvals = []v1alpha1.SyncWaveApproval{{Phase: synccommon.SyncPhaseSync, Wave: 1, ApprovedBy: "admin"}}
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	pendingApproval, found, _ := unstructured.NestedFieldNoCopy(receivedPatch, "status", "operationState", "pendingApproval")
+	assert.True(t, found)
+	assert.Nil(t, pendingApproval)
+	approvals, _, _ := unstructured.NestedSlice(receivedPatch, "status", "operationState", "operation", "sync", "approvals")
+	require.Len(t, approvals, 1)
+	assert.Equal(t, "admin", approvals[0].(map[string]any)["approvedBy"])
+}
+
+func TestProcessRequestedAppOperation_WaitingForDependencies(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "db"}, {Name: "cache"}}
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	app.Status.OperationState = nil
+	db := newFakeApp()
+	db.Name = "db"
+	db.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	db.Status.Health.Status = health.HealthStatusProgressing
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, db, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "waiting for dependencies to be synced and healthy: fake-argocd-ns/db (Synced, Progressing), fake-argocd-ns/cache (not found)", message)
+	_, found, _ := unstructured.NestedMap(receivedPatch, "status", "operationState", "syncResult")
+	assert.False(t, found)
+}
+
+func TestProcessRequestedAppOperation_WaitingForSyncSlot(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+		configMapData: map[string]string{
+			"application.sync.clusterConcurrencyLimit": "1",
+		},
+	}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			receivedPatch = map[string]any{}
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+	// another app is syncing to the same cluster
+	acquired, _ := ctrl.syncSlots.acquire("https://localhost:6443", "argocd/other-app", 1, false)
+	require.True(t, acquired)
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Waiting for sync slot: 1 of 1 sync operations to cluster https://localhost:6443 are running, 0 operations ahead in queue", message)
+	running, waiting := ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 1, waiting)
+
+	_, waitingKeys := ctrl.syncSlots.release("argocd/other-app")
+	assert.Equal(t, []string{ctrl.toAppKey(app.QualifiedName())}, waitingKeys)
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: *app.Operation.DeepCopy(),
+		Phase:     synccommon.OperationRunning,
+		Message:   message,
+		StartedAt: metav1.Now(),
+	}
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	// the slot is freed once the operation completed
+	running, waiting = ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 0, running)
+	assert.Equal(t, 0, waiting)
+}
+
+// waitingForApprovalStateManager stops the syncs at a sync wave waiting for approval
+type waitingForApprovalStateManager struct {
+	AppStateManager
+	syncs int
+}
+
+func (m *waitingForApprovalStateManager) SyncAppState(_ *v1alpha1.Application, _ *v1alpha1.AppProject, state *v1alpha1.OperationState) {
+	m.syncs++
+	state.Phase = synccommon.OperationWaitingForApproval
+	state.Message = "waiting for approval of wave 1 of phase Sync"
+	state.PendingApproval = &v1alpha1.SyncWaveApproval{Phase: synccommon.SyncPhaseSync, Wave: 1}
+	state.SyncResult = &v1alpha1.SyncOperationResult{}
+}
+
+func TestProcessRequestedAppOperation_WaitingForApprovalReleasesSyncSlot(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		configMapData: map[string]string{
+			"application.sync.clusterConcurrencyLimit": "1",
+		},
+	}, nil)
+	stateManager := &waitingForApprovalStateManager{AppStateManager: ctrl.appStateManager}
+	ctrl.appStateManager = stateManager
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			receivedPatch = map[string]any{}
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationWaitingForApproval), phase)
+	assert.Equal(t, 1, stateManager.syncs)
+	// the slot is freed while the operation waits for approval
+	running, waiting := ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 0, running)
+	assert.Equal(t, 0, waiting)
+
+	// another app is syncing to the same cluster
+	acquired, _ := ctrl.syncSlots.acquire("https://localhost:6443", "argocd/other-app", 1, false)
+	require.True(t, acquired)
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:       *app.Operation.DeepCopy(),
+		Phase:           synccommon.OperationWaitingForApproval,
+		StartedAt:       metav1.Now(),
+		SyncResult:      &v1alpha1.SyncOperationResult{},
+		PendingApproval: &v1alpha1.SyncWaveApproval{Phase: synccommon.SyncPhaseSync, Wave: 1},
+	}
+
+	ctrl.processRequestedAppOperation(app)
+
+	// the operation keeps waiting for approval without waiting for a slot
+	assert.Equal(t, 2, stateManager.syncs)
+	running, waiting = ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 0, waiting)
+
+	app.Operation.Sync.Approvals = []v1alpha1.SyncWaveApproval{{Phase: synccommon.SyncPhaseSync, Wave: 1, ApprovedBy: "admin"}}
+
+	ctrl.processRequestedAppOperation(app)
+
+	// once approved, the operation waits for a slot again
+	assert.Equal(t, 2, stateManager.syncs)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "Waiting for sync slot: 1 of 1 sync operations to cluster https://localhost:6443 are running, 0 operations ahead in queue", message)
+	running, waiting = ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 1, waiting)
+}
+
+func TestReconcileSyncSlots(t *testing.T) {
+	syncingApp := newFakeApp()
+	syncingApp.Name = "syncing-app"
+	syncingApp.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	waitingApp := newFakeApp()
+	waitingApp.Name = "waiting-app"
+	waitingApp.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	// the operation of the app was removed before it completed
+	clearedApp := newFakeApp()
+	clearedApp.Name = "cleared-app"
+	clearedApp.Operation = nil
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{syncingApp, waitingApp, clearedApp, &defaultProj}}, nil)
+
+	for _, appKey := range []string{"fake-argocd-ns/syncing-app", "fake-argocd-ns/cleared-app", "fake-argocd-ns/deleted-app", "fake-argocd-ns/waiting-app"} {
+		ctrl.syncSlots.acquire("https://localhost:6443", appKey, 3, false)
+	}
+	running, waiting := ctrl.syncSlots.count("https://localhost:6443")
+	require.Equal(t, 3, running)
+	require.Equal(t, 1, waiting)
+
+	ctrl.reconcileSyncSlots()
+
+	assert.Equal(t, []string{"fake-argocd-ns/syncing-app", "fake-argocd-ns/waiting-app"}, ctrl.syncSlots.keys())
+	running, waiting = ctrl.syncSlots.count("https://localhost:6443")
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 1, waiting)
+	// the operations waiting for the freed slots are resumed
+	assert.Equal(t, 1, ctrl.appOperationQueue.Len())
+}
+
+func TestRefreshAppConditions_DependencyCycle(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "db"}}
+	db := newFakeApp()
+	db.Name = "db"
+	db.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: app.Name}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, db, &defaultProj}}, nil)
+
+	_, hasErrors := ctrl.refreshAppConditions(app)
+	assert.True(t, hasErrors)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+	assert.Equal(t, "dependency cycle detected: fake-argocd-ns/my-app -> fake-argocd-ns/db -> fake-argocd-ns/my-app", app.Status.Conditions[0].Message)
+}
+
 func TestProcessRequestedAppOperation_SyncTimeout(t *testing.T) {
 	testCases := []struct {
-		name            string
-		startedSince    time.Duration
-		syncTimeout     time.Duration
-		retryAttempt    int
-		currentPhase    synccommon.OperationPhase
-		expectedPhase   synccommon.OperationPhase
-		expectedMessage string
+		name               string
+		startedSince       time.Duration
+		syncTimeout        time.Duration
+		appSyncTimeout     string
+		projectSyncTimeout string
+		retryAttempt       int
+		currentPhase       synccommon.OperationPhase
+		expectedPhase      synccommon.OperationPhase
+		expectedMessage    string
 	}{{
 		name:            "Continue when running operation has not exceeded timeout",
 		syncTimeout:     time.Minute,
@@ -2568,7 +2966,7 @@ func TestProcessRequestedAppOperation_SyncTimeout(t *testing.T) {
 		startedSince:    2 * time.Minute,
 		currentPhase:    synccommon.OperationTerminating,
 		expectedPhase:   synccommon.OperationFailed,
-		expectedMessage: "Operation terminated",
+		expectedMessage: "Operation terminated, triggered by controller sync timeout",
 	}, {
 		name:            "Terminate when running operation exceeded timeout",
 		syncTimeout:     time.Minute,
@@ -2584,6 +2982,31 @@ func TestProcessRequestedAppOperation_SyncTimeout(t *testing.T) {
 		retryAttempt:    1,
 		expectedPhase:   synccommon.OperationFailed,
 		expectedMessage: "Operation terminated, triggered by controller sync timeout (retried 1 times).",
+	}, {
+		name:               "Terminate when running operation exceeded project timeout",
+		syncTimeout:        time.Hour,
+		projectSyncTimeout: "1m",
+		startedSince:       2 * time.Minute,
+		currentPhase:       synccommon.OperationRunning,
+		expectedPhase:      synccommon.OperationFailed,
+		expectedMessage:    "Operation terminated, triggered by project sync timeout",
+	}, {
+		name:               "Terminate when running operation exceeded application timeout",
+		projectSyncTimeout: "1h",
+		appSyncTimeout:     "60",
+		startedSince:       2 * time.Minute,
+		currentPhase:       synccommon.OperationRunning,
+		expectedPhase:      synccommon.OperationFailed,
+		expectedMessage:    "Operation terminated, triggered by application sync timeout",
+	}, {
+		name:               "Continue when running operation has not exceeded application timeout",
+		syncTimeout:        time.Minute,
+		projectSyncTimeout: "1m",
+		appSyncTimeout:     "1h",
+		startedSince:       2 * time.Minute,
+		currentPhase:       synccommon.OperationRunning,
+		expectedPhase:      synccommon.OperationSucceeded,
+		expectedMessage:    "successfully synced (no more tasks)",
 	}}
 	for i := range testCases {
 		tc := testCases[i]
@@ -2595,8 +3018,13 @@ func TestProcessRequestedAppOperation_SyncTimeout(t *testing.T) {
 					Revision: "HEAD",
 				},
 			}
+			if tc.appSyncTimeout != "" {
+				app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Timeout: tc.appSyncTimeout}
+			}
+			proj := defaultProj.DeepCopy()
+			proj.Spec.SyncTimeout = tc.projectSyncTimeout
 			ctrl := newFakeController(t.Context(), &fakeData{
-				apps: []runtime.Object{app, &defaultProj},
+				apps: []runtime.Object{app, proj},
 				manifestResponses: []*apiclient.ManifestResponse{{
 					Manifests: []string{},
 				}},
@@ -75,6 +75,23 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheSnapshotDir is the env variable that holds the directory in which the cluster cache snapshots are persisted.
+	// Snapshots are disabled if it is empty.
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheSnapshotInterval is the env variable that holds the interval between two cluster cache snapshots
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
+	// EnvClusterCacheSnapshotMaxAge is the env variable that holds the maximum age of a snapshot used to warm up a cluster cache
+	EnvClusterCacheSnapshotMaxAge = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_MAX_AGE"
+
+	// EnvClusterCacheMetadataOnlyResources is the env variable that holds the comma separated list of kinds, in the
+	// Kind.group format, whose resources are watched as metadata only unless they are managed by an application
+	EnvClusterCacheMetadataOnlyResources = "ARGOCD_CLUSTER_CACHE_METADATA_ONLY_RESOURCES"
+
+	// EnvClusterCacheWatchList is the env variable to control whether to load the resources using watch list requests
+	EnvClusterCacheWatchList = "ARGOCD_CLUSTER_CACHE_WATCH_LIST"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -115,6 +132,21 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheSnapshotDir is the directory in which the cluster cache snapshots are persisted. Snapshots are disabled if empty.
+	clusterCacheSnapshotDir = ""
+
+	// clusterCacheSnapshotInterval is the interval between two cluster cache snapshots
+	clusterCacheSnapshotInterval = 5 * time.Minute
+
+	// clusterCacheSnapshotMaxAge is the maximum age of a snapshot used to warm up a cluster cache
+	clusterCacheSnapshotMaxAge = 1 * time.Hour
+
+	// clusterCacheMetadataOnlyResources holds the kinds whose resources are watched as metadata only
+	clusterCacheMetadataOnlyResources map[schema.GroupKind]bool
+
+	// clusterCacheWatchList specifies whether to load the resources using watch list requests when supported by the server
+	clusterCacheWatchList = false
 )
 
 func init() {
@@ -128,6 +160,25 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotDir = env.StringFromEnv(EnvClusterCacheSnapshotDir, clusterCacheSnapshotDir)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, time.Second, math.MaxInt64)
+	clusterCacheSnapshotMaxAge = env.ParseDurationFromEnv(EnvClusterCacheSnapshotMaxAge, clusterCacheSnapshotMaxAge, 0, math.MaxInt64)
+	clusterCacheWatchList = env.ParseBoolFromEnv(EnvClusterCacheWatchList, clusterCacheWatchList)
+	clusterCacheMetadataOnlyResources = parseMetadataOnlyResources(env.StringsFromEnv(EnvClusterCacheMetadataOnlyResources, nil, ","))
+}
+
+// parseMetadataOnlyResources parses the given kinds, in the Kind.group format
+func parseMetadataOnlyResources(kinds []string) map[schema.GroupKind]bool {
+	if len(kinds) == 0 {
+		return nil
+	}
+	res := make(map[schema.GroupKind]bool)
+	for _, kind := range kinds {
+		if kind != "" {
+			res[schema.ParseGroupKind(kind)] = true
+		}
+	}
+	return res
 }
 
 type LiveStateCache interface {
@@ -426,6 +477,24 @@ func shouldHashManifest(appName string, gvk schema.GroupVersionKind, un *unstruc
 	return isTrackedResource
 }
 
+// isMetadataOnlyResource returns true if the resources of the given kind can be watched as metadata only: the kind must
+// be configured as such, and neither its health nor its resource info may depend on the content of the resources.
+func (c *liveStateCache) isMetadataOnlyResource(gk schema.GroupKind) bool {
+	if !clusterCacheMetadataOnlyResources[gk] || requiresFullObjectForInfo(gk) {
+		return false
+	}
+	if health.GetHealthCheckFunc(gk.WithVersion("")) != nil {
+		return false
+	}
+	c.lock.RLock()
+	resourceOverrides := c.cacheSettings.resourceOverrides
+	c.lock.RUnlock()
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gk.WithVersion(""))
+	script, _, err := lua.VM{ResourceOverrides: resourceOverrides}.GetHealthScript(obj)
+	return err == nil && script == ""
+}
+
 // isRetryableError is a helper method to see whether an error
 // returned from the dynamic client is potentially retryable.
 func isRetryableError(err error) bool {
@@ -587,6 +656,22 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetRespectRBAC(respectRBAC),
 		clustercache.SetBatchEventsProcessing(clusterCacheBatchEventsProcessing),
 		clustercache.SetEventProcessingInterval(clusterCacheEventsProcessingInterval),
+		clustercache.SetWatchList(clusterCacheWatchList),
+	}
+	if len(clusterCacheMetadataOnlyResources) > 0 {
+		clusterCacheOpts = append(clusterCacheOpts, clustercache.SetMetadataOnlyResources(c.isMetadataOnlyResource, func(un *unstructured.Unstructured) bool {
+			c.lock.RLock()
+			cacheSettings := c.cacheSettings
+			c.lock.RUnlock()
+			// managed resources are diffed against their desired state
+			return c.resourceTracking.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod, cacheSettings.installationID) != ""
+		}))
+	}
+	if clusterCacheSnapshotDir != "" {
+		store := newDiskSnapshotStore(clusterCacheSnapshotDir, cluster.Server, func() string {
+			return c.snapshotSettingsHash(resourceCustomLabels)
+		})
+		clusterCacheOpts = append(clusterCacheOpts, clustercache.SetSnapshotStore(store, clusterCacheSnapshotInterval, clusterCacheSnapshotMaxAge))
 	}
 
 	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
@@ -819,7 +904,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 }
 
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	return c.clusterSharding.IsCachedCluster(cluster)
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -903,6 +988,11 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		delete(c.clusters, clusterServer)
 		c.lock.Unlock()
 	}
+	if clusterCacheSnapshotDir != "" {
+		if err := newDiskSnapshotStore(clusterCacheSnapshotDir, clusterServer, nil).Delete(); err != nil {
+			log.Warnf("Failed to delete cluster cache snapshot of %s: %v", clusterServer, err)
+		}
+	}
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -952,3 +952,29 @@ func Test_asResourceNode_same_namespace_parent(t *testing.T) {
 	assert.Equal(t, "my-deployment", resNode.ParentRefs[0].Name)
 	assert.Equal(t, "my-namespace", resNode.ParentRefs[0].Namespace, "Deployment parent should have same namespace")
 }
+
+func TestParseMetadataOnlyResources(t *testing.T) {
+	assert.Nil(t, parseMetadataOnlyResources(nil))
+	assert.Equal(t, map[schema.GroupKind]bool{
+		{Kind: "Secret"}: true,
+		{Group: "coordination.k8s.io", Kind: "Lease"}: true,
+	}, parseMetadataOnlyResources([]string{"Secret", "Lease.coordination.k8s.io", ""}))
+}
+
+func TestIsMetadataOnlyResource(t *testing.T) {
+	previous := clusterCacheMetadataOnlyResources
+	t.Cleanup(func() {
+		clusterCacheMetadataOnlyResources = previous
+	})
+	clusterCacheMetadataOnlyResources = parseMetadataOnlyResources([]string{"Secret", "ConfigMap", "Pod", "Deployment.apps", "Certificate.cert-manager.io"})
+
+	c := &liveStateCache{cacheSettings: cacheSettings{resourceOverrides: map[string]appv1.ResourceOverride{
+		"ConfigMap": {HealthLua: "return {}"},
+	}}}
+	assert.True(t, c.isMetadataOnlyResource(schema.GroupKind{Kind: "Secret"}))
+	assert.False(t, c.isMetadataOnlyResource(schema.GroupKind{Kind: "ServiceAccount"}), "kind is not configured")
+	assert.False(t, c.isMetadataOnlyResource(schema.GroupKind{Kind: "Pod"}), "resource info requires the spec")
+	assert.False(t, c.isMetadataOnlyResource(schema.GroupKind{Group: "apps", Kind: "Deployment"}), "built-in health check")
+	assert.False(t, c.isMetadataOnlyResource(schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}), "built-in health script")
+	assert.False(t, c.isMetadataOnlyResource(schema.GroupKind{Kind: "ConfigMap"}), "health check override")
+}
@@ -22,6 +22,22 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/resource"
 )
 
+// requiresFullObjectForInfo returns true if the resource info of the given kind is populated from the spec or the status
+// of the resources, which are not available when only their metadata is retrieved.
+func requiresFullObjectForInfo(gk schema.GroupKind) bool {
+	switch gk.Group {
+	case "":
+		return gk.Kind == kube.PodKind || gk.Kind == kube.ServiceKind || gk.Kind == "Node"
+	case "extensions", "networking.k8s.io":
+		return gk.Kind == kube.IngressKind
+	case "networking.istio.io":
+		return gk.Kind == "VirtualService" || gk.Kind == "ServiceEntry"
+	case "argoproj.io":
+		return gk.Kind == "Application"
+	}
+	return false
+}
+
 func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLabels []string) {
 	gvk := un.GroupVersionKind()
 	revision := resource.GetRevision(un)
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// snapshotFormatVersion is incremented whenever the format of the persisted snapshots changes
+const snapshotFormatVersion = 2
+
+// snapshotExcludedResources holds the kinds which are never persisted in the snapshots since their manifests hold
+// credentials. Their APIs are missing from the persisted snapshots, so they are listed again when the cache is warmed up.
+var snapshotExcludedResources = map[schema.GroupKind]bool{
+	{Group: "", Kind: kube.SecretKind}: true,
+}
+
+// clusterSnapshot is the persisted form of a cluster cache snapshot
+type clusterSnapshot struct {
+	Version int
+	// SettingsHash holds the hash of the settings used to populate the resource info of the snapshot
+	SettingsHash string
+	Time         time.Time
+	APIs         []clusterSnapshotAPI
+}
+
+type clusterSnapshotAPI struct {
+	clustercache.SnapshotAPI
+	Resources []clusterSnapshotResource
+}
+
+type clusterSnapshotResource struct {
+	clustercache.SnapshotResource
+	Info *clusterSnapshotResourceInfo `json:",omitempty"`
+}
+
+type clusterSnapshotResourceInfo struct {
+	*ResourceInfo
+	ManifestHash string `json:",omitempty"`
+}
+
+// diskSnapshotStore persists the snapshots of the cache of a cluster in a gzipped JSON file
+type diskSnapshotStore struct {
+	path string
+	// settingsHash returns the hash of the current cache settings. Snapshots taken with other settings are ignored
+	// since the resource info they hold might be outdated.
+	settingsHash func() string
+}
+
+func newDiskSnapshotStore(dir string, server string, settingsHash func() string) *diskSnapshotStore {
+	sum := sha256.Sum256([]byte(server))
+	return &diskSnapshotStore{path: filepath.Join(dir, hex.EncodeToString(sum[:])+".json.gz"), settingsHash: settingsHash}
+}
+
+func (s *diskSnapshotStore) Load() (*clustercache.Snapshot, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error opening snapshot file: %w", err)
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot file: %w", err)
+	}
+	defer r.Close()
+	var snapshot clusterSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot file: %w", err)
+	}
+	if snapshot.Version != snapshotFormatVersion {
+		log.Infof("Ignoring cluster cache snapshot %s with version %d", s.path, snapshot.Version)
+		return nil, nil
+	}
+	if snapshot.SettingsHash != s.settingsHash() {
+		log.Infof("Ignoring cluster cache snapshot %s taken with different settings", s.path)
+		return nil, nil
+	}
+
+	res := &clustercache.Snapshot{Time: snapshot.Time, APIs: make([]clustercache.SnapshotAPI, len(snapshot.APIs))}
+	for i, api := range snapshot.APIs {
+		res.APIs[i] = api.SnapshotAPI
+		res.APIs[i].Resources = make([]clustercache.SnapshotResource, len(api.Resources))
+		for j, resource := range api.Resources {
+			res.APIs[i].Resources[j] = resource.SnapshotResource
+			if resource.Info != nil && resource.Info.ResourceInfo != nil {
+				info := resource.Info.ResourceInfo
+				info.manifestHash = resource.Info.ManifestHash
+				res.APIs[i].Resources[j].Info = info
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *diskSnapshotStore) Save(snapshot *clustercache.Snapshot) error {
+	persisted := clusterSnapshot{
+		Version:      snapshotFormatVersion,
+		SettingsHash: s.settingsHash(),
+		Time:         snapshot.Time,
+		APIs:         make([]clusterSnapshotAPI, 0, len(snapshot.APIs)),
+	}
+	for _, api := range snapshot.APIs {
+		if snapshotExcludedResources[api.GroupKind] {
+			continue
+		}
+		persistedAPI := clusterSnapshotAPI{SnapshotAPI: api, Resources: make([]clusterSnapshotResource, len(api.Resources))}
+		persistedAPI.SnapshotAPI.Resources = nil
+		for j, resource := range api.Resources {
+			persistedAPI.Resources[j] = clusterSnapshotResource{SnapshotResource: resource}
+			persistedAPI.Resources[j].SnapshotResource.Info = nil
+			if info, ok := resource.Info.(*ResourceInfo); ok && info != nil {
+				persistedAPI.Resources[j].Info = &clusterSnapshotResourceInfo{ResourceInfo: info, ManifestHash: info.manifestHash}
+			}
+		}
+		persisted.APIs = append(persisted.APIs, persistedAPI)
+	}
+
+	// the snapshot is written to a temporary file first so that an interrupted write never corrupts the previous one
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("error creating snapshot directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, ".snapshot-*")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	// the snapshot holds the manifests of the managed resources, so it must only be readable by the controller
+	if err := f.Chmod(0o600); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error setting snapshot file permissions: %w", err)
+	}
+	w := gzip.NewWriter(f)
+	if err := json.NewEncoder(w).Encode(persisted); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error encoding snapshot: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("error renaming snapshot file: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the persisted snapshot, if any
+func (s *diskSnapshotStore) Delete() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error deleting snapshot file: %w", err)
+	}
+	return nil
+}
+
+// snapshotSettingsHash returns the hash of the settings used to populate the resource info of the cluster caches
+func (c *liveStateCache) snapshotSettingsHash(resourceCustomLabels []string) string {
+	c.lock.RLock()
+	cacheSettings := c.cacheSettings
+	c.lock.RUnlock()
+	metadataOnlyResources := make([]string, 0, len(clusterCacheMetadataOnlyResources))
+	for gk := range clusterCacheMetadataOnlyResources {
+		metadataOnlyResources = append(metadataOnlyResources, gk.String())
+	}
+	sort.Strings(metadataOnlyResources)
+	data, err := json.Marshal(struct {
+		ResourceHealthOverride       any
+		ResourcesFilter              any
+		AppInstanceLabelKey          string
+		TrackingMethod               string
+		InstallationID               string
+		ResourceOverrides            any
+		IgnoreResourceUpdatesEnabled bool
+		ResourceCustomLabels         []string
+		MetadataOnlyResources        []string
+	}{
+		ResourceHealthOverride:       cacheSettings.clusterSettings.ResourceHealthOverride,
+		ResourcesFilter:              cacheSettings.clusterSettings.ResourcesFilter,
+		AppInstanceLabelKey:          cacheSettings.appInstanceLabelKey,
+		TrackingMethod:               string(cacheSettings.trackingMethod),
+		InstallationID:               cacheSettings.installationID,
+		ResourceOverrides:            cacheSettings.resourceOverrides,
+		IgnoreResourceUpdatesEnabled: cacheSettings.ignoreResourceUpdatesEnabled,
+		ResourceCustomLabels:         resourceCustomLabels,
+		MetadataOnlyResources:        metadataOnlyResources,
+	})
+	if err != nil {
+		log.Warnf("Failed to hash cluster cache settings: %v", err)
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestSnapshot() *clustercache.Snapshot {
+	return &clustercache.Snapshot{
+		Time: time.Now().UTC().Truncate(time.Second),
+		APIs: []clustercache.SnapshotAPI{{
+			GroupKind:            schema.GroupKind{Kind: "Pod"},
+			GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			Namespace:            "default",
+			ResourceVersion:      "123",
+			Resources: []clustercache.SnapshotResource{{
+				ResourceVersion: "100",
+				Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod", UID: "uid-pod"},
+				OwnerRefs:       []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", UID: "uid-rs"}},
+				Info: &ResourceInfo{
+					AppName: "guestbook",
+					Images:  []string{"nginx:1.27"},
+					Health:  &health.HealthStatus{Status: health.HealthStatusHealthy},
+					PodInfo: &PodInfo{
+						NodeName:         "node",
+						ResourceRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+						Phase:            corev1.PodRunning,
+					},
+					manifestHash: "hash",
+				},
+				Resource: &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "Pod",
+					"metadata":   map[string]any{"name": "pod", "namespace": "default"},
+				}},
+			}, {
+				ResourceVersion: "101",
+				Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "other", UID: "uid-other"},
+			}},
+		}},
+	}
+}
+
+func TestDiskSnapshotStore_SaveLoad(t *testing.T) {
+	store := newDiskSnapshotStore(t.TempDir(), "https://kubernetes.default.svc", func() string { return "settings" })
+	snapshot := newTestSnapshot()
+	require.NoError(t, store.Save(snapshot))
+
+	loaded, err := store.Load()
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.True(t, snapshot.Time.Equal(loaded.Time))
+	require.Len(t, loaded.APIs, 1)
+	api := loaded.APIs[0]
+	assert.Equal(t, snapshot.APIs[0].GroupKind, api.GroupKind)
+	assert.Equal(t, snapshot.APIs[0].GroupVersionResource, api.GroupVersionResource)
+	assert.Equal(t, "default", api.Namespace)
+	assert.Equal(t, "123", api.ResourceVersion)
+	require.Len(t, api.Resources, 2)
+
+	res := api.Resources[0]
+	assert.Equal(t, "100", res.ResourceVersion)
+	assert.Equal(t, snapshot.APIs[0].Resources[0].Ref, res.Ref)
+	assert.Equal(t, snapshot.APIs[0].Resources[0].OwnerRefs, res.OwnerRefs)
+	assert.Equal(t, "pod", res.Resource.GetName())
+	info, ok := res.Info.(*ResourceInfo)
+	require.True(t, ok)
+	assert.Equal(t, "guestbook", info.AppName)
+	assert.Equal(t, []string{"nginx:1.27"}, info.Images)
+	assert.Equal(t, health.HealthStatusHealthy, info.Health.Status)
+	assert.Equal(t, "hash", info.manifestHash)
+	cpu := info.PodInfo.ResourceRequests[corev1.ResourceCPU]
+	assert.Equal(t, int64(250), cpu.MilliValue())
+
+	assert.Nil(t, api.Resources[1].Info)
+	assert.Nil(t, api.Resources[1].Resource)
+}
+
+func TestDiskSnapshotStore_SaveExcludesSecrets(t *testing.T) {
+	store := newDiskSnapshotStore(t.TempDir(), "https://kubernetes.default.svc", func() string { return "settings" })
+	snapshot := newTestSnapshot()
+	snapshot.APIs = append(snapshot.APIs, clustercache.SnapshotAPI{
+		GroupKind:            schema.GroupKind{Kind: "Secret"},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		Namespace:            "default",
+		ResourceVersion:      "124",
+		Resources: []clustercache.SnapshotResource{{
+			ResourceVersion: "102",
+			Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "credentials", UID: "uid-secret"},
+			Info:            &ResourceInfo{AppName: "guestbook"},
+			Resource: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "credentials", "namespace": "default"},
+				"data":       map[string]any{"password": "c3VwZXJzZWNyZXQ="},
+			}},
+		}},
+	})
+	require.NoError(t, store.Save(snapshot))
+
+	stat, err := os.Stat(store.path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), stat.Mode().Perm())
+
+	f, err := os.Open(store.path)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "c3VwZXJzZWNyZXQ=")
+	assert.NotContains(t, string(data), "credentials")
+
+	// the secrets API is missing from the snapshot so that it is listed again when the cache is warmed up
+	loaded, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, loaded.APIs, 1)
+	assert.Equal(t, "Pod", loaded.APIs[0].GroupKind.Kind)
+}
+
+func TestDiskSnapshotStore_LoadMissing(t *testing.T) {
+	store := newDiskSnapshotStore(t.TempDir(), "https://kubernetes.default.svc", func() string { return "settings" })
+	snapshot, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestDiskSnapshotStore_SettingsChanged(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, newDiskSnapshotStore(dir, "https://kubernetes.default.svc", func() string { return "settings" }).Save(newTestSnapshot()))
+
+	snapshot, err := newDiskSnapshotStore(dir, "https://kubernetes.default.svc", func() string { return "other-settings" }).Load()
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestDiskSnapshotStore_Delete(t *testing.T) {
+	dir := t.TempDir()
+	store := newDiskSnapshotStore(dir, "https://kubernetes.default.svc", func() string { return "settings" })
+	other := newDiskSnapshotStore(dir, "https://other", func() string { return "settings" })
+	require.NoError(t, store.Save(newTestSnapshot()))
+	require.NoError(t, other.Save(newTestSnapshot()))
+
+	require.NoError(t, store.Delete())
+	_, err := os.Stat(store.path)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(other.path)
+	require.NoError(t, err)
+
+	// deleting a missing snapshot is not an error
+	require.NoError(t, store.Delete())
+}
+
+func TestSnapshotSettingsHash(t *testing.T) {
+	c := &liveStateCache{cacheSettings: cacheSettings{appInstanceLabelKey: "app", trackingMethod: appv1.TrackingMethodLabel}}
+	hash := c.snapshotSettingsHash([]string{"team"})
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, hash, c.snapshotSettingsHash([]string{"team"}))
+	assert.NotEqual(t, hash, c.snapshotSettingsHash(nil))
+
+	c.cacheSettings.resourceOverrides = map[string]appv1.ResourceOverride{"apps/Deployment": {HealthLua: "return {}"}}
+	assert.NotEqual(t, hash, c.snapshotSettingsHash([]string{"team"}))
+}
@@ -40,6 +40,8 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+	// weightsUpdater updates the weights of the clusters used for sharding from the info cached by all the shards
+	weightsUpdater func(getClusterInfo func(server string) (*appv1.ClusterInfo, error))
 }
 
 func NewClusterInfoUpdater(
@@ -50,8 +52,9 @@ func NewClusterInfoUpdater(
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
+	weightsUpdater func(getClusterInfo func(server string) (*appv1.ClusterInfo, error)),
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}, weightsUpdater}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -111,6 +114,16 @@ func (c *clusterInfoUpdater) updateClusters() {
 		return nil
 	})
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
+
+	if c.weightsUpdater != nil {
+		c.weightsUpdater(func(server string) (*appv1.ClusterInfo, error) {
+			var info appv1.ClusterInfo
+			if err := c.cache.GetClusterInfo(server, &info); err != nil {
+				return nil, err
+			}
+			return &info, nil
+		})
+	}
 }
 
 func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo) error {
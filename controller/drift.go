@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/scheme"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo/managedfields"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+// updateDriftHistory records the drifts of the managed resources of the application in its drift history. A resource
+// has drifted when it is OutOfSync although the application was successfully synced to its current desired state. The
+// drift history is only loaded from the cache if a resource has drifted or was OutOfSync during the previous
+// reconciliation, so that reconciling synced applications does not cost any cache request.
+func (ctrl *ApplicationController) updateDriftHistory(app *appv1.Application, compareResult *comparisonResult) error {
+	detectable := isSyncedToDesiredState(app, compareResult.syncStatus)
+	managed := map[kube.ResourceKey]bool{}
+	drifted := map[kube.ResourceKey]managedResource{}
+	synced := map[kube.ResourceKey]bool{}
+	for i, res := range compareResult.resources {
+		if res.Hook || i >= len(compareResult.managedResources) {
+			continue
+		}
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		managed[key] = true
+		switch res.Status {
+		case appv1.SyncStatusCodeOutOfSync:
+			if mr := compareResult.managedResources[i]; detectable && mr.Live != nil && mr.Target != nil {
+				drifted[key] = mr
+			}
+		case appv1.SyncStatusCodeSynced:
+			synced[key] = true
+		}
+	}
+	if len(drifted) == 0 && !hasOutOfSyncResources(app.Status.Resources) {
+		return nil
+	}
+
+	appName := app.InstanceName(ctrl.namespace)
+	var history []*appv1.ResourceDriftHistory
+	if err := ctrl.cache.GetAppDriftHistory(appName, &history); err != nil && !stderrors.Is(err, appstatecache.ErrCacheMiss) {
+		return fmt.Errorf("error getting drift history: %w", err)
+	}
+	changed := false
+	histories := map[kube.ResourceKey]*appv1.ResourceDriftHistory{}
+	for _, h := range history {
+		key := kube.NewResourceKey(h.Group, h.Kind, h.Namespace, h.Name)
+		if !managed[key] {
+			// the resource is no longer part of the application
+			changed = true
+			continue
+		}
+		histories[key] = h
+	}
+
+	now := metav1.Now()
+	for key, res := range drifted {
+		h, ok := histories[key]
+		if !ok {
+			h = &appv1.ResourceDriftHistory{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name}
+			histories[key] = h
+		}
+		if h.OngoingDrift() != nil {
+			continue
+		}
+		event := appv1.ResourceDriftEvent{DetectedAt: now}
+		if drift, err := computeResourceDrift(res, compareResult); err != nil {
+			log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warnf("Failed to compute drifted fields of %s", key.String())
+		} else {
+			event.FieldPaths = drift.FieldPaths
+			event.Managers = drift.Managers
+		}
+		h.AddDrift(event, app.Spec.GetRevisionHistoryLimit())
+		changed = true
+	}
+	for key := range synced {
+		if h, ok := histories[key]; ok {
+			if event := h.OngoingDrift(); event != nil {
+				event.ResolvedAt = &now
+				event.SelfHealed = isSelfHealedDrift(app, event)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	history = nil
+	for _, h := range histories {
+		history = append(history, h)
+	}
+	return ctrl.cache.SetAppDriftHistory(appName, history)
+}
+
+// isSyncedToDesiredState returns true if the last operation of the application successfully synced it to the desired
+// state of the given sync status
+func isSyncedToDesiredState(app *appv1.Application, syncStatus *appv1.SyncStatus) bool {
+	op := app.Status.OperationState
+	if op == nil || op.Phase != synccommon.OperationSucceeded || op.SyncResult == nil || syncStatus == nil {
+		return false
+	}
+	if app.Spec.HasMultipleSources() {
+		return reflect.DeepEqual(op.SyncResult.Revisions, syncStatus.Revisions) && reflect.DeepEqual(app.Spec.Sources, op.SyncResult.Sources)
+	}
+	return op.SyncResult.Revision == syncStatus.Revision && reflect.DeepEqual(app.Spec.GetSource(), op.SyncResult.Source)
+}
+
+// isSelfHealedDrift returns true if the given drift was resolved by an automated self-heal sync
+func isSelfHealedDrift(app *appv1.Application, event *appv1.ResourceDriftEvent) bool {
+	op := app.Status.OperationState
+	return op != nil && op.Operation.InitiatedBy.Automated && op.Operation.Sync != nil &&
+		op.Operation.Sync.SelfHealAttemptsCount > 0 && !op.StartedAt.Before(&event.DetectedAt)
+}
+
+func hasOutOfSyncResources(resources []appv1.ResourceStatus) bool {
+	for _, res := range resources {
+		if !res.Hook && res.Status == appv1.SyncStatusCodeOutOfSync {
+			return true
+		}
+	}
+	return false
+}
+
+// computeResourceDrift returns the fields of the normalized live state of the resource which diverge from its predicted
+// live state, and their managers in the live state
+func computeResourceDrift(res managedResource, compareResult *comparisonResult) (*managedfields.Drift, error) {
+	live := &unstructured.Unstructured{}
+	if err := json.Unmarshal(res.Diff.NormalizedLive, &live.Object); err != nil {
+		return nil, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+	}
+	predicted := &unstructured.Unstructured{}
+	if err := json.Unmarshal(res.Diff.PredictedLive, &predicted.Object); err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	// the managers are read from the managed fields of the live resource, which are not part of the drift
+	live.SetManagedFields(nil)
+	predicted.SetManagedFields(nil)
+	if compareResult.diffConfig != nil {
+		pt := scheme.ResolveParseableType(res.Target.GroupVersionKind(), compareResult.diffConfig.GVKParser())
+		if drift, err := managedfields.ComputeDrift(live, predicted, res.Live.GetManagedFields(), pt); err == nil {
+			return drift, nil
+		}
+	}
+	// the states may not match the schema of the resource, in which case they are compared as plain maps and lists
+	return managedfields.ComputeDrift(live, predicted, res.Live.GetManagedFields(), nil)
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+func newDriftTestConfigMap(value string, manager string) *unstructured.Unstructured {
+	cm := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "my-map", "namespace": "default"},
+		"data":       map[string]any{"foo": value},
+	}}
+	if manager != "" {
+		cm.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+		}})
+	}
+	return cm
+}
+
+func newDriftTestComparisonResult(t *testing.T, revision string, status v1alpha1.SyncStatusCode) *comparisonResult {
+	t.Helper()
+	target := newDriftTestConfigMap("bar", "")
+	live := newDriftTestConfigMap("bar", "")
+	if status == v1alpha1.SyncStatusCodeOutOfSync {
+		live = newDriftTestConfigMap("baz", "kubectl-edit")
+	}
+	normalizedLive, err := json.Marshal(live.Object)
+	require.NoError(t, err)
+	predictedLive, err := json.Marshal(target.Object)
+	require.NoError(t, err)
+	return &comparisonResult{
+		syncStatus: &v1alpha1.SyncStatus{Status: status, Revision: revision},
+		resources: []v1alpha1.ResourceStatus{{
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Namespace: "default",
+			Name:      "my-map",
+			Status:    status,
+		}},
+		managedResources: []managedResource{{
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Namespace: "default",
+			Name:      "my-map",
+			Live:      live,
+			Target:    target,
+			Diff:      diff.DiffResult{Modified: status == v1alpha1.SyncStatusCodeOutOfSync, NormalizedLive: normalizedLive, PredictedLive: predictedLive},
+		}},
+	}
+}
+
+func getDriftHistory(t *testing.T, ctrl *ApplicationController, app *v1alpha1.Application) []*v1alpha1.ResourceDriftHistory {
+	t.Helper()
+	var history []*v1alpha1.ResourceDriftHistory
+	err := ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history)
+	if errors.Is(err, appstate.ErrCacheMiss) {
+		return nil
+	}
+	require.NoError(t, err)
+	return history
+}
+
+func TestUpdateDriftHistory(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	revision := app.Status.OperationState.SyncResult.Revision
+
+	t.Run("records the drifted fields and their managers", func(t *testing.T) {
+		outOfSync := newDriftTestComparisonResult(t, revision, v1alpha1.SyncStatusCodeOutOfSync)
+		require.NoError(t, ctrl.updateDriftHistory(app, outOfSync))
+
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Equal(t, "/ConfigMap/default/my-map", history[0].FullName())
+		require.Len(t, history[0].Events, 1)
+		event := history[0].Events[0]
+		assert.Equal(t, []string{".data.foo"}, event.FieldPaths)
+		assert.Equal(t, []string{"kubectl-edit"}, event.Managers)
+		assert.Nil(t, event.ResolvedAt)
+
+		// an ongoing drift is recorded once
+		app.Status.Resources = outOfSync.resources
+		require.NoError(t, ctrl.updateDriftHistory(app, outOfSync))
+		assert.Len(t, getDriftHistory(t, ctrl, app)[0].Events, 1)
+	})
+
+	t.Run("records that the drift was self-healed", func(t *testing.T) {
+		app.Status.OperationState.Operation.InitiatedBy.Automated = true
+		app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount = 1
+		app.Status.OperationState.StartedAt = metav1.NewTime(time.Now().Add(time.Minute))
+		require.NoError(t, ctrl.updateDriftHistory(app, newDriftTestComparisonResult(t, revision, v1alpha1.SyncStatusCodeSynced)))
+
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		require.Len(t, history[0].Events, 1)
+		assert.NotNil(t, history[0].Events[0].ResolvedAt)
+		assert.True(t, history[0].Events[0].SelfHealed)
+	})
+
+	t.Run("ignores resources which are OutOfSync because of a new revision", func(t *testing.T) {
+		app.Status.Resources = nil
+		require.NoError(t, ctrl.updateDriftHistory(app, newDriftTestComparisonResult(t, "new-revision", v1alpha1.SyncStatusCodeOutOfSync)))
+
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Len(t, history[0].Events, 1)
+	})
+
+	t.Run("removes the history of resources which are no longer managed", func(t *testing.T) {
+		outOfSync := newDriftTestComparisonResult(t, revision, v1alpha1.SyncStatusCodeOutOfSync)
+		outOfSync.resources[0].Name = "other-map"
+		outOfSync.managedResources[0].Name = "other-map"
+		require.NoError(t, ctrl.updateDriftHistory(app, outOfSync))
+
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Equal(t, "other-map", history[0].Name)
+	})
+}
+
+func TestIsSyncedToDesiredState(t *testing.T) {
+	app := newFakeApp()
+	revision := app.Status.OperationState.SyncResult.Revision
+	assert.True(t, isSyncedToDesiredState(app, &v1alpha1.SyncStatus{Revision: revision}))
+	assert.False(t, isSyncedToDesiredState(app, &v1alpha1.SyncStatus{Revision: "new-revision"}))
+
+	app.Spec.Source.Path = "other/path"
+	assert.False(t, isSyncedToDesiredState(app, &v1alpha1.SyncStatus{Revision: revision}))
+
+	app.Status.OperationState = nil
+	assert.False(t, isSyncedToDesiredState(app, &v1alpha1.SyncStatus{Revision: revision}))
+}
+
+func TestFinalizeAppDeletion_DeletesDriftHistory(t *testing.T) {
+	appNamespace := "app-namespace"
+	app := newFakeApp()
+	app.Namespace = appNamespace
+	app.Finalizers = nil
+	now := metav1.Now()
+	app.DeletionTimestamp = &now
+	proj := defaultProj
+	proj.Spec.SourceNamespaces = []string{appNamespace}
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps:                  []runtime.Object{app, &proj},
+		applicationNamespaces: []string{appNamespace},
+	}, nil)
+
+	revision := app.Status.OperationState.SyncResult.Revision
+	require.NoError(t, ctrl.updateDriftHistory(app, newDriftTestComparisonResult(t, revision, v1alpha1.SyncStatusCodeOutOfSync)))
+	require.Len(t, getDriftHistory(t, ctrl, app), 1)
+
+	err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+		return []*v1alpha1.Cluster{}, nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, getDriftHistory(t, ctrl, app))
+}
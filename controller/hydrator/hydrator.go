@@ -3,6 +3,7 @@ package hydrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"path/filepath"
@@ -13,6 +14,8 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -33,10 +36,37 @@ type RepoGetter interface {
 	GetRepository(ctx context.Context, repoURL, project string) (*appv1.Repository, error)
 }
 
+// ManifestDependencies is the subset of Dependencies needed to render hydrated manifests and build the requests sent to
+// the commit server. Unlike Dependencies, it does not require access to the app controller, so it can also be provided
+// by the API server to preview hydration.
+type ManifestDependencies interface {
+	// GetRepoObjs returns the repository objects for the given application, sources, and revisions. It calls the repo-
+	// server and gets the manifests (objects) of all the sources, rendered like the sources of a multi-source
+	// application, along with one manifest response per source.
+	GetRepoObjs(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)
+
+	// GetWriteCredentials returns the repository credentials for the given repository URL and project. These are to be
+	// sent to the commit server to write the hydrated manifests.
+	GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error)
+
+	// GetHydratorCommitMessageTemplate gets the configured template for rendering commit messages.
+	GetHydratorCommitMessageTemplate() (string, error)
+
+	// GetHydratorPruneOrphanedPaths returns whether hydrated paths which are no longer hydrated by any application are
+	// removed from the hydrated branch.
+	GetHydratorPruneOrphanedPaths() (bool, error)
+
+	// GetAllApps returns all the applications in the allowed namespaces, including the ones which are processed by other
+	// controller shards or skip reconciliation, since they all determine which hydrated paths are orphaned.
+	GetAllApps() (*appv1.ApplicationList, error)
+}
+
 // Dependencies is the interface for the dependencies of the Hydrator. It serves two purposes: 1) it prevents the
 // hydrator from having direct access to the app controller, and 2) it allows for easy mocking of dependencies in tests.
 // If you add something here, be sure that it is something the app controller needs to provide to the hydrator.
 type Dependencies interface {
+	ManifestDependencies
+
 	// TODO: determine if we actually need to get the app, or if all the stuff we need the app for is done already on
 	//       the app controller side.
 
@@ -48,14 +78,6 @@ type Dependencies interface {
 	// GetProcessableApps returns a list of applications that are processable by the controller.
 	GetProcessableApps() (*appv1.ApplicationList, error)
 
-	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
-	// server and gets the manifests (objects).
-	GetRepoObjs(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
-
-	// GetWriteCredentials returns the repository credentials for the given repository URL and project. These are to be
-	// sent to the commit server to write the hydrated manifests.
-	GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error)
-
 	// RequestAppRefresh requests a refresh of the application with the given name and namespace. This is used to
 	// trigger a refresh after the application has been hydrated and a new commit has been pushed.
 	RequestAppRefresh(appName string, appNamespace string) error
@@ -63,12 +85,17 @@ type Dependencies interface {
 	// PersistAppHydratorStatus persists the application status for the source hydrator.
 	PersistAppHydratorStatus(orig *appv1.Application, newStatus *appv1.SourceHydratorStatus)
 
+	// PersistAppHydrateRollback persists the application status for the source hydrator after recording a hydrate
+	// rollback, and removes the annotation holding the rollback.
+	PersistAppHydrateRollback(orig *appv1.Application, newStatus *appv1.SourceHydratorStatus)
+
+	// PersistAppHydratorValidationError sets the HydratorValidationError condition of the application to the given
+	// message, or removes the condition if the message is empty.
+	PersistAppHydratorValidationError(orig *appv1.Application, message string)
+
 	// AddHydrationQueueItem adds a hydration queue item to the queue. This is used to trigger the hydration process for
 	// a group of applications which are hydrating to the same repo and target branch.
 	AddHydrationQueueItem(key types.HydrationQueueKey)
-
-	// GetHydratorCommitMessageTemplate gets the configured template for rendering commit messages.
-	GetHydratorCommitMessageTemplate() (string, error)
 }
 
 // Hydrator is the main struct that implements the hydration logic. It uses the Dependencies interface to access the
@@ -98,6 +125,9 @@ func NewHydrator(dependencies Dependencies, statusRefreshTimeout time.Duration,
 // and if so, it updates the application's status to indicate that hydration is in progress. It then adds the
 // hydration queue item to the queue for further processing.
 //
+// A hydrate rollback pushed by the API server is recorded first, once no hydration is in progress, since the status
+// persisted at the end of a hydration would otherwise drop it from the hydrate history.
+//
 // It's likely that multiple applications will trigger hydration at the same time. The hydration queue key is meant to
 // dedupe these requests.
 func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
@@ -109,13 +139,22 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	logCtx.Debug("Processing app hydrate queue item")
 
+	if _, ok := app.GetAnnotations()[appv1.AnnotationKeyHydrateRollback]; ok {
+		if app.Status.SourceHydrator.CurrentOperation != nil && app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseHydrating {
+			logCtx.Debug("Hydration in progress, deferring the recording of the hydrate rollback")
+		} else {
+			h.recordRollback(logCtx, origApp, app)
+			return
+		}
+	}
+
 	needsHydration, reason := appNeedsHydration(app)
 	if needsHydration {
 		app.Status.SourceHydrator.CurrentOperation = &appv1.HydrateOperation{
 			StartedAt:      metav1.Now(),
 			FinishedAt:     nil,
 			Phase:          appv1.HydrateOperationPhaseHydrating,
-			SourceHydrator: *app.Spec.SourceHydrator,
+			SourceHydrator: app.Spec.SourceHydrator.Record(),
 		}
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 	}
@@ -131,12 +170,30 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 	logCtx.Debug("Successfully processed app hydrate queue item")
 }
 
+// recordRollback records the hydrate rollback held by the annotation of the given app in its hydrate history, and
+// removes the annotation. A rollback which can't be decoded is dropped, so that it doesn't block the app.
+func (h *Hydrator) recordRollback(logCtx *log.Entry, origApp *appv1.Application, app *appv1.Application) {
+	var record RollbackRecord
+	err := json.Unmarshal([]byte(app.GetAnnotations()[appv1.AnnotationKeyHydrateRollback]), &record)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to decode hydrate rollback, dropping it")
+	} else {
+		logCtx.WithField("hydratedSHA", record.History.HydratedSHA).Info("Recording hydrate rollback")
+		app.Status.SourceHydrator.AddHydrateHistory(record.History, app.Spec.GetRevisionHistoryLimit())
+		app.Status.SourceHydrator.PullRequest = getPullRequestStatus(app, record.PullRequest)
+	}
+	h.dependencies.PersistAppHydrateRollback(origApp, &app.Status.SourceHydrator)
+}
+
 func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 	key := types.HydrationQueueKey{
 		SourceRepoURL:        git.NormalizeGitURLAllowInvalid(app.Spec.SourceHydrator.DrySource.RepoURL),
 		SourceTargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
 		DestinationBranch:    app.Spec.GetHydrateToSource().TargetRevision,
 	}
+	if app.Spec.SourceHydrator.SyncSource.IsOCI() {
+		key.DestinationRepoURL = app.Spec.SourceHydrator.SyncSource.RepoURL
+	}
 	return key
 }
 
@@ -150,6 +207,9 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 		"sourceTargetRevision": hydrationKey.SourceTargetRevision,
 		"destinationBranch":    hydrationKey.DestinationBranch,
 	})
+	if hydrationKey.DestinationRepoURL != "" {
+		logCtx = logCtx.WithField("destinationRepoURL", hydrationKey.DestinationRepoURL)
+	}
 
 	// Get all applications sharing the same hydration key
 	apps, err := h.getAppsForHydrationKey(hydrationKey)
@@ -197,7 +257,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 
 	// Hydrate all the apps
-	drySHA, hydratedSHA, appErrors, err := h.hydrate(logCtx, apps, projects)
+	drySHA, dryRevisions, hydratedSHA, promotion, appErrors, err := h.hydrate(logCtx, apps, projects)
 	if err != nil {
 		// If there is a single error, it affects each applications
 		for i := range apps {
@@ -211,10 +271,12 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 		// For the applications that have an error, set the specific error in their status.
 		// Applications without error will still fail with a generic error since the hydration cannot be partial
 		genericError := genericHydrationError(appErrors)
+		validationMessage, validationFailed := getValidationErrorMessage(err)
 		for _, app := range apps {
 			if drySHA != "" {
 				// If we have a drySHA, we can set it on the app status
 				app.Status.SourceHydrator.CurrentOperation.DrySHA = drySHA
+				app.Status.SourceHydrator.CurrentOperation.DryRevisions = dryRevisions[app.QualifiedName()]
 			}
 			if err, ok := appErrors[app.QualifiedName()]; ok {
 				logCtx = logCtx.WithFields(applog.GetAppLogFields(app))
@@ -223,11 +285,22 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			} else {
 				h.setAppHydratorError(app, genericError)
 			}
+			if validationFailed {
+				// The manifests are validated together, so the failure is surfaced on every app.
+				h.dependencies.PersistAppHydratorValidationError(app, validationMessage)
+			}
 		}
 		return
 	}
 
 	logCtx.Debug("Successfully hydrated apps")
+	message := ""
+	if promotion != nil && promotion.err != "" {
+		// The manifests were pushed, so the hydration is not failed and retried. The promotion is retried by the next
+		// hydration.
+		logCtx.Errorf("Failed to promote hydrated manifests: %s", promotion.err)
+		message = "Hydrated manifests were pushed, but " + promotion.err
+	}
 	finishedAt := metav1.Now()
 	for _, app := range apps {
 		origApp := app.DeepCopy()
@@ -235,18 +308,25 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			StartedAt:      app.Status.SourceHydrator.CurrentOperation.StartedAt,
 			FinishedAt:     &finishedAt,
 			Phase:          appv1.HydrateOperationPhaseHydrated,
-			Message:        "",
+			Message:        message,
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DryRevisions:   dryRevisions[app.QualifiedName()],
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DryRevisions:   dryRevisions[app.QualifiedName()],
 		}
+		app.Status.SourceHydrator.PullRequest = getPullRequestStatus(app, promotion.pullRequest)
+		addHydrateHistory(app, operation)
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		if len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionHydratorValidationError: true})) > 0 {
+			h.dependencies.PersistAppHydratorValidationError(app, "")
+		}
 
 		// Request a refresh since we pushed a new commit.
 		err := h.dependencies.RequestAppRefresh(app.Name, app.Namespace)
@@ -256,6 +336,26 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 }
 
+// getPullRequestStatus returns the pull request status to record for the app after a successful hydration. The commit
+// server reports the open pull request, or the previously recorded one with its final state once it was merged or
+// closed.
+func getPullRequestStatus(app *appv1.Application, pullRequest *appv1.HydratePullRequestStatus) *appv1.HydratePullRequestStatus {
+	if app.Spec.SourceHydrator.HydrateTo == nil || app.Spec.SourceHydrator.HydrateTo.PullRequest == nil || pullRequest == nil {
+		return nil
+	}
+	return pullRequest.DeepCopy()
+}
+
+// getValidationErrorMessage returns the message of the validation error of the commit server wrapped by err, and true
+// if err wraps one. The commit server returns validation errors with the FailedPrecondition code.
+func getValidationErrorMessage(err error) (string, bool) {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) && grpcErr.GRPCStatus().Code() == codes.FailedPrecondition {
+		return grpcErr.GRPCStatus().Message(), true
+	}
+	return "", false
+}
+
 // setAppHydratorError updates the CurrentOperation with the error information.
 func (h *Hydrator) setAppHydratorError(app *appv1.Application, err error) {
 	// if the operation is not in progress, we do not update the status
@@ -268,9 +368,44 @@ func (h *Hydrator) setAppHydratorError(app *appv1.Application, err error) {
 	failedAt := metav1.Now()
 	app.Status.SourceHydrator.CurrentOperation.FinishedAt = &failedAt
 	app.Status.SourceHydrator.CurrentOperation.Message = fmt.Sprintf("Failed to hydrate: %v", err.Error())
+	addHydrateHistory(app, app.Status.SourceHydrator.CurrentOperation)
 	h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 }
 
+// addHydrateHistory records the given completed hydrate operation in the hydrate history of the app. Operations which
+// didn't produce anything new, i.e. the hydration of an already hydrated dry commit or a repeated failure, are not
+// recorded, so that retries don't push the entries available for rollbacks out of the history.
+func addHydrateHistory(app *appv1.Application, operation *appv1.HydrateOperation) {
+	history := app.Status.SourceHydrator.History
+	switch operation.Phase {
+	case appv1.HydrateOperationPhaseHydrated:
+		if slices.ContainsFunc(history, func(h appv1.HydrateHistory) bool {
+			return h.Phase == appv1.HydrateOperationPhaseHydrated && h.DrySHA == operation.DrySHA && h.HydratedSHA == operation.HydratedSHA
+		}) {
+			return
+		}
+	case appv1.HydrateOperationPhaseFailed:
+		if len(history) > 0 {
+			last := history[len(history)-1]
+			if last.Phase == appv1.HydrateOperationPhaseFailed && last.DrySHA == operation.DrySHA && last.Message == operation.Message {
+				return
+			}
+		}
+	default:
+		return
+	}
+	app.Status.SourceHydrator.AddHydrateHistory(appv1.HydrateHistory{
+		StartedAt:      operation.StartedAt,
+		FinishedAt:     operation.FinishedAt,
+		Phase:          operation.Phase,
+		Message:        operation.Message,
+		DrySHA:         operation.DrySHA,
+		HydratedSHA:    operation.HydratedSHA,
+		SourceHydrator: operation.SourceHydrator,
+		DryRevisions:   operation.DryRevisions,
+	}, app.Spec.GetRevisionHistoryLimit())
+}
+
 // getAppsForHydrationKey returns the applications matching the hydration key.
 func (h *Hydrator) getAppsForHydrationKey(hydrationKey types.HydrationQueueKey) ([]*appv1.Application, error) {
 	// Get all apps
@@ -343,35 +478,40 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 	return projects, errors
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, map[string]error, error) {
+// hydrate hydrates the given apps from the same dry revision and commits the manifests. It returns the resolved dry
+// SHA, the resolved revisions of the dry sources of each app with additional dry sources (keyed by the app's qualified
+// name), the hydrated SHA, the status of the promotion of the target branch, the errors of individual apps and an error
+// affecting all apps.
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, map[string][]string, string, *promotionStatus, map[string]error, error) {
 	errors := make(map[string]error)
 	if len(apps) == 0 {
-		return "", "", nil, nil
+		return "", nil, "", nil, nil, nil
 	}
 
-	// These values are the same for all apps being hydrated together, so just get them from the first app.
-	repoURL := apps[0].Spec.GetHydrateToSource().RepoURL
-	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
-	// FIXME: As a convenience, the commit server will create the syncBranch if it does not exist. If the
-	// targetBranch does not exist, it will create it based on the syncBranch. On the next line, we take
-	// the `syncBranch` from the first app and assume that they're all configured the same. Instead, if any
-	// app has a different syncBranch, we should send the commit server an empty string and allow it to
-	// create the targetBranch as an orphan since we can't reliable determine a reasonable base.
-	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
-
 	// Get a static SHA revision from the first app so that all apps are hydrated from the same revision.
-	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], "", projects[apps[0].Spec.Project])
+	targetRevision, pathDetails, err := getManifests(context.Background(), h.dependencies, apps[0], "", projects[apps[0].Spec.Project])
 	if err != nil {
 		errors[apps[0].QualifiedName()] = fmt.Errorf("failed to get manifests: %w", err)
-		return "", "", errors, nil
+		return "", nil, "", nil, errors, nil
 	}
 	paths := []*commitclient.PathDetails{pathDetails}
+	dryRevisions := make(map[string][]string)
+	if len(pathDetails.DryRevisions) > 0 {
+		dryRevisions[apps[0].QualifiedName()] = pathDetails.DryRevisions
+	}
 	logCtx = logCtx.WithFields(log.Fields{"drySha": targetRevision})
 	// De-dupe, if the drySha was already hydrated log a debug and return using the data from the last successful hydration run.
 	// We only inspect one app. If apps have been added/removed, that will be handled on the next DRY commit.
-	if apps[0].Status.SourceHydrator.LastSuccessfulOperation != nil && targetRevision == apps[0].Status.SourceHydrator.LastSuccessfulOperation.DrySHA {
+	lastSuccessfulOperation := apps[0].Status.SourceHydrator.LastSuccessfulOperation
+	alreadyHydrated := lastSuccessfulOperation != nil && targetRevision == lastSuccessfulOperation.DrySHA
+	// The revisions of additional dry sources, e.g. a new chart version, may change without a new DRY commit. They are
+	// only known once the manifests of each app are rendered, so they are compared below.
+	multipleDrySources := slices.ContainsFunc(apps, func(app *appv1.Application) bool {
+		return app.Spec.SourceHydrator.HasMultipleDrySources()
+	})
+	if alreadyHydrated && !multipleDrySources {
 		logCtx.Debug("Skipping hydration since the DRY commit was already hydrated")
-		return targetRevision, apps[0].Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA, nil, nil
+		return targetRevision, dryRevisions, lastSuccessfulOperation.HydratedSHA, &promotionStatus{pullRequest: apps[0].Status.SourceHydrator.PullRequest}, nil, nil
 	}
 
 	eg, ctx := errgroup.WithContext(context.Background())
@@ -380,7 +520,7 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	for _, app := range apps[1:] {
 		app := app
 		eg.Go(func() error {
-			_, pathDetails, err = h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
+			_, pathDetails, err = getManifests(ctx, h.dependencies, app, targetRevision, projects[app.Spec.Project])
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
@@ -388,11 +528,19 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 				return errors[app.QualifiedName()]
 			}
 			paths = append(paths, pathDetails)
+			if len(pathDetails.DryRevisions) > 0 {
+				dryRevisions[app.QualifiedName()] = pathDetails.DryRevisions
+			}
 			return nil
 		})
 	}
 	if err := eg.Wait(); err != nil {
-		return targetRevision, "", errors, nil
+		return targetRevision, dryRevisions, "", nil, errors, nil
+	}
+
+	if alreadyHydrated && dryRevisionsHydrated(apps, dryRevisions) {
+		logCtx.Debug("Skipping hydration since the DRY commit and the revisions of the dry sources were already hydrated")
+		return targetRevision, dryRevisions, lastSuccessfulOperation.HydratedSHA, &promotionStatus{pullRequest: apps[0].Status.SourceHydrator.PullRequest}, nil, nil
 	}
 
 	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
@@ -405,15 +553,67 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		}
 	}
 
+	manifestsRequest, err := getCommitRequest(context.Background(), logCtx, h.dependencies, h.repoGetter, h.repoClientset, apps[0], project, targetRevision, paths)
+	if err != nil {
+		return targetRevision, dryRevisions, "", nil, errors, err
+	}
+
+	closer, commitService, err := h.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return targetRevision, dryRevisions, "", nil, errors, fmt.Errorf("failed to create commit service: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.CommitHydratedManifests(context.Background(), manifestsRequest)
+	if err != nil {
+		return targetRevision, dryRevisions, "", nil, errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	return targetRevision, dryRevisions, resp.HydratedSha, &promotionStatus{pullRequest: resp.PullRequest, err: resp.PromotionError}, errors, nil
+}
+
+// dryRevisionsHydrated returns true if the given resolved revisions of the dry sources of every app, keyed by the app's
+// qualified name, are the ones of the app's last successful hydration.
+func dryRevisionsHydrated(apps []*appv1.Application, dryRevisions map[string][]string) bool {
+	for _, app := range apps {
+		lastSuccessfulOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+		if lastSuccessfulOperation == nil || !slices.Equal(dryRevisions[app.QualifiedName()], lastSuccessfulOperation.DryRevisions) {
+			return false
+		}
+	}
+	return true
+}
+
+// promotionStatus is the outcome of the promotion of the hydrated target branch to the sync branch.
+type promotionStatus struct {
+	// pullRequest is the pull request reported by the commit server
+	pullRequest *appv1.HydratePullRequestStatus
+	// err is the error which occurred while promoting the target branch, after the manifests were pushed
+	err string
+}
+
+// getCommitRequest builds the request sent to the commit server to write the given hydrated paths. The repository and
+// branches are taken from app, which must be configured the same as all other apps being hydrated together. project is
+// the project used to look up credentials, or an empty string to use global credentials.
+func getCommitRequest(ctx context.Context, logCtx *log.Entry, dependencies ManifestDependencies, repoGetter RepoGetter, repoClientset apiclient.Clientset, app *appv1.Application, project, targetRevision string, paths []*commitclient.PathDetails) (*commitclient.CommitHydratedManifestsRequest, error) {
+	// These values are the same for all apps being hydrated together, so just get them from the given app.
+	dryRepoURL := app.Spec.SourceHydrator.DrySource.RepoURL
+	repoURL := app.Spec.GetHydrateToSource().RepoURL
+	targetBranch := app.Spec.GetHydrateToSource().TargetRevision
+	// FIXME: As a convenience, the commit server will create the syncBranch if it does not exist. If the
+	// targetBranch does not exist, it will create it based on the syncBranch. On the next line, we take
+	// the `syncBranch` from the first app and assume that they're all configured the same. Instead, if any
+	// app has a different syncBranch, we should send the commit server an empty string and allow it to
+	// create the targetBranch as an orphan since we can't reliable determine a reasonable base.
+	syncBranch := app.Spec.SourceHydrator.SyncSource.TargetBranch
+
 	// Get the commit metadata for the target revision.
-	revisionMetadata, err := h.getRevisionMetadata(context.Background(), repoURL, project, targetRevision)
+	revisionMetadata, err := getRevisionMetadata(ctx, repoGetter, repoClientset, dryRepoURL, project, targetRevision)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
+		return nil, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
 	}
 
-	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
+	repo, err := dependencies.GetWriteCredentials(ctx, repoURL, project)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
+		return nil, fmt.Errorf("failed to get hydrator credentials: %w", err)
 	}
 	if repo == nil {
 		// Try without credentials.
@@ -423,16 +623,21 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		logCtx.Warn("no credentials found for repo, continuing without credentials")
 	}
 	// get the commit message template
-	commitMessageTemplate, err := h.dependencies.GetHydratorCommitMessageTemplate()
+	commitMessageTemplate, err := dependencies.GetHydratorCommitMessageTemplate()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
+		return nil, fmt.Errorf("failed to get hydrated commit message template: %w", err)
 	}
-	commitMessage, errMsg := getTemplatedCommitMessage(repoURL, targetRevision, commitMessageTemplate, revisionMetadata)
+	commitMessage, errMsg := getTemplatedCommitMessage(dryRepoURL, targetRevision, commitMessageTemplate, revisionMetadata)
 	if errMsg != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
+		return nil, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
 	}
 
-	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
+	activePaths, err := getActivePaths(dependencies, app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active hydrated paths: %w", err)
+	}
+
+	manifestsRequest := &commitclient.CommitHydratedManifestsRequest{
 		Repo:              repo,
 		SyncBranch:        syncBranch,
 		TargetBranch:      targetBranch,
@@ -440,43 +645,76 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		CommitMessage:     commitMessage,
 		Paths:             paths,
 		DryCommitMetadata: revisionMetadata,
+		DryRepoURL:        dryRepoURL,
+		ActivePaths:       activePaths,
+	}
+	if app.Spec.SourceHydrator.HydrateTo != nil && app.Spec.SourceHydrator.HydrateTo.PullRequest != nil {
+		manifestsRequest.PullRequest = app.Spec.SourceHydrator.HydrateTo.PullRequest
+		manifestsRequest.PreviousPullRequest = app.Status.SourceHydrator.PullRequest
 	}
+	return manifestsRequest, nil
+}
 
-	closer, commitService, err := h.commitClientset.NewCommitServerClient()
+// getActivePaths returns the sync source paths of all applications hydrating to the same repository and branch as the
+// given app, regardless of their dry source revision or of the controller shard processing them. The commit server removes the hydrated paths which are not among
+// them. It returns nil if pruning is disabled or the app hydrates to an OCI repository, whose artifacts only ever
+// contain the active paths.
+func getActivePaths(dependencies ManifestDependencies, app *appv1.Application) ([]string, error) {
+	if app.Spec.SourceHydrator.SyncSource.IsOCI() {
+		return nil, nil
+	}
+	prune, err := dependencies.GetHydratorPruneOrphanedPaths()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to create commit service: %w", err)
+		return nil, fmt.Errorf("failed to get prune orphaned paths setting: %w", err)
 	}
-	defer utilio.Close(closer)
-	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
+	if !prune {
+		return nil, nil
+	}
+	apps, err := dependencies.GetAllApps()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+		return nil, fmt.Errorf("failed to list apps: %w", err)
 	}
-	return targetRevision, resp.HydratedSha, errors, nil
+	repoURL := git.NormalizeGitURLAllowInvalid(app.Spec.GetHydrateToSource().RepoURL)
+	branch := app.Spec.GetHydrateToSource().TargetRevision
+	var paths []string
+	for _, a := range apps.Items {
+		if a.Spec.SourceHydrator == nil || a.Spec.SourceHydrator.SyncSource.IsOCI() {
+			continue
+		}
+		if git.NormalizeGitURLAllowInvalid(a.Spec.GetHydrateToSource().RepoURL) != repoURL || a.Spec.GetHydrateToSource().TargetRevision != branch {
+			continue
+		}
+		paths = append(paths, a.Spec.SourceHydrator.SyncSource.Path)
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths), nil
 }
 
 // getManifests gets the manifests for the given application and target revision. It returns the resolved revision
 // (a git SHA), and path details for the commit server.
 //
-// If the given target revision is empty, it uses the target revision from the app dry source spec.
-func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
-	drySource := appv1.ApplicationSource{
-		RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
-		Path:           app.Spec.SourceHydrator.DrySource.Path,
-		TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
-		Helm:           app.Spec.SourceHydrator.DrySource.Helm,
-		Kustomize:      app.Spec.SourceHydrator.DrySource.Kustomize,
-		Directory:      app.Spec.SourceHydrator.DrySource.Directory,
-		Plugin:         app.Spec.SourceHydrator.DrySource.Plugin,
-	}
+// If the given target revision is empty, it uses the target revision from the app dry source spec. Additional dry
+// sources are always rendered at their own target revisions, and their resolved revisions are recorded in the path
+// details.
+func getManifests(ctx context.Context, dependencies ManifestDependencies, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
+	drySources := app.Spec.SourceHydrator.GetDrySources()
 	if targetRevision == "" {
 		targetRevision = app.Spec.SourceHydrator.DrySource.TargetRevision
 	}
+	revisions := make([]string, len(drySources))
+	revisions[0] = targetRevision
+	for i := 1; i < len(drySources); i++ {
+		revisions[i] = drySources[i].TargetRevision
+	}
 
 	// TODO: enable signature verification
-	objs, resp, err := h.dependencies.GetRepoObjs(ctx, app, drySource, targetRevision, project)
+	objs, resps, err := dependencies.GetRepoObjs(ctx, app, drySources, revisions, project)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
 	}
+	if len(resps) != len(drySources) {
+		return "", nil, fmt.Errorf("expected %d manifest responses for app %q, got %d", len(drySources), app.QualifiedName(), len(resps))
+	}
 
 	// Set up a ManifestsRequest
 	manifestDetails := make([]*commitclient.HydratedManifestDetails, len(objs))
@@ -488,26 +726,45 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 		manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 	}
 
-	return resp.Revision, &commitclient.PathDetails{
-		Path:      app.Spec.SourceHydrator.SyncSource.Path,
-		Manifests: manifestDetails,
-		Commands:  resp.Commands,
-	}, nil
+	pathDetails = &commitclient.PathDetails{
+		Path:           app.Spec.SourceHydrator.SyncSource.Path,
+		Manifests:      manifestDetails,
+		ManifestLayout: string(app.Spec.SourceHydrator.ManifestLayout),
+		DrySource:      app.Spec.SourceHydrator.DrySource.DeepCopy(),
+	}
+	for _, resp := range resps {
+		pathDetails.Commands = append(pathDetails.Commands, resp.Commands...)
+		for tool, version := range resp.ToolVersions {
+			if pathDetails.ToolVersions == nil {
+				pathDetails.ToolVersions = make(map[string]string)
+			}
+			pathDetails.ToolVersions[tool] = version
+		}
+	}
+	if app.Spec.SourceHydrator.HasMultipleDrySources() {
+		for i := range app.Spec.SourceHydrator.DrySources {
+			pathDetails.DrySources = append(pathDetails.DrySources, app.Spec.SourceHydrator.DrySources[i].DeepCopy())
+		}
+		for _, resp := range resps {
+			pathDetails.DryRevisions = append(pathDetails.DryRevisions, resp.Revision)
+		}
+	}
+	return resps[0].Revision, pathDetails, nil
 }
 
-func (h *Hydrator) getRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*appv1.RevisionMetadata, error) {
-	repo, err := h.repoGetter.GetRepository(ctx, repoURL, project)
+func getRevisionMetadata(ctx context.Context, repoGetter RepoGetter, repoClientset apiclient.Clientset, repoURL, project, revision string) (*appv1.RevisionMetadata, error) {
+	repo, err := repoGetter.GetRepository(ctx, repoURL, project)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repository %q: %w", repoURL, err)
 	}
 
-	closer, repoService, err := h.repoClientset.NewRepoServerClient()
+	closer, repoService, err := repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create commit service: %w", err)
 	}
 	defer utilio.Close(closer)
 
-	resp, err := repoService.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+	resp, err := repoService.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:     repo,
 		Revision: revision,
 	})
@@ -528,7 +785,7 @@ func appNeedsHydration(app *appv1.Application) (needsHydration bool, reason stri
 		return false, "hydration operation already in progress"
 	case app.IsHydrateRequested():
 		return true, "hydrate requested"
-	case !app.Spec.SourceHydrator.DeepEquals(app.Status.SourceHydrator.CurrentOperation.SourceHydrator):
+	case !app.Spec.SourceHydrator.Record().DeepEquals(app.Status.SourceHydrator.CurrentOperation.SourceHydrator):
 		return true, "spec.sourceHydrator differs"
 	case app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseFailed && metav1.Now().Sub(app.Status.SourceHydrator.CurrentOperation.FinishedAt.Time) > 2*time.Minute:
 		return true, "previous hydrate operation failed more than 2 minutes ago"
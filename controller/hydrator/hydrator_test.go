@@ -2,6 +2,7 @@ package hydrator
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"path/filepath"
 	"testing"
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -85,7 +88,7 @@ func Test_appNeedsHydration(t *testing.T) {
 			app: &v1alpha1.Application{
 				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{}},
 				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
-					SourceHydrator: v1alpha1.SourceHydrator{DrySource: v1alpha1.DrySource{RepoURL: "something new"}},
+					SourceHydrator: v1alpha1.SourceHydratorRecord{DrySource: v1alpha1.DrySource{RepoURL: "something new"}},
 				}}},
 			},
 			expectedNeedsHydration: true,
@@ -355,7 +358,7 @@ func setTestAppPhase(app *v1alpha1.Application, phase v1alpha1.HydrateOperationP
 				StartedAt:      metav1.Now(),
 				FinishedAt:     nil,
 				Phase:          phase,
-				SourceHydrator: *app.Spec.SourceHydrator,
+				SourceHydrator: app.Spec.SourceHydrator.Record(),
 			},
 		}
 	case v1alpha1.HydrateOperationPhaseFailed:
@@ -365,7 +368,7 @@ func setTestAppPhase(app *v1alpha1.Application, phase v1alpha1.HydrateOperationP
 				FinishedAt:     ptr.To(metav1.Now()),
 				Phase:          phase,
 				Message:        "some error",
-				SourceHydrator: *app.Spec.SourceHydrator,
+				SourceHydrator: app.Spec.SourceHydrator.Record(),
 			},
 		}
 
@@ -377,7 +380,7 @@ func setTestAppPhase(app *v1alpha1.Application, phase v1alpha1.HydrateOperationP
 				Phase:          phase,
 				DrySHA:         "12345",
 				HydratedSHA:    "67890",
-				SourceHydrator: *app.Spec.SourceHydrator,
+				SourceHydrator: app.Spec.SourceHydrator.Record(),
 			},
 		}
 	}
@@ -414,7 +417,59 @@ func TestProcessAppHydrateQueueItem_HydrationNeeded(t *testing.T) {
 	assert.NotNil(t, persistedStatus.CurrentOperation.StartedAt)
 	assert.Nil(t, persistedStatus.CurrentOperation.FinishedAt)
 	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, persistedStatus.CurrentOperation.Phase)
-	assert.Equal(t, *app.Spec.SourceHydrator, persistedStatus.CurrentOperation.SourceHydrator)
+	assert.Equal(t, app.Spec.SourceHydrator.Record(), persistedStatus.CurrentOperation.SourceHydrator)
+}
+
+func TestProcessAppHydrateQueueItem_RecordsRollback(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrated)
+	app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydrateToPullRequest{}
+	app.Status.SourceHydrator.History = []v1alpha1.HydrateHistory{{ID: 4, Phase: v1alpha1.HydrateOperationPhaseHydrated, HydratedSHA: "hydrated-sha"}}
+	record, err := json.Marshal(&RollbackRecord{
+		History:     v1alpha1.HydrateHistory{Phase: v1alpha1.HydrateOperationPhaseHydrated, HydratedSHA: "revert-sha", RollbackID: ptr.To(int64(4))},
+		PullRequest: &v1alpha1.HydratePullRequestStatus{Number: 2, State: v1alpha1.HydratePullRequestStateOpen},
+	})
+	require.NoError(t, err)
+	app.Annotations = map[string]string{v1alpha1.AnnotationKeyHydrateRollback: string(record)}
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydrateRollback(app, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+
+	h.ProcessAppHydrateQueueItem(app)
+
+	require.NotNil(t, persistedStatus)
+	require.Len(t, persistedStatus.History, 2)
+	assert.Equal(t, int64(5), persistedStatus.History[1].ID)
+	assert.Equal(t, "revert-sha", persistedStatus.History[1].HydratedSHA)
+	assert.Equal(t, ptr.To(int64(4)), persistedStatus.History[1].RollbackID)
+	assert.Equal(t, &v1alpha1.HydratePullRequestStatus{Number: 2, State: v1alpha1.HydratePullRequestStateOpen}, persistedStatus.PullRequest)
+	// The app is not modified in place.
+	assert.Len(t, app.Status.SourceHydrator.History, 1)
+}
+
+func TestProcessAppHydrateQueueItem_DefersRollbackWhileHydrating(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Annotations = map[string]string{v1alpha1.AnnotationKeyHydrateRollback: `{"history":{"phase":"Hydrated"}}`}
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+
+	h.ProcessAppHydrateQueueItem(app)
+
+	// The rollback is recorded once the hydration is complete, since the hydration replaces the hydrate history.
+	d.AssertNotCalled(t, "PersistAppHydrateRollback", mock.Anything, mock.Anything)
 }
 
 func TestProcessAppHydrateQueueItem_HydrationPassedTimeout(t *testing.T) {
@@ -429,7 +484,7 @@ func TestProcessAppHydrateQueueItem_HydrationPassedTimeout(t *testing.T) {
 			CurrentOperation: &v1alpha1.HydrateOperation{
 				StartedAt:      startedAt,
 				Phase:          v1alpha1.HydrateOperationPhaseHydrating,
-				SourceHydrator: v1alpha1.SourceHydrator{},
+				SourceHydrator: v1alpha1.SourceHydratorRecord{},
 			},
 		},
 	}
@@ -586,9 +641,9 @@ func TestProcessHydrationQueueItem_HydrateFails_CommonError(t *testing.T) {
 	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
 	h := &Hydrator{dependencies: d, repoGetter: r}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil)
+	}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("repo error"))
 
 	// Expect setAppHydratorError to be called
@@ -615,6 +670,9 @@ func TestProcessHydrationQueueItem_HydrateFails_CommonError(t *testing.T) {
 	assert.Contains(t, persistedStatus2.CurrentOperation.Message, "repo error")
 	assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, persistedStatus1.CurrentOperation.Phase)
 	assert.Equal(t, "abc123", persistedStatus1.CurrentOperation.DrySHA)
+	require.Len(t, persistedStatus1.History, 1)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, persistedStatus1.History[0].Phase)
+	assert.Equal(t, persistedStatus1.CurrentOperation.Message, persistedStatus1.History[0].Message)
 
 	d.AssertNumberOfCalls(t, "PersistAppHydratorStatus", 2)
 	d.AssertNotCalled(t, "RequestAppRefresh", mock.Anything, mock.Anything)
@@ -638,13 +696,14 @@ func TestProcessHydrationQueueItem_SuccessfulHydration(t *testing.T) {
 		persistedStatus = newStatus
 	}).Return().Once()
 	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil).Once()
+	}}, nil).Once()
 	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
 	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil).Once()
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil).Once()
 
 	h.ProcessHydrationQueueItem(hydrationKey)
@@ -663,6 +722,160 @@ func TestProcessHydrationQueueItem_SuccessfulHydration(t *testing.T) {
 	assert.Equal(t, "abc123", persistedStatus.LastSuccessfulOperation.DrySHA)
 	assert.Equal(t, "def456", persistedStatus.LastSuccessfulOperation.HydratedSHA)
 	assert.Equal(t, app.Status.SourceHydrator.CurrentOperation.SourceHydrator, persistedStatus.LastSuccessfulOperation.SourceHydrator)
+	require.Len(t, persistedStatus.History, 1)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, persistedStatus.History[0].Phase)
+	assert.Equal(t, "abc123", persistedStatus.History[0].DrySHA)
+	assert.Equal(t, "def456", persistedStatus.History[0].HydratedSHA)
+}
+
+func TestProcessHydrationQueueItem_PromotionFails(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub}
+	pullRequest := &v1alpha1.HydratePullRequestStatus{Number: 42, State: v1alpha1.HydratePullRequestStateOpen}
+	app.Status.SourceHydrator.PullRequest = pullRequest
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
+		Revision: "abc123",
+	}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil).Once()
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{
+		HydratedSha:    "def456",
+		PullRequest:    pullRequest,
+		PromotionError: "failed to promote target branch: rate limited",
+	}, nil).Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+
+	// the push succeeded, so the hydration is successful and is not retried
+	require.NotNil(t, persistedStatus)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, persistedStatus.CurrentOperation.Phase)
+	assert.Equal(t, "Hydrated manifests were pushed, but failed to promote target branch: rate limited", persistedStatus.CurrentOperation.Message)
+	require.NotNil(t, persistedStatus.LastSuccessfulOperation)
+	assert.Equal(t, "def456", persistedStatus.LastSuccessfulOperation.HydratedSHA)
+	assert.Equal(t, pullRequest, persistedStatus.PullRequest)
+}
+
+func Test_addHydrateHistory(t *testing.T) {
+	t.Parallel()
+	finishedAt := metav1.Now()
+	hydrated := func(drySHA, hydratedSHA string) *v1alpha1.HydrateOperation {
+		return &v1alpha1.HydrateOperation{FinishedAt: &finishedAt, Phase: v1alpha1.HydrateOperationPhaseHydrated, DrySHA: drySHA, HydratedSHA: hydratedSHA}
+	}
+	failed := func(drySHA, message string) *v1alpha1.HydrateOperation {
+		return &v1alpha1.HydrateOperation{FinishedAt: &finishedAt, Phase: v1alpha1.HydrateOperationPhaseFailed, DrySHA: drySHA, Message: message}
+	}
+
+	app := newTestApp("test-app")
+	app.Spec.RevisionHistoryLimit = ptr.To(int64(3))
+	addHydrateHistory(app, hydrated("dry1", "hydrated1"))
+	addHydrateHistory(app, failed("dry2", "error"))
+	// A repeated failure is only recorded once.
+	addHydrateHistory(app, failed("dry2", "error"))
+	addHydrateHistory(app, hydrated("dry2", "hydrated2"))
+	// An already hydrated dry commit is not recorded again, even after other entries.
+	addHydrateHistory(app, hydrated("dry1", "hydrated1"))
+	addHydrateHistory(app, &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrating})
+
+	history := app.Status.SourceHydrator.History
+	require.Len(t, history, 3)
+	assert.Equal(t, []int64{0, 1, 2}, []int64{history[0].ID, history[1].ID, history[2].ID})
+	assert.Equal(t, "hydrated1", history[0].HydratedSHA)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, history[1].Phase)
+	assert.Equal(t, "error", history[1].Message)
+	assert.Equal(t, "hydrated2", history[2].HydratedSHA)
+
+	// The history is bounded by the revision history limit.
+	addHydrateHistory(app, failed("dry3", "error"))
+	require.Len(t, app.Status.SourceHydrator.History, 3)
+	assert.Equal(t, int64(1), app.Status.SourceHydrator.History[0].ID)
+	assert.Equal(t, int64(3), app.Status.SourceHydrator.History[2].ID)
+}
+
+func TestProcessHydrationQueueItem_ManifestValidationFails(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app1 := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app2 := newTestApp("test-app-2")
+	app2.Spec.SourceHydrator.SyncSource.Path = "something/else"
+	app2 = setTestAppPhase(app2, v1alpha1.HydrateOperationPhaseHydrating)
+	hydrationKey := getHydrationQueueKey(app1)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app1, *app2}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
+		Revision: "abc123",
+	}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil).Once()
+	validationErr := status.Error(codes.FailedPrecondition, `manifest validation failed: stage "no-secrets" failed for path "test": Secret test is denied`)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(nil, validationErr).Once()
+
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Return().Twice()
+	validationMessages := map[string]string{}
+	d.EXPECT().PersistAppHydratorValidationError(mock.Anything, mock.Anything).Run(func(orig *v1alpha1.Application, message string) {
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, orig.Status.SourceHydrator.CurrentOperation.Phase)
+		validationMessages[orig.Name] = message
+	}).Return().Twice()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+
+	expected := `manifest validation failed: stage "no-secrets" failed for path "test": Secret test is denied`
+	assert.Equal(t, map[string]string{app1.Name: expected, app2.Name: expected}, validationMessages)
+	d.AssertNotCalled(t, "RequestAppRefresh", mock.Anything, mock.Anything)
+}
+
+func TestProcessHydrationQueueItem_SuccessfulHydrationClearsValidationError(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionHydratorValidationError, Message: "manifest validation failed"}}
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
+		Revision: "abc123",
+	}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil).Once()
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil).Once()
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Return().Once()
+	d.EXPECT().PersistAppHydratorValidationError(mock.Anything, "").Return().Once()
+	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
 }
 
 func TestValidateApplications_ProjectError(t *testing.T) {
@@ -795,8 +1008,8 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
-	d.EXPECT().GetRepoObjs(mock.Anything, app2, app2.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app2, []v1alpha1.ApplicationSource{app2.Spec.SourceHydrator.GetDrySource()}, []string{"sha123"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil).Run(func(_ context.Context, in *repoclient.RepoServerRevisionMetadataRequest, _ ...grpc.CallOption) {
 		assert.Equal(t, readRepo, in.Repo)
@@ -804,6 +1017,15 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	})
 	d.EXPECT().GetWriteCredentials(mock.Anything, readRepo.Repo, proj.Name).Return(writeRepo, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	// Apps hydrating to the same branch from another dry revision are active too, apps hydrating elsewhere are not.
+	otherRevisionApp := newTestApp("other-revision")
+	otherRevisionApp.Spec.SourceHydrator.DrySource.TargetRevision = "release"
+	otherRevisionApp.Spec.SourceHydrator.SyncSource.Path = "release-path"
+	otherBranchApp := newTestApp("other-branch")
+	otherBranchApp.Spec.SourceHydrator.HydrateTo.TargetBranch = "other-branch"
+	otherBranchApp.Spec.SourceHydrator.SyncSource.Path = "other-branch-path"
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(true, nil)
+	d.EXPECT().GetAllApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app1, *app2, *otherRevisionApp, *otherBranchApp}}, nil)
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated123"}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
 		assert.Equal(t, "commit message", in.CommitMessage)
 		assert.Equal(t, "hydrated", in.SyncBranch)
@@ -814,10 +1036,11 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 		assert.Equal(t, app1.Spec.SourceHydrator.SyncSource.Path, in.Paths[0].Path)
 		assert.Equal(t, app2.Spec.SourceHydrator.SyncSource.Path, in.Paths[1].Path)
 		assert.Equal(t, "metadata", in.DryCommitMetadata.Message)
+		assert.Equal(t, []string{app1.Spec.SourceHydrator.SyncSource.Path, "other-path", "release-path"}, in.ActivePaths)
 	})
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -825,6 +1048,26 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	assert.Empty(t, errs)
 }
 
+func TestGetActivePaths_AppOfOtherShard(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	app := newTestApp("app")
+	// The app is processed by another controller shard, because it is deployed to another cluster, but it hydrates to the
+	// same branch, so its path must not be pruned.
+	otherShardApp := newTestApp("other-shard")
+	otherShardApp.Spec.Destination.Server = "https://other-cluster"
+	otherShardApp.Spec.SourceHydrator.SyncSource.Path = "other-shard-path"
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(true, nil)
+	d.EXPECT().GetAllApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app, *otherShardApp}}, nil)
+
+	paths, err := getActivePaths(d, app)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app", "other-shard-path"}, paths)
+	d.AssertNotCalled(t, "GetProcessableApps")
+}
+
 func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	t.Parallel()
 
@@ -846,7 +1089,7 @@ func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, nil, errors.New("manifests error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -873,12 +1116,12 @@ func TestHydrator_hydrate_RevisionMetadataError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, errors.New("metadata error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -905,13 +1148,13 @@ func TestHydrator_hydrate_GetWriteCredentialsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("creds error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -938,14 +1181,14 @@ func TestHydrator_hydrate_CommitMessageTemplateError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("", errors.New("template error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -972,14 +1215,14 @@ func TestHydrator_hydrate_TemplatedCommitMessageError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("{{ notAFunction }} template", nil)
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1006,15 +1249,16 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil)
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("commit error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1029,7 +1273,7 @@ func TestHydrator_hydrate_EmptyApps(t *testing.T) {
 	logCtx := log.NewEntry(log.StandardLogger())
 	h := &Hydrator{dependencies: d}
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -1050,20 +1294,52 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 		},
 	})
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return([]*unstructured.Unstructured{cm}, &repoclient.ManifestResponse{
-		Revision: "sha123",
-		Commands: []string{"cmd1", "cmd2"},
-	}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"sha123"}, proj).Return([]*unstructured.Unstructured{cm}, []*repoclient.ManifestResponse{{
+		Revision:     "sha123",
+		Commands:     []string{"cmd1", "cmd2"},
+		ToolVersions: map[string]string{"helm": "v3.18.4"},
+	}}, nil)
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
+	rev, pathDetails, err := getManifests(t.Context(), h.dependencies, app, "sha123", proj)
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", rev)
 	assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Path, pathDetails.Path)
 	assert.Equal(t, []string{"cmd1", "cmd2"}, pathDetails.Commands)
+	assert.Equal(t, map[string]string{"helm": "v3.18.4"}, pathDetails.ToolVersions)
+	assert.Equal(t, &app.Spec.SourceHydrator.DrySource, pathDetails.DrySource)
 	assert.Len(t, pathDetails.Manifests, 1)
 	assert.JSONEq(t, `{"metadata":{"name":"test"}}`, pathDetails.Manifests[0].ManifestJSON)
 }
 
+func TestHydrator_getManifests_MultipleDrySources(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Ref = "values"
+	app.Spec.SourceHydrator.DrySources = []v1alpha1.DrySource{{
+		RepoURL:        "https://charts.example.com",
+		TargetRevision: "1.*",
+		Chart:          "guestbook",
+		Helm:           &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"$values/base/app/values.yaml"}},
+	}}
+	proj := newTestProject()
+
+	// The DrySource is pinned to the given revision, the additional dry sources are rendered at their target revision.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource(app.Spec.SourceHydrator.GetDrySources()), []string{"sha123", "1.*"}, proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{
+		{Revision: "sha123"},
+		{Revision: "1.2.3", Commands: []string{"helm template . --values values.yaml"}, ToolVersions: map[string]string{"helm": "v3.18.4"}},
+	}, nil)
+
+	rev, pathDetails, err := getManifests(t.Context(), h.dependencies, app, "sha123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", rev)
+	assert.Equal(t, []string{"helm template . --values values.yaml"}, pathDetails.Commands)
+	assert.Equal(t, map[string]string{"helm": "v3.18.4"}, pathDetails.ToolVersions)
+	assert.Equal(t, []*v1alpha1.DrySource{&app.Spec.SourceHydrator.DrySources[0]}, pathDetails.DrySources)
+	assert.Equal(t, []string{"sha123", "1.2.3"}, pathDetails.DryRevisions)
+}
+
 func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	t.Parallel()
 	d := mocks.NewDependencies(t)
@@ -1071,9 +1347,9 @@ func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	app := newTestApp("test-app")
 	proj := newTestProject()
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return([]*unstructured.Unstructured{}, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, []string{"main"}, proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "", proj)
+	rev, pathDetails, err := getManifests(t.Context(), h.dependencies, app, "", proj)
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", rev)
 	assert.NotNil(t, pathDetails)
@@ -1086,9 +1362,9 @@ func TestHydrator_getManifests_GetRepoObjsError(t *testing.T) {
 	app := newTestApp("test-app")
 	proj := newTestProject()
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return(nil, nil, errors.New("repo error"))
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, []string{"main"}, proj).Return(nil, nil, errors.New("repo error"))
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "main", proj)
+	rev, pathDetails, err := getManifests(t.Context(), h.dependencies, app, "main", proj)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "repo error")
 	assert.Empty(t, rev)
@@ -1117,13 +1393,239 @@ func TestHydrator_hydrate_DeDupe_Success(t *testing.T) {
 
 	// Asserting .Once() confirms that we only make one call to repo-server to get the last hydrated DRY
 	// sha, and then we quit early.
-	d.On("GetRepoObjs", mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil).Once()
+	d.On("GetRepoObjs", mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, _, hydratedSha, _, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
 	assert.Equal(t, "hydrated123", hydratedSha)
 	assert.Empty(t, errs)
 }
+
+func TestHydrator_hydrate_DeDupe_DryRevisionsChanged(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.DrySources = []v1alpha1.DrySource{{RepoURL: "https://charts.example.com", TargetRevision: "1.*", Chart: "guestbook"}}
+	app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+		LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:       "sha123",
+			HydratedSHA:  "hydrated123",
+			DryRevisions: []string{"sha123", "1.2.3"},
+		},
+	}
+	proj := newTestProject()
+
+	// The dry commit was already hydrated, but a new chart version was released since, so the app is hydrated again.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}, {Revision: "1.2.4"}}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+	rg := mocks.NewRepoGetter(t)
+	rg.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
+	h.repoGetter = rg
+	h.repoClientset = &reposervermocks.Clientset{RepoServerServiceClient: rc}
+
+	sha, dryRevisions, _, _, errs, err := h.hydrate(log.NewEntry(log.StandardLogger()), []*v1alpha1.Application{app}, map[string]*v1alpha1.AppProject{app.Spec.Project: proj})
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, errs)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, map[string][]string{app.QualifiedName(): {"sha123", "1.2.4"}}, dryRevisions)
+}
+
+func TestHydrator_hydrate_DeDupe_OtherAppDryRevisions(t *testing.T) {
+	t.Parallel()
+
+	lastSuccessfulOperation := &v1alpha1.SuccessfulHydrateOperation{DrySHA: "sha123", HydratedSHA: "hydrated123"}
+	newApps := func() []*v1alpha1.Application {
+		app1 := newTestApp("app1")
+		app1.Status.SourceHydrator.LastSuccessfulOperation = lastSuccessfulOperation.DeepCopy()
+		app2 := newTestApp("app2")
+		app2.Spec.SourceHydrator.SyncSource.Path = "app2"
+		app2.Spec.SourceHydrator.DrySources = []v1alpha1.DrySource{{RepoURL: "https://charts.example.com", TargetRevision: "1.*", Chart: "guestbook"}}
+		app2.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{DrySHA: "sha123", HydratedSHA: "hydrated123", DryRevisions: []string{"sha123", "1.2.3"}}
+		return []*v1alpha1.Application{app1, app2}
+	}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{proj.Name: proj}
+
+	t.Run("skips hydration if the revisions of every app were hydrated", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		apps := newApps()
+
+		d.EXPECT().GetRepoObjs(mock.Anything, apps[0], mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
+		d.EXPECT().GetRepoObjs(mock.Anything, apps[1], mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}, {Revision: "1.2.3"}}, nil).Once()
+
+		sha, dryRevisions, hydratedSha, _, errs, err := h.hydrate(log.NewEntry(log.StandardLogger()), apps, projects)
+
+		require.NoError(t, err)
+		assert.Empty(t, errs)
+		assert.Equal(t, "sha123", sha)
+		assert.Equal(t, "hydrated123", hydratedSha)
+		assert.Equal(t, map[string][]string{apps[1].QualifiedName(): {"sha123", "1.2.3"}}, dryRevisions)
+	})
+
+	t.Run("hydrates if the revisions of another app changed", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		apps := newApps()
+
+		d.EXPECT().GetRepoObjs(mock.Anything, apps[0], mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
+		d.EXPECT().GetRepoObjs(mock.Anything, apps[1], mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}, {Revision: "1.2.4"}}, nil).Once()
+		// The commit request is built, which fails early on the credentials.
+		rg := mocks.NewRepoGetter(t)
+		rg.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
+		rc := reposervermocks.NewRepoServerServiceClient(t)
+		rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
+		h.repoGetter = rg
+		h.repoClientset = &reposervermocks.Clientset{RepoServerServiceClient: rc}
+		d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		_, _, _, _, _, err := h.hydrate(log.NewEntry(log.StandardLogger()), apps, projects)
+
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestHydrator_hydrate_PullRequest(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+	repo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	pullRequest := &v1alpha1.HydratePullRequestStatus{Number: 42, URL: "https://example.com/repo/pull/42", State: v1alpha1.HydratePullRequestStateOpen}
+	previousPullRequest := &v1alpha1.HydratePullRequestStatus{Number: 41, URL: "https://example.com/repo/pull/41", State: v1alpha1.HydratePullRequestStateOpen}
+	app.Status.SourceHydrator.PullRequest = previousPullRequest
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated123", PullRequest: pullRequest}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, app.Spec.SourceHydrator.HydrateTo.PullRequest, in.PullRequest)
+		assert.Equal(t, previousPullRequest, in.PreviousPullRequest)
+	})
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, _, hydratedSha, promotion, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "hydrated123", hydratedSha)
+	assert.Equal(t, &promotionStatus{pullRequest: pullRequest}, promotion)
+	assert.Empty(t, errs)
+}
+
+func Test_getPullRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	openPullRequest := &v1alpha1.HydratePullRequestStatus{Number: 42, URL: "https://example.com/repo/pull/42", State: v1alpha1.HydratePullRequestStateOpen}
+	mergedPullRequest := &v1alpha1.HydratePullRequestStatus{Number: 42, URL: "https://example.com/repo/pull/42", State: v1alpha1.HydratePullRequestStateMerged}
+
+	tests := []struct {
+		name           string
+		configured     bool
+		previous       *v1alpha1.HydratePullRequestStatus
+		reported       *v1alpha1.HydratePullRequestStatus
+		expectedStatus *v1alpha1.HydratePullRequestStatus
+	}{
+		{name: "not configured", configured: false, previous: openPullRequest, reported: nil, expectedStatus: nil},
+		{name: "newly opened", configured: true, previous: nil, reported: openPullRequest, expectedStatus: openPullRequest},
+		{name: "still open", configured: true, previous: openPullRequest, reported: openPullRequest, expectedStatus: openPullRequest},
+		{name: "merged", configured: true, previous: openPullRequest, reported: mergedPullRequest, expectedStatus: mergedPullRequest},
+		{name: "never opened", configured: true, previous: nil, reported: nil, expectedStatus: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := newTestApp("app")
+			if tt.configured {
+				app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.PullRequestProviderGitHub}
+			}
+			app.Status.SourceHydrator.PullRequest = tt.previous
+			assert.Equal(t, tt.expectedStatus, getPullRequestStatus(app, tt.reported))
+		})
+	}
+}
+
+func TestHydrator_hydrate_OCI(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+	app.Spec.SourceHydrator.SyncSource.TargetBranch = "env-dev"
+	app.Spec.SourceHydrator.HydrateTo = nil
+	proj := newTestProject()
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	writeRepo := &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated", Type: "oci"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	// The revision metadata is read from the dry source, while the credentials are those of the OCI repository.
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, writeRepo.Repo, proj.Name).Return(writeRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "sha256:abc"}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, writeRepo, in.Repo)
+		// OCI artifacts only contain the hydrated paths, so there is nothing to prune.
+		assert.Empty(t, in.ActivePaths)
+		assert.Equal(t, readRepo.Repo, in.DryRepoURL)
+		assert.Equal(t, "env-dev", in.TargetBranch)
+	})
+
+	sha, _, hydratedSha, _, errs, err := h.hydrate(log.NewEntry(log.StandardLogger()), []*v1alpha1.Application{app}, map[string]*v1alpha1.AppProject{app.Spec.Project: proj})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "sha256:abc", hydratedSha)
+	assert.Empty(t, errs)
+}
+
+func Test_getHydrationQueueKey_OCI(t *testing.T) {
+	t.Parallel()
+
+	gitApp := newTestApp("git")
+	gitApp.Spec.SourceHydrator.HydrateTo = nil
+	ociApp := newTestApp("oci")
+	ociApp.Spec.SourceHydrator.HydrateTo = nil
+	ociApp.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+
+	assert.Empty(t, getHydrationQueueKey(gitApp).DestinationRepoURL)
+	assert.Equal(t, "oci://registry.example.com/hydrated", getHydrationQueueKey(ociApp).DestinationRepoURL)
+	assert.NotEqual(t, getHydrationQueueKey(gitApp), getHydrationQueueKey(ociApp))
+}
@@ -81,6 +81,61 @@ func (_c *Dependencies_AddHydrationQueueItem_Call) RunAndReturn(run func(key typ
 	return _c
 }
 
+// GetAllApps provides a mock function for the type Dependencies
+func (_mock *Dependencies) GetAllApps() (*v1alpha1.ApplicationList, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllApps")
+	}
+
+	var r0 *v1alpha1.ApplicationList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*v1alpha1.ApplicationList, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *v1alpha1.ApplicationList); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ApplicationList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_GetAllApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllApps'
+type Dependencies_GetAllApps_Call struct {
+	*mock.Call
+}
+
+// GetAllApps is a helper method to define mock.On call
+func (_e *Dependencies_Expecter) GetAllApps() *Dependencies_GetAllApps_Call {
+	return &Dependencies_GetAllApps_Call{Call: _e.mock.On("GetAllApps")}
+}
+
+func (_c *Dependencies_GetAllApps_Call) Run(run func()) *Dependencies_GetAllApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Dependencies_GetAllApps_Call) Return(applicationList *v1alpha1.ApplicationList, err error) *Dependencies_GetAllApps_Call {
+	_c.Call.Return(applicationList, err)
+	return _c
+}
+
+func (_c *Dependencies_GetAllApps_Call) RunAndReturn(run func() (*v1alpha1.ApplicationList, error)) *Dependencies_GetAllApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHydratorCommitMessageTemplate provides a mock function for the type Dependencies
 func (_mock *Dependencies) GetHydratorCommitMessageTemplate() (string, error) {
 	ret := _mock.Called()
@@ -134,6 +189,59 @@ func (_c *Dependencies_GetHydratorCommitMessageTemplate_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetHydratorPruneOrphanedPaths provides a mock function for the type Dependencies
+func (_mock *Dependencies) GetHydratorPruneOrphanedPaths() (bool, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHydratorPruneOrphanedPaths")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (bool, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_GetHydratorPruneOrphanedPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratorPruneOrphanedPaths'
+type Dependencies_GetHydratorPruneOrphanedPaths_Call struct {
+	*mock.Call
+}
+
+// GetHydratorPruneOrphanedPaths is a helper method to define mock.On call
+func (_e *Dependencies_Expecter) GetHydratorPruneOrphanedPaths() *Dependencies_GetHydratorPruneOrphanedPaths_Call {
+	return &Dependencies_GetHydratorPruneOrphanedPaths_Call{Call: _e.mock.On("GetHydratorPruneOrphanedPaths")}
+}
+
+func (_c *Dependencies_GetHydratorPruneOrphanedPaths_Call) Run(run func()) *Dependencies_GetHydratorPruneOrphanedPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Dependencies_GetHydratorPruneOrphanedPaths_Call) Return(b bool, err error) *Dependencies_GetHydratorPruneOrphanedPaths_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Dependencies_GetHydratorPruneOrphanedPaths_Call) RunAndReturn(run func() (bool, error)) *Dependencies_GetHydratorPruneOrphanedPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProcessableAppProj provides a mock function for the type Dependencies
 func (_mock *Dependencies) GetProcessableAppProj(app *v1alpha1.Application) (*v1alpha1.AppProject, error) {
 	ret := _mock.Called(app)
@@ -252,35 +360,35 @@ func (_c *Dependencies_GetProcessableApps_Call) RunAndReturn(run func() (*v1alph
 }
 
 // GetRepoObjs provides a mock function for the type Dependencies
-func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	ret := _mock.Called(ctx, app, source, revision, project)
+func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	ret := _mock.Called(ctx, app, sources, revisions, project)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRepoObjs")
 	}
 
 	var r0 []*unstructured.Unstructured
-	var r1 *apiclient.ManifestResponse
+	var r1 []*apiclient.ManifestResponse
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)); ok {
-		return returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)); ok {
+		return returnFunc(ctx, app, sources, revisions, project)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
-		r0 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
+		r0 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*unstructured.Unstructured)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) *apiclient.ManifestResponse); ok {
-		r1 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) []*apiclient.ManifestResponse); ok {
+		r1 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*apiclient.ManifestResponse)
+			r1 = ret.Get(1).([]*apiclient.ManifestResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) error); ok {
-		r2 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) error); ok {
+		r2 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -295,14 +403,14 @@ type Dependencies_GetRepoObjs_Call struct {
 // GetRepoObjs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - app *v1alpha1.Application
-//   - source v1alpha1.ApplicationSource
-//   - revision string
+//   - sources []v1alpha1.ApplicationSource
+//   - revisions []string
 //   - project *v1alpha1.AppProject
-func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, source interface{}, revision interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
-	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, source, revision, project)}
+func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, sources interface{}, revisions interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
+	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, sources, revisions, project)}
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -312,13 +420,13 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 		if args[1] != nil {
 			arg1 = args[1].(*v1alpha1.Application)
 		}
-		var arg2 v1alpha1.ApplicationSource
+		var arg2 []v1alpha1.ApplicationSource
 		if args[2] != nil {
-			arg2 = args[2].(v1alpha1.ApplicationSource)
+			arg2 = args[2].([]v1alpha1.ApplicationSource)
 		}
-		var arg3 string
+		var arg3 []string
 		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg3 = args[3].([]string)
 		}
 		var arg4 *v1alpha1.AppProject
 		if args[4] != nil {
@@ -335,12 +443,12 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponse *apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
-	_c.Call.Return(unstructureds, manifestResponse, err)
+func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponses []*apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
+	_c.Call.Return(unstructureds, manifestResponses, err)
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -419,6 +527,52 @@ func (_c *Dependencies_GetWriteCredentials_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// PersistAppHydrateRollback provides a mock function for the type Dependencies
+func (_mock *Dependencies) PersistAppHydrateRollback(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+	_mock.Called(orig, newStatus)
+	return
+}
+
+// Dependencies_PersistAppHydrateRollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistAppHydrateRollback'
+type Dependencies_PersistAppHydrateRollback_Call struct {
+	*mock.Call
+}
+
+// PersistAppHydrateRollback is a helper method to define mock.On call
+//   - orig *v1alpha1.Application
+//   - newStatus *v1alpha1.SourceHydratorStatus
+func (_e *Dependencies_Expecter) PersistAppHydrateRollback(orig interface{}, newStatus interface{}) *Dependencies_PersistAppHydrateRollback_Call {
+	return &Dependencies_PersistAppHydrateRollback_Call{Call: _e.mock.On("PersistAppHydrateRollback", orig, newStatus)}
+}
+
+func (_c *Dependencies_PersistAppHydrateRollback_Call) Run(run func(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus)) *Dependencies_PersistAppHydrateRollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Application
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Application)
+		}
+		var arg1 *v1alpha1.SourceHydratorStatus
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.SourceHydratorStatus)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydrateRollback_Call) Return() *Dependencies_PersistAppHydrateRollback_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydrateRollback_Call) RunAndReturn(run func(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus)) *Dependencies_PersistAppHydrateRollback_Call {
+	_c.Run(run)
+	return _c
+}
+
 // PersistAppHydratorStatus provides a mock function for the type Dependencies
 func (_mock *Dependencies) PersistAppHydratorStatus(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
 	_mock.Called(orig, newStatus)
@@ -465,6 +619,52 @@ func (_c *Dependencies_PersistAppHydratorStatus_Call) RunAndReturn(run func(orig
 	return _c
 }
 
+// PersistAppHydratorValidationError provides a mock function for the type Dependencies
+func (_mock *Dependencies) PersistAppHydratorValidationError(orig *v1alpha1.Application, message string) {
+	_mock.Called(orig, message)
+	return
+}
+
+// Dependencies_PersistAppHydratorValidationError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistAppHydratorValidationError'
+type Dependencies_PersistAppHydratorValidationError_Call struct {
+	*mock.Call
+}
+
+// PersistAppHydratorValidationError is a helper method to define mock.On call
+//   - orig *v1alpha1.Application
+//   - message string
+func (_e *Dependencies_Expecter) PersistAppHydratorValidationError(orig interface{}, message interface{}) *Dependencies_PersistAppHydratorValidationError_Call {
+	return &Dependencies_PersistAppHydratorValidationError_Call{Call: _e.mock.On("PersistAppHydratorValidationError", orig, message)}
+}
+
+func (_c *Dependencies_PersistAppHydratorValidationError_Call) Run(run func(orig *v1alpha1.Application, message string)) *Dependencies_PersistAppHydratorValidationError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Application
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Application)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydratorValidationError_Call) Return() *Dependencies_PersistAppHydratorValidationError_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydratorValidationError_Call) RunAndReturn(run func(orig *v1alpha1.Application, message string)) *Dependencies_PersistAppHydratorValidationError_Call {
+	_c.Run(run)
+	return _c
+}
+
 // RequestAppRefresh provides a mock function for the type Dependencies
 func (_mock *Dependencies) RequestAppRefresh(appName string, appNamespace string) error {
 	ret := _mock.Called(appName, appNamespace)
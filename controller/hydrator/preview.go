@@ -0,0 +1,78 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// Previewer renders the hydrated manifests of an application and compares them to the current contents of its
+// hydrated branch, without committing anything. It follows the same path as the Hydrator, but only needs the
+// ManifestDependencies, so it can be used outside the app controller.
+type Previewer struct {
+	dependencies    ManifestDependencies
+	commitClientset commitclient.Clientset
+	repoClientset   apiclient.Clientset
+	repoGetter      RepoGetter
+}
+
+// NewPreviewer creates a new Previewer instance with the given dependencies, commit clientset, repo clientset, and repo
+// getter.
+func NewPreviewer(dependencies ManifestDependencies, commitClientset commitclient.Clientset, repoClientset apiclient.Clientset, repoGetter RepoGetter) *Previewer {
+	return &Previewer{
+		dependencies:    dependencies,
+		commitClientset: commitClientset,
+		repoClientset:   repoClientset,
+		repoGetter:      repoGetter,
+	}
+}
+
+// Preview hydrates the given application at the given dry revision and returns the resolved dry SHA along with the
+// diff of each hydrated file that would change on the hydrated branch, and the orphaned paths which would be removed
+// from it. If revision is empty, the target revision of the app's dry source is used. The data of Secrets and the
+// values of the given sensitive annotations are hidden from the diff.
+//
+// Only the given application is hydrated. Other applications hydrating to the same branch are left untouched, so their
+// files never show up in the diff, unless their paths are orphaned.
+func (p *Previewer) Preview(ctx context.Context, app *appv1.Application, project *appv1.AppProject, revision string, sensitiveAnnotations map[string]bool) (string, *commitclient.DiffHydratedManifestsResponse, error) {
+	if app.Spec.SourceHydrator == nil {
+		return "", nil, errors.New("application does not use the source hydrator")
+	}
+	if IsRootPath(app.Spec.SourceHydrator.SyncSource.Path) {
+		return "", nil, fmt.Errorf("app is configured to hydrate to the repository root (branch %q, path %q) which is not allowed", app.Spec.GetHydrateToSource().TargetRevision, app.Spec.SourceHydrator.SyncSource.Path)
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+
+	drySHA, pathDetails, err := getManifests(ctx, p.dependencies, app, revision, project)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get manifests: %w", err)
+	}
+	logCtx = logCtx.WithField("drySha", drySHA)
+
+	manifestsRequest, err := getCommitRequest(ctx, logCtx, p.dependencies, p.repoGetter, p.repoClientset, app, app.Spec.Project, drySHA, []*commitclient.PathDetails{pathDetails})
+	if err != nil {
+		return drySHA, nil, err
+	}
+	manifestsRequest.SensitiveAnnotations = slices.Sorted(maps.Keys(sensitiveAnnotations))
+
+	closer, commitService, err := p.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return drySHA, nil, fmt.Errorf("failed to create commit service: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.DiffHydratedManifests(ctx, manifestsRequest)
+	if err != nil {
+		return drySHA, nil, fmt.Errorf("failed to diff hydrated manifests: %w", err)
+	}
+	return drySHA, resp, nil
+}
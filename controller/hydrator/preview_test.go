@@ -0,0 +1,99 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	reposervermocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+func TestPreviewer_Preview_Success(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	p := NewPreviewer(d, &commitservermocks.Clientset{CommitServiceClient: cc}, &reposervermocks.Clientset{RepoServerServiceClient: rc}, r)
+
+	app := newTestApp("app1")
+	proj := newTestProject()
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	files := []*commitclient.HydratedFileDiff{{Path: "app/manifest.yaml", Diff: "diff"}}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"feature"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, readRepo.Repo, proj.Name).Return(writeRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(true, nil)
+	d.EXPECT().GetAllApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	cc.EXPECT().DiffHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.DiffHydratedManifestsResponse{Files: files, OrphanedPaths: []string{"deleted"}}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, "hydrated", in.SyncBranch)
+		assert.Equal(t, "hydrated-next", in.TargetBranch)
+		assert.Equal(t, "sha123", in.DrySha)
+		assert.Equal(t, writeRepo, in.Repo)
+		require.Len(t, in.Paths, 1)
+		assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Path, in.Paths[0].Path)
+		assert.Equal(t, []string{app.Spec.SourceHydrator.SyncSource.Path}, in.ActivePaths)
+		assert.Equal(t, []string{"a", "b"}, in.SensitiveAnnotations)
+	})
+
+	drySHA, diff, err := p.Preview(t.Context(), app, proj, "feature", map[string]bool{"b": true, "a": true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", drySHA)
+	assert.Equal(t, files, diff.Files)
+	assert.Equal(t, []string{"deleted"}, diff.OrphanedPaths)
+}
+
+func TestPreviewer_Preview_NoSourceHydrator(t *testing.T) {
+	t.Parallel()
+
+	p := NewPreviewer(mocks.NewDependencies(t), nil, nil, mocks.NewRepoGetter(t))
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator = nil
+
+	_, _, err := p.Preview(t.Context(), app, newTestProject(), "", nil)
+
+	require.ErrorContains(t, err, "application does not use the source hydrator")
+}
+
+func TestPreviewer_Preview_DiffError(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	p := NewPreviewer(d, &commitservermocks.Clientset{CommitServiceClient: cc}, &reposervermocks.Clientset{RepoServerServiceClient: rc}, r)
+
+	app := newTestApp("app1")
+	proj := newTestProject()
+	repo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorPruneOrphanedPaths().Return(false, nil)
+	cc.EXPECT().DiffHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("diff error"))
+
+	drySHA, _, err := p.Preview(t.Context(), app, proj, "", nil)
+
+	require.ErrorContains(t, err, "diff error")
+	assert.Equal(t, "sha123", drySHA)
+}
@@ -0,0 +1,136 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// Rollbacker restores the hydrated manifests of an application to the ones of a previous hydrate operation, by
+// committing them to the branch it hydrates to. Like the Previewer, it only needs the ManifestDependencies, so it can be used
+// outside the app controller.
+type Rollbacker struct {
+	dependencies    ManifestDependencies
+	commitClientset commitclient.Clientset
+}
+
+// NewRollbacker creates a new Rollbacker instance with the given dependencies and commit clientset.
+func NewRollbacker(dependencies ManifestDependencies, commitClientset commitclient.Clientset) *Rollbacker {
+	return &Rollbacker{
+		dependencies:    dependencies,
+		commitClientset: commitClientset,
+	}
+}
+
+// RollbackRecord is the outcome of a rollback. The API server hands it over to the app controller, which records it in
+// the hydrate history of the application along with the status it persists for the hydrator, so that the rollback is
+// not lost to a concurrent hydration.
+type RollbackRecord struct {
+	// History is the hydrate history entry of the rollback. Its ID is assigned when it is recorded.
+	History appv1.HydrateHistory `json:"history"`
+	// PullRequest is the pull request promoting the rollback, as reported by the commit server
+	PullRequest *appv1.HydratePullRequestStatus `json:"pullRequest,omitempty"`
+}
+
+// Rollback restores the hydrated path of the given application to its contents at the hydrated commit of the given
+// hydrate history entry. Like a hydration, the restored manifests are committed on top of the branch the application
+// hydrates to, which is then promoted to the sync branch through a pull request if one is configured, so the rollback
+// is itself part of the branch history. username is the user initiating the rollback, and is recorded in the commit
+// message. It returns the hydrate history entry recording the rollback.
+//
+// Only the path of the given application is restored. Other applications hydrating to the same branch are left
+// untouched. The rollback holds until the next dry commit is hydrated.
+func (r *Rollbacker) Rollback(ctx context.Context, app *appv1.Application, history *appv1.HydrateHistory, username string) (*RollbackRecord, error) {
+	if app.Spec.SourceHydrator == nil {
+		return nil, errors.New("application does not use the source hydrator")
+	}
+	if app.Spec.SourceHydrator.SyncSource.IsOCI() {
+		return nil, errors.New("rolling back is not supported when hydrating to an OCI repository")
+	}
+	syncSource := app.Spec.SourceHydrator.SyncSource
+	if IsRootPath(syncSource.Path) {
+		return nil, fmt.Errorf("app is configured to hydrate to the repository root (branch %q, path %q) which is not allowed", syncSource.TargetBranch, syncSource.Path)
+	}
+	if history.Phase != appv1.HydrateOperationPhaseHydrated || history.HydratedSHA == "" {
+		return nil, fmt.Errorf("hydrate history %d did not produce a hydrated commit", history.ID)
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app)).WithField("hydratedSha", history.HydratedSHA)
+	startedAt := metav1.Now()
+
+	hydrateTo := app.Spec.GetHydrateToSource()
+	repo, err := r.dependencies.GetWriteCredentials(ctx, hydrateTo.RepoURL, app.Spec.Project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Try without credentials.
+		repo = &appv1.Repository{
+			Repo: hydrateTo.RepoURL,
+		}
+		logCtx.Warn("no credentials found for repo, continuing without credentials")
+	}
+
+	request := &commitclient.RevertHydratedManifestsRequest{
+		Repo:          repo,
+		SyncBranch:    syncSource.TargetBranch,
+		TargetBranch:  hydrateTo.TargetRevision,
+		Path:          syncSource.Path,
+		HydratedSha:   history.HydratedSHA,
+		CommitMessage: getRollbackCommitMessage(syncSource.Path, history, username),
+	}
+	if app.Spec.SourceHydrator.HydrateTo != nil && app.Spec.SourceHydrator.HydrateTo.PullRequest != nil {
+		request.PullRequest = app.Spec.SourceHydrator.HydrateTo.PullRequest
+		request.PreviousPullRequest = app.Status.SourceHydrator.PullRequest
+	}
+
+	closer, commitService, err := r.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit service: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.RevertHydratedManifests(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert hydrated manifests: %w", err)
+	}
+
+	message := fmt.Sprintf("Rolled back to hydrate history %d", history.ID)
+	if resp.PromotionError != "" {
+		// Like for a hydration, the restored manifests were pushed, so the rollback did not fail.
+		logCtx.Errorf("Failed to promote restored manifests: %s", resp.PromotionError)
+		message += ". Restored manifests were pushed, but " + resp.PromotionError
+	}
+	finishedAt := metav1.Now()
+	return &RollbackRecord{
+		History: appv1.HydrateHistory{
+			StartedAt:      startedAt,
+			FinishedAt:     &finishedAt,
+			Phase:          appv1.HydrateOperationPhaseHydrated,
+			Message:        message,
+			DrySHA:         history.DrySHA,
+			HydratedSHA:    resp.HydratedSha,
+			SourceHydrator: app.Spec.SourceHydrator.Record(),
+			RollbackID:     ptr.To(history.ID),
+			InitiatedBy:    appv1.OperationInitiator{Username: username},
+		},
+		PullRequest: resp.PullRequest,
+	}, nil
+}
+
+// getRollbackCommitMessage returns the message of the commit restoring the given path to the given hydrate history
+// entry.
+func getRollbackCommitMessage(path string, history *appv1.HydrateHistory, username string) string {
+	message := fmt.Sprintf("Roll back %s to hydrated commit %s\n\nRestore the manifests hydrated from dry commit %s.", path, history.HydratedSHA, history.DrySHA)
+	if username != "" {
+		message += "\n\nInitiated by: " + username
+	}
+	return message
+}
@@ -0,0 +1,127 @@
+package hydrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/utils/ptr"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRollbacker_Rollback_Success(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	r := NewRollbacker(d, &commitservermocks.Clientset{CommitServiceClient: cc})
+
+	app := newTestApp("app1")
+	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	history := &v1alpha1.HydrateHistory{ID: 3, Phase: v1alpha1.HydrateOperationPhaseHydrated, DrySHA: "dry123", HydratedSHA: "hydrated123"}
+
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(writeRepo, nil)
+	cc.EXPECT().RevertHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.RevertHydratedManifestsResponse{HydratedSha: "revert123"}, nil).Run(func(_ context.Context, in *commitclient.RevertHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, writeRepo, in.Repo)
+		// Like a hydration, the rollback is committed to the branch the hydrator commits to.
+		assert.Equal(t, "hydrated-next", in.TargetBranch)
+		assert.Equal(t, "hydrated", in.SyncBranch)
+		assert.Nil(t, in.PullRequest)
+		assert.Equal(t, "app", in.Path)
+		assert.Equal(t, "hydrated123", in.HydratedSha)
+		assert.Equal(t, "Roll back app to hydrated commit hydrated123\n\nRestore the manifests hydrated from dry commit dry123.\n\nInitiated by: admin", in.CommitMessage)
+	})
+
+	record, err := r.Rollback(t.Context(), app, history, "admin")
+
+	require.NoError(t, err)
+	assert.Equal(t, "revert123", record.History.HydratedSHA)
+	assert.Equal(t, "dry123", record.History.DrySHA)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, record.History.Phase)
+	assert.Equal(t, "Rolled back to hydrate history 3", record.History.Message)
+	assert.Equal(t, ptr.To(int64(3)), record.History.RollbackID)
+	assert.Equal(t, "admin", record.History.InitiatedBy.Username)
+	assert.NotNil(t, record.History.FinishedAt)
+	assert.Nil(t, record.PullRequest)
+}
+
+func TestRollbacker_Rollback_PullRequest(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	r := NewRollbacker(d, &commitservermocks.Clientset{CommitServiceClient: cc})
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydrateToPullRequest{}
+	app.Status.SourceHydrator.PullRequest = &v1alpha1.HydratePullRequestStatus{Number: 1, State: v1alpha1.HydratePullRequestStateOpen}
+	pullRequest := &v1alpha1.HydratePullRequestStatus{Number: 2, State: v1alpha1.HydratePullRequestStateOpen}
+
+	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	cc.EXPECT().RevertHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.RevertHydratedManifestsResponse{HydratedSha: "revert123", PullRequest: pullRequest, PromotionError: "failed to promote target branch: boom"}, nil).Run(func(_ context.Context, in *commitclient.RevertHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, "hydrated-next", in.TargetBranch)
+		assert.Equal(t, "hydrated", in.SyncBranch)
+		assert.Equal(t, app.Spec.SourceHydrator.HydrateTo.PullRequest, in.PullRequest)
+		assert.Equal(t, app.Status.SourceHydrator.PullRequest, in.PreviousPullRequest)
+	})
+
+	record, err := r.Rollback(t.Context(), app, &v1alpha1.HydrateHistory{ID: 1, Phase: v1alpha1.HydrateOperationPhaseHydrated, HydratedSHA: "hydrated123"}, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "revert123", record.History.HydratedSHA)
+	assert.Equal(t, "Rolled back to hydrate history 1. Restored manifests were pushed, but failed to promote target branch: boom", record.History.Message)
+	assert.Equal(t, pullRequest, record.PullRequest)
+}
+
+func TestRollbacker_Rollback_InvalidHistory(t *testing.T) {
+	t.Parallel()
+
+	r := NewRollbacker(mocks.NewDependencies(t), nil)
+	app := newTestApp("app1")
+
+	_, err := r.Rollback(t.Context(), app, &v1alpha1.HydrateHistory{ID: 3, Phase: v1alpha1.HydrateOperationPhaseFailed, DrySHA: "dry123"}, "admin")
+
+	require.ErrorContains(t, err, "hydrate history 3 did not produce a hydrated commit")
+}
+
+func TestRollbacker_Rollback_OCI(t *testing.T) {
+	t.Parallel()
+
+	r := NewRollbacker(mocks.NewDependencies(t), nil)
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/app"
+
+	_, err := r.Rollback(t.Context(), app, &v1alpha1.HydrateHistory{Phase: v1alpha1.HydrateOperationPhaseHydrated, HydratedSHA: "hydrated123"}, "admin")
+
+	require.ErrorContains(t, err, "not supported when hydrating to an OCI repository")
+}
+
+func TestRollbacker_Rollback_RevertError(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	r := NewRollbacker(d, &commitservermocks.Clientset{CommitServiceClient: cc})
+
+	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	cc.EXPECT().RevertHydratedManifests(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	_, err := r.Rollback(t.Context(), newTestApp("app1"), &v1alpha1.HydrateHistory{Phase: v1alpha1.HydrateOperationPhaseHydrated, HydratedSHA: "hydrated123"}, "")
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "failed to revert hydrated manifests")
+}
+
+func Test_getRollbackCommitMessage(t *testing.T) {
+	t.Parallel()
+
+	history := &v1alpha1.HydrateHistory{DrySHA: "dry123", HydratedSHA: "hydrated123"}
+	assert.Equal(t, "Roll back app to hydrated commit hydrated123\n\nRestore the manifests hydrated from dry commit dry123.", getRollbackCommitMessage("app", history, ""))
+}
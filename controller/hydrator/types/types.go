@@ -8,4 +8,7 @@ type HydrationQueueKey struct {
 	SourceRepoURL        string
 	SourceTargetRevision string
 	DestinationBranch    string
+	// DestinationRepoURL is the OCI repository hydrated manifests are pushed to, or empty if they are committed to
+	// SourceRepoURL.
+	DestinationRepoURL string
 }
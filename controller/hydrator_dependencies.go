@@ -11,6 +11,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 /**
@@ -31,50 +32,42 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
-func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	drySources := []appv1.ApplicationSource{drySource}
-	dryRevisions := []string{revision}
-
-	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
-	}
-
-	app := origApp.DeepCopy()
-	// Remove the manifest generate path annotation, because the feature will misbehave for apps using source hydrator.
-	// Setting this annotation causes GetRepoObjs to compare the dry source commit to the most recent synced commit. The
-	// problem is that the most recent synced commit is likely on the hydrated branch, not the dry branch. The
-	// comparison will throw an error and break hydration.
-	//
-	// The long-term solution will probably be to persist the synced _dry_ revision and use that for the comparison.
-	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
-
-	// FIXME: use cache and revision cache
-	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, false, project, false)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
-	}
-	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+// GetAllApps returns all the applications in the namespaces allowed for the controller, including the ones which are
+// processed by other shards or skip reconciliation.
+func (ctrl *ApplicationController) GetAllApps() (*appv1.ApplicationList, error) {
+	apps, err := ctrl.appLister.List(labels.Everything())
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get tracking method: %w", err)
+		return nil, fmt.Errorf("error listing apps: %w", err)
 	}
-	for _, obj := range objs {
-		if err := argoutil.NewResourceTracking().RemoveAppInstance(obj, trackingMethod); err != nil {
-			return nil, nil, fmt.Errorf("failed to remove the app instance value: %w", err)
+	list := &appv1.ApplicationList{}
+	for _, app := range apps {
+		if ctrl.isAppNamespaceAllowed(app) {
+			list.Items = append(list.Items, *app)
 		}
 	}
+	return list, nil
+}
 
-	if len(resp) != 1 {
-		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	objs, resp, err := ctrl.hydratorManifestGenerator.GetRepoObjs(ctx, origApp, drySources, revisions, project)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
 	}
-
-	return objs, resp[0], nil
+	return objs, resp, nil
 }
 
 func (ctrl *ApplicationController) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
 	return ctrl.db.GetWriteRepository(ctx, repoURL, project)
 }
 
+func (ctrl *ApplicationController) GetHydratorPruneOrphanedPaths() (bool, error) {
+	prune, err := ctrl.settingsMgr.GetSourceHydratorPruneOrphanedPaths()
+	if err != nil {
+		return false, fmt.Errorf("failed to get sourceHydrator prune orphaned paths setting: %w", err)
+	}
+	return prune, nil
+}
+
 func (ctrl *ApplicationController) RequestAppRefresh(appName string, appNamespace string) error {
 	// We request a refresh by setting the annotation instead of by adding it to the refresh queue, because there is no
 	// guarantee that the hydrator is running on the same controller shard as is processing the application.
@@ -94,6 +87,22 @@ func (ctrl *ApplicationController) PersistAppHydratorStatus(orig *appv1.Applicat
 	ctrl.persistAppStatus(orig, status)
 }
 
+func (ctrl *ApplicationController) PersistAppHydrateRollback(orig *appv1.Application, newStatus *appv1.SourceHydratorStatus) {
+	status := orig.Status.DeepCopy()
+	status.SourceHydrator = *newStatus
+	ctrl.persistAppStatus(orig, status, appv1.AnnotationKeyHydrateRollback)
+}
+
+func (ctrl *ApplicationController) PersistAppHydratorValidationError(orig *appv1.Application, message string) {
+	status := orig.Status.DeepCopy()
+	var conditions []appv1.ApplicationCondition
+	if message != "" {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionHydratorValidationError, Message: message})
+	}
+	status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionHydratorValidationError: true})
+	ctrl.persistAppStatus(orig, status)
+}
+
 func (ctrl *ApplicationController) AddHydrationQueueItem(key types.HydrationQueueKey) {
 	ctrl.hydrationQueue.AddRateLimited(key)
 }
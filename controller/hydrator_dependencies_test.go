@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/test"
@@ -47,7 +49,7 @@ func TestGetRepoObjs(t *testing.T) {
 	source := app.Spec.GetSource()
 	source.RepoURL = "oci://example.com/argo/argo-cd"
 
-	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, source, "abc123", &v1alpha1.AppProject{
+	objs, resps, err := ctrl.GetRepoObjs(t.Context(), app, []v1alpha1.ApplicationSource{source}, []string{"abc123"}, &v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "default",
 			Namespace: test.FakeArgoCDNamespace,
@@ -63,8 +65,8 @@ func TestGetRepoObjs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	assert.NotNil(t, resp)
-	assert.Equal(t, "abc123", resp.Revision)
+	require.Len(t, resps, 1)
+	assert.Equal(t, "abc123", resps[0].Revision)
 	assert.Len(t, objs, 1)
 
 	annotations := objs[0].GetAnnotations()
@@ -121,3 +123,37 @@ func TestGetHydratorCommitMessageTemplate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, tmpl)
 }
+
+func TestGetAllApps(t *testing.T) {
+	newHydratedApp := func(name string) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Name = name
+		app.Spec.Source = nil
+		app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource:  v1alpha1.DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "dry"},
+			SyncSource: v1alpha1.SyncSource{TargetBranch: "hydrated", Path: name},
+		}
+		return app
+	}
+	otherShardApp := newHydratedApp("other-shard-app")
+	skipReconcileApp := newHydratedApp("skip-reconcile-app")
+	skipReconcileApp.Annotations = map[string]string{common.AnnotationKeyAppSkipReconcile: "true"}
+	otherNamespaceApp := newHydratedApp("other-namespace-app")
+	otherNamespaceApp.Namespace = "other-namespace"
+
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{otherShardApp, skipReconcileApp, otherNamespaceApp}}, nil)
+	// The controller is the second of two shards, while the destination cluster of the apps is assigned to the first one.
+	ctrl.clusterSharding = sharding.NewClusterSharding(ctrl.db, 1, 2, common.DefaultShardingAlgorithm)
+	require.False(t, ctrl.canProcessApp(otherShardApp))
+	require.False(t, ctrl.canProcessApp(skipReconcileApp))
+
+	apps, err := ctrl.GetAllApps()
+	require.NoError(t, err)
+	names := make([]string, 0, len(apps.Items))
+	for _, app := range apps.Items {
+		names = append(names, app.Name)
+	}
+	// The apps of other shards and the apps skipping reconciliation share the hydrated branch, so their paths are
+	// active, but the apps in namespaces which are not allowed are not listed.
+	assert.ElementsMatch(t, []string{"other-shard-app", "skip-reconcile-app"}, names)
+}
@@ -35,6 +35,12 @@ var (
 		descClusterDefaultLabels,
 		nil,
 	)
+	descClusterCacheMetadataOnlyResources = prometheus.NewDesc(
+		"argocd_cluster_api_metadata_only_resource_objects",
+		"Number of k8s resource objects in the cache for which only the metadata was retrieved.",
+		descClusterDefaultLabels,
+		nil,
+	)
 	descClusterAPIs = prometheus.NewDesc(
 		"argocd_cluster_api_resources",
 		"Number of monitored kubernetes API resources.",
@@ -158,6 +164,7 @@ func (c *clusterCollector) getClusterData() ([]*clusterData, error) {
 func (c *clusterCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descClusterInfo
 	ch <- descClusterCacheResources
+	ch <- descClusterCacheMetadataOnlyResources
 	ch <- descClusterAPIs
 	ch <- descClusterCacheAgeSeconds
 	ch <- descClusterConnectionStatus
@@ -180,6 +187,7 @@ func (c *clusterCollector) Collect(ch chan<- prometheus.Metric) {
 		defaultValues := []string{info.Server}
 		ch <- prometheus.MustNewConstMetric(descClusterInfo, prometheus.GaugeValue, 1, append(defaultValues, info.K8SVersion, name)...)
 		ch <- prometheus.MustNewConstMetric(descClusterCacheResources, prometheus.GaugeValue, float64(info.ResourcesCount), defaultValues...)
+		ch <- prometheus.MustNewConstMetric(descClusterCacheMetadataOnlyResources, prometheus.GaugeValue, float64(info.MetadataOnlyResourcesCount), defaultValues...)
 		ch <- prometheus.MustNewConstMetric(descClusterAPIs, prometheus.GaugeValue, float64(info.APIsCount), defaultValues...)
 		cacheAgeSeconds := -1
 		if info.LastCacheSyncTime != nil {
@@ -70,6 +70,27 @@ argocd_cluster_connection_status{k8s_version="1.21",server="server1"} 0
 				},
 			},
 		},
+		{
+			description:   "metric will have the number of resources for which only the metadata was retrieved",
+			skip:          false,
+			metricLabels:  []string{"non-existing"},
+			clusterLabels: []string{"env"},
+			testCombination: testCombination{
+				applications: []string{fakeApp},
+				responseContains: `
+# TYPE argocd_cluster_api_metadata_only_resource_objects gauge
+argocd_cluster_api_metadata_only_resource_objects{server="server1"} 42
+`,
+			},
+			clustersInfo: []gitopsCache.ClusterInfo{
+				{
+					Server:                     "server1",
+					K8SVersion:                 "1.21",
+					ResourcesCount:             100,
+					MetadataOnlyResourcesCount: 42,
+				},
+			},
+		},
 		{
 			description:   "will have one metric per cluster",
 			skip:          false,
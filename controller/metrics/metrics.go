@@ -34,6 +34,7 @@ type MetricsServer struct {
 	*http.Server
 	syncCounter                       *prometheus.CounterVec
 	syncDuration                      *prometheus.CounterVec
+	syncTimeoutCounter                *prometheus.CounterVec
 	kubectlExecCounter                *prometheus.CounterVec
 	kubectlExecPendingGauge           *prometheus.GaugeVec
 	orphanedResourcesGauge            *prometheus.GaugeVec
@@ -44,6 +45,9 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	clusterSyncRunningGauge           *prometheus.GaugeVec
+	clusterSyncWaitingGauge           *prometheus.GaugeVec
+	appQueueWaitHistogram             *prometheus.HistogramVec
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
@@ -85,6 +89,14 @@ var (
 		append(descAppDefaultLabels, "dest_server"),
 	)
 
+	syncTimeoutCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_sync_timeout_total",
+			Help: "Number of application syncs terminated because they exceeded their sync timeout.",
+		},
+		descAppDefaultLabels,
+	)
+
 	k8sRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_k8s_request_total",
@@ -156,6 +168,25 @@ var (
 		Name: "argocd_resource_events_processed_in_batch",
 		Help: "Number of resource events processed in batch",
 	}, []string{"server"})
+
+	clusterSyncRunningGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_sync_operations_running",
+		Help: "Number of sync operations running against the cluster.",
+	}, descClusterDefaultLabels)
+
+	clusterSyncWaitingGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_sync_operations_waiting",
+		Help: "Number of sync operations waiting for a sync slot of the cluster.",
+	}, descClusterDefaultLabels)
+
+	appQueueWaitHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_queue_wait_seconds",
+			Help:    "Time applications wait in the controller queues before being processed, in seconds.",
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600},
+		},
+		[]string{"queue", "priority"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -198,6 +229,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 
 	registry.MustRegister(syncCounter)
 	registry.MustRegister(syncDuration)
+	registry.MustRegister(syncTimeoutCounter)
 	registry.MustRegister(k8sRequestCounter)
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
@@ -208,6 +240,9 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
 	registry.MustRegister(resourceEventsNumberGauge)
+	registry.MustRegister(clusterSyncRunningGauge)
+	registry.MustRegister(clusterSyncWaitingGauge)
+	registry.MustRegister(appQueueWaitHistogram)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(registry)
@@ -220,6 +255,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		},
 		syncCounter:                       syncCounter,
 		syncDuration:                      syncDuration,
+		syncTimeoutCounter:                syncTimeoutCounter,
 		k8sRequestCounter:                 k8sRequestCounter,
 		kubectlExecCounter:                kubectlExecCounter,
 		kubectlExecPendingGauge:           kubectlExecPendingGauge,
@@ -230,6 +266,9 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
 		resourceEventsNumberGauge:         resourceEventsNumberGauge,
+		clusterSyncRunningGauge:           clusterSyncRunningGauge,
+		clusterSyncWaitingGauge:           clusterSyncWaitingGauge,
+		appQueueWaitHistogram:             appQueueWaitHistogram,
 		hostname:                          hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
@@ -262,6 +301,23 @@ func (m *MetricsServer) IncAppSyncDuration(app *argoappv1.Application, destServe
 	}
 }
 
+// IncSyncTimeout increments the counter of syncs terminated because they timed out for an application
+func (m *MetricsServer) IncSyncTimeout(app *argoappv1.Application) {
+	m.syncTimeoutCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject()).Inc()
+}
+
+// SetClusterSyncOperations sets the number of sync operations running against the cluster and waiting for one of its
+// sync slots
+func (m *MetricsServer) SetClusterSyncOperations(server string, running, waiting int) {
+	m.clusterSyncRunningGauge.WithLabelValues(server).Set(float64(running))
+	m.clusterSyncWaitingGauge.WithLabelValues(server).Set(float64(waiting))
+}
+
+// ObserveAppQueueWait observes the time an application waited in the queue for the given priority class
+func (m *MetricsServer) ObserveAppQueueWait(queue string, priority string, wait time.Duration) {
+	m.appQueueWaitHistogram.WithLabelValues(queue, priority).Observe(wait.Seconds())
+}
+
 func (m *MetricsServer) IncKubectlExec(command string) {
 	m.kubectlExecCounter.WithLabelValues(m.hostname, command).Inc()
 }
@@ -334,6 +390,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		log.Infof("Reset Prometheus metrics based on existing expiration '%v'", cacheExpiration)
 		m.syncCounter.Reset()
 		m.syncDuration.Reset()
+		m.syncTimeoutCounter.Reset()
 		m.kubectlExecCounter.Reset()
 		m.kubectlExecPendingGauge.Reset()
 		m.orphanedResourcesGauge.Reset()
@@ -344,6 +401,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestHistogram.Reset()
 		m.resourceEventsProcessingHistogram.Reset()
 		m.resourceEventsNumberGauge.Reset()
+		m.appQueueWaitHistogram.Reset()
 		kubectl.ResetAll()
 	})
 	if err != nil {
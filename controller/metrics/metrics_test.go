@@ -503,6 +503,83 @@ argocd_app_sync_total{dest_server="https://localhost:6443",dry_run="false",name=
 	assertMetricsPrinted(t, appSyncTotal, body)
 }
 
+func TestMetricsSyncTimeoutCounter(t *testing.T) {
+	cancel, appLister := newFakeLister(t.Context())
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+	require.NoError(t, err)
+
+	appSyncTimeoutTotal := `
+# HELP argocd_app_sync_timeout_total Number of application syncs terminated because they exceeded their sync timeout.
+# TYPE argocd_app_sync_timeout_total counter
+argocd_app_sync_timeout_total{name="my-app",namespace="argocd",project="important-project"} 1
+`
+
+	metricsServ.IncSyncTimeout(newFakeApp(fakeApp))
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assertMetricsPrinted(t, appSyncTimeoutTotal, rr.Body.String())
+}
+
+func TestMetricsClusterSyncOperations(t *testing.T) {
+	cancel, appLister := newFakeLister(t.Context())
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+	require.NoError(t, err)
+
+	clusterSyncOperations := `
+# HELP argocd_cluster_sync_operations_running Number of sync operations running against the cluster.
+# TYPE argocd_cluster_sync_operations_running gauge
+argocd_cluster_sync_operations_running{server="https://localhost:6443"} 5
+# HELP argocd_cluster_sync_operations_waiting Number of sync operations waiting for a sync slot of the cluster.
+# TYPE argocd_cluster_sync_operations_waiting gauge
+argocd_cluster_sync_operations_waiting{server="https://localhost:6443"} 12
+`
+
+	metricsServ.SetClusterSyncOperations("https://localhost:6443", 5, 12)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assertMetricsPrinted(t, clusterSyncOperations, rr.Body.String())
+}
+
+func TestMetricsAppQueueWait(t *testing.T) {
+	cancel, appLister := newFakeLister(t.Context())
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+	require.NoError(t, err)
+
+	appQueueWait := `
+# HELP argocd_app_queue_wait_seconds Time applications wait in the controller queues before being processed, in seconds.
+# TYPE argocd_app_queue_wait_seconds histogram
+argocd_app_queue_wait_seconds_bucket{priority="user",queue="app_reconciliation_queue",le="0.5"} 1
+argocd_app_queue_wait_seconds_bucket{priority="user",queue="app_reconciliation_queue",le="+Inf"} 2
+argocd_app_queue_wait_seconds_count{priority="user",queue="app_reconciliation_queue"} 2
+argocd_app_queue_wait_seconds_count{priority="periodic",queue="app_reconciliation_queue"} 1
+`
+
+	metricsServ.ObserveAppQueueWait("app_reconciliation_queue", "user", 200*time.Millisecond)
+	metricsServ.ObserveAppQueueWait("app_reconciliation_queue", "user", 20*time.Second)
+	metricsServ.ObserveAppQueueWait("app_reconciliation_queue", "periodic", 5*time.Minute)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assertMetricsPrinted(t, appQueueWait, rr.Body.String())
+}
+
 // assertMetricsPrinted asserts every line in the expected lines appears in the body
 func assertMetricsPrinted(t *testing.T, expectedLines, body string) {
 	t.Helper()
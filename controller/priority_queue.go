@@ -0,0 +1,278 @@
+package controller
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// EnvQueueMaxWait is the env variable for the time after which an application waiting in the queues of the
+	// controller is promoted to the next priority class
+	EnvQueueMaxWait = "ARGOCD_APPLICATION_CONTROLLER_QUEUE_MAX_WAIT"
+)
+
+var queueMaxWait = env.ParseDurationFromEnv(EnvQueueMaxWait, time.Minute, 0, math.MaxInt64)
+
+// queuePriority is the priority class of an application queued for processing. Applications of a higher priority
+// class are processed first, so that interactive actions do not wait behind the periodic reconciliation of all the
+// applications of the controller.
+type queuePriority int
+
+const (
+	// queuePriorityPeriodic is the priority of periodic reconciliations and of changes made by the controller itself
+	queuePriorityPeriodic queuePriority = iota
+	// queuePriorityWebhook is the priority of refreshes requested by a Git webhook
+	queuePriorityWebhook
+	// queuePriorityUser is the priority of refreshes and operations requested by a user
+	queuePriorityUser
+)
+
+// getQueuePriority returns the priority class of the processing of an application update
+func getQueuePriority(oldApp, newApp *appv1.Application) queuePriority {
+	if oldApp.ResourceVersion == newApp.ResourceVersion {
+		return queuePriorityPeriodic
+	}
+	_, oldRefresh := oldApp.IsRefreshRequested()
+	if _, newRefresh := newApp.IsRefreshRequested(); newRefresh && (!oldRefresh || oldApp.GetRefreshSource() != newApp.GetRefreshSource()) {
+		switch newApp.GetRefreshSource() {
+		case appv1.RefreshSourceUser:
+			return queuePriorityUser
+		case appv1.RefreshSourceWebhook:
+			return queuePriorityWebhook
+		}
+	}
+	if oldApp.Operation == nil && newApp.Operation != nil && !newApp.Operation.InitiatedBy.Automated {
+		return queuePriorityUser
+	}
+	return queuePriorityPeriodic
+}
+
+func (p queuePriority) String() string {
+	switch p {
+	case queuePriorityUser:
+		return "user"
+	case queuePriorityWebhook:
+		return "webhook"
+	default:
+		return "periodic"
+	}
+}
+
+// priorityQueue is a rate limited work queue which hands out the items of a higher priority class first. To protect
+// the items of a lower priority class from starvation, an item is promoted to the next priority class each time it
+// waited for maxWait.
+type priorityQueue struct {
+	name        string
+	queue       priorityqueue.PriorityQueue[string]
+	rateLimiter workqueue.TypedRateLimiter[string]
+	maxWait     time.Duration
+	// observeWait is called with the time each item waited to be handed out
+	observeWait func(queue string, priority queuePriority, wait time.Duration)
+	now         func() time.Time
+	stopCh      chan struct{}
+	stopOnce    sync.Once
+
+	lock sync.Mutex
+	// items contains the items which were added and not handed out yet
+	items map[string]*priorityQueueItem
+	// processing contains the items which were handed out and are not done yet
+	processing map[string]bool
+	// draining is true while ShutDownWithDrain waits for the items to be processed
+	draining bool
+	// drained is signaled whenever an item is done while draining
+	drained *sync.Cond
+}
+
+type priorityQueueItem struct {
+	// priority is the priority class the item was added with
+	priority queuePriority
+	// promoted is the priority class the item was promoted to while waiting
+	promoted queuePriority
+	// readyAt is the time the item is ready to be handed out
+	readyAt time.Time
+}
+
+var _ workqueue.TypedRateLimitingInterface[string] = &priorityQueue{}
+
+// newPriorityQueue returns a priority queue which promotes items waiting longer than maxWait. A maxWait of 0 disables
+// the promotion.
+func newPriorityQueue(name string, rateLimiter workqueue.TypedRateLimiter[string], maxWait time.Duration, observeWait func(queue string, priority queuePriority, wait time.Duration)) *priorityQueue {
+	q := &priorityQueue{
+		name: name,
+		queue: priorityqueue.New(name, func(o *priorityqueue.Opts[string]) {
+			o.RateLimiter = rateLimiter
+		}),
+		rateLimiter: rateLimiter,
+		maxWait:     maxWait,
+		observeWait: observeWait,
+		now:         time.Now,
+		stopCh:      make(chan struct{}),
+		items:       map[string]*priorityQueueItem{},
+		processing:  map[string]bool{},
+	}
+	q.drained = sync.NewCond(&q.lock)
+	if maxWait > 0 {
+		go wait.Until(q.promoteWaiting, maxWait/2, q.stopCh)
+	}
+	return q
+}
+
+// AddWithPriority adds the item with the given priority class. An item which is already queued keeps the highest of
+// its priority classes.
+func (q *priorityQueue) AddWithPriority(item string, priority queuePriority) {
+	q.add(item, priority, 0)
+}
+
+func (q *priorityQueue) add(key string, priority queuePriority, after time.Duration) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.draining {
+		// like the client-go queues, new items are ignored once the queue is shutting down
+		return
+	}
+
+	readyAt := q.now().Add(after)
+	item, ok := q.items[key]
+	switch {
+	case !ok:
+		item = &priorityQueueItem{priority: priority, promoted: priority, readyAt: readyAt}
+		q.items[key] = item
+	case priority > item.priority:
+		// the wait of the item is measured from the time the higher priority class was requested
+		item.priority = priority
+		item.promoted = max(item.promoted, priority)
+		item.readyAt = readyAt
+	case readyAt.Before(item.readyAt):
+		item.readyAt = readyAt
+	}
+	q.queue.AddWithOpts(priorityqueue.AddOpts{After: after, Priority: int(item.promoted)}, key)
+}
+
+// promoteWaiting promotes the items which are ready to the next priority class for each maxWait they waited.
+func (q *priorityQueue) promoteWaiting() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	now := q.now()
+	for key, item := range q.items {
+		waited := now.Sub(item.readyAt)
+		if item.promoted >= queuePriorityUser || waited < q.maxWait {
+			continue
+		}
+		promoted := min(queuePriorityUser, item.priority+queuePriority(waited/q.maxWait))
+		if promoted > item.promoted {
+			item.promoted = promoted
+			q.queue.AddWithOpts(priorityqueue.AddOpts{Priority: int(promoted)}, key)
+		}
+	}
+}
+
+// GetWithPriority blocks until an item can be processed, and returns it along with the priority class it was added
+// with.
+func (q *priorityQueue) GetWithPriority() (string, queuePriority, bool) {
+	key, _, shutdown := q.queue.GetWithPriority()
+
+	q.lock.Lock()
+	item, ok := q.items[key]
+	delete(q.items, key)
+	if !shutdown {
+		q.processing[key] = true
+	}
+	q.lock.Unlock()
+
+	if !ok {
+		return key, queuePriorityPeriodic, shutdown
+	}
+	if q.observeWait != nil {
+		q.observeWait(q.name, item.priority, max(0, q.now().Sub(item.readyAt)))
+	}
+	return key, item.priority, shutdown
+}
+
+func (q *priorityQueue) Get() (string, bool) {
+	key, _, shutdown := q.GetWithPriority()
+	return key, shutdown
+}
+
+// Add adds the item with the periodic priority class
+func (q *priorityQueue) Add(item string) {
+	q.add(item, queuePriorityPeriodic, 0)
+}
+
+// AddAfter adds the item with the periodic priority class after the given duration
+func (q *priorityQueue) AddAfter(item string, duration time.Duration) {
+	q.add(item, queuePriorityPeriodic, duration)
+}
+
+// AddRateLimited adds the item with the periodic priority class after the rate limiter says it's ok
+func (q *priorityQueue) AddRateLimited(item string) {
+	q.add(item, queuePriorityPeriodic, q.rateLimiter.When(item))
+}
+
+func (q *priorityQueue) Forget(item string) {
+	q.rateLimiter.Forget(item)
+}
+
+func (q *priorityQueue) NumRequeues(item string) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+func (q *priorityQueue) Done(item string) {
+	q.lock.Lock()
+	delete(q.processing, item)
+	q.drained.Broadcast()
+	q.lock.Unlock()
+	q.queue.Done(item)
+}
+
+func (q *priorityQueue) Len() int {
+	return q.queue.Len()
+}
+
+func (q *priorityQueue) ShutDown() {
+	q.stopOnce.Do(func() {
+		close(q.stopCh)
+		q.queue.ShutDown()
+	})
+	// stop draining, if ShutDownWithDrain is waiting
+	q.lock.Lock()
+	q.draining = false
+	q.drained.Broadcast()
+	q.lock.Unlock()
+}
+
+// ShutDownWithDrain ignores the items added from now on, and waits until the items which are ready have been handed
+// out and all the items handed out are done before shutting down the queue. Items which are not ready yet, because
+// they were added with a delay, are dropped.
+func (q *priorityQueue) ShutDownWithDrain() {
+	q.lock.Lock()
+	q.draining = true
+	for q.draining && (len(q.processing) > 0 || q.hasReadyItemsLocked()) {
+		q.drained.Wait()
+	}
+	q.lock.Unlock()
+	q.ShutDown()
+}
+
+// hasReadyItemsLocked returns whether any item waiting to be handed out is ready. The lock must be held.
+func (q *priorityQueue) hasReadyItemsLocked() bool {
+	now := q.now()
+	for _, item := range q.items {
+		if !item.readyAt.After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *priorityQueue) ShuttingDown() bool {
+	return q.queue.ShuttingDown()
+}
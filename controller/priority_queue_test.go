@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type queueWait struct {
+	priority queuePriority
+	wait     time.Duration
+}
+
+func newTestPriorityQueue(t *testing.T) (*priorityQueue, *time.Time, *[]queueWait) {
+	t.Helper()
+	now := time.Now()
+	var waits []queueWait
+	q := newPriorityQueue("", workqueue.DefaultTypedControllerRateLimiter[string](), 0, func(_ string, priority queuePriority, wait time.Duration) {
+		waits = append(waits, queueWait{priority: priority, wait: wait})
+	})
+	q.now = func() time.Time {
+		return now
+	}
+	t.Cleanup(q.ShutDown)
+	return q, &now, &waits
+}
+
+func getAll(q *priorityQueue, count int) []string {
+	var keys []string
+	for range count {
+		key, _ := q.Get()
+		q.Done(key)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestPriorityQueue(t *testing.T) {
+	q, now, waits := newTestPriorityQueue(t)
+
+	q.Add("argocd/periodic")
+	q.AddWithPriority("argocd/webhook", queuePriorityWebhook)
+	q.AddWithPriority("argocd/user", queuePriorityUser)
+	// an item which is already queued keeps the highest priority
+	q.AddWithPriority("argocd/user", queuePriorityWebhook)
+	q.AddRateLimited("argocd/user")
+	*now = now.Add(time.Second)
+
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, []string{"argocd/user", "argocd/webhook", "argocd/periodic"}, getAll(q, 3))
+	assert.Equal(t, []queueWait{
+		{priority: queuePriorityUser, wait: time.Second},
+		{priority: queuePriorityWebhook, wait: time.Second},
+		{priority: queuePriorityPeriodic, wait: time.Second},
+	}, *waits)
+
+	// the wait of an item is measured from the time its priority was raised
+	*waits = nil
+	q.Add("argocd/app")
+	*now = now.Add(time.Minute)
+	q.AddWithPriority("argocd/app", queuePriorityUser)
+	*now = now.Add(time.Second)
+	assert.Equal(t, []string{"argocd/app"}, getAll(q, 1))
+	assert.Equal(t, []queueWait{{priority: queuePriorityUser, wait: time.Second}}, *waits)
+}
+
+func TestPriorityQueue_PromoteWaiting(t *testing.T) {
+	q, now, waits := newTestPriorityQueue(t)
+	q.maxWait = time.Minute
+
+	q.Add("argocd/periodic-1")
+	*now = now.Add(time.Minute)
+	q.Add("argocd/periodic-2")
+	*now = now.Add(time.Minute)
+	q.AddWithPriority("argocd/webhook", queuePriorityWebhook)
+	q.AddWithPriority("argocd/user", queuePriorityUser)
+	q.promoteWaiting()
+
+	// periodic-1 waited twice the max wait and is promoted to the user priority, periodic-2 waited once and is
+	// promoted to the webhook priority
+	assert.Equal(t, []string{"argocd/periodic-1", "argocd/user", "argocd/periodic-2", "argocd/webhook"}, getAll(q, 4))
+	// promoted items are reported with the priority they were added with
+	assert.Equal(t, queuePriorityPeriodic, (*waits)[0].priority)
+	assert.Equal(t, 2*time.Minute, (*waits)[0].wait)
+}
+
+func TestGetQueuePriority(t *testing.T) {
+	newApp := func(resourceVersion string, annotations map[string]string, operation *appv1.Operation) *appv1.Application {
+		return &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd", ResourceVersion: resourceVersion, Annotations: annotations},
+			Operation:  operation,
+		}
+	}
+	refresh := map[string]string{appv1.AnnotationKeyRefresh: string(appv1.RefreshTypeNormal)}
+	userRefresh := map[string]string{appv1.AnnotationKeyRefresh: string(appv1.RefreshTypeHard), appv1.AnnotationKeyRefreshSource: string(appv1.RefreshSourceUser)}
+	webhookRefresh := map[string]string{appv1.AnnotationKeyRefresh: string(appv1.RefreshTypeNormal), appv1.AnnotationKeyRefreshSource: string(appv1.RefreshSourceWebhook)}
+	manualSync := &appv1.Operation{Sync: &appv1.SyncOperation{}, InitiatedBy: appv1.OperationInitiator{Username: "admin"}}
+	autoSync := &appv1.Operation{Sync: &appv1.SyncOperation{}, InitiatedBy: appv1.OperationInitiator{Automated: true}}
+
+	tests := []struct {
+		name     string
+		oldApp   *appv1.Application
+		newApp   *appv1.Application
+		expected queuePriority
+	}{
+		{"Resync", newApp("1", userRefresh, nil), newApp("1", userRefresh, nil), queuePriorityPeriodic},
+		{"Status update", newApp("1", nil, nil), newApp("2", nil, nil), queuePriorityPeriodic},
+		{"User refresh", newApp("1", nil, nil), newApp("2", userRefresh, nil), queuePriorityUser},
+		{"Webhook refresh", newApp("1", nil, nil), newApp("2", webhookRefresh, nil), queuePriorityWebhook},
+		{"Refresh without source", newApp("1", nil, nil), newApp("2", refresh, nil), queuePriorityPeriodic},
+		{"User refresh while webhook refresh pending", newApp("1", webhookRefresh, nil), newApp("2", userRefresh, nil), queuePriorityUser},
+		{"Refresh pending", newApp("1", userRefresh, nil), newApp("2", userRefresh, nil), queuePriorityPeriodic},
+		{"Manual sync", newApp("1", nil, nil), newApp("2", nil, manualSync), queuePriorityUser},
+		{"Automated sync", newApp("1", nil, nil), newApp("2", nil, autoSync), queuePriorityPeriodic},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getQueuePriority(tt.oldApp, tt.newApp))
+		})
+	}
+}
+
+func TestPriorityQueue_ShutDownWithDrain(t *testing.T) {
+	q, _, _ := newTestPriorityQueue(t)
+
+	q.Add("argocd/ready")
+	q.AddAfter("argocd/delayed", time.Hour)
+	key, _ := q.Get()
+	assert.Equal(t, "argocd/ready", key)
+
+	drained := make(chan struct{})
+	go func() {
+		q.ShutDownWithDrain()
+		close(drained)
+	}()
+
+	// the drain waits for the items handed out to be done
+	assert.Never(t, func() bool {
+		select {
+		case <-drained:
+			return true
+		default:
+			return false
+		}
+	}, 100*time.Millisecond, 10*time.Millisecond)
+	// items added while draining are ignored
+	q.Add("argocd/new")
+	q.Done(key)
+
+	// items which are not ready yet are not waited for
+	select {
+	case <-drained:
+	case <-time.After(10 * time.Second):
+		t.Fatal("ShutDownWithDrain did not return")
+	}
+	assert.True(t, q.ShuttingDown())
+	assert.NotContains(t, q.items, "argocd/new")
+}
@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"maps"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/db"
 )
@@ -18,9 +20,13 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
+	IsCachedCluster(c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	UpdateClusterWeights(getClusterInfo func(server string) (*v1alpha1.ClusterInfo, error))
+	GetSyncConcurrencyLimit(c *v1alpha1.Cluster, limit int) int
 }
 
 type ClusterSharding struct {
@@ -29,8 +35,10 @@ type ClusterSharding struct {
 	Shards          map[string]int
 	Clusters        map[string]*v1alpha1.Cluster
 	Apps            map[string]*v1alpha1.Application
+	Weights         map[string]int64
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	usesWeights     bool
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -41,11 +49,13 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+		Weights:  make(map[string]int64),
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getWeightAccessor(), shardingAlgorithm, replicas)
+		clusterSharding.usesWeights = shardingAlgorithm == common.ResourceWeightedShardingAlgorithm
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -70,6 +80,40 @@ func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	return clusterShard == sharding.Shard
 }
 
+// IsManagedApp returns whether or not the application with the given destination cluster should be processed by a
+// given shard. Applications are processed by the shard of their cluster, unless application sharding is enabled for it.
+func (sharding *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if sharding.Replicas <= 1 || !IsAppShardingEnabled(c) {
+		return sharding.IsManagedCluster(c)
+	}
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	appShard := GetAppShard(a, sharding.Replicas)
+	log.Debugf("Checking if application %s/%s with appShard %d should be processed by shard %d", a.Namespace, a.Name, appShard, sharding.Shard)
+	return appShard == sharding.Shard
+}
+
+// IsCachedCluster returns whether or not the cache of the cluster should be maintained by a given shard. Every shard
+// maintains a read-only replica of the cache of the clusters with application sharding enabled, while the cluster
+// level tasks remain handled by the shard of the cluster.
+func (sharding *ClusterSharding) IsCachedCluster(c *v1alpha1.Cluster) bool {
+	if sharding.Replicas > 1 && IsAppShardingEnabled(c) {
+		return true
+	}
+	return sharding.IsManagedCluster(c)
+}
+
+// GetSyncConcurrencyLimit returns the number of concurrent sync operations to the cluster the shard may run, given the
+// sync concurrency limit of the cluster. When application sharding is enabled for the cluster, every shard syncs
+// Applications to it, so the limit is split evenly between the shards. Each shard may run at least one operation, so
+// the limit is exceeded if it is lower than the number of shards. A limit of 0 means unlimited.
+func (sharding *ClusterSharding) GetSyncConcurrencyLimit(c *v1alpha1.Cluster, limit int) int {
+	if limit <= 0 || sharding.Replicas <= 1 || !IsAppShardingEnabled(c) {
+		return limit
+	}
+	return max(limit/sharding.Replicas, 1)
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -212,6 +256,13 @@ func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getWeightAccessor.
+func (sharding *ClusterSharding) getWeightAccessor() weightAccessor {
+	return func() map[string]int64 {
+		return sharding.Weights
+	}
+}
+
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -276,3 +327,51 @@ func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	}
 	return false
 }
+
+// UpdateClusterWeights updates the weights of the clusters from the info cached about them, and updates the
+// distribution if any weight changed. It is a no-op unless the clusters are distributed by the resource-weighted
+// sharding algorithm.
+//
+// The info of a cluster is only written by the shard processing it, so it is missing or reports an empty cache until
+// the cache of the shard the cluster was last moved to is synchronized. Such a cluster keeps its last known weight,
+// otherwise it would look lighter and could be moved back to its previous shard, and so on.
+func (sharding *ClusterSharding) UpdateClusterWeights(getClusterInfo func(server string) (*v1alpha1.ClusterInfo, error)) {
+	if !sharding.usesWeights {
+		return
+	}
+	sharding.lock.RLock()
+	servers := make([]string, 0, len(sharding.Clusters))
+	for server := range sharding.Clusters {
+		servers = append(servers, server)
+	}
+	previousWeights := maps.Clone(sharding.Weights)
+	sharding.lock.RUnlock()
+
+	weights := make(map[string]int64, len(servers))
+	for _, server := range servers {
+		info, err := getClusterInfo(server)
+		if err == nil && info.CacheInfo.LastCacheSyncTime != nil {
+			weights[server] = GetClusterWeight(&info.CacheInfo)
+			continue
+		}
+		weight, ok := previousWeights[server]
+		if !ok {
+			weight = 1
+		}
+		if err != nil {
+			log.Debugf("Failed to get info of cluster %s, using the weight %d: %v", server, weight, err)
+		} else {
+			log.Debugf("Cache of cluster %s is not synchronized, using the weight %d", server, weight)
+		}
+		weights[server] = weight
+	}
+
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	if maps.Equal(sharding.Weights, weights) {
+		log.Debugf("Skipping sharding distribution update. No cluster weight changes")
+		return
+	}
+	sharding.Weights = weights
+	sharding.updateDistribution()
+}
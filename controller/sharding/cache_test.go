@@ -1,10 +1,14 @@
 package sharding
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
 )
@@ -322,6 +326,104 @@ func TestClusterSharding_IsManagedCluster(t *testing.T) {
 	}))
 }
 
+func TestClusterSharding_IsManagedApp(t *testing.T) {
+	enableApplicationSharding(t)
+	replicas := 2
+	clusters := &v1alpha1.ClusterList{
+		Items: []v1alpha1.Cluster{
+			{
+				ID:     "1",
+				Server: "https://kubernetes.default.svc",
+			},
+			{
+				ID:          "2",
+				Server:      "https://127.0.0.1:6443",
+				Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+			},
+		},
+	}
+	sharding0 := setupTestSharding(0, replicas)
+	sharding0.Init(clusters, &v1alpha1.ApplicationList{})
+	sharding1 := setupTestSharding(1, replicas)
+	sharding1.Init(clusters, &v1alpha1.ApplicationList{})
+
+	// clusters with application sharding are cached by every shard, but still managed by a single one
+	for _, sharding := range []*ClusterSharding{sharding0, sharding1} {
+		assert.True(t, sharding.IsCachedCluster(&clusters.Items[1]))
+	}
+	assert.False(t, sharding0.IsManagedCluster(&clusters.Items[1]))
+	assert.True(t, sharding1.IsManagedCluster(&clusters.Items[1]))
+	assert.True(t, sharding0.IsCachedCluster(&clusters.Items[0]))
+	assert.False(t, sharding1.IsCachedCluster(&clusters.Items[0]))
+
+	appsByShard := map[int]int{}
+	for i := 0; i < 20; i++ {
+		app := createApp(fmt.Sprintf("app-%d", i), "https://127.0.0.1:6443")
+		managed0 := sharding0.IsManagedApp(&app, &clusters.Items[1])
+		managed1 := sharding1.IsManagedApp(&app, &clusters.Items[1])
+		assert.NotEqual(t, managed0, managed1, "application %s should be managed by exactly one shard", app.Name)
+		if managed0 {
+			appsByShard[0]++
+		} else {
+			appsByShard[1]++
+		}
+
+		// applications of clusters without application sharding are managed by the shard of their cluster
+		app = createApp(fmt.Sprintf("app-%d", i), "https://kubernetes.default.svc")
+		assert.True(t, sharding0.IsManagedApp(&app, &clusters.Items[0]))
+		assert.False(t, sharding1.IsManagedApp(&app, &clusters.Items[0]))
+	}
+	assert.Positive(t, appsByShard[0])
+	assert.Positive(t, appsByShard[1])
+}
+
+func TestClusterSharding_IsManagedAppSingleReplica(t *testing.T) {
+	enableApplicationSharding(t)
+	sharding := setupTestSharding(0, 1)
+	cluster := v1alpha1.Cluster{
+		ID:          "1",
+		Server:      "https://kubernetes.default.svc",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster}}, &v1alpha1.ApplicationList{})
+	app := createApp("app1", "https://kubernetes.default.svc")
+	assert.True(t, sharding.IsManagedApp(&app, &cluster))
+	assert.True(t, sharding.IsCachedCluster(&cluster))
+}
+
+func TestClusterSharding_IsManagedAppDisabled(t *testing.T) {
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{{
+		ID:          "2",
+		Server:      "https://127.0.0.1:6443",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}}}
+	sharding0 := setupTestSharding(0, 2)
+	sharding0.Init(clusters, &v1alpha1.ApplicationList{})
+
+	// the annotation is ignored, so the cluster is only cached by its shard
+	assert.False(t, sharding0.IsCachedCluster(&clusters.Items[0]))
+	app := createApp("app-0", "https://127.0.0.1:6443")
+	assert.False(t, sharding0.IsManagedApp(&app, &clusters.Items[0]))
+}
+
+func TestClusterSharding_GetSyncConcurrencyLimit(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "https://127.0.0.1:6443"}
+	appShardedCluster := &v1alpha1.Cluster{
+		Server:      "https://127.0.0.1:6443",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}
+	sharding := setupTestSharding(0, 3)
+
+	assert.Equal(t, 10, sharding.GetSyncConcurrencyLimit(appShardedCluster, 10), "application sharding is disabled")
+
+	enableApplicationSharding(t)
+	assert.Equal(t, 10, sharding.GetSyncConcurrencyLimit(cluster, 10))
+	assert.Equal(t, 3, sharding.GetSyncConcurrencyLimit(appShardedCluster, 10))
+	assert.Equal(t, 1, sharding.GetSyncConcurrencyLimit(appShardedCluster, 2))
+	assert.Equal(t, 0, sharding.GetSyncConcurrencyLimit(appShardedCluster, 0), "unlimited")
+	assert.Equal(t, 10, setupTestSharding(0, 1).GetSyncConcurrencyLimit(appShardedCluster, 10))
+}
+
 func TestClusterSharding_ClusterShardOfResourceShouldNotBeChanged(t *testing.T) {
 	shard := 1
 	replicas := 2
@@ -509,3 +611,80 @@ func TestHasShardingUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterSharding_UpdateClusterWeights(t *testing.T) {
+	clusters := &v1alpha1.ClusterList{
+		Items: []v1alpha1.Cluster{
+			{ID: "1", Server: "https://cluster-01"},
+			{ID: "2", Server: "https://cluster-02"},
+			{ID: "3", Server: "https://cluster-03"},
+			{ID: "4", Server: "https://cluster-04"},
+			{ID: "5", Server: "https://cluster-05"},
+			{ID: "6", Server: "https://cluster-06"},
+		},
+	}
+	syncTime := metav1.Now()
+	getClusterInfo := func(server string) (*v1alpha1.ClusterInfo, error) {
+		if server == "https://cluster-01" {
+			return &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 10000, LastCacheSyncTime: &syncTime}}, nil
+		}
+		if server == "https://cluster-06" {
+			return nil, errors.New("cluster info not found")
+		}
+		return &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 100, LastCacheSyncTime: &syncTime}}, nil
+	}
+
+	t.Run("weights are ignored by other algorithms", func(t *testing.T) {
+		sharding := setupTestSharding(0, 2)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		sharding.UpdateClusterWeights(getClusterInfo)
+		assert.Empty(t, sharding.Weights)
+	})
+
+	t.Run("distribution is updated with the weights", func(t *testing.T) {
+		sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.ResourceWeightedShardingAlgorithm).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		sharding.UpdateClusterWeights(getClusterInfo)
+		assert.Equal(t, map[string]int64{
+			"https://cluster-01": 16384,
+			"https://cluster-02": 128,
+			"https://cluster-03": 128,
+			"https://cluster-04": 128,
+			"https://cluster-05": 128,
+			"https://cluster-06": 1,
+		}, sharding.Weights)
+
+		distribution := sharding.GetDistribution()
+		heavyShard := distribution["https://cluster-01"]
+		for server, shard := range distribution {
+			if server != "https://cluster-01" {
+				assert.NotEqual(t, heavyShard, shard, "cluster %s should have been moved away from the heavy cluster", server)
+			}
+		}
+	})
+
+	t.Run("reassigned cluster keeps its weight until its cache is synchronized", func(t *testing.T) {
+		sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.ResourceWeightedShardingAlgorithm).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		sharding.UpdateClusterWeights(getClusterInfo)
+		distribution := sharding.GetDistribution()
+
+		// the shards the clusters were moved to have not synchronized their caches yet
+		sharding.UpdateClusterWeights(func(server string) (*v1alpha1.ClusterInfo, error) {
+			if server == "https://cluster-06" {
+				return nil, errors.New("cluster info not found")
+			}
+			return &v1alpha1.ClusterInfo{ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusUnknown}}, nil
+		})
+		assert.Equal(t, int64(16384), sharding.Weights["https://cluster-01"])
+		assert.Equal(t, int64(128), sharding.Weights["https://cluster-02"])
+		assert.Equal(t, int64(1), sharding.Weights["https://cluster-06"])
+		assert.Equal(t, distribution, sharding.GetDistribution(), "clusters should not move while their caches are not synchronized")
+
+		// once synchronized, the weights are updated again
+		sharding.UpdateClusterWeights(func(_ string) (*v1alpha1.ClusterInfo, error) {
+			return &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 100, LastCacheSyncTime: &syncTime}}, nil
+		})
+		assert.Equal(t, int64(128), sharding.Weights["https://cluster-01"])
+	})
+}
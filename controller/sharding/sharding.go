@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"math"
+	"math/bits"
 	"os"
 	"slices"
 	"sort"
@@ -40,6 +41,13 @@ var heartbeatCurrentTime = metav1.Now
 var (
 	HeartbeatDuration = env.ParseNumFromEnv(common.EnvControllerHeartbeatTime, 10, 10, 60)
 	HeartbeatTimeout  = 3 * HeartbeatDuration
+	// ImbalanceThreshold is the percentage by which the load of a shard may exceed the average load before the
+	// resource-weighted sharding algorithm moves clusters to other shards
+	ImbalanceThreshold = env.ParseNumFromEnv(common.EnvControllerShardingImbalanceThreshold, 20, 0, math.MaxInt32)
+	// ApplicationShardingEnabled allows distributing the Applications of the clusters annotated with
+	// common.AnnotationKeyApplicationSharding across all shards. It is disabled by default, since every shard then
+	// watches such clusters.
+	ApplicationShardingEnabled = env.ParseBoolFromEnv(common.EnvControllerApplicationShardingEnabled, false)
 )
 
 const ShardControllerMappingKey = "shardControllerMapping"
@@ -49,6 +57,7 @@ type (
 	ClusterFilterFunction func(c *v1alpha1.Cluster) bool
 	clusterAccessor       func() []*v1alpha1.Cluster
 	appAccessor           func() []*v1alpha1.Application
+	weightAccessor        func() map[string]int64
 )
 
 // shardApplicationControllerMapping stores the mapping of Shard Number to Application Controller in ConfigMap.
@@ -82,7 +91,7 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
-func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, weights weightAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +101,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.ResourceWeightedShardingAlgorithm:
+		distributionFunction = ResourceWeightedDistributionFunction(clusters, weights, replicasCount, ImbalanceThreshold)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -131,6 +142,29 @@ func LegacyDistributionFunction(replicas int) DistributionFunction {
 	}
 }
 
+// IsAppShardingEnabled returns whether the Applications of the given cluster are distributed across all shards
+// instead of being processed by the shard of the cluster. The annotation of the cluster is ignored unless application
+// sharding is enabled for the controller.
+func IsAppShardingEnabled(c *v1alpha1.Cluster) bool {
+	if c == nil || !ApplicationShardingEnabled {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(c.Annotations[common.AnnotationKeyApplicationSharding])
+	return enabled
+}
+
+// GetAppShard returns the shard processing the given Application when the Applications of its destination cluster
+// are distributed across shards. The shard is based on the hash of the Application namespace and name, so it is
+// stable as long as the number of replicas does not change.
+func GetAppShard(a *v1alpha1.Application, replicas int) int {
+	if replicas <= 0 {
+		return -1
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.Namespace + "/" + a.Name))
+	return int(h.Sum32() % uint32(replicas))
+}
+
 // RoundRobinDistributionFunction returns a DistributionFunction using an homogeneous distribution algorithm:
 // for a given cluster the function will return the shard number based on the modulo of the cluster rank in
 // the cluster's list sorted by uid on the shard number.
@@ -249,6 +283,102 @@ func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[str
 	return appDistribution
 }
 
+// ResourceWeightedDistributionFunction returns a DistributionFunction balancing the load of the shards, where the load
+// of a cluster is its weight as returned by GetClusterWeight. Clusters are assigned to their legacy shard, which does
+// not depend on the other clusters. While the load of a shard exceeds the average load by more than the given
+// threshold percentage, the largest cluster of the most loaded shard which reduces the imbalance is moved to the least
+// loaded shard. This way the fewest clusters needed are moved away from their legacy shard, and a cluster only moves
+// again if the weights change significantly.
+func ResourceWeightedDistributionFunction(clusters clusterAccessor, weights weightAccessor, replicas int, threshold int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shardIndexedByCluster := createResourceWeightedDistribution(replicas, threshold, clusters, weights)
+			shard, ok := shardIndexedByCluster[c.ID]
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+func createResourceWeightedDistribution(replicas int, threshold int, getCluster clusterAccessor, getWeights weightAccessor) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	weights := getWeights()
+	legacyShard := LegacyDistributionFunction(replicas)
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	loadsIndexedByShard := make([]int64, replicas)
+	var movable []*v1alpha1.Cluster
+	var totalLoad int64
+	for _, c := range clusters {
+		shard := legacyShard(c)
+		shardIndexedByCluster[c.ID] = shard
+		weight := max(weights[c.Server], 1)
+		loadsIndexedByShard[shard] += weight
+		totalLoad += weight
+		if c.Shard == nil || int(*c.Shard) >= replicas {
+			movable = append(movable, c)
+		}
+	}
+	maxLoad := float64(totalLoad) / float64(replicas) * (1 + float64(threshold)/100)
+
+	for {
+		mostLoaded, leastLoaded := 0, 0
+		for shard, load := range loadsIndexedByShard {
+			if load > loadsIndexedByShard[mostLoaded] {
+				mostLoaded = shard
+			}
+			if load < loadsIndexedByShard[leastLoaded] {
+				leastLoaded = shard
+			}
+		}
+		if float64(loadsIndexedByShard[mostLoaded]) <= maxLoad {
+			break
+		}
+		// moving a cluster reduces the imbalance only if it is lighter than the difference between the loads
+		var moved *v1alpha1.Cluster
+		for _, c := range movable {
+			weight := max(weights[c.Server], 1)
+			if shardIndexedByCluster[c.ID] == mostLoaded && weight < loadsIndexedByShard[mostLoaded]-loadsIndexedByShard[leastLoaded] &&
+				(moved == nil || weight > max(weights[moved.Server], 1)) {
+				moved = c
+			}
+		}
+		if moved == nil {
+			break
+		}
+		weight := max(weights[moved.Server], 1)
+		log.Debugf("Moving cluster with id=%s and weight %d from shard %d to shard %d", moved.ID, weight, mostLoaded, leastLoaded)
+		shardIndexedByCluster[moved.ID] = leastLoaded
+		loadsIndexedByShard[mostLoaded] -= weight
+		loadsIndexedByShard[leastLoaded] += weight
+	}
+	return shardIndexedByCluster
+}
+
+// GetClusterWeight returns the weight of a cluster for the resource-weighted sharding algorithm, which is the number of
+// resources and APIs the controller watches in the cluster rounded up to the next power of two, so that small changes
+// do not move the cluster to another shard.
+func GetClusterWeight(info *v1alpha1.ClusterCacheInfo) int64 {
+	count := info.ResourcesCount + info.APIsCount
+	if count <= 1 {
+		return 1
+	}
+	return 1 << bits.Len64(uint64(count-1))
+}
+
 // NoShardingDistributionFunction returns a DistributionFunction that will process all cluster by shard 0
 // the function is created for API compatibility purposes and is not supposed to be activated.
 func NoShardingDistributionFunction() DistributionFunction {
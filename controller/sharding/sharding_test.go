@@ -110,7 +110,7 @@ func TestGetClusterFilterUnknown(t *testing.T) {
 	t.Setenv(common.EnvControllerShardingAlgorithm, "unknown")
 	replicasCount := 2
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
-	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, "unknown", replicasCount)
+	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, nil, "unknown", replicasCount)
 	assert.Equal(t, 0, distributionFunction(nil))
 	assert.Equal(t, 0, distributionFunction(&cluster1))
 	assert.Equal(t, 1, distributionFunction(&cluster2))
@@ -125,7 +125,7 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	appAccessor, _, _, _, _, _ := createTestApps()
 	replicasCount := 5
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 4, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -135,13 +135,13 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	var fixedShard int64 = 4
 	cluster5 := &v1alpha1.Cluster{ID: "5", Shard: &fixedShard}
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(cluster5))
 
 	fixedShard = 1
 	cluster5.Shard = &fixedShard
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{ID: "4", Shard: &fixedShard}))
 }
 
@@ -153,7 +153,7 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	replicasCount := 4
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
 
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 0, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -166,14 +166,14 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	cluster5 := v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters := []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&cluster5))
 
 	fixedShard = 1
 	cluster5 = v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters = []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{Name: "cluster4", ID: "4", Shard: &fixedShard}))
 }
 
@@ -378,6 +378,131 @@ func TestConsistentHashingWhenClusterWithFixedShard(t *testing.T) {
 	assert.Equal(t, fixedShard, int64(distributionFunction(cluster)))
 }
 
+func TestResourceWeightedDistributionFunction(t *testing.T) {
+	clusters := []v1alpha1.Cluster{}
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("%06d", i)
+		clusters = append(clusters, createCluster("cluster-"+id, id))
+	}
+	clusterAccessor := getClusterAccessor(clusters)
+	replicasCount := 3
+	legacy := LegacyDistributionFunction(replicasCount)
+
+	t.Run("equal weights keep the legacy distribution", func(t *testing.T) {
+		weights := map[string]int64{}
+		for _, c := range clusters {
+			weights[c.Server] = 128
+		}
+		distributionFunction := ResourceWeightedDistributionFunction(clusterAccessor, func() map[string]int64 { return weights }, replicasCount, 20)
+		assert.Equal(t, 0, distributionFunction(nil))
+		for i := range clusters {
+			assert.Equal(t, legacy(&clusters[i]), distributionFunction(&clusters[i]))
+		}
+	})
+
+	t.Run("heavy cluster gets a shard of its own", func(t *testing.T) {
+		heavy := clusters[0]
+		weights := map[string]int64{}
+		for _, c := range clusters {
+			weights[c.Server] = 128
+		}
+		weights[heavy.Server] = 16384
+		distributionFunction := ResourceWeightedDistributionFunction(clusterAccessor, func() map[string]int64 { return weights }, replicasCount, 20)
+		heavyShard := distributionFunction(&heavy)
+		assert.Equal(t, legacy(&heavy), heavyShard)
+		moved := 0
+		for i := 1; i < len(clusters); i++ {
+			shard := distributionFunction(&clusters[i])
+			assert.NotEqual(t, heavyShard, shard)
+			if shard != legacy(&clusters[i]) {
+				moved++
+			}
+		}
+		// only the clusters sharing the shard of the heavy cluster are moved
+		assert.Positive(t, moved)
+		assert.Less(t, moved, len(clusters)/2)
+	})
+
+	t.Run("imbalance below the threshold does not move clusters", func(t *testing.T) {
+		weights := map[string]int64{}
+		for _, c := range clusters {
+			weights[c.Server] = 128
+		}
+		weights[clusters[0].Server] = 256
+		distributionFunction := ResourceWeightedDistributionFunction(clusterAccessor, func() map[string]int64 { return weights }, replicasCount, 50)
+		for i := range clusters {
+			assert.Equal(t, legacy(&clusters[i]), distributionFunction(&clusters[i]))
+		}
+	})
+
+	t.Run("pinned clusters are not moved", func(t *testing.T) {
+		var fixedShard int64 = 1
+		pinned := make([]v1alpha1.Cluster, len(clusters))
+		copy(pinned, clusters)
+		weights := map[string]int64{}
+		for i := range pinned {
+			pinned[i].Shard = &fixedShard
+			weights[pinned[i].Server] = 128
+		}
+		distributionFunction := ResourceWeightedDistributionFunction(getClusterAccessor(pinned), func() map[string]int64 { return weights }, replicasCount, 0)
+		for i := range pinned {
+			assert.Equal(t, int(fixedShard), distributionFunction(&pinned[i]))
+		}
+	})
+}
+
+func TestResourceWeightedDistributionFunctionWhenClusterWithZeroReplicas(t *testing.T) {
+	clusters := []v1alpha1.Cluster{createCluster("cluster-01", "01")}
+	distributionFunction := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), func() map[string]int64 { return nil }, 0, 20)
+	assert.Equal(t, -1, distributionFunction(nil))
+	assert.Equal(t, -1, distributionFunction(&clusters[0]))
+}
+
+// enableApplicationSharding enables application sharding for the duration of the test
+func enableApplicationSharding(t *testing.T) {
+	t.Helper()
+	previous := ApplicationShardingEnabled
+	ApplicationShardingEnabled = true
+	t.Cleanup(func() { ApplicationShardingEnabled = previous })
+}
+
+func TestIsAppShardingEnabled(t *testing.T) {
+	annotated := &v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"}}
+	assert.False(t, IsAppShardingEnabled(annotated), "the annotation is ignored unless application sharding is enabled")
+
+	enableApplicationSharding(t)
+	assert.False(t, IsAppShardingEnabled(nil))
+	assert.False(t, IsAppShardingEnabled(&v1alpha1.Cluster{}))
+	assert.False(t, IsAppShardingEnabled(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "false"}}))
+	assert.False(t, IsAppShardingEnabled(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "invalid"}}))
+	assert.True(t, IsAppShardingEnabled(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"}}))
+}
+
+func TestGetAppShard(t *testing.T) {
+	app := createApp("app1", "https://kubernetes.default.svc")
+	assert.Equal(t, -1, GetAppShard(&app, 0))
+	assert.Equal(t, 0, GetAppShard(&app, 1))
+	shard := GetAppShard(&app, 3)
+	assert.GreaterOrEqual(t, shard, 0)
+	assert.Less(t, shard, 3)
+	assert.Equal(t, shard, GetAppShard(&app, 3))
+
+	// applications with the same name in different namespaces are distributed independently
+	distribution := map[int]int{}
+	for i := 0; i < 30; i++ {
+		app.Namespace = fmt.Sprintf("ns-%d", i)
+		distribution[GetAppShard(&app, 3)]++
+	}
+	assert.Len(t, distribution, 3)
+}
+
+func TestGetClusterWeight(t *testing.T) {
+	assert.Equal(t, int64(1), GetClusterWeight(&v1alpha1.ClusterCacheInfo{}))
+	assert.Equal(t, int64(128), GetClusterWeight(&v1alpha1.ClusterCacheInfo{ResourcesCount: 100, APIsCount: 20}))
+	assert.Equal(t, int64(128), GetClusterWeight(&v1alpha1.ClusterCacheInfo{ResourcesCount: 128}))
+	assert.Equal(t, int64(256), GetClusterWeight(&v1alpha1.ClusterCacheInfo{ResourcesCount: 120, APIsCount: 9}))
+}
+
 func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	clusters, db, cluster1, cluster2, _, _, _ := createTestClusters()
 	replicasCount := 2
@@ -127,6 +127,7 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	syncTimeout           time.Duration
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -231,6 +232,10 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 
 	revisionsMayHaveChanges := false
 
+	// The dry sources of an application using the source hydrator are rendered like the sources of a multi-source
+	// application, so that they can refer to each other.
+	hasMultipleSources := app.Spec.HasMultipleSources() || len(sources) > 1
+
 	keyManifestGenerateAnnotationVal, keyManifestGenerateAnnotationExists := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]
 
 	for i, source := range sources {
@@ -282,7 +287,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 				ApiVersions:        apiVersions,
 				TrackingMethod:     trackingMethod,
 				RefSources:         refSources,
-				HasMultipleSources: app.Spec.HasMultipleSources(),
+				HasMultipleSources: hasMultipleSources,
 				InstallationID:     installationID,
 			})
 			if err != nil {
@@ -332,7 +337,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enabledSourceTypes,
 			HelmOptions:                     helmOptions,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              hasMultipleSources,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -1088,6 +1093,7 @@ func (m *appStateManager) persistRevisionHistory(
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
+	autoRollbackFrom *int64,
 ) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -1096,21 +1102,23 @@ func (m *appStateManager) persistRevisionHistory(
 
 	if hasMultipleSources {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
-			DeployStartedAt: &startedAt,
-			ID:              nextID,
-			Sources:         sources,
-			Revisions:       revisions,
-			InitiatedBy:     initiatedBy,
+			DeployedAt:       metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:  &startedAt,
+			ID:               nextID,
+			Sources:          sources,
+			Revisions:        revisions,
+			InitiatedBy:      initiatedBy,
+			AutoRollbackFrom: autoRollbackFrom,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			Revision:        revision,
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
-			DeployStartedAt: &startedAt,
-			ID:              nextID,
-			Source:          source,
-			InitiatedBy:     initiatedBy,
+			Revision:         revision,
+			DeployedAt:       metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:  &startedAt,
+			ID:               nextID,
+			Source:           source,
+			InitiatedBy:      initiatedBy,
+			AutoRollbackFrom: autoRollbackFrom,
 		})
 	}
 
@@ -1146,6 +1154,7 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	syncTimeout time.Duration,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
@@ -1164,6 +1173,7 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		syncTimeout:           syncTimeout,
 	}
 }
 
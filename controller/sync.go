@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -304,6 +305,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 	}
 
+	globalPruneThreshold, err := m.settingsMgr.GetPruneThreshold()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to load prune threshold: %v", err)
+		return
+	}
+
+	syncTimeout, _ := getSyncTimeout(app, project, m.syncTimeout)
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
 		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
@@ -336,6 +345,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveApprovalHook(func(phase common.SyncPhase, wave int) bool {
+			if syncOp.IsWaveApproved(phase, int64(wave)) {
+				return true
+			}
+			state.PendingApproval = &v1alpha1.SyncWaveApproval{Phase: phase, Wave: int64(wave)}
+			return false
+		}),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -347,7 +363,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			clientSideApplyManager,
 		),
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
+		sync.WithPruneThreshold(getPruneThreshold(app, project, globalPruneThreshold), isPruneThresholdConfirmed(app)),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		// Unlike a termination requested by a user, a sync terminated because it timed out runs the SyncFail hooks.
+		sync.WithSyncFailHooksOnTerminate(isSyncTimedOut(state, syncTimeout)),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
@@ -374,6 +393,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	start := time.Now()
 
+	// set again by the sync wave approval hook if the operation still waits for approval
+	state.PendingApproval = nil
 	if state.Phase == common.OperationTerminating {
 		syncCtx.Terminate()
 	} else {
@@ -423,7 +444,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy, syncOp.AutoRollbackFrom)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -570,6 +591,52 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 	return !canSync, nil
 }
 
+// getSyncTimeout returns the maximum amount of time a sync operation of the given application may run, and whether it
+// is set by the "application", its "project" or the "controller". The timeout of the application takes precedence over
+// the one of its project, which takes precedence over controllerTimeout. A timeout of 0 means the operation never times
+// out. Invalid timeouts are ignored, they are reported when the application or project is validated.
+func getSyncTimeout(app *v1alpha1.Application, proj *v1alpha1.AppProject, controllerTimeout time.Duration) (time.Duration, string) {
+	if timeout, err := app.Spec.SyncPolicy.GetTimeout(); err == nil && timeout > 0 {
+		return timeout, "application"
+	}
+	if proj != nil {
+		if timeout, err := proj.Spec.GetSyncTimeout(); err == nil && timeout > 0 {
+			return timeout, "project"
+		}
+	}
+	return controllerTimeout, "controller"
+}
+
+// getPruneThreshold returns the maximum number or percentage of resources a sync of the application may prune without
+// confirmation. The threshold of the application takes precedence over the one of its project, which takes precedence
+// over the global one.
+func getPruneThreshold(app *v1alpha1.Application, proj *v1alpha1.AppProject, globalThreshold *intstr.IntOrString) *intstr.IntOrString {
+	if threshold, err := app.Spec.SyncPolicy.GetPruneThreshold(); err == nil && threshold != nil {
+		return threshold
+	}
+	if proj != nil {
+		if threshold, err := proj.Spec.GetPruneThreshold(); err == nil && threshold != nil {
+			return threshold
+		}
+	}
+	return globalThreshold
+}
+
+// isPruneThresholdConfirmed returns whether pruning more resources than the prune threshold allows was confirmed since
+// the application was last synced successfully.
+func isPruneThresholdConfirmed(app *v1alpha1.Application) bool {
+	var since time.Time
+	if len(app.Status.History) > 0 {
+		since = app.Status.History.LastRevisionHistory().DeployedAt.Time
+	}
+	return app.IsDeletionConfirmed(since)
+}
+
+// isSyncTimedOut returns whether the given operation has been running for longer than the given timeout.
+func isSyncTimedOut(state *v1alpha1.OperationState, timeout time.Duration) bool {
+	return timeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(timeout))
+}
+
 // deriveServiceAccountToImpersonate determines the service account to be used for impersonation for the sync operation.
 // The returned service account will be fully qualified including namespace and the service account name in the format system:serviceaccount:<namespace>:<service_account>
 func deriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application *v1alpha1.Application, destCluster *v1alpha1.Cluster) (string, error) {
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"slices"
+	"sync"
+)
+
+// clusterSyncSlots limits the number of concurrent sync operations per destination cluster. Operations which exceed
+// the limit of their cluster wait for a slot in the order they asked for one.
+type clusterSyncSlots struct {
+	lock sync.Mutex
+	// running contains the keys of the apps holding a slot by cluster server
+	running map[string]map[string]bool
+	// waiting contains the keys of the apps waiting for a slot by cluster server, in the order they asked for one
+	waiting map[string][]string
+	// servers contains the cluster server of the apps holding or waiting for a slot by app key
+	servers map[string]string
+}
+
+func newClusterSyncSlots() *clusterSyncSlots {
+	return &clusterSyncSlots{
+		running: map[string]map[string]bool{},
+		waiting: map[string][]string{},
+		servers: map[string]string{},
+	}
+}
+
+// acquire returns whether the app may run a sync operation against the cluster, or its position in the queue of the
+// cluster otherwise. A limit of 0 means unlimited. Operations which already started always get a slot, so that the
+// operations running before the controller restarted are accounted for.
+func (s *clusterSyncSlots) acquire(server string, appKey string, limit int, started bool) (bool, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.running[server][appKey] {
+		return true, 0
+	}
+	if prev, ok := s.servers[appKey]; ok && prev != server {
+		// the destination of the app changed while it was waiting
+		s.removeLocked(appKey)
+	}
+	s.servers[appKey] = server
+
+	position := slices.Index(s.waiting[server], appKey)
+	if position < 0 {
+		s.waiting[server] = append(s.waiting[server], appKey)
+		position = len(s.waiting[server]) - 1
+	}
+	if !started && limit > 0 && position >= limit-len(s.running[server]) {
+		return false, position + 1
+	}
+
+	s.waiting[server] = slices.Delete(s.waiting[server], position, position+1)
+	if s.running[server] == nil {
+		s.running[server] = map[string]bool{}
+	}
+	s.running[server][appKey] = true
+	return true, 0
+}
+
+// release frees the slot held by the app, or removes it from the queue it is waiting in. It returns the cluster server
+// of the slot along with the keys of the apps waiting for a slot of that cluster.
+func (s *clusterSyncSlots) release(appKey string) (string, []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	server, ok := s.servers[appKey]
+	if !ok {
+		return "", nil
+	}
+	s.removeLocked(appKey)
+	return server, slices.Clone(s.waiting[server])
+}
+
+func (s *clusterSyncSlots) removeLocked(appKey string) {
+	server := s.servers[appKey]
+	delete(s.servers, appKey)
+	delete(s.running[server], appKey)
+	if len(s.running[server]) == 0 {
+		delete(s.running, server)
+	}
+	s.waiting[server] = slices.DeleteFunc(s.waiting[server], func(key string) bool {
+		return key == appKey
+	})
+	if len(s.waiting[server]) == 0 {
+		delete(s.waiting, server)
+	}
+}
+
+// count returns the number of operations running against the cluster and waiting for one of its slots
+func (s *clusterSyncSlots) count(server string) (int, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.running[server]), len(s.waiting[server])
+}
+
+// keys returns the keys of the apps holding or waiting for a slot
+func (s *clusterSyncSlots) keys() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := make([]string, 0, len(s.servers))
+	for appKey := range s.servers {
+		keys = append(keys, appKey)
+	}
+	slices.Sort(keys)
+	return keys
+}
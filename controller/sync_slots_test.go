@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterSyncSlots(t *testing.T) {
+	slots := newClusterSyncSlots()
+
+	acquired, _ := slots.acquire("https://cluster-1", "argocd/app-1", 2, false)
+	assert.True(t, acquired)
+	acquired, _ = slots.acquire("https://cluster-1", "argocd/app-2", 2, false)
+	assert.True(t, acquired)
+	// another cluster has its own slots
+	acquired, _ = slots.acquire("https://cluster-2", "argocd/app-3", 2, false)
+	assert.True(t, acquired)
+
+	acquired, position := slots.acquire("https://cluster-1", "argocd/app-4", 2, false)
+	assert.False(t, acquired)
+	assert.Equal(t, 1, position)
+	acquired, position = slots.acquire("https://cluster-1", "argocd/app-5", 2, false)
+	assert.False(t, acquired)
+	assert.Equal(t, 2, position)
+	running, waiting := slots.count("https://cluster-1")
+	assert.Equal(t, 2, running)
+	assert.Equal(t, 2, waiting)
+
+	// acquiring a held slot again is a no-op
+	acquired, _ = slots.acquire("https://cluster-1", "argocd/app-1", 2, false)
+	assert.True(t, acquired)
+
+	server, waitingKeys := slots.release("argocd/app-1")
+	assert.Equal(t, "https://cluster-1", server)
+	assert.Equal(t, []string{"argocd/app-4", "argocd/app-5"}, waitingKeys)
+
+	// operations are given the freed slot in the order they asked for one
+	acquired, position = slots.acquire("https://cluster-1", "argocd/app-5", 2, false)
+	assert.False(t, acquired)
+	assert.Equal(t, 2, position)
+	acquired, _ = slots.acquire("https://cluster-1", "argocd/app-4", 2, false)
+	assert.True(t, acquired)
+
+	// operations which already started are always given a slot
+	acquired, _ = slots.acquire("https://cluster-1", "argocd/app-6", 2, true)
+	assert.True(t, acquired)
+	running, waiting = slots.count("https://cluster-1")
+	assert.Equal(t, 3, running)
+	assert.Equal(t, 1, waiting)
+
+	// releasing a waiting operation removes it from the queue
+	server, waitingKeys = slots.release("argocd/app-5")
+	assert.Equal(t, "https://cluster-1", server)
+	assert.Empty(t, waitingKeys)
+	server, _ = slots.release("argocd/unknown")
+	assert.Empty(t, server)
+
+	// 0 means unlimited
+	acquired, _ = slots.acquire("https://cluster-1", "argocd/app-7", 0, false)
+	assert.True(t, acquired)
+
+	assert.Equal(t, []string{"argocd/app-2", "argocd/app-3", "argocd/app-4", "argocd/app-6", "argocd/app-7"}, slots.keys())
+}
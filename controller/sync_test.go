@@ -3,6 +3,7 @@ package controller
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -13,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/testdata"
@@ -670,6 +672,64 @@ func TestNormalizeTargetResourcesWithList(t *testing.T) {
 	})
 }
 
+func TestGetSyncTimeout(t *testing.T) {
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{}
+
+	timeout, source := getSyncTimeout(app, proj, time.Minute)
+	assert.Equal(t, time.Minute, timeout)
+	assert.Equal(t, "controller", source)
+
+	proj.Spec.SyncTimeout = "10m"
+	timeout, source = getSyncTimeout(app, proj, time.Minute)
+	assert.Equal(t, 10*time.Minute, timeout)
+	assert.Equal(t, "project", source)
+
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Timeout: "1h"}
+	timeout, source = getSyncTimeout(app, proj, time.Minute)
+	assert.Equal(t, time.Hour, timeout)
+	assert.Equal(t, "application", source)
+
+	// Invalid timeouts are ignored.
+	app.Spec.SyncPolicy.Timeout = "invalid"
+	timeout, source = getSyncTimeout(app, nil, time.Minute)
+	assert.Equal(t, time.Minute, timeout)
+	assert.Equal(t, "controller", source)
+}
+
+func TestGetPruneThreshold(t *testing.T) {
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{}
+	global := intstr.FromString("90%")
+
+	assert.Nil(t, getPruneThreshold(app, proj, nil))
+	assert.Equal(t, &global, getPruneThreshold(app, proj, &global))
+
+	proj.Spec.PruneThreshold = "50%"
+	assert.Equal(t, intstr.FromString("50%"), *getPruneThreshold(app, proj, &global))
+
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{PruneThreshold: "10"}
+	assert.Equal(t, intstr.FromInt32(10), *getPruneThreshold(app, proj, &global))
+
+	// Invalid thresholds are ignored.
+	app.Spec.SyncPolicy.PruneThreshold = "invalid"
+	assert.Equal(t, &global, getPruneThreshold(app, nil, &global))
+}
+
+func TestIsPruneThresholdConfirmed(t *testing.T) {
+	app := newFakeApp()
+	app.Status.History = nil
+	assert.False(t, isPruneThresholdConfirmed(app))
+
+	deployedAt := time.Now().Add(-time.Hour)
+	app.Status.History = v1alpha1.RevisionHistories{{ID: 1, DeployedAt: metav1.NewTime(deployedAt)}}
+	app.Annotations = map[string]string{synccommon.AnnotationDeletionApproved: deployedAt.Add(-time.Minute).Format(time.RFC3339)}
+	assert.False(t, isPruneThresholdConfirmed(app), "confirmations given before the last sync are ignored")
+
+	app.Annotations[synccommon.AnnotationDeletionApproved] = time.Now().Format(time.RFC3339)
+	assert.True(t, isPruneThresholdConfirmed(app))
+}
+
 func TestDeriveServiceAccountMatchingNamespaces(t *testing.T) {
 	t.Parallel()
 
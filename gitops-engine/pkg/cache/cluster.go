@@ -30,6 +30,7 @@ import (
 	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -48,6 +49,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	authType1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/pager"
@@ -97,6 +99,11 @@ type apiMeta struct {
 	// watchCancel stops the watch of all resources for this API. This gets called when the cache is invalidated or when
 	// the watched API ceases to exist (e.g. a CRD gets deleted).
 	watchCancel context.CancelFunc
+	// resource holds the watched version of the API
+	resource schema.GroupVersionResource
+	// resourceVersions holds, for each watched namespace, the resource version of the most recent event applied to
+	// the cache. It is used to resume the watches from a snapshot.
+	resourceVersions map[string]string
 }
 
 type eventMeta struct {
@@ -120,6 +127,8 @@ type ClusterInfo struct {
 	SyncError error
 	// APIResources holds list of API resources supported by the cluster
 	APIResources []kube.APIResourceInfo
+	// MetadataOnlyResourcesCount holds number of observed Kubernetes resources for which only the metadata is retrieved
+	MetadataOnlyResourcesCount int
 }
 
 // OnEventHandler is a function that handles Kubernetes event
@@ -275,6 +284,27 @@ type clusterCache struct {
 	// Maps any resource's UID to its direct children's ResourceKeys
 	// Eliminates need for O(n) graph building during hierarchy traversal
 	parentUIDToChildren map[types.UID][]kube.ResourceKey
+
+	// snapshotStore persists the snapshots used to warm up the cache on its first synchronization
+	snapshotStore    SnapshotStore
+	snapshotInterval time.Duration
+	snapshotMaxAge   time.Duration
+	// snapshotCancel stops persisting the snapshots of the cache
+	snapshotCancel context.CancelFunc
+	// initialSyncDone is true once the cache has been successfully synchronized
+	initialSyncDone bool
+
+	// metadataOnlyFilter returns true for the kinds watched as PartialObjectMetadata
+	metadataOnlyFilter func(gk schema.GroupKind) bool
+	// needsFullObject returns true if the full object of a metadata-only resource is required
+	needsFullObject func(un *unstructured.Unstructured) bool
+	// metadataOnlyResourcesCount holds the number of cached resources for which only the metadata was retrieved
+	metadataOnlyResourcesCount int
+
+	// watchListEnabled is true if the resources are loaded using watch list requests
+	watchListEnabled bool
+	// watchListUnsupported is set once the server rejected a watch list request, until the next synchronization
+	watchListUnsupported atomic.Bool
 }
 
 type clusterCacheSync struct {
@@ -445,7 +475,7 @@ func (c *clusterCache) replaceResourceCache(gk schema.GroupKind, resources []*Re
 }
 
 func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
-	ownerRefs, isInferredParentOf := c.resolveResourceReferences(un)
+	ownerRefs, isInferredParentOf, volumeClaimTemplates := c.resolveResourceReferences(un)
 
 	cacheManifest := false
 	var info any
@@ -458,16 +488,20 @@ func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
 		creationTimestamp = &ct
 	}
 	resource := &Resource{
-		ResourceVersion:    un.GetResourceVersion(),
-		Ref:                kube.GetObjectRef(un),
-		OwnerRefs:          ownerRefs,
-		Info:               info,
-		CreationTimestamp:  creationTimestamp,
-		isInferredParentOf: isInferredParentOf,
+		ResourceVersion:      un.GetResourceVersion(),
+		Ref:                  kube.GetObjectRef(un),
+		OwnerRefs:            ownerRefs,
+		Info:                 info,
+		CreationTimestamp:    creationTimestamp,
+		isInferredParentOf:   isInferredParentOf,
+		volumeClaimTemplates: volumeClaimTemplates,
 	}
 	if cacheManifest {
 		resource.Resource = un
 	}
+	if c.isMetadataOnly(resource.Ref.GroupVersionKind().GroupKind()) && isMetadataOnlyObject(un) {
+		resource.metadataOnly = true
+	}
 
 	return resource
 }
@@ -477,6 +511,12 @@ func (c *clusterCache) setNode(n *Resource) {
 
 	// Keep track of existing resource for index updates
 	existing := c.resources[key]
+	if existing != nil && existing.metadataOnly {
+		c.metadataOnlyResourcesCount--
+	}
+	if n.metadataOnly {
+		c.metadataOnlyResourcesCount++
+	}
 
 	c.resources[key] = n
 	ns, ok := c.nsIndex[key.Namespace]
@@ -598,6 +638,7 @@ func (c *clusterCache) Invalidate(opts ...UpdateSettingsFunc) {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopPersistingSnapshots()
 	for i := range opts {
 		opts[i](c)
 	}
@@ -648,6 +689,10 @@ func (c *clusterCache) startMissingWatches() error {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(c.config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -658,9 +703,9 @@ func (c *clusterCache) startMissingWatches() error {
 		namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
 			ctx, cancel := context.WithCancel(context.Background())
-			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel, resource: api.GroupVersionResource}
 
-			err := c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			err := c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
 				resourceVersion, err := c.loadInitialState(ctx, api, resClient, ns, false) // don't lock here, we are already in a lock before startMissingWatches is called inside watchEvents
 				if err != nil && c.isRestrictedResource(err) {
 					keep := false
@@ -750,15 +795,9 @@ func (c *clusterCache) listResources(ctx context.Context, resClient dynamic.Reso
 // loadInitialState loads the state of all the resources retrieved by the given resource client.
 func (c *clusterCache) loadInitialState(ctx context.Context, api kube.APIResourceInfo, resClient dynamic.ResourceInterface, ns string, lock bool) (string, error) {
 	var items []*Resource
-	resourceVersion, err := c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
-		return listPager.EachListItem(ctx, metav1.ListOptions{}, func(obj runtime.Object) error {
-			if un, ok := obj.(*unstructured.Unstructured); !ok {
-				return fmt.Errorf("object %s/%s has an unexpected type", un.GroupVersionKind().String(), un.GetName())
-			} else {
-				items = append(items, c.newResource(un))
-			}
-			return nil
-		})
+	resourceVersion, err := c.listObjects(ctx, resClient, func(un *unstructured.Unstructured) error {
+		items = append(items, c.newResource(un))
+		return nil
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to load initial state of resource %s: %w", api.GroupKind.String(), err)
@@ -767,13 +806,22 @@ func (c *clusterCache) loadInitialState(ctx context.Context, api kube.APIResourc
 	if lock {
 		return resourceVersion, runSynced(&c.lock, func() error {
 			c.replaceResourceCache(api.GroupKind, items, ns)
+			c.setListResourceVersion(api.GroupKind, ns, resourceVersion)
 			return nil
 		})
 	}
 	c.replaceResourceCache(api.GroupKind, items, ns)
+	c.setListResourceVersion(api.GroupKind, ns, resourceVersion)
 	return resourceVersion, nil
 }
 
+// setListResourceVersion records the resource version of the list of the given API. The cache lock must be held.
+func (c *clusterCache) setListResourceVersion(gk schema.GroupKind, ns string, resourceVersion string) {
+	if info, ok := c.apisMeta[gk]; ok && resourceVersion != "" {
+		info.setResourceVersion(ns, resourceVersion)
+	}
+}
+
 func (c *clusterCache) watchEvents(ctx context.Context, api kube.APIResourceInfo, resClient dynamic.ResourceInterface, ns string, resourceVersion string) {
 	kube.RetryUntilSucceed(ctx, watchResourcesRetryTimeout, fmt.Sprintf("watch %s on %s", api.GroupKind, c.config.Host), c.log, func() (err error) {
 		defer func() {
@@ -790,12 +838,19 @@ func (c *clusterCache) watchEvents(ctx context.Context, api kube.APIResourceInfo
 			}
 		}
 
-		w, err := watchutil.NewRetryWatcherWithContext(ctx, resourceVersion, &cache.ListWatch{
+		// the retry watcher keeps retrying when the watch request itself fails because the resource version is too old,
+		// which happens when resuming from a snapshot, so it is stopped to relist the API instead.
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+		w, err := watchutil.NewRetryWatcherWithContext(watchCtx, resourceVersion, &cache.ListWatch{
 			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
 				res, err := resClient.Watch(ctx, options)
 				if apierrors.IsNotFound(err) {
 					c.stopWatching(api.GroupKind, ns)
 				}
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					cancelWatch()
+				}
 				//nolint:wrapcheck // wrap outside the retry
 				return res, err
 			},
@@ -907,19 +962,53 @@ func (c *clusterCache) watchEvents(ctx context.Context, api kube.APIResourceInfo
 	})
 }
 
+// newMetadataClient returns the client used to list and watch metadata-only resources, or nil if none are configured
+func (c *clusterCache) newMetadataClient() (metadata.Interface, error) {
+	if c.metadataOnlyFilter == nil {
+		return nil, nil
+	}
+	client, err := c.kubectl.NewMetadataClient(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	return client, nil
+}
+
 // processApi processes all the resources for a given API. First we construct an API client for the given API. Then we
 // call the callback. If we're managing the whole cluster, we call the callback with the client and an empty namespace.
-// If we're managing specific namespaces, we call the callback for each namespace.
-func (c *clusterCache) processApi(client dynamic.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
+// If we're managing specific namespaces, we call the callback for each namespace. The resources of metadata-only APIs
+// are listed and watched using the metadata client.
+func (c *clusterCache) processApi(client dynamic.Interface, metadataClient metadata.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
 	resClient := client.Resource(api.GroupVersionResource)
+	newResClient := func(ns string) dynamic.ResourceInterface {
+		var nsClient dynamic.ResourceInterface = resClient
+		if ns != "" {
+			nsClient = resClient.Namespace(ns)
+		}
+		if metadataClient == nil || !c.isMetadataOnly(api.GroupKind) {
+			return nsClient
+		}
+		var nsMetadataClient metadata.ResourceInterface = metadataClient.Resource(api.GroupVersionResource)
+		if ns != "" {
+			nsMetadataClient = metadataClient.Resource(api.GroupVersionResource).Namespace(ns)
+		}
+		return &metadataResourceClient{
+			ResourceInterface: nsClient,
+			client:            resClient,
+			metadata:          nsMetadataClient,
+			gvk:               api.GroupVersionResource.GroupVersion().WithKind(api.GroupKind.Kind),
+			needsFullObject:   c.needsFullObject,
+			log:               c.log,
+		}
+	}
 	switch {
 	// if manage whole cluster or resource is cluster level and cluster resources enabled
 	case len(c.namespaces) == 0 || (!api.Meta.Namespaced && c.clusterResources):
-		return callback(resClient, "")
+		return callback(newResClient(""), "")
 	// if manage some namespaces and resource is namespaced
 	case len(c.namespaces) != 0 && api.Meta.Namespaced:
 		for _, ns := range c.namespaces {
-			err := callback(resClient.Namespace(ns), ns)
+			err := callback(newResClient(ns), ns)
 			if err != nil {
 				return err
 			}
@@ -996,6 +1085,7 @@ func (c *clusterCache) sync() error {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopPersistingSnapshots()
 
 	if c.batchEventsProcessing {
 		c.invalidateEventMeta()
@@ -1004,6 +1094,8 @@ func (c *clusterCache) sync() error {
 
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
 	c.resources = make(map[kube.ResourceKey]*Resource)
+	c.metadataOnlyResourcesCount = 0
+	c.watchListUnsupported.Store(false)
 	c.namespacedResources = make(map[schema.GroupKind]bool)
 	c.parentUIDToChildren = make(map[types.UID][]kube.ResourceKey)
 	config := c.config
@@ -1037,6 +1129,10 @@ func (c *clusterCache) sync() error {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -1046,6 +1142,11 @@ func (c *clusterCache) sync() error {
 		go c.processEvents()
 	}
 
+	var snapshotAPIs map[snapshotAPIKey]*SnapshotAPI
+	if c.snapshotStore != nil && !c.initialSyncDone {
+		snapshotAPIs = c.loadSnapshot()
+	}
+
 	// Each API is processed in parallel, so we need to take out a lock when we update clusterCache fields.
 	lock := sync.Mutex{}
 	err = kube.RunAllAsync(len(apis), func(i int) error {
@@ -1053,24 +1154,30 @@ func (c *clusterCache) sync() error {
 
 		lock.Lock()
 		ctx, cancel := context.WithCancel(context.Background())
-		info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+		info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel, resource: api.GroupVersionResource}
 		c.apisMeta[api.GroupKind] = info
 		c.namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		lock.Unlock()
 
-		return c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
-			resourceVersion, err := c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
-				return listPager.EachListItem(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
-					if un, ok := obj.(*unstructured.Unstructured); !ok {
-						return fmt.Errorf("object %s/%s has an unexpected type", un.GroupVersionKind().String(), un.GetName())
-					} else {
-						newRes := c.newResource(un)
-						lock.Lock()
-						c.setNode(newRes)
-						lock.Unlock()
-					}
-					return nil
-				})
+		return c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			// resume the watch from the snapshot, the API gets relisted if the resource version is too old
+			if snapshotAPI, ok := snapshotAPIs[snapshotAPIKey{api.GroupKind, ns}]; ok && snapshotAPI.GroupVersionResource == api.GroupVersionResource {
+				lock.Lock()
+				for i := range snapshotAPI.Resources {
+					c.setNode(c.newResourceFromSnapshot(&snapshotAPI.Resources[i]))
+				}
+				info.setResourceVersion(ns, snapshotAPI.ResourceVersion)
+				lock.Unlock()
+				go c.watchEvents(ctx, api, resClient, ns, snapshotAPI.ResourceVersion)
+				return nil
+			}
+
+			resourceVersion, err := c.listObjects(ctx, resClient, func(un *unstructured.Unstructured) error {
+				newRes := c.newResource(un)
+				lock.Lock()
+				c.setNode(newRes)
+				lock.Unlock()
+				return nil
 			})
 			if err != nil {
 				if c.isRestrictedResource(err) {
@@ -1094,6 +1201,9 @@ func (c *clusterCache) sync() error {
 				return fmt.Errorf("failed to load initial state of resource %s: %w", api.GroupKind.String(), err)
 			}
 
+			lock.Lock()
+			info.setResourceVersion(ns, resourceVersion)
+			lock.Unlock()
 			go c.watchEvents(ctx, api, resClient, ns, resourceVersion)
 
 			return nil
@@ -1107,10 +1217,25 @@ func (c *clusterCache) sync() error {
 	// Rebuild orphaned children index after all resources are loaded
 	c.rebuildParentToChildrenIndex()
 
+	c.initialSyncDone = true
+	if c.snapshotStore != nil && c.snapshotInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		c.snapshotCancel = cancel
+		go c.persistSnapshots(ctx)
+	}
+
 	c.log.Info("Cluster successfully synced")
 	return nil
 }
 
+// stopPersistingSnapshots stops persisting the snapshots of the cache, if started
+func (c *clusterCache) stopPersistingSnapshots() {
+	if c.snapshotCancel != nil {
+		c.snapshotCancel()
+		c.snapshotCancel = nil
+	}
+}
+
 // invalidateEventMeta closes the eventMeta channel if it is open
 func (c *clusterCache) invalidateEventMeta() {
 	if c.eventMetaCh != nil {
@@ -1560,6 +1685,7 @@ func (c *clusterCache) processEvent(key kube.ResourceKey, evMeta eventMeta) {
 	} else {
 		c.onNodeUpdated(existingNode, c.newResource(evMeta.un))
 	}
+	c.updateResourceVersion(key, evMeta.un.GetResourceVersion())
 }
 
 func (c *clusterCache) onNodeUpdated(oldRes *Resource, newRes *Resource) {
@@ -1573,6 +1699,9 @@ func (c *clusterCache) onNodeRemoved(key kube.ResourceKey) {
 	existing, ok := c.resources[key]
 	if ok {
 		delete(c.resources, key)
+		if existing.metadataOnly {
+			c.metadataOnlyResourcesCount--
+		}
 		ns, ok := c.nsIndex[key.Namespace]
 		if ok {
 			delete(ns, key)
@@ -1621,6 +1750,8 @@ func (c *clusterCache) GetClusterInfo() ClusterInfo {
 		LastCacheSyncTime: c.syncStatus.syncTime,
 		SyncError:         c.syncStatus.syncError,
 		APIResources:      c.apiResources,
+
+		MetadataOnlyResourcesCount: c.metadataOnlyResourcesCount,
 	}
 }
 
@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+)
+
+// requiresFullObject returns true if the cache needs the full objects of the given kind to track the ownership of
+// resources, or to discover APIs, whatever the metadata-only settings are.
+func requiresFullObject(gk schema.GroupKind) bool {
+	switch {
+	case gk.Kind == kube.CustomResourceDefinitionKind && gk.Group == "apiextensions.k8s.io":
+		return true
+	case gk.Kind == kube.StatefulSetKind && (gk.Group == "apps" || gk.Group == "extensions"):
+		return true
+	}
+	return false
+}
+
+// needsFullObjectForReferences returns true if the full object is required to resolve the references of the resource
+// with the given metadata.
+func needsFullObjectForReferences(un *unstructured.Unstructured) bool {
+	gvk := un.GroupVersionKind()
+	if gvk.Kind == kube.SecretKind && gvk.Group == "" {
+		// the type of service account token secrets is not part of their metadata
+		annotations := un.GetAnnotations()
+		return annotations["kubernetes.io/service-account.uid"] != "" && annotations["kubernetes.io/service-account.name"] != ""
+	}
+	return false
+}
+
+// isMetadataOnly returns true if the resources of the given kind are listed and watched as PartialObjectMetadata
+func (c *clusterCache) isMetadataOnly(gk schema.GroupKind) bool {
+	return c.metadataOnlyFilter != nil && c.metadataOnlyFilter(gk) && !requiresFullObject(gk)
+}
+
+// isMetadataOnlyObject returns true if the given object only holds the metadata of a resource
+func isMetadataOnlyObject(un *unstructured.Unstructured) bool {
+	for k := range un.Object {
+		if k != "apiVersion" && k != "kind" && k != "metadata" {
+			return false
+		}
+	}
+	return true
+}
+
+// metadataResourceClient is a dynamic resource client which lists and watches resources as PartialObjectMetadata. The
+// full objects are only retrieved for the resources which need them.
+type metadataResourceClient struct {
+	dynamic.ResourceInterface
+	// client is used to retrieve the full objects
+	client          dynamic.NamespaceableResourceInterface
+	metadata        metadata.ResourceInterface
+	gvk             schema.GroupVersionKind
+	needsFullObject func(un *unstructured.Unstructured) bool
+	log             logr.Logger
+}
+
+func (c *metadataResourceClient) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := c.metadata.List(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // wrapped by the caller
+		return nil, err
+	}
+	res := &unstructured.UnstructuredList{Items: make([]unstructured.Unstructured, 0, len(list.Items))}
+	res.SetResourceVersion(list.ResourceVersion)
+	res.SetContinue(list.Continue)
+	res.SetRemainingItemCount(list.RemainingItemCount)
+	items := make([]*unstructured.Unstructured, len(list.Items))
+	// the full objects of a page are retrieved concurrently, the requests being throttled by the client rate limiter
+	err = kube.RunAllAsync(len(list.Items), func(i int) error {
+		un, err := c.toUnstructured(ctx, &list.Items[i])
+		items[i] = un
+		return err
+	})
+	if err != nil {
+		//nolint:wrapcheck // wrapped by the caller
+		return nil, err
+	}
+	for _, un := range items {
+		res.Items = append(res.Items, *un)
+	}
+	return res, nil
+}
+
+func (c *metadataResourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.metadata.Watch(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // wrapped by the caller
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		obj, ok := event.Object.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return event, true
+		}
+		if event.Type == watch.Deleted || event.Type == watch.Bookmark {
+			event.Object = c.metadataToUnstructured(obj)
+			return event, true
+		}
+		un, err := c.toUnstructured(ctx, obj)
+		if err != nil {
+			// the resource is cached from its metadata and the full object is retrieved on its next change
+			c.log.Error(err, "Failed to get full object", "name", obj.Name, "namespace", obj.Namespace)
+			un = c.metadataToUnstructured(obj)
+		}
+		event.Object = un
+		return event, true
+	}), nil
+}
+
+func (c *metadataResourceClient) metadataToUnstructured(obj *metav1.PartialObjectMetadata) *unstructured.Unstructured {
+	un := &unstructured.Unstructured{Object: map[string]any{}}
+	if content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj.ObjectMeta); err == nil {
+		un.Object["metadata"] = content
+	} else {
+		un.SetName(obj.Name)
+		un.SetNamespace(obj.Namespace)
+		un.SetUID(obj.UID)
+		un.SetResourceVersion(obj.ResourceVersion)
+	}
+	un.SetGroupVersionKind(c.gvk)
+	return un
+}
+
+// toUnstructured converts the given metadata to an unstructured object, which holds the full object if needed
+func (c *metadataResourceClient) toUnstructured(ctx context.Context, obj *metav1.PartialObjectMetadata) (*unstructured.Unstructured, error) {
+	un := c.metadataToUnstructured(obj)
+	if !needsFullObjectForReferences(un) && (c.needsFullObject == nil || !c.needsFullObject(un)) {
+		return un, nil
+	}
+	full, err := c.client.Namespace(un.GetNamespace()).Get(ctx, un.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the resource has been deleted in the meantime, and the deletion event is going to be received
+			return un, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", c.gvk.Kind, un.GetNamespace(), un.GetName(), err)
+	}
+	return full, nil
+}
@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+)
+
+var podsGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+func newMetadataTestPod(name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name), ResourceVersion: "1", Labels: labels},
+		Spec:       corev1.PodSpec{NodeName: "node"},
+	}
+}
+
+func toPartialObjectMetadata(pod *corev1.Pod) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{TypeMeta: pod.TypeMeta, ObjectMeta: pod.ObjectMeta}
+}
+
+func newMetadataClient(t *testing.T, objs ...runtime.Object) *metadatafake.FakeMetadataClient {
+	t.Helper()
+	s := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(s))
+	client := metadatafake.NewSimpleMetadataClient(s, objs...)
+	reactor := client.ReactionChain[0]
+	client.PrependReactor("list", "*", func(action testcore.Action) (handled bool, ret runtime.Object, err error) {
+		handled, ret, err = reactor.React(action)
+		if err != nil || !handled {
+			return handled, ret, err
+		}
+		// make sure list response have resource version
+		ret.(metav1.ListInterface).SetResourceVersion("123")
+		return handled, ret, nil
+	})
+	return client
+}
+
+func isManagedTestResource(un *unstructured.Unstructured) bool {
+	return un.GetLabels()["app"] != ""
+}
+
+func TestMetadataOnly_Sync(t *testing.T) {
+	managed := newMetadataTestPod("managed", map[string]string{"app": "guestbook"})
+	unmanaged := newMetadataTestPod("unmanaged", nil)
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{
+		SetMetadataOnlyResources(func(gk schema.GroupKind) bool {
+			return gk.Kind == kube.PodKind
+		}, isManagedTestResource),
+		SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, _ bool) (any, bool) {
+			return nil, isManagedTestResource(un)
+		}),
+	}, managed, unmanaged)
+	metadataClient := newMetadataClient(t, toPartialObjectMetadata(managed), toPartialObjectMetadata(unmanaged))
+	cluster.kubectl.(*kubetest.MockKubectlCmd).MetadataClient = metadataClient
+	t.Cleanup(func() {
+		cluster.Invalidate()
+	})
+	require.NoError(t, cluster.EnsureSynced())
+
+	resources := cluster.FindResources("default")
+	managedRes := resources[kube.NewResourceKey("", kube.PodKind, "default", "managed")]
+	require.NotNil(t, managedRes)
+	assert.False(t, managedRes.metadataOnly)
+	require.NotNil(t, managedRes.Resource)
+	nodeName, _, _ := unstructured.NestedString(managedRes.Resource.Object, "spec", "nodeName")
+	assert.Equal(t, "node", nodeName)
+
+	unmanagedRes := resources[kube.NewResourceKey("", kube.PodKind, "default", "unmanaged")]
+	require.NotNil(t, unmanagedRes)
+	assert.True(t, unmanagedRes.metadataOnly)
+	assert.Nil(t, unmanagedRes.Resource)
+	assert.Equal(t, "uid-unmanaged", string(unmanagedRes.Ref.UID))
+
+	assert.Equal(t, 1, cluster.GetClusterInfo().MetadataOnlyResourcesCount)
+
+	listed := false
+	for _, action := range metadataClient.Actions() {
+		if action.GetVerb() == "list" && action.GetResource() == podsGVR {
+			listed = true
+		}
+	}
+	assert.True(t, listed)
+
+	// the counter is updated when resources are deleted
+	cluster.lock.Lock()
+	cluster.processEvent(unmanagedRes.ResourceKey(), eventMeta{watch.Deleted, mustToUnstructured(unmanaged)})
+	cluster.lock.Unlock()
+	assert.Equal(t, 0, cluster.GetClusterInfo().MetadataOnlyResourcesCount)
+}
+
+func TestMetadataOnly_Watch(t *testing.T) {
+	managed := newMetadataTestPod("managed", map[string]string{"app": "guestbook"})
+	unmanaged := newMetadataTestPod("unmanaged", nil)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, managed)
+	metadataClient := newMetadataClient(t)
+	fakeWatcher := watch.NewFake()
+	metadataClient.PrependWatchReactor("*", testcore.DefaultWatchReactor(fakeWatcher, nil))
+
+	cluster := newCluster(t)
+	resClient := &metadataResourceClient{
+		ResourceInterface: dynamicClient.Resource(podsGVR),
+		client:            dynamicClient.Resource(podsGVR),
+		metadata:          metadataClient.Resource(podsGVR),
+		gvk:               schema.GroupVersionKind{Version: "v1", Kind: kube.PodKind},
+		needsFullObject:   isManagedTestResource,
+		log:               cluster.log,
+	}
+	w, err := resClient.Watch(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	defer w.Stop()
+
+	go func() {
+		fakeWatcher.Add(toPartialObjectMetadata(managed))
+		fakeWatcher.Modify(toPartialObjectMetadata(unmanaged))
+		fakeWatcher.Delete(toPartialObjectMetadata(managed))
+	}()
+
+	next := func() watch.Event {
+		select {
+		case event := <-w.ResultChan():
+			return event
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for event")
+		}
+		return watch.Event{}
+	}
+
+	event := next()
+	assert.Equal(t, watch.Added, event.Type)
+	un := event.Object.(*unstructured.Unstructured)
+	assert.False(t, isMetadataOnlyObject(un))
+	assert.Equal(t, "managed", un.GetName())
+
+	event = next()
+	assert.Equal(t, watch.Modified, event.Type)
+	un = event.Object.(*unstructured.Unstructured)
+	assert.True(t, isMetadataOnlyObject(un))
+	assert.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: kube.PodKind}, un.GroupVersionKind())
+	assert.Equal(t, "unmanaged", un.GetName())
+	assert.Equal(t, "default", un.GetNamespace())
+
+	event = next()
+	assert.Equal(t, watch.Deleted, event.Type)
+	assert.True(t, isMetadataOnlyObject(event.Object.(*unstructured.Unstructured)))
+}
+
+func TestMetadataOnly_RequiresFullObject(t *testing.T) {
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{SetMetadataOnlyResources(func(_ schema.GroupKind) bool {
+		return true
+	}, nil)})
+	assert.True(t, cluster.isMetadataOnly(schema.GroupKind{Kind: kube.SecretKind}))
+	assert.False(t, cluster.isMetadataOnly(schema.GroupKind{Group: "apps", Kind: kube.StatefulSetKind}))
+	assert.False(t, cluster.isMetadataOnly(schema.GroupKind{Group: "apiextensions.k8s.io", Kind: kube.CustomResourceDefinitionKind}))
+
+	assert.False(t, newCluster(t).isMetadataOnly(schema.GroupKind{Kind: kube.SecretKind}))
+}
+
+func TestNeedsFullObjectForReferences(t *testing.T) {
+	secret := &unstructured.Unstructured{}
+	secret.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: kube.SecretKind})
+	assert.False(t, needsFullObjectForReferences(secret))
+
+	secret.SetAnnotations(map[string]string{
+		"kubernetes.io/service-account.uid":  "123",
+		"kubernetes.io/service-account.name": "default",
+	})
+	assert.True(t, needsFullObjectForReferences(secret))
+
+	configMap := secret.DeepCopy()
+	configMap.SetKind("ConfigMap")
+	assert.False(t, needsFullObjectForReferences(configMap))
+}
@@ -18,8 +18,9 @@ func mightHaveInferredOwner(r *Resource) bool {
 	return r.Ref.GroupVersionKind().Group == "" && r.Ref.Kind == kube.PersistentVolumeClaimKind
 }
 
-func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured) ([]metav1.OwnerReference, func(kube.ResourceKey) bool) {
+func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured) ([]metav1.OwnerReference, func(kube.ResourceKey) bool, []string) {
 	var isInferredParentOf func(_ kube.ResourceKey) bool
+	var volumeClaimTemplates []string
 	ownerRefs := un.GetOwnerReferences()
 	gvk := un.GroupVersionKind()
 
@@ -49,17 +50,27 @@ func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured)
 		}
 
 	case (gvk.Group == "apps" || gvk.Group == "extensions") && gvk.Kind == kube.StatefulSetKind:
-		if refs, err := isStatefulSetChild(un); err != nil {
+		if templates, err := getVolumeClaimTemplates(un); err != nil {
 			c.log.Error(err, fmt.Sprintf("Failed to extract StatefulSet %s/%s PVC references", un.GetNamespace(), un.GetName()))
 		} else {
-			isInferredParentOf = refs
+			volumeClaimTemplates = templates
+			isInferredParentOf = statefulSetChildMatcher(un.GetName(), templates)
 		}
 	}
 
-	return ownerRefs, isInferredParentOf
+	return ownerRefs, isInferredParentOf, volumeClaimTemplates
 }
 
 func isStatefulSetChild(un *unstructured.Unstructured) (func(kube.ResourceKey) bool, error) {
+	templates, err := getVolumeClaimTemplates(un)
+	if err != nil {
+		return nil, err
+	}
+	return statefulSetChildMatcher(un.GetName(), templates), nil
+}
+
+// getVolumeClaimTemplates returns the names of the volume claim templates of the given StatefulSet
+func getVolumeClaimTemplates(un *unstructured.Unstructured) ([]string, error) {
 	sts := appsv1.StatefulSet{}
 	data, err := json.Marshal(un)
 	if err != nil {
@@ -70,17 +81,26 @@ func isStatefulSetChild(un *unstructured.Unstructured) (func(kube.ResourceKey) b
 		return nil, fmt.Errorf("failed to unmarshal statefulset: %w", err)
 	}
 
-	templates := sts.Spec.VolumeClaimTemplates
+	templates := make([]string, 0, len(sts.Spec.VolumeClaimTemplates))
+	for _, templ := range sts.Spec.VolumeClaimTemplates {
+		templates = append(templates, templ.Name)
+	}
+	return templates, nil
+}
+
+// statefulSetChildMatcher answers if a resource is a PVC created from one of the volume claim templates of the
+// StatefulSet with the given name
+func statefulSetChildMatcher(name string, templates []string) func(kube.ResourceKey) bool {
 	return func(key kube.ResourceKey) bool {
 		if key.Kind == kube.PersistentVolumeClaimKind && key.GroupKind().Group == "" {
 			for _, templ := range templates {
-				if match, _ := regexp.MatchString(fmt.Sprintf(`%s-%s-\d+$`, templ.Name, un.GetName()), key.Name); match {
+				if match, _ := regexp.MatchString(fmt.Sprintf(`%s-%s-\d+$`, templ, name), key.Name); match {
 					return true
 				}
 			}
 		}
 		return false
-	}, nil
+	}
 }
 
 func isServiceAccountTokenSecret(un *unstructured.Unstructured) (bool, metav1.OwnerReference) {
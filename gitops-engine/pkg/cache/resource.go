@@ -29,6 +29,10 @@ type Resource struct {
 
 	// answers if resource is inferred parent of provided resource
 	isInferredParentOf func(key kube.ResourceKey) bool
+	// names of the volume claim templates of a StatefulSet, used to restore isInferredParentOf from a snapshot
+	volumeClaimTemplates []string
+	// metadataOnly is true if only the metadata of the resource has been retrieved
+	metadataOnly bool
 }
 
 func (r *Resource) ResourceKey() kube.ResourceKey {
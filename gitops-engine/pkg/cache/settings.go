@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
@@ -177,6 +178,36 @@ func SetBatchEventsProcessing(batchProcessing bool) UpdateSettingsFunc {
 	}
 }
 
+// SetSnapshotStore sets the store used to persist a snapshot of the cache every given interval. On its first
+// synchronization, the cache is warmed up from the stored snapshot unless it is older than maxAge, and the watches
+// are resumed from the resource versions of the snapshot.
+func SetSnapshotStore(store SnapshotStore, interval time.Duration, maxAge time.Duration) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.snapshotStore = store
+		cache.snapshotInterval = interval
+		cache.snapshotMaxAge = maxAge
+	}
+}
+
+// SetMetadataOnlyResources enables listing and watching the resources of the kinds matched by isMetadataOnly as
+// PartialObjectMetadata, which reduces the memory and bandwidth used by the cache. needsFullObject is called with the
+// metadata of each of these resources, and the full object is retrieved when it returns true. The full objects are
+// always retrieved for the kinds and resources whose ownership is inferred from their content.
+func SetMetadataOnlyResources(isMetadataOnly func(gk schema.GroupKind) bool, needsFullObject func(un *unstructured.Unstructured) bool) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.metadataOnlyFilter = isMetadataOnly
+		cache.needsFullObject = needsFullObject
+	}
+}
+
+// SetWatchList enables loading the resources using watch list requests, which stream the resources instead of
+// listing them page by page. Paginated list requests are used if the server does not support watch list requests.
+func SetWatchList(enabled bool) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.watchListEnabled = enabled
+	}
+}
+
 // SetEventProcessingInterval allows to set the interval for processing events
 func SetEventProcessingInterval(interval time.Duration) UpdateSettingsFunc {
 	return func(cache *clusterCache) {
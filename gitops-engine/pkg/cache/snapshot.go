@@ -0,0 +1,208 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+)
+
+// Snapshot holds the state of a cluster cache. It allows to warm up the cache and to resume the watches from the
+// stored resource versions instead of listing all the resources of the cluster.
+type Snapshot struct {
+	// Time holds the time the snapshot was taken
+	Time time.Time
+	// APIs holds the state of each watched API
+	APIs []SnapshotAPI
+}
+
+// SnapshotAPI holds the resources of an API watched in a given namespace, and the resource version from which the
+// watch can be resumed.
+type SnapshotAPI struct {
+	GroupKind            schema.GroupKind
+	GroupVersionResource schema.GroupVersionResource
+	// Namespace holds the watched namespace, or is empty if the API is watched in all namespaces
+	Namespace string
+	// ResourceVersion holds the resource version of the most recent event applied to the cache
+	ResourceVersion string
+	Resources       []SnapshotResource
+}
+
+// SnapshotResource holds the cached information about a resource
+type SnapshotResource struct {
+	ResourceVersion   string
+	Ref               corev1.ObjectReference
+	OwnerRefs         []metav1.OwnerReference
+	CreationTimestamp *metav1.Time
+	// Info holds the additional information returned by the OnPopulateResourceInfoHandler. The SnapshotStore is
+	// responsible for persisting it.
+	Info     any
+	Resource *unstructured.Unstructured
+	// VolumeClaimTemplates holds the names of the volume claim templates of a StatefulSet
+	VolumeClaimTemplates []string
+	// MetadataOnly is true if only the metadata of the resource has been retrieved
+	MetadataOnly bool `json:",omitempty"`
+}
+
+// SnapshotStore persists the snapshots of a cluster cache
+type SnapshotStore interface {
+	// Load returns the most recently saved snapshot, or nil if there is none
+	Load() (*Snapshot, error)
+	// Save persists the given snapshot
+	Save(snapshot *Snapshot) error
+}
+
+type snapshotAPIKey struct {
+	groupKind schema.GroupKind
+	namespace string
+}
+
+// watchNamespace returns the namespace in which the resource with the given key is watched, or an empty string if its
+// API is watched in all namespaces.
+func (c *clusterCache) watchNamespace(key kube.ResourceKey, namespaced bool) string {
+	if namespaced && len(c.namespaces) > 0 {
+		return key.Namespace
+	}
+	return ""
+}
+
+// updateResourceVersion records the resource version of the most recent event applied to the cache for the API of the
+// resource with the given key. The cache lock must be held.
+func (c *clusterCache) updateResourceVersion(key kube.ResourceKey, resourceVersion string) {
+	info, ok := c.apisMeta[key.GroupKind()]
+	if !ok || resourceVersion == "" {
+		return
+	}
+	info.setResourceVersion(c.watchNamespace(key, info.namespaced), resourceVersion)
+}
+
+func (m *apiMeta) setResourceVersion(ns string, resourceVersion string) {
+	if m.resourceVersions == nil {
+		m.resourceVersions = make(map[string]string)
+	}
+	m.resourceVersions[ns] = resourceVersion
+}
+
+// takeSnapshot returns a snapshot of the resources of the watched APIs
+func (c *clusterCache) takeSnapshot() *Snapshot {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	apis := make(map[snapshotAPIKey]*SnapshotAPI)
+	for gk, info := range c.apisMeta {
+		for ns, resourceVersion := range info.resourceVersions {
+			apis[snapshotAPIKey{gk, ns}] = &SnapshotAPI{
+				GroupKind:            gk,
+				GroupVersionResource: info.resource,
+				Namespace:            ns,
+				ResourceVersion:      resourceVersion,
+			}
+		}
+	}
+	for key, res := range c.resources {
+		info, ok := c.apisMeta[key.GroupKind()]
+		if !ok {
+			continue
+		}
+		api, ok := apis[snapshotAPIKey{key.GroupKind(), c.watchNamespace(key, info.namespaced)}]
+		if !ok {
+			continue
+		}
+		api.Resources = append(api.Resources, SnapshotResource{
+			ResourceVersion:      res.ResourceVersion,
+			Ref:                  res.Ref,
+			OwnerRefs:            append([]metav1.OwnerReference(nil), res.OwnerRefs...),
+			CreationTimestamp:    res.CreationTimestamp,
+			Info:                 res.Info,
+			Resource:             res.Resource,
+			VolumeClaimTemplates: res.volumeClaimTemplates,
+			MetadataOnly:         res.metadataOnly,
+		})
+	}
+
+	snapshot := &Snapshot{Time: time.Now(), APIs: make([]SnapshotAPI, 0, len(apis))}
+	for _, api := range apis {
+		snapshot.APIs = append(snapshot.APIs, *api)
+	}
+	sort.Slice(snapshot.APIs, func(i, j int) bool {
+		if snapshot.APIs[i].GroupKind != snapshot.APIs[j].GroupKind {
+			return snapshot.APIs[i].GroupKind.String() < snapshot.APIs[j].GroupKind.String()
+		}
+		return snapshot.APIs[i].Namespace < snapshot.APIs[j].Namespace
+	})
+	return snapshot
+}
+
+// loadSnapshot returns the APIs of the stored snapshot indexed by group kind and namespace, or nil if there is no
+// snapshot or if it is older than the snapshot max age.
+func (c *clusterCache) loadSnapshot() map[snapshotAPIKey]*SnapshotAPI {
+	snapshot, err := c.snapshotStore.Load()
+	if err != nil {
+		c.log.Error(err, "Failed to load cluster cache snapshot")
+		return nil
+	}
+	if snapshot == nil {
+		return nil
+	}
+	if age := time.Since(snapshot.Time); c.snapshotMaxAge > 0 && age > c.snapshotMaxAge {
+		c.log.Info(fmt.Sprintf("Ignoring cluster cache snapshot taken %s ago", age.Round(time.Second)))
+		return nil
+	}
+	apis := make(map[snapshotAPIKey]*SnapshotAPI, len(snapshot.APIs))
+	for i := range snapshot.APIs {
+		api := &snapshot.APIs[i]
+		apis[snapshotAPIKey{api.GroupKind, api.Namespace}] = api
+	}
+	c.log.Info("Warming up cluster cache from snapshot", "time", snapshot.Time, "apis", len(apis))
+	return apis
+}
+
+func (c *clusterCache) newResourceFromSnapshot(res *SnapshotResource) *Resource {
+	resource := &Resource{
+		ResourceVersion:      res.ResourceVersion,
+		Ref:                  res.Ref,
+		OwnerRefs:            res.OwnerRefs,
+		CreationTimestamp:    res.CreationTimestamp,
+		Info:                 res.Info,
+		Resource:             res.Resource,
+		volumeClaimTemplates: res.VolumeClaimTemplates,
+		metadataOnly:         res.MetadataOnly,
+	}
+	if res.VolumeClaimTemplates != nil {
+		resource.isInferredParentOf = statefulSetChildMatcher(res.Ref.Name, res.VolumeClaimTemplates)
+	}
+	return resource
+}
+
+// persistSnapshots saves a snapshot of the cache every snapshot interval until the given context is done
+func (c *clusterCache) persistSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(c.snapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.syncStatus.lock.Lock()
+			synced := c.syncStatus.syncTime != nil && c.syncStatus.syncError == nil
+			c.syncStatus.lock.Unlock()
+			if !synced {
+				continue
+			}
+			start := time.Now()
+			snapshot := c.takeSnapshot()
+			if err := c.snapshotStore.Save(snapshot); err != nil {
+				c.log.Error(err, "Failed to save cluster cache snapshot")
+				continue
+			}
+			c.log.V(1).Info("Saved cluster cache snapshot", "apis", len(snapshot.APIs), "duration", time.Since(start))
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+)
+
+type memorySnapshotStore struct {
+	lock     sync.Mutex
+	snapshot *Snapshot
+	saved    int
+}
+
+func (s *memorySnapshotStore) Load() (*Snapshot, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.snapshot, nil
+}
+
+func (s *memorySnapshotStore) Save(snapshot *Snapshot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.snapshot = snapshot
+	s.saved++
+	return nil
+}
+
+func (s *memorySnapshotStore) getSaved() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.saved
+}
+
+func newSnapshotTestPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name), ResourceVersion: "100"},
+	}
+}
+
+func populateTestInfo(un *unstructured.Unstructured, _ bool) (any, bool) {
+	return "info-" + un.GetName(), un.GetName() == "pod-cached"
+}
+
+func TestSnapshot_WarmUp(t *testing.T) {
+	source := newCluster(t, newSnapshotTestPod("pod-cached"), newSnapshotTestPod("pod"))
+	source.populateResourceInfoHandler = populateTestInfo
+	require.NoError(t, source.EnsureSynced())
+
+	snapshot := source.takeSnapshot()
+	var podAPI *SnapshotAPI
+	for i := range snapshot.APIs {
+		if snapshot.APIs[i].GroupKind.Kind == "Pod" {
+			podAPI = &snapshot.APIs[i]
+		}
+	}
+	require.NotNil(t, podAPI)
+	assert.Equal(t, "123", podAPI.ResourceVersion)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "pods"}, podAPI.GroupVersionResource)
+	assert.Len(t, podAPI.Resources, 2)
+
+	// the restored cache does not list the resources of the cluster, which only contains a new pod
+	store := &memorySnapshotStore{snapshot: snapshot}
+	restored := newClusterWithOptions(t, []UpdateSettingsFunc{SetSnapshotStore(store, 0, time.Hour)}, newSnapshotTestPod("pod-new"))
+	t.Cleanup(func() {
+		restored.Invalidate()
+	})
+	require.NoError(t, restored.EnsureSynced())
+
+	resources := restored.FindResources("default")
+	require.Len(t, resources, 2)
+	cached := resources[kube.NewResourceKey("", "Pod", "default", "pod-cached")]
+	require.NotNil(t, cached)
+	assert.Equal(t, "info-pod-cached", cached.Info)
+	assert.Equal(t, "100", cached.ResourceVersion)
+	assert.NotNil(t, cached.Resource)
+	assert.Contains(t, resources, kube.NewResourceKey("", "Pod", "default", "pod"))
+	assert.Equal(t, "123", restored.apisMeta[schema.GroupKind{Kind: "Pod"}].resourceVersions[""])
+
+	// the snapshot is only used on the first synchronization
+	restored.Invalidate()
+	require.NoError(t, restored.EnsureSynced())
+	restored.lock.RLock()
+	defer restored.lock.RUnlock()
+	require.Len(t, restored.resources, 1)
+	assert.Contains(t, restored.resources, kube.NewResourceKey("", "Pod", "default", "pod-new"))
+}
+
+func TestSnapshot_StaleSnapshotIsIgnored(t *testing.T) {
+	source := newCluster(t, newSnapshotTestPod("pod"))
+	require.NoError(t, source.EnsureSynced())
+	snapshot := source.takeSnapshot()
+	snapshot.Time = time.Now().Add(-2 * time.Hour)
+
+	store := &memorySnapshotStore{snapshot: snapshot}
+	restored := newClusterWithOptions(t, []UpdateSettingsFunc{SetSnapshotStore(store, 0, time.Hour)}, newSnapshotTestPod("pod-new"))
+	t.Cleanup(func() {
+		restored.Invalidate()
+	})
+	require.NoError(t, restored.EnsureSynced())
+
+	resources := restored.FindResources("default")
+	require.Len(t, resources, 1)
+	assert.Contains(t, resources, kube.NewResourceKey("", "Pod", "default", "pod-new"))
+}
+
+func TestSnapshot_APIVersionChanged(t *testing.T) {
+	source := newCluster(t, newSnapshotTestPod("pod"))
+	require.NoError(t, source.EnsureSynced())
+	snapshot := source.takeSnapshot()
+	for i := range snapshot.APIs {
+		snapshot.APIs[i].GroupVersionResource.Version = "v0"
+	}
+
+	store := &memorySnapshotStore{snapshot: snapshot}
+	restored := newClusterWithOptions(t, []UpdateSettingsFunc{SetSnapshotStore(store, 0, time.Hour)}, newSnapshotTestPod("pod-new"))
+	t.Cleanup(func() {
+		restored.Invalidate()
+	})
+	require.NoError(t, restored.EnsureSynced())
+
+	resources := restored.FindResources("default")
+	require.Len(t, resources, 1)
+	assert.Contains(t, resources, kube.NewResourceKey("", "Pod", "default", "pod-new"))
+}
+
+func TestSnapshot_ResourceVersionUpdatedByEvents(t *testing.T) {
+	cluster := newCluster(t, newSnapshotTestPod("pod"))
+	require.NoError(t, cluster.EnsureSynced())
+
+	pod := newSnapshotTestPod("pod")
+	pod.ResourceVersion = "200"
+	cluster.lock.Lock()
+	cluster.processEvent(kube.NewResourceKey("", "Pod", "default", "pod"), eventMeta{watch.Modified, mustToUnstructured(pod)})
+	cluster.lock.Unlock()
+
+	snapshot := cluster.takeSnapshot()
+	for _, api := range snapshot.APIs {
+		if api.GroupKind.Kind == "Pod" {
+			assert.Equal(t, "200", api.ResourceVersion)
+			require.Len(t, api.Resources, 1)
+			assert.Equal(t, "200", api.Resources[0].ResourceVersion)
+		}
+	}
+}
+
+func TestSnapshot_NamespacedCache(t *testing.T) {
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{SetNamespaces([]string{"default"})}, newSnapshotTestPod("pod"))
+	t.Cleanup(func() {
+		cluster.Invalidate()
+	})
+	require.NoError(t, cluster.EnsureSynced())
+
+	snapshot := cluster.takeSnapshot()
+	for _, api := range snapshot.APIs {
+		assert.Equal(t, "default", api.Namespace)
+	}
+}
+
+func TestSnapshot_StatefulSetInferredParent(t *testing.T) {
+	cluster := newCluster(t)
+	sts := &appsv1.StatefulSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.StatefulSetKind},
+		ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "web", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "www"},
+			}},
+		},
+	}
+	res := cluster.newResource(mustToUnstructured(sts))
+	assert.Equal(t, []string{"www"}, res.volumeClaimTemplates)
+
+	restored := cluster.newResourceFromSnapshot(&SnapshotResource{Ref: res.Ref, VolumeClaimTemplates: res.volumeClaimTemplates})
+	require.NotNil(t, restored.isInferredParentOf)
+	assert.True(t, restored.isInferredParentOf(kube.NewResourceKey("", kube.PersistentVolumeClaimKind, "default", "www-web-0")))
+	assert.False(t, restored.isInferredParentOf(kube.NewResourceKey("", kube.PersistentVolumeClaimKind, "default", "www-db-0")))
+}
+
+func TestSnapshot_PersistSnapshots(t *testing.T) {
+	store := &memorySnapshotStore{}
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{SetSnapshotStore(store, 10*time.Millisecond, time.Hour)}, []runtime.Object{newSnapshotTestPod("pod")}...)
+	require.NoError(t, cluster.EnsureSynced())
+
+	assert.Eventually(t, func() bool {
+		return store.getSaved() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	snapshot, err := store.Load()
+	require.NoError(t, err)
+	assert.NotEmpty(t, snapshot.APIs)
+
+	// invalidating the cache stops persisting the snapshots
+	cluster.Invalidate()
+	saved := store.getSaved()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, saved, store.getSaved())
+}
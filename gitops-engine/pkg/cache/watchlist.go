@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/pager"
+)
+
+// errWatchListNotSupported is returned when the server rejects a watch list request
+var errWatchListNotSupported = errors.New("watch list is not supported by the server")
+
+// listObjects calls the callback for each resource returned by the given client. The resources are streamed using a
+// watch list request when enabled and supported by the server, which avoids loading whole pages of resources in
+// memory, and are listed page by page otherwise. It returns the resource version of the returned state.
+func (c *clusterCache) listObjects(ctx context.Context, resClient dynamic.ResourceInterface, callback func(un *unstructured.Unstructured) error) (string, error) {
+	if c.watchListEnabled && !c.watchListUnsupported.Load() {
+		resourceVersion, err := c.watchListResources(ctx, resClient, callback)
+		if !errors.Is(err, errWatchListNotSupported) {
+			return resourceVersion, err
+		}
+		c.watchListUnsupported.Store(true)
+		c.log.Info("Falling back to paginated list requests", "reason", err.Error())
+	}
+	return c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
+		return listPager.EachListItem(ctx, metav1.ListOptions{}, func(obj runtime.Object) error {
+			un, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("object %s has an unexpected type", obj.GetObjectKind().GroupVersionKind().String())
+			}
+			return callback(un)
+		})
+	})
+}
+
+// watchListResources streams the resources returned by the given client using a watch list request, and returns the
+// resource version of the bookmark which marks the end of the initial events. errWatchListNotSupported is returned if
+// the server rejects the request before sending any event.
+func (c *clusterCache) watchListResources(ctx context.Context, resClient dynamic.ResourceInterface, callback func(un *unstructured.Unstructured) error) (string, error) {
+	if err := c.listSemaphore.Acquire(ctx, 1); err != nil {
+		return "", fmt.Errorf("failed to acquire list semaphore: %w", err)
+	}
+	defer c.listSemaphore.Release(1)
+
+	sendInitialEvents := true
+	w, err := resClient.Watch(ctx, metav1.ListOptions{
+		SendInitialEvents:    &sendInitialEvents,
+		ResourceVersionMatch: metav1.ResourceVersionMatchNotOlderThan,
+		AllowWatchBookmarks:  true,
+	})
+	if err != nil {
+		// servers which do not support watch list reject the sendInitialEvents and resourceVersionMatch parameters
+		if apierrors.IsInvalid(err) || apierrors.IsBadRequest(err) {
+			return "", fmt.Errorf("%w: %w", errWatchListNotSupported, err)
+		}
+		return "", fmt.Errorf("failed to start watch list: %w", err)
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("watch list interrupted: %w", ctx.Err())
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return "", errors.New("watch list closed before the end of the initial events")
+			}
+			switch event.Type {
+			case watch.Error:
+				return "", fmt.Errorf("watch list failed: %w", apierrors.FromObject(event.Object))
+			case watch.Bookmark:
+				obj, err := meta.Accessor(event.Object)
+				if err != nil {
+					return "", fmt.Errorf("failed to access bookmark metadata: %w", err)
+				}
+				if obj.GetAnnotations()[metav1.InitialEventsAnnotationKey] == "true" {
+					return obj.GetResourceVersion(), nil
+				}
+			case watch.Added, watch.Modified:
+				un, ok := event.Object.(*unstructured.Unstructured)
+				if !ok {
+					return "", fmt.Errorf("failed to convert to *unstructured.Unstructured: %v", event.Object)
+				}
+				if err := callback(un); err != nil {
+					return "", err
+				}
+			case watch.Deleted:
+				// initial events only hold additions, the deletions are handled by the subsequent watch
+			}
+		}
+	}
+}
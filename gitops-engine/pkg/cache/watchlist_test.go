@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+)
+
+func newWatchListTestPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "100"},
+	}
+}
+
+func newInitialEventsEndBookmark(resourceVersion string) *unstructured.Unstructured {
+	bookmark := &unstructured.Unstructured{}
+	bookmark.SetAPIVersion("v1")
+	bookmark.SetKind("Pod")
+	bookmark.SetResourceVersion(resourceVersion)
+	bookmark.SetAnnotations(map[string]string{metav1.InitialEventsAnnotationKey: "true"})
+	return bookmark
+}
+
+// newWatchListCluster returns a cluster cache which only watches pods, and whose watch list requests are handled by
+// the given reactor
+func newWatchListCluster(t *testing.T, reactor func() (watch.Interface, error), opts ...UpdateSettingsFunc) (*clusterCache, *fake.FakeDynamicClient) {
+	t.Helper()
+	cluster := newClusterWithOptions(t, opts, newWatchListTestPod("pod-1"), newWatchListTestPod("pod-2"))
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	kubectl.APIResources = []kube.APIResourceInfo{{
+		GroupKind:            schema.GroupKind{Kind: "Pod"},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}}
+	client := kubectl.DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependWatchReactor("pods", func(action testcore.Action) (bool, watch.Interface, error) {
+		// watches resumed from a resource version are handled by the default reactor
+		if action.(testcore.WatchAction).GetWatchRestrictions().ResourceVersion != "" {
+			return false, nil, nil
+		}
+		w, err := reactor()
+		return true, w, err
+	})
+	t.Cleanup(func() {
+		cluster.Invalidate()
+	})
+	return cluster, client
+}
+
+func countActions(client *fake.FakeDynamicClient, verb string) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == verb && action.GetResource().Resource == "pods" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestWatchList_Sync(t *testing.T) {
+	cluster, client := newWatchListCluster(t, func() (watch.Interface, error) {
+		w := watch.NewFakeWithChanSize(3, false)
+		w.Add(mustToUnstructured(newWatchListTestPod("pod-1")))
+		w.Add(mustToUnstructured(newWatchListTestPod("pod-2")))
+		w.Action(watch.Bookmark, newInitialEventsEndBookmark("200"))
+		return w, nil
+	}, SetWatchList(true))
+	require.NoError(t, cluster.EnsureSynced())
+
+	resources := cluster.FindResources("default")
+	assert.Len(t, resources, 2)
+	assert.Contains(t, resources, kube.NewResourceKey("", "Pod", "default", "pod-1"))
+	assert.Contains(t, resources, kube.NewResourceKey("", "Pod", "default", "pod-2"))
+
+	cluster.lock.RLock()
+	assert.Equal(t, "200", cluster.apisMeta[schema.GroupKind{Kind: "Pod"}].resourceVersions[""])
+	cluster.lock.RUnlock()
+	assert.Equal(t, 0, countActions(client, "list"))
+	assert.False(t, cluster.watchListUnsupported.Load())
+}
+
+func TestWatchList_FallbackToList(t *testing.T) {
+	cluster, client := newWatchListCluster(t, func() (watch.Interface, error) {
+		return nil, apierrors.NewBadRequest("sendInitialEvents is forbidden for watch unless the WatchList feature gate is enabled")
+	}, SetWatchList(true))
+	require.NoError(t, cluster.EnsureSynced())
+
+	assert.Len(t, cluster.FindResources("default"), 2)
+	assert.Equal(t, 1, countActions(client, "list"))
+	assert.True(t, cluster.watchListUnsupported.Load())
+
+	// the watch list request is not attempted again until the next synchronization
+	_, err := cluster.loadInitialState(t.Context(), kube.APIResourceInfo{GroupKind: schema.GroupKind{Kind: "Pod"}}, client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}), "", true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, countActions(client, "list"))
+}
+
+func TestWatchList_ErrorEvent(t *testing.T) {
+	cluster, _ := newWatchListCluster(t, func() (watch.Interface, error) {
+		w := watch.NewFakeWithChanSize(2, false)
+		w.Add(mustToUnstructured(newWatchListTestPod("pod-1")))
+		w.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+		return w, nil
+	}, SetWatchList(true))
+
+	err := cluster.EnsureSynced()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too old resource version")
+	assert.False(t, cluster.watchListUnsupported.Load())
+}
+
+func TestWatchList_Disabled(t *testing.T) {
+	cluster, client := newWatchListCluster(t, func() (watch.Interface, error) {
+		return nil, apierrors.NewBadRequest("unexpected watch list request")
+	})
+	require.NoError(t, cluster.EnsureSynced())
+
+	assert.Len(t, cluster.FindResources("default"), 2)
+	assert.Equal(t, 1, countActions(client, "list"))
+	assert.False(t, cluster.watchListUnsupported.Load())
+}
@@ -12,6 +12,11 @@ const (
 	AnnotationSyncOptions = "argocd.argoproj.io/sync-options"
 	// AnnotationSyncWave indicates which wave of the sync the resource or hook should be in
 	AnnotationSyncWave = "argocd.argoproj.io/sync-wave"
+	// AnnotationDependsOn is a comma-separated list of resources which must be synced and healthy before the resource
+	// or hook is synced. Resources are referenced as kind/name, group/kind/name or group/kind/namespace/name
+	AnnotationDependsOn = "argocd.argoproj.io/depends-on"
+	// AnnotationApprovalRequired indicates that the wave of the resource or hook must be approved before it is synced
+	AnnotationApprovalRequired = "argocd.argoproj.io/approval-required"
 	// AnnotationKeyHook contains the hook type of a resource
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
@@ -64,6 +69,11 @@ type SyncPhase string
 // executed, and whether or not that wave was the final one.
 type SyncWaveHook func(phase SyncPhase, wave int, final bool) error
 
+// SyncWaveApprovalHook is a callback function which will be invoked before a sync wave containing
+// resources or hooks which require approval is applied. The callback indicates which phase and
+// wave is about to be applied, and returns whether or not that wave has been approved.
+type SyncWaveApprovalHook func(phase SyncPhase, wave int) bool
+
 const (
 	SyncPhasePreSync  = "PreSync"
 	SyncPhaseSync     = "Sync"
@@ -74,11 +84,12 @@ const (
 type OperationPhase string
 
 const (
-	OperationRunning     OperationPhase = "Running"
-	OperationTerminating OperationPhase = "Terminating"
-	OperationFailed      OperationPhase = "Failed"
-	OperationError       OperationPhase = "Error"
-	OperationSucceeded   OperationPhase = "Succeeded"
+	OperationRunning            OperationPhase = "Running"
+	OperationWaitingForApproval OperationPhase = "WaitingForApproval"
+	OperationTerminating        OperationPhase = "Terminating"
+	OperationFailed             OperationPhase = "Failed"
+	OperationError              OperationPhase = "Error"
+	OperationSucceeded          OperationPhase = "Succeeded"
 )
 
 func (os OperationPhase) Completed() bool {
@@ -93,6 +104,10 @@ func (os OperationPhase) Running() bool {
 	return os == OperationRunning
 }
 
+func (os OperationPhase) WaitingForApproval() bool {
+	return os == OperationWaitingForApproval
+}
+
 func (os OperationPhase) Successful() bool {
 	return os == OperationSucceeded
 }
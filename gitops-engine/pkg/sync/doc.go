@@ -75,6 +75,30 @@ that runs before all other resources. The `argocd.argoproj.io/sync-wave` annotat
 	  annotations:
 	    argocd.argoproj.io/sync-wave: "5"
 
+# Dependencies
+
+Instead of maintaining wave numbers, resources and hooks can declare the resources they depend on using the
+`argocd.argoproj.io/depends-on` annotation. It is a comma-separated list of references to resources of the same sync, as
+kind/name for core resources, group/kind/name, or group/kind/namespace/name:
+
+	metadata:
+	  annotations:
+	    argocd.argoproj.io/depends-on: ConfigMap/my-config,apps/Deployment/my-database
+
+A resource is synced once the resources it depends on are synced and healthy. Resources which do not depend on each
+other are synced concurrently, so independent branches of the dependency graph do not wait for each other. Dependencies
+work together with waves and phases: a resource is moved to the wave of its latest dependency, dependencies on
+resources of an earlier phase are always satisfied, and dependencies on resources of a later phase or dependency cycles
+fail the sync. References to resources which are not part of the sync are ignored, and pruning is not affected by
+dependencies.
+
+# Approvals
+
+A wave can be gated by annotating any of its resources or hooks with `argocd.argoproj.io/approval-required: "true"`.
+Before such a wave is applied, the callback configured using WithSyncWaveApprovalHook is asked whether the wave was
+approved. If it was not, the sync stops in the WaitingForApproval phase and the wave is applied by a later sync, once
+the callback approves it. The annotation is ignored if no callback is configured and during dry runs.
+
 # Sync Options
 
 The sync options allows customizing the synchronization of selected resources. The options are specified using the
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -31,6 +32,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
 
@@ -122,6 +124,15 @@ func WithPruneConfirmed(confirmed bool) SyncOpt {
 	}
 }
 
+// WithPruneThreshold sets the maximum number or percentage of the existing resources a sync may prune. A sync which
+// would prune more resources fails before applying any change, unless the pruning is confirmed.
+func WithPruneThreshold(threshold *intstr.IntOrString, confirmed bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.pruneThreshold = threshold
+		ctx.pruneThresholdConfirmed = confirmed
+	}
+}
+
 // WithOperationSettings allows to set sync operation settings
 func WithOperationSettings(dryRun bool, prune bool, force bool, skipHooks bool) SyncOpt {
 	return func(ctx *syncContext) {
@@ -182,12 +193,27 @@ func WithSyncWaveHook(syncWaveHook common.SyncWaveHook) SyncOpt {
 	}
 }
 
+// WithSyncWaveApprovalHook sets a callback that is invoked before application of every wave which requires approval.
+// Waves are not gated if no callback is set.
+func WithSyncWaveApprovalHook(syncWaveApprovalHook common.SyncWaveApprovalHook) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncWaveApprovalHook = syncWaveApprovalHook
+	}
+}
+
 func WithReplace(replace bool) SyncOpt {
 	return func(ctx *syncContext) {
 		ctx.replace = replace
 	}
 }
 
+// WithSyncFailHooksOnTerminate specifies if the SyncFail hooks should be run when the sync is terminated
+func WithSyncFailHooksOnTerminate(enabled bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncFailHooksOnTerminate = enabled
+	}
+}
+
 func WithSkipDryRunOnMissingResource(skipDryRunOnMissingResource bool) SyncOpt {
 	return func(ctx *syncContext) {
 		ctx.skipDryRunOnMissingResource = skipDryRunOnMissingResource
@@ -367,8 +393,11 @@ type syncContext struct {
 	pruneLast                       bool
 	prunePropagationPolicy          *metav1.DeletionPropagation
 	pruneConfirmed                  bool
+	pruneThreshold                  *intstr.IntOrString
+	pruneThresholdConfirmed         bool
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	syncFailHooksOnTerminate        bool
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -384,7 +413,8 @@ type syncContext struct {
 	// namespace should be synced
 	syncNamespace func(*unstructured.Unstructured, *unstructured.Unstructured) (bool, error)
 
-	syncWaveHook common.SyncWaveHook
+	syncWaveHook         common.SyncWaveHook
+	syncWaveApprovalHook common.SyncWaveApprovalHook
 
 	applyOutOfSyncOnly bool
 	// stores whether the resource is modified or not
@@ -424,6 +454,13 @@ func (sc *syncContext) Sync() {
 	if sc.started() {
 		sc.log.WithValues("tasks", tasks).Info("Tasks")
 	} else {
+		// Refuse to start a sync which would prune most of the resources, e.g. because of a broken commit, before any
+		// change is made.
+		if err := sc.checkPruneThreshold(tasks); err != nil {
+			sc.setOperationPhase(common.OperationFailed, err.Error())
+			return
+		}
+
 		// Perform a `kubectl apply --dry-run` against all the manifests. This will detect most (but
 		// not all) validation issues with the user's manifests (e.g. will detect syntax issues, but
 		// will not not detect if they are mutating immutable fields). If anything fails, we will refuse
@@ -496,7 +533,9 @@ func (sc *syncContext) Sync() {
 	// then wait...
 	multiStep := tasks.multiStep()
 	runningTasks := tasks.Filter(func(t *syncTask) bool { return (multiStep || t.isHook()) && t.running() })
-	if runningTasks.Len() > 0 {
+	// if tasks depend on each other, tasks whose dependencies have completed are started while other tasks of the
+	// same phase and wave are still running
+	if runningTasks.Len() > 0 && !tasks.hasDependencies() {
 		sc.setRunningPhase(runningTasks, false)
 		return
 	}
@@ -551,6 +590,18 @@ func (sc *syncContext) Sync() {
 		tasks = sc.filterOutOfSyncTasks(tasks)
 	}
 
+	// if tasks are running, only the tasks of their phase and wave whose dependencies have completed can be started
+	if runningTasks.Len() > 0 {
+		pendingTasks := tasks
+		tasks = tasks.Filter(func(t *syncTask) bool {
+			return t.phase == runningTasks.phase() && t.wave() == runningTasks.wave() && t.dependenciesCompleted(pendingTasks)
+		})
+		if tasks.Len() == 0 {
+			sc.setRunningPhase(runningTasks, false)
+			return
+		}
+	}
+
 	// If no sync tasks were generated (e.g., in case all application manifests have been removed),
 	// the sync operation is successful.
 	if len(tasks) == 0 {
@@ -571,14 +622,26 @@ func (sc *syncContext) Sync() {
 	remainingTasks := tasks.Filter(func(t *syncTask) bool { return t.phase != phase || wave != t.wave() || t.isHook() })
 
 	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
+	pendingTasks := tasks
 	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
 
+	if !sc.waveApproved(phase, wave, tasks) {
+		sc.setOperationPhase(common.OperationWaitingForApproval, fmt.Sprintf("waiting for approval of wave %d of phase %s", wave, phase))
+		return
+	}
+
+	// tasks waiting for their dependencies are started by a later sync, once their dependencies have completed
+	tasks, blockedTasks := tasks.Split(func(t *syncTask) bool { return t.dependenciesCompleted(pendingTasks) })
+	remainingTasks = append(remainingTasks, blockedTasks...)
+	remainingTasks = append(remainingTasks, runningTasks...)
+	finalWave = finalWave && blockedTasks.Len() == 0
+
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
 	sc.log.WithValues("tasks", tasks).V(1).Info("Wet-run")
 	runState := sc.runTasks(tasks, false)
 
-	if sc.syncWaveHook != nil && runState != failed {
+	if sc.syncWaveHook != nil && runState != failed && blockedTasks.Len() == 0 {
 		err := sc.syncWaveHook(phase, wave, finalWave)
 		if err != nil {
 			sc.deleteHooks(hooksPendingDeletionFailed)
@@ -608,6 +671,38 @@ func (sc *syncContext) Sync() {
 	}
 }
 
+// checkPruneThreshold returns an error if the tasks would prune more of the existing resources than the prune threshold
+// allows and the pruning is not confirmed
+func (sc *syncContext) checkPruneThreshold(tasks syncTasks) error {
+	if sc.pruneThreshold == nil || !sc.prune || sc.dryRun || sc.pruneThresholdConfirmed {
+		return nil
+	}
+	existingTasks := tasks.Filter(func(t *syncTask) bool { return !t.isHook() && t.liveObj != nil })
+	pruneTasks := existingTasks.Filter(func(t *syncTask) bool {
+		return t.isPrune() && !resourceutil.HasAnnotationOption(t.liveObj, common.AnnotationSyncOptions, common.SyncOptionDisablePrune)
+	})
+	limit, err := intstr.GetScaledValueFromIntOrPercent(sc.pruneThreshold, existingTasks.Len(), false)
+	if err != nil {
+		return fmt.Errorf("invalid prune threshold: %w", err)
+	}
+	if pruneTasks.Len() > limit {
+		return fmt.Errorf("pruning %d of %d resources exceeds the prune threshold of %s, the pruning must be confirmed", pruneTasks.Len(), existingTasks.Len(), sc.pruneThreshold.String())
+	}
+	return nil
+}
+
+// waveApproved returns whether the given tasks of the phase and wave may be applied. A wave which contains resources or
+// hooks requiring approval is only applied once the approval hook reports it as approved.
+func (sc *syncContext) waveApproved(phase common.SyncPhase, wave int, tasks syncTasks) bool {
+	if sc.syncWaveApprovalHook == nil || sc.dryRun {
+		return true
+	}
+	if !tasks.Any(func(t *syncTask) bool { return syncwaves.ApprovalRequired(t.obj()) }) {
+		return true
+	}
+	return sc.syncWaveApprovalHook(phase, wave)
+}
+
 // filter out out-of-sync tasks
 func (sc *syncContext) filterOutOfSyncTasks(tasks syncTasks) syncTasks {
 	return tasks.Filter(func(t *syncTask) bool {
@@ -908,6 +1003,12 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	// order tasks after the tasks they depend on
+	if task, err := tasks.resolveDependencies(); err != nil {
+		sc.setResourceResult(task, common.ResultCodeSyncFailed, "", err.Error())
+		successful = false
+	}
+
 	// for prune tasks, modify the waves for proper cleanup i.e reverse of sync wave (creation order)
 	pruneTasks := make(map[int][]*syncTask)
 	for _, task := range tasks {
@@ -1288,6 +1389,11 @@ func (sc *syncContext) Terminate() {
 	terminateSuccessful := true
 	sc.log.V(1).Info("terminating")
 	tasks, _ := sc.getSyncTasks()
+	var syncFailTasks syncTasks
+	if sc.syncFailHooksOnTerminate {
+		// the SyncFail hooks are run once the other hooks are terminated, so they must not be terminated themselves
+		syncFailTasks, tasks = tasks.Split(func(t *syncTask) bool { return t.phase == common.SyncPhaseSyncFail })
+	}
 	for _, task := range tasks {
 		if !task.isHook() || task.liveObj == nil {
 			continue
@@ -1314,10 +1420,45 @@ func (sc *syncContext) Terminate() {
 			sc.setResourceResult(task, "", phase, msg)
 		}
 	}
-	if terminateSuccessful {
-		sc.setOperationPhase(common.OperationFailed, "Operation terminated")
-	} else {
+	switch {
+	case !terminateSuccessful:
 		sc.setOperationPhase(common.OperationError, "Operation termination had errors")
+	case len(syncFailTasks) > 0:
+		sc.terminateWithSyncFailTasks(syncFailTasks)
+	default:
+		sc.setOperationPhase(common.OperationFailed, "Operation terminated")
+	}
+}
+
+// terminateWithSyncFailTasks runs the SyncFail hooks of a terminated sync. The operation stays in the terminating phase
+// until all of them are completed, and then fails.
+func (sc *syncContext) terminateWithSyncFailTasks(syncFailTasks syncTasks) {
+	// update the result of the hooks which are already running
+	for _, task := range syncFailTasks.Filter(func(t *syncTask) bool { return t.running() && t.liveObj != nil }) {
+		operationState, message, err := sc.getOperationPhase(task.liveObj)
+		if err != nil {
+			sc.setResourceResult(task, "", common.OperationError, fmt.Sprintf("failed to get resource health: %v", err))
+		} else {
+			sc.setResourceResult(task, "", operationState, message)
+		}
+	}
+	if syncFailTasks.Any(func(t *syncTask) bool { return t.running() }) {
+		sc.setOperationPhase(common.OperationTerminating, "Operation terminated, waiting for SyncFail hooks to complete")
+		return
+	}
+
+	if syncFailTasks.All(func(t *syncTask) bool { return t.completed() }) {
+		for _, task := range syncFailTasks {
+			if err := sc.removeHookFinalizer(task); err != nil {
+				sc.setResourceResult(task, task.syncStatus, common.OperationError, fmt.Sprintf("Failed to remove hook finalizer: %v", err))
+			}
+		}
+		sc.deleteHooks(syncFailTasks.Filter(func(t *syncTask) bool { return t.liveObj != nil && t.deleteOnPhaseFailed() }))
+	}
+	sc.setOperationFailed(syncFailTasks, syncTasks{}, "Operation terminated")
+	if !sc.phase.Completed() {
+		// the SyncFail hooks were started, the next termination waits for them to complete
+		sc.setOperationPhase(common.OperationTerminating, "Operation terminated, running SyncFail hooks")
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/discovery"
 	fakedisco "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic/fake"
@@ -811,6 +812,60 @@ func TestDoNotSyncOrPruneHooks(t *testing.T) {
 	assert.Equal(t, synccommon.OperationSucceeded, phase)
 }
 
+func TestSyncPruneThreshold(t *testing.T) {
+	newResources := func() ReconciliationResult {
+		var live, target []*unstructured.Unstructured
+		for _, name := range []string{"pod-a", "pod-b", "pod-c", "pod-d"} {
+			pod := testingutils.NewPod()
+			pod.SetName(name)
+			pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+			live = append(live, pod)
+			target = append(target, nil)
+		}
+		// only pod-a remains in the target state
+		target[0] = live[0]
+		return ReconciliationResult{Live: live, Target: target}
+	}
+	fiftyPercent := intstr.FromString("50%")
+	three := intstr.FromInt32(3)
+
+	tests := []struct {
+		name          string
+		opts          []SyncOpt
+		expectedPhase synccommon.OperationPhase
+	}{
+		{"Exceeded", []SyncOpt{WithPruneThreshold(&fiftyPercent, false)}, synccommon.OperationFailed},
+		{"NotExceeded", []SyncOpt{WithPruneThreshold(&three, false)}, synccommon.OperationSucceeded},
+		{"Confirmed", []SyncOpt{WithPruneThreshold(&fiftyPercent, true)}, synccommon.OperationSucceeded},
+		{"NoThreshold", nil, synccommon.OperationSucceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx(nil, append([]SyncOpt{WithOperationSettings(false, true, false, false)}, tt.opts...)...)
+			syncCtx.resources = groupResources(newResources())
+
+			syncCtx.Sync()
+			phase, message, resources := syncCtx.GetState()
+			assert.Equal(t, tt.expectedPhase, phase)
+			if tt.expectedPhase == synccommon.OperationFailed {
+				assert.Equal(t, "pruning 3 of 4 resources exceeds the prune threshold of 50%, the pruning must be confirmed", message)
+				assert.Empty(t, resources)
+			} else {
+				assert.Len(t, resources, 4)
+			}
+		})
+	}
+
+	t.Run("PruneDisabled", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false), WithPruneThreshold(&fiftyPercent, false))
+		syncCtx.resources = groupResources(newResources())
+
+		syncCtx.Sync()
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+	})
+}
+
 // make sure that we do not prune resources with Prune=false
 func TestDoNotPrunePruneFalse(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false))
@@ -1513,6 +1568,55 @@ func TestRunSyncFailHooksFailed(t *testing.T) {
 	assert.Equal(t, synccommon.ResultCodeSynced, resources[2].Status)
 }
 
+func TestTerminate_SyncFailHooks(t *testing.T) {
+	newTerminatingSyncCtx := func(opts ...SyncOpt) *syncContext {
+		syncCtx := newTestSyncCtx(nil, opts...)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.hooks = []*unstructured.Unstructured{newHook(synccommon.HookTypeSyncFail)}
+		syncCtx.phase = synccommon.OperationTerminating
+		return syncCtx
+	}
+
+	t.Run("SyncFail hooks are not run by default", func(t *testing.T) {
+		syncCtx := newTerminatingSyncCtx()
+
+		syncCtx.Terminate()
+		phase, message, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "Operation terminated", message)
+		assert.Empty(t, resources)
+	})
+
+	t.Run("SyncFail hooks are run before the operation fails", func(t *testing.T) {
+		syncCtx := newTerminatingSyncCtx(WithSyncFailHooksOnTerminate(true))
+
+		syncCtx.Terminate()
+		phase, message, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationTerminating, phase)
+		assert.Equal(t, "Operation terminated, running SyncFail hooks", message)
+		require.Len(t, resources, 1)
+		assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSyncFail), resources[0].SyncPhase)
+		assert.Equal(t, synccommon.OperationRunning, resources[0].HookPhase)
+
+		syncCtx.Terminate()
+		phase, message, _ = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationTerminating, phase)
+		assert.Equal(t, "Operation terminated, waiting for SyncFail hooks to complete", message)
+
+		for key, res := range syncCtx.syncRes {
+			res.HookPhase = synccommon.OperationSucceeded
+			syncCtx.syncRes[key] = res
+		}
+		syncCtx.Terminate()
+		phase, message, _ = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "Operation terminated", message)
+	})
+}
+
 type resourceNameHealthOverride map[string]health.HealthStatusCode
 
 func (r resourceNameHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
@@ -1846,6 +1950,192 @@ func TestSyncWaveHook(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestSyncDependsOn(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	podA := testingutils.NewPod()
+	podA.SetName("pod-a")
+	podB := testingutils.NewPod()
+	podB.SetName("pod-b")
+	podB.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: "Pod/pod-a"})
+	podC := testingutils.NewPod()
+	podC.SetName("pod-c")
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil, nil},
+		Target: []*unstructured.Unstructured{podA, podB, podC},
+	})
+
+	getResult := func(name string) *synccommon.ResourceSyncResult {
+		_, _, results := syncCtx.GetState()
+		for _, res := range results {
+			if res.ResourceKey.Name == name {
+				return &res
+			}
+		}
+		return nil
+	}
+	complete := func(name string) {
+		res := getResult(name)
+		require.NotNil(t, res)
+		res.HookPhase = synccommon.OperationSucceeded
+		syncCtx.syncRes[resourceResultKey(res.ResourceKey, synccommon.SyncPhaseSync)] = *res
+	}
+
+	// pod-b waits for pod-a, while the independent pod-c is applied with pod-a
+	syncCtx.Sync()
+	phase, _, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.NotNil(t, getResult("pod-a"))
+	assert.Nil(t, getResult("pod-b"))
+	assert.NotNil(t, getResult("pod-c"))
+
+	syncCtx.Sync()
+	assert.Nil(t, getResult("pod-b"))
+
+	// pod-b is applied as soon as pod-a completes, even though pod-c is still running
+	complete("pod-a")
+	syncCtx.Sync()
+	phase, _, _ = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.NotNil(t, getResult("pod-b"))
+
+	complete("pod-b")
+	syncCtx.Sync()
+	phase, message, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "waiting for healthy state of /Pod/pod-c", message)
+
+	complete("pod-c")
+	syncCtx.Sync()
+	phase, _, _ = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+}
+
+func TestSyncWaveApproval(t *testing.T) {
+	newPods := func() []*unstructured.Unstructured {
+		podA := testingutils.NewPod()
+		podA.SetName("pod-a")
+		podB := testingutils.NewPod()
+		podB.SetName("pod-b")
+		podB.SetAnnotations(map[string]string{
+			synccommon.AnnotationSyncWave:         "1",
+			synccommon.AnnotationApprovalRequired: "true",
+		})
+		return []*unstructured.Unstructured{podA, podB}
+	}
+
+	t.Run("WaitsForApproval", func(t *testing.T) {
+		approved := false
+		var requested []int
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false), WithSyncWaveApprovalHook(func(phase synccommon.SyncPhase, wave int) bool {
+			assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSync), phase)
+			requested = append(requested, wave)
+			return approved
+		}))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: newPods(),
+		})
+
+		// the first wave does not require approval
+		syncCtx.Sync()
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		require.Len(t, results, 1)
+		assert.Empty(t, requested)
+
+		results[0].HookPhase = synccommon.OperationSucceeded
+		syncCtx.syncRes[resourceResultKey(results[0].ResourceKey, synccommon.SyncPhaseSync)] = results[0]
+
+		syncCtx.Sync()
+		phase, message, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationWaitingForApproval, phase)
+		assert.Equal(t, "waiting for approval of wave 1 of phase Sync", message)
+		assert.Len(t, results, 1)
+		assert.Equal(t, []int{1}, requested)
+
+		approved = true
+		syncCtx.Sync()
+		phase, _, results = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("NoApprovalHook", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: newPods(),
+		})
+
+		syncCtx.Sync()
+		_, _, results := syncCtx.GetState()
+		require.Len(t, results, 1)
+		results[0].HookPhase = synccommon.OperationSucceeded
+		syncCtx.syncRes[resourceResultKey(results[0].ResourceKey, synccommon.SyncPhaseSync)] = results[0]
+
+		syncCtx.Sync()
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(true, false, false, false), WithSyncWaveApprovalHook(func(_ synccommon.SyncPhase, _ int) bool {
+			return false
+		}))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: newPods(),
+		})
+
+		syncCtx.Sync()
+		phase, _, _ := syncCtx.GetState()
+		assert.NotEqual(t, synccommon.OperationWaitingForApproval, phase)
+	})
+}
+
+func TestSyncDependsOnCycle(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	podA := testingutils.NewPod()
+	podA.SetName("pod-a")
+	podA.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: "Pod/pod-b"})
+	podB := testingutils.NewPod()
+	podB.SetName("pod-b")
+	podB.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: "Pod/pod-a"})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{podA, podB},
+	})
+
+	syncCtx.Sync()
+	phase, message, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "one or more synchronization tasks are not valid", message)
+	require.Len(t, results, 1)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, results[0].Status)
+	assert.Contains(t, results[0].Message, "dependency cycle detected: /Pod/pod-")
+}
+
+func TestSyncDependsOnLaterPhase(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: "Pod/post-sync"})
+	hook := testingutils.NewPod()
+	hook.SetName("post-sync")
+	hook.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PostSync"})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{pod},
+	})
+	syncCtx.hooks = []*unstructured.Unstructured{hook}
+
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	require.Len(t, results, 1)
+	assert.Equal(t, "/Pod/my-pod depends on /Pod/post-sync which is synced in the later PostSync phase", results[0].Message)
+}
+
 func TestSyncWaveHookFail(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
 	pod1 := testingutils.NewPod()
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,6 +25,8 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	// dependencies are the tasks of the same phase which must complete before this task runs
+	dependencies syncTasks
 }
 
 func ternary(val bool, a, b string) string {
@@ -107,6 +110,27 @@ func (t *syncTask) successful() bool {
 	return t.operationState.Successful()
 }
 
+// dependenciesCompleted returns whether none of the tasks this task depends on is running or in the given pending tasks
+func (t *syncTask) dependenciesCompleted(pending syncTasks) bool {
+	for _, dependency := range t.dependencies {
+		if dependency.running() || slices.Contains(pending, dependency) {
+			return false
+		}
+	}
+	return true
+}
+
+// ref returns the reference of the task in messages
+func (t *syncTask) ref() string {
+	return fmt.Sprintf("%s/%s/%s", t.group(), t.kind(), t.name())
+}
+
+// matches returns whether the task syncs the given resource. The namespace is only compared if it is set.
+func (t *syncTask) matches(key kube.ResourceKey) bool {
+	return t.group() == key.Group && t.kind() == key.Kind && t.name() == key.Name &&
+		(key.Namespace == "" || t.namespace() == key.Namespace)
+}
+
 func (t *syncTask) pruned() bool {
 	return t.syncStatus == common.ResultCodePruned
 }
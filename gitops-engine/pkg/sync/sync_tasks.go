@@ -2,12 +2,14 @@ package sync
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
 
@@ -272,5 +274,79 @@ func (s syncTasks) lastWave() int {
 }
 
 func (s syncTasks) multiStep() bool {
-	return s.wave() != s.lastWave() || s.phase() != s.lastPhase()
+	return s.wave() != s.lastWave() || s.phase() != s.lastPhase() || s.hasDependencies()
+}
+
+func (s syncTasks) hasDependencies() bool {
+	return s.Any(func(t *syncTask) bool { return len(t.dependencies) > 0 })
+}
+
+// resolveDependencies resolves the depends-on annotations of the tasks into dependencies between tasks of the same
+// phase. Dependencies on tasks of an earlier phase are always satisfied, and dependencies on resources which are not
+// part of the sync are ignored. A task is moved to the wave of its latest dependency, so that it never waits for a
+// later wave. Pruning is not affected by dependencies.
+// It returns the offending task and an error if an annotation is invalid, a task depends on a task of a later phase,
+// or the dependencies form a cycle.
+func (s syncTasks) resolveDependencies() (*syncTask, error) {
+	for _, t := range s {
+		t.dependencies = nil
+	}
+	for _, t := range s {
+		if t.isPrune() {
+			continue
+		}
+		keys, err := syncwaves.DependsOn(t.obj())
+		if err != nil {
+			return t, err
+		}
+		for _, key := range keys {
+			for _, dependency := range s {
+				if dependency.isPrune() || !dependency.matches(key) || kube.GetResourceKey(dependency.obj()) == kube.GetResourceKey(t.obj()) {
+					continue
+				}
+				switch d := syncPhaseOrder[dependency.phase] - syncPhaseOrder[t.phase]; {
+				case d > 0:
+					return t, fmt.Errorf("%s depends on %s which is synced in the later %s phase", t.ref(), dependency.ref(), dependency.phase)
+				case d == 0:
+					t.dependencies = append(t.dependencies, dependency)
+				}
+			}
+		}
+	}
+
+	// visit the tasks depth-first to detect cycles, and move tasks to the wave of their latest dependency
+	visited := map[*syncTask]bool{}
+	var path syncTasks
+	var visit func(t *syncTask) (*syncTask, error)
+	visit = func(t *syncTask) (*syncTask, error) {
+		if i := slices.Index(path, t); i >= 0 {
+			var cycle []string
+			for _, task := range path[i:] {
+				cycle = append(cycle, task.ref())
+			}
+			cycle = append(cycle, t.ref())
+			return t, fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+		if visited[t] {
+			return nil, nil
+		}
+		path = append(path, t)
+		for _, dependency := range t.dependencies {
+			if task, err := visit(dependency); err != nil {
+				return task, err
+			}
+			if wave := dependency.wave(); wave > t.wave() {
+				t.waveOverride = &wave
+			}
+		}
+		path = path[:len(path)-1]
+		visited[t] = true
+		return nil, nil
+	}
+	for _, t := range s {
+		if task, err := visit(t); err != nil {
+			return task, err
+		}
+	}
+	return nil, nil
 }
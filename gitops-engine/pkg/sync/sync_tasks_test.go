@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -481,4 +482,64 @@ func Test_syncTasks_multiStep(t *testing.T) {
 		assert.Equal(t, 1, tasks.lastWave())
 		assert.True(t, tasks.multiStep())
 	})
+	t.Run("Dependencies", func(t *testing.T) {
+		dependency := &syncTask{targetObj: testingutils.NewPod(), phase: common.SyncPhaseSync}
+		tasks := syncTasks{dependency, {targetObj: testingutils.NewPod(), phase: common.SyncPhaseSync, dependencies: syncTasks{dependency}}}
+		assert.True(t, tasks.multiStep())
+	})
+}
+
+func Test_syncTasks_resolveDependencies(t *testing.T) {
+	newTask := func(name string, wave string, dependsOn string) *syncTask {
+		pod := testingutils.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace("my-namespace")
+		pod.SetAnnotations(map[string]string{common.AnnotationSyncWave: wave, common.AnnotationDependsOn: dependsOn})
+		return &syncTask{targetObj: pod, phase: common.SyncPhaseSync}
+	}
+
+	t.Run("MovesTasksToTheWaveOfTheirDependencies", func(t *testing.T) {
+		a := newTask("a", "2", "")
+		b := newTask("b", "0", "Pod/a")
+		c := newTask("c", "1", "/Pod/b, /Pod/my-namespace/a")
+		d := newTask("d", "5", "Pod/c")
+		other := newTask("other", "0", "Pod/not-synced")
+		tasks := syncTasks{a, b, c, d, other}
+
+		task, err := tasks.resolveDependencies()
+		require.NoError(t, err)
+		assert.Nil(t, task)
+		assert.Equal(t, syncTasks{a}, b.dependencies)
+		assert.Equal(t, syncTasks{b, a}, c.dependencies)
+		assert.Equal(t, 2, a.wave())
+		assert.Equal(t, 2, b.wave())
+		assert.Equal(t, 2, c.wave())
+		assert.Equal(t, 5, d.wave())
+		assert.Empty(t, other.dependencies)
+		assert.Equal(t, 0, other.wave())
+	})
+
+	t.Run("IgnoresNamespaceMismatch", func(t *testing.T) {
+		a := newTask("a", "0", "")
+		b := newTask("b", "0", "/Pod/other-namespace/a")
+		_, err := syncTasks{a, b}.resolveDependencies()
+		require.NoError(t, err)
+		assert.Empty(t, b.dependencies)
+	})
+
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		a := newTask("a", "0", "a")
+		task, err := syncTasks{a}.resolveDependencies()
+		require.ErrorContains(t, err, `invalid argocd.argoproj.io/depends-on reference "a"`)
+		assert.Equal(t, a, task)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		a := newTask("a", "0", "Pod/c")
+		b := newTask("b", "0", "Pod/a")
+		c := newTask("c", "0", "Pod/b")
+		task, err := syncTasks{a, b, c}.resolveDependencies()
+		require.EqualError(t, err, "dependency cycle detected: /Pod/a -> /Pod/c -> /Pod/b -> /Pod/a")
+		assert.Equal(t, a, task)
+	})
 }
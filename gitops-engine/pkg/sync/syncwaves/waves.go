@@ -1,12 +1,15 @@
 package syncwaves
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	helmhook "github.com/argoproj/gitops-engine/pkg/sync/hook/helm"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
 
 func Wave(obj *unstructured.Unstructured) int {
@@ -19,3 +22,42 @@ func Wave(obj *unstructured.Unstructured) int {
 	}
 	return helmhook.Weight(obj)
 }
+
+// ApprovalRequired returns whether the wave of the given object must be approved before it is synced
+func ApprovalRequired(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[common.AnnotationApprovalRequired] == "true"
+}
+
+// DependsOn returns the resources referenced by the depends-on annotation of the given object. References are
+// kind/name for core resources, group/kind/name, or group/kind/namespace/name. The namespace of a reference is only
+// set if it is explicitly given.
+func DependsOn(obj *unstructured.Unstructured) ([]kube.ResourceKey, error) {
+	text, ok := obj.GetAnnotations()[common.AnnotationDependsOn]
+	if !ok {
+		return nil, nil
+	}
+	var keys []kube.ResourceKey
+	for _, ref := range strings.Split(text, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		var key kube.ResourceKey
+		parts := strings.Split(ref, "/")
+		switch len(parts) {
+		case 2:
+			key = kube.ResourceKey{Kind: parts[0], Name: parts[1]}
+		case 3:
+			key = kube.ResourceKey{Group: parts[0], Kind: parts[1], Name: parts[2]}
+		case 4:
+			key = kube.ResourceKey{Group: parts[0], Kind: parts[1], Namespace: parts[2], Name: parts[3]}
+		default:
+			return nil, fmt.Errorf("invalid %s reference %q: expected kind/name, group/kind/name or group/kind/namespace/name", common.AnnotationDependsOn, ref)
+		}
+		if key.Kind == "" || key.Name == "" {
+			return nil, fmt.Errorf("invalid %s reference %q: kind and name are required", common.AnnotationDependsOn, ref)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
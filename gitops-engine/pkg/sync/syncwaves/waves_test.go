@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
 )
 
@@ -13,3 +15,29 @@ func TestWave(t *testing.T) {
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave", "1")))
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "helm.sh/hook-weight", "1")))
 }
+
+func TestApprovalRequired(t *testing.T) {
+	assert.False(t, ApprovalRequired(testingutils.NewPod()))
+	assert.True(t, ApprovalRequired(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/approval-required", "true")))
+	assert.False(t, ApprovalRequired(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/approval-required", "false")))
+}
+
+func TestDependsOn(t *testing.T) {
+	keys, err := DependsOn(testingutils.NewPod())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/depends-on", "ConfigMap/my-cm, apps/Deployment/my-deploy,,batch/Job/other-ns/my-job"))
+	require.NoError(t, err)
+	assert.Equal(t, []kube.ResourceKey{
+		{Kind: "ConfigMap", Name: "my-cm"},
+		{Group: "apps", Kind: "Deployment", Name: "my-deploy"},
+		{Group: "batch", Kind: "Job", Namespace: "other-ns", Name: "my-job"},
+	}, keys)
+
+	_, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/depends-on", "my-cm"))
+	require.ErrorContains(t, err, `invalid argocd.argoproj.io/depends-on reference "my-cm"`)
+
+	_, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/depends-on", "apps/Deployment/"))
+	require.ErrorContains(t, err, "kind and name are required")
+}
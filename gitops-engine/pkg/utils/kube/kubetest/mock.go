@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
@@ -27,6 +28,8 @@ type MockKubectlCmd struct {
 	Events        chan watch.Event
 	Version       string
 	DynamicClient dynamic.Interface
+	// MetadataClient is the client used to list and watch metadata-only resources
+	MetadataClient metadata.Interface
 
 	convertToVersionFunc *func(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
 	getResourceFunc      *func(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
@@ -48,6 +51,10 @@ func (k *MockKubectlCmd) NewDynamicClient(_ *rest.Config) (dynamic.Interface, er
 	return k.DynamicClient, nil
 }
 
+func (k *MockKubectlCmd) NewMetadataClient(_ *rest.Config) (metadata.Interface, error) {
+	return k.MetadataClient, nil
+}
+
 func (k *MockKubectlCmd) GetAPIResources(_ *rest.Config, _ bool, _ kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
 	return k.APIResources, nil
 }
@@ -180,6 +180,10 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Each window must have a unique identity hash
 //   - Each window must validate successfully
 //   - A window must target at least one of applications, clusters, or namespaces
+//   - SyncTimeout:
+//   - Must be a valid, non-negative duration
+//   - PruneThreshold:
+//   - Must be a non-negative number or a percentage between 0% and 100%
 //   - DestinationServiceAccounts:
 //   - Server and namespace fields must not contain invalid characters or "!"
 //   - Default service account must not be empty or contain disallowed characters
@@ -286,6 +290,14 @@ func (proj *AppProject) ValidateProject() error {
 		}
 	}
 
+	if _, err := proj.Spec.GetSyncTimeout(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "syncTimeout has an invalid format: %v", err)
+	}
+
+	if _, err := proj.Spec.GetPruneThreshold(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "pruneThreshold has an invalid format: %v", err)
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -6,6 +6,14 @@ const (
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
 	// AnnotationKeyHydrate is the annotation key which indicates that app needs to be hydrated. Removed by application controller after app is hydrated.
 	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
+	// AnnotationKeyRefreshSource is the annotation key which indicates what requested the refresh of the app, so that the
+	// application controller can prioritize it. Removed by application controller after app is refreshed.
+	// Might take values 'user'/'webhook'. A refresh without the annotation gets no priority.
+	AnnotationKeyRefreshSource string = "argocd.argoproj.io/refresh-source"
+	// AnnotationKeyHydrateRollback is the annotation key which holds a hydrate rollback pushed by the API server, as JSON,
+	// until the application controller records it in the hydrate history. Removed by application controller after the
+	// rollback is recorded.
+	AnnotationKeyHydrateRollback string = "argocd.argoproj.io/hydrate-rollback"
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
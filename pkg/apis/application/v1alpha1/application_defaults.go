@@ -6,6 +6,8 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+	// DefaultAutoRollbackWindow is the amount of time after a sync during which a degraded application is rolled back
+	DefaultAutoRollbackWindow time.Duration = 300000000000 // 5m0s
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +632,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{21}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +688,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +772,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +940,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1248,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1276,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1304,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1332,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1360,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1388,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1416,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1444,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1472,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1500,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1528,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1556,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *ClusterResourceRestrictionItem) Reset()      { *m = ClusterResourceRestrictionItem{} }
 func (*ClusterResourceRestrictionItem) ProtoMessage() {}
 func (*ClusterResourceRestrictionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterResourceRestrictionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1584,7 @@ var xxx_messageInfo_ClusterResourceRestrictionItem proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1612,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1640,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1668,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1696,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1724,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1752,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1780,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1808,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1836,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1864,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1892,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1920,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +1948,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +1976,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2004,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2032,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2060,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2088,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2116,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2144,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2172,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2169,10 +2197,38 @@ func (m *HostResourceInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 
+func (m *HydrateHistory) Reset()      { *m = HydrateHistory{} }
+func (*HydrateHistory) ProtoMessage() {}
+func (*HydrateHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{77}
+}
+func (m *HydrateHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateHistory.Merge(m, src)
+}
+func (m *HydrateHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateHistory proto.InternalMessageInfo
+
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,10 +2253,38 @@ func (m *HydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 
+func (m *HydratePullRequestStatus) Reset()      { *m = HydratePullRequestStatus{} }
+func (*HydratePullRequestStatus) ProtoMessage() {}
+func (*HydratePullRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{79}
+}
+func (m *HydratePullRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratePullRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratePullRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratePullRequestStatus.Merge(m, src)
+}
+func (m *HydratePullRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratePullRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratePullRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratePullRequestStatus proto.InternalMessageInfo
+
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2225,10 +2309,38 @@ func (m *HydrateTo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 
+func (m *HydrateToPullRequest) Reset()      { *m = HydrateToPullRequest{} }
+func (*HydrateToPullRequest) ProtoMessage() {}
+func (*HydrateToPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{81}
+}
+func (m *HydrateToPullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateToPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateToPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateToPullRequest.Merge(m, src)
+}
+func (m *HydrateToPullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateToPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateToPullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateToPullRequest proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2368,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2396,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2424,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2452,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2480,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2508,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2536,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2564,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2592,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2620,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2648,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2676,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2704,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2732,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2760,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2788,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2816,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2844,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2872,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2900,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2928,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +2956,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +2984,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3012,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3040,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3068,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3096,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3124,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3152,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3180,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3208,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3236,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3264,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3292,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3488,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3516,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3544,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3572,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3600,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3628,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3656,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3684,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3712,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3740,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3768,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +3793,66 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceDriftEvent) Reset()      { *m = ResourceDriftEvent{} }
+func (*ResourceDriftEvent) ProtoMessage() {}
+func (*ResourceDriftEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{134}
+}
+func (m *ResourceDriftEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDriftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceDriftEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDriftEvent.Merge(m, src)
+}
+func (m *ResourceDriftEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDriftEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDriftEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDriftEvent proto.InternalMessageInfo
+
+func (m *ResourceDriftHistory) Reset()      { *m = ResourceDriftHistory{} }
+func (*ResourceDriftHistory) ProtoMessage() {}
+func (*ResourceDriftHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{135}
+}
+func (m *ResourceDriftHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDriftHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceDriftHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDriftHistory.Merge(m, src)
+}
+func (m *ResourceDriftHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDriftHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDriftHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDriftHistory proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3880,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3908,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3936,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +3964,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +3992,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4020,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4048,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4076,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4104,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4132,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4160,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4440,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4468,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4325,10 +4493,38 @@ func (m *SourceHydrator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 
+func (m *SourceHydratorRecord) Reset()      { *m = SourceHydratorRecord{} }
+func (*SourceHydratorRecord) ProtoMessage() {}
+func (*SourceHydratorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{159}
+}
+func (m *SourceHydratorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceHydratorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SourceHydratorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceHydratorRecord.Merge(m, src)
+}
+func (m *SourceHydratorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceHydratorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceHydratorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceHydratorRecord proto.InternalMessageInfo
+
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4552,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4580,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4608,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4636,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4664,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4493,10 +4689,38 @@ func (m *SyncPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 
+func (m *SyncPolicyAutoRollback) Reset()      { *m = SyncPolicyAutoRollback{} }
+func (*SyncPolicyAutoRollback) ProtoMessage() {}
+func (*SyncPolicyAutoRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{166}
+}
+func (m *SyncPolicyAutoRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyAutoRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyAutoRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyAutoRollback.Merge(m, src)
+}
+func (m *SyncPolicyAutoRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyAutoRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyAutoRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyAutoRollback proto.InternalMessageInfo
+
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4748,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4776,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4804,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +4832,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +4860,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4661,10 +4885,38 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncWaveApproval) Reset()      { *m = SyncWaveApproval{} }
+func (*SyncWaveApproval) ProtoMessage() {}
+func (*SyncWaveApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{173}
+}
+func (m *SyncWaveApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaveApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWaveApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaveApproval.Merge(m, src)
+}
+func (m *SyncWaveApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaveApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaveApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaveApproval proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{174}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +4944,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +4972,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4755,6 +5007,7 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
@@ -4834,8 +5087,11 @@ func init() {
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo.LabelsEntry")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
+	proto.RegisterType((*HydrateHistory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateHistory")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
+	proto.RegisterType((*HydratePullRequestStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratePullRequestStatus")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*HydrateToPullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateToPullRequest")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTToken")
@@ -4895,6 +5151,8 @@ func init() {
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceDriftEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDriftEvent")
+	proto.RegisterType((*ResourceDriftHistory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDriftHistory")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
@@ -4921,18 +5179,21 @@ func init() {
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SignatureKey")
 	proto.RegisterType((*SourceHydrator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydrator")
+	proto.RegisterType((*SourceHydratorRecord)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydratorRecord")
 	proto.RegisterType((*SourceHydratorStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydratorStatus")
 	proto.RegisterType((*SuccessfulHydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SuccessfulHydrateOperation")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicy")
+	proto.RegisterType((*SyncPolicyAutoRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutoRollback")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncSource")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWaveApproval)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWaveApproval")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
@@ -4943,787 +5204,843 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x24, 0xdb,
-	0x55, 0x18, 0xee, 0x9e, 0xd1, 0x48, 0x33, 0x47, 0x1f, 0xbb, 0xba, 0xbb, 0xfb, 0x9e, 0x76, 0xdf,
-	0x87, 0x96, 0x7e, 0xf0, 0x6c, 0x7e, 0x7e, 0x96, 0xf0, 0xda, 0xcf, 0xbc, 0x1f, 0x0f, 0x0c, 0xfa,
-	0xd8, 0x0f, 0xed, 0x4a, 0x2b, 0xf9, 0x8e, 0x76, 0x17, 0x7f, 0x3c, 0xdb, 0xad, 0x9e, 0xab, 0x51,
-	0xaf, 0x7a, 0xba, 0xe7, 0x75, 0xf7, 0x68, 0x35, 0x0f, 0x63, 0x6c, 0x6c, 0x83, 0xc1, 0xc6, 0x7e,
-	0x81, 0x14, 0x3c, 0x92, 0x98, 0x98, 0x40, 0x3e, 0xaa, 0x52, 0x14, 0x24, 0xfc, 0x11, 0x2a, 0x40,
-	0x51, 0x01, 0x8a, 0x82, 0x0a, 0x09, 0x84, 0x22, 0x40, 0x02, 0x28, 0xf6, 0x26, 0x29, 0xa8, 0x54,
-	0x85, 0x2a, 0x92, 0xfc, 0x91, 0xda, 0xa4, 0xa8, 0xd4, 0xfd, 0xbe, 0xdd, 0xd3, 0x23, 0x8d, 0x56,
-	0x2d, 0xed, 0x1a, 0xde, 0x7f, 0x33, 0xf7, 0x9c, 0x3e, 0xe7, 0xf6, 0xed, 0x7b, 0xcf, 0x39, 0xf7,
-	0xdc, 0x73, 0xce, 0x85, 0xe5, 0xa6, 0x97, 0x6c, 0x75, 0x36, 0x66, 0xdc, 0xb0, 0x35, 0xeb, 0x44,
-	0xcd, 0xb0, 0x1d, 0x85, 0x77, 0xd9, 0x8f, 0x77, 0xb8, 0x8d, 0xd9, 0x9d, 0x77, 0xcd, 0xb6, 0xb7,
-	0x9b, 0xb3, 0x4e, 0xdb, 0x8b, 0x67, 0x9d, 0x76, 0xdb, 0xf7, 0x5c, 0x27, 0xf1, 0xc2, 0x60, 0x76,
-	0xe7, 0x9d, 0x8e, 0xdf, 0xde, 0x72, 0xde, 0x39, 0xdb, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0x66,
-	0xda, 0x51, 0x98, 0x84, 0xe8, 0x5b, 0x35, 0xb5, 0x19, 0x49, 0x8d, 0xfd, 0xf8, 0x88, 0xdb, 0x98,
-	0xd9, 0x79, 0xd7, 0x4c, 0x7b, 0xbb, 0x39, 0x43, 0xa9, 0xcd, 0x18, 0xd4, 0x66, 0x24, 0xb5, 0x0b,
-	0xef, 0x30, 0xfa, 0xd2, 0x0c, 0x9b, 0xe1, 0x2c, 0x23, 0xba, 0xd1, 0xd9, 0x64, 0xff, 0xd8, 0x1f,
-	0xf6, 0x8b, 0x33, 0xbb, 0x60, 0x6f, 0xbf, 0x14, 0xcf, 0x78, 0x21, 0xed, 0xde, 0xac, 0x1b, 0x46,
-	0x64, 0x76, 0xa7, 0xa7, 0x43, 0x17, 0xae, 0x69, 0x1c, 0xb2, 0x9b, 0x90, 0x20, 0xf6, 0xc2, 0x20,
-	0x7e, 0x07, 0xed, 0x02, 0x89, 0x76, 0x48, 0x64, 0xbe, 0x9e, 0x81, 0x90, 0x47, 0xe9, 0xdd, 0x9a,
-	0x52, 0xcb, 0x71, 0xb7, 0xbc, 0x80, 0x44, 0x5d, 0xfd, 0x78, 0x8b, 0x24, 0x4e, 0xde, 0x53, 0xb3,
-	0xfd, 0x9e, 0x8a, 0x3a, 0x41, 0xe2, 0xb5, 0x48, 0xcf, 0x03, 0xef, 0x39, 0xe8, 0x81, 0xd8, 0xdd,
-	0x22, 0x2d, 0xa7, 0xe7, 0xb9, 0x77, 0xf5, 0x7b, 0xae, 0x93, 0x78, 0xfe, 0xac, 0x17, 0x24, 0x71,
-	0x12, 0x65, 0x1f, 0xb2, 0xff, 0x9e, 0x05, 0xe3, 0x73, 0x77, 0xea, 0x73, 0x9d, 0x64, 0x6b, 0x21,
-	0x0c, 0x36, 0xbd, 0x26, 0x7a, 0x11, 0x46, 0x5d, 0xbf, 0x13, 0x27, 0x24, 0xba, 0xe9, 0xb4, 0xc8,
-	0x94, 0x75, 0xd1, 0x7a, 0x5b, 0x6d, 0xfe, 0xcc, 0x6f, 0xee, 0x4d, 0xbf, 0xe5, 0xfe, 0xde, 0xf4,
-	0xe8, 0x82, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0x23, 0x8c, 0x44, 0xa1, 0x4f, 0xe6, 0xf0, 0xcd, 0xa9,
-	0x12, 0x7b, 0xe4, 0x94, 0x78, 0x64, 0x04, 0xf3, 0x66, 0x2c, 0xe1, 0x14, 0xb5, 0x1d, 0x85, 0x9b,
-	0x9e, 0x4f, 0xa6, 0xca, 0x69, 0xd4, 0x35, 0xde, 0x8c, 0x25, 0xdc, 0xfe, 0xf1, 0x12, 0x9c, 0x9a,
-	0x6b, 0xb7, 0xaf, 0x11, 0xc7, 0x4f, 0xb6, 0xea, 0x89, 0x93, 0x74, 0x62, 0xd4, 0x84, 0xe1, 0x98,
-	0xfd, 0x12, 0x7d, 0x5b, 0x15, 0x4f, 0x0f, 0x73, 0xf8, 0x83, 0xbd, 0xe9, 0x6f, 0xcb, 0x9b, 0xd1,
-	0x4d, 0x2f, 0x09, 0xdb, 0xf1, 0x3b, 0x48, 0xd0, 0xf4, 0x02, 0xc2, 0xc6, 0x65, 0x8b, 0x51, 0x9d,
-	0x31, 0x89, 0x2f, 0x84, 0x0d, 0x82, 0x05, 0x79, 0xda, 0xcf, 0x16, 0x89, 0x63, 0xa7, 0x49, 0xb2,
-	0xaf, 0xb4, 0xc2, 0x9b, 0xb1, 0x84, 0xa3, 0x08, 0x90, 0xef, 0xc4, 0xc9, 0x7a, 0xe4, 0x04, 0xb1,
-	0x47, 0xa7, 0xf4, 0xba, 0xd7, 0xe2, 0x6f, 0x37, 0x7a, 0xe9, 0xff, 0x9b, 0xe1, 0x1f, 0x66, 0xc6,
-	0xfc, 0x30, 0x7a, 0x1d, 0xd0, 0x79, 0x33, 0xb3, 0xf3, 0xce, 0x19, 0xfa, 0xc4, 0xfc, 0x13, 0xf7,
-	0xf7, 0xa6, 0xd1, 0x72, 0x0f, 0x25, 0x9c, 0x43, 0xdd, 0xfe, 0x83, 0x12, 0xc0, 0x5c, 0xbb, 0xbd,
-	0x16, 0x85, 0x77, 0x89, 0x9b, 0xa0, 0x8f, 0x42, 0x95, 0x92, 0x6a, 0x38, 0x89, 0xc3, 0x06, 0x66,
-	0xf4, 0xd2, 0x37, 0x0d, 0xc6, 0x78, 0x75, 0x83, 0x3e, 0xbf, 0x42, 0x12, 0x67, 0x1e, 0x89, 0x17,
-	0x04, 0xdd, 0x86, 0x15, 0x55, 0x14, 0xc0, 0x50, 0xdc, 0x26, 0x2e, 0x1b, 0x8c, 0xd1, 0x4b, 0xcb,
-	0x33, 0x47, 0x59, 0xe9, 0x33, 0xba, 0xe7, 0xf5, 0x36, 0x71, 0xe7, 0xc7, 0x04, 0xe7, 0x21, 0xfa,
-	0x0f, 0x33, 0x3e, 0x68, 0x47, 0x7d, 0x68, 0x3e, 0x90, 0x37, 0x0b, 0xe3, 0xc8, 0xa8, 0xce, 0x4f,
-	0xa4, 0x27, 0x8e, 0xfc, 0xee, 0xf6, 0x9f, 0x5a, 0x30, 0xa1, 0x91, 0x97, 0xbd, 0x38, 0x41, 0x1f,
-	0xea, 0x19, 0xdc, 0x99, 0xc1, 0x06, 0x97, 0x3e, 0xcd, 0x86, 0xf6, 0xb4, 0x60, 0x56, 0x95, 0x2d,
-	0xc6, 0xc0, 0xb6, 0xa0, 0xe2, 0x25, 0xa4, 0x15, 0x4f, 0x95, 0x2e, 0x96, 0xdf, 0x36, 0x7a, 0xe9,
-	0x5a, 0x51, 0xef, 0x39, 0x3f, 0x2e, 0x98, 0x56, 0x96, 0x28, 0x79, 0xcc, 0xb9, 0xd8, 0xbf, 0x3d,
-	0x61, 0xbe, 0x1f, 0x1d, 0x70, 0xf4, 0x4e, 0x18, 0x8d, 0xc3, 0x4e, 0xe4, 0x12, 0x4c, 0xda, 0x21,
-	0x5d, 0x58, 0x65, 0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xeb, 0x66, 0x6c, 0xe2, 0xa0, 0x2f, 0x58, 0x30,
-	0xd6, 0x20, 0x71, 0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xfa, 0x91, 0x3b, 0x2f, 0x1b, 0x17, 0x35,
-	0xf1, 0xf9, 0xb3, 0xe2, 0x45, 0xc6, 0x8c, 0xc6, 0x18, 0xa7, 0xf8, 0x53, 0xc1, 0xd5, 0x20, 0xb1,
-	0x1b, 0x79, 0x6d, 0xfa, 0x5f, 0x88, 0x16, 0x25, 0xb8, 0x16, 0x35, 0x08, 0x9b, 0x78, 0x28, 0x80,
-	0x0a, 0x15, 0x4c, 0xf1, 0xd4, 0x10, 0xeb, 0xff, 0xd2, 0xd1, 0xfa, 0x2f, 0x06, 0x95, 0xca, 0x3c,
-	0x3d, 0xfa, 0xf4, 0x5f, 0x8c, 0x39, 0x1b, 0xf4, 0x2f, 0x2d, 0x98, 0x12, 0x82, 0x13, 0x13, 0x3e,
-	0xa0, 0x77, 0xb6, 0xbc, 0x84, 0xf8, 0x5e, 0x9c, 0x4c, 0x55, 0x58, 0x1f, 0x3e, 0x74, 0xb4, 0x3e,
-	0x2c, 0xa4, 0xa9, 0x63, 0x12, 0x27, 0x91, 0xe7, 0x52, 0x1c, 0x3a, 0x0d, 0xe6, 0x2f, 0x8a, 0x6e,
-	0x4d, 0x2d, 0xf4, 0xe9, 0x05, 0xee, 0xdb, 0x3f, 0xf4, 0x23, 0x16, 0x5c, 0x08, 0x9c, 0x16, 0x89,
-	0xdb, 0x0e, 0x23, 0xcc, 0xc0, 0xf3, 0xbe, 0xe3, 0x6e, 0xb3, 0xee, 0x0f, 0xb3, 0xee, 0xcf, 0x0e,
-	0xb6, 0x34, 0xae, 0x46, 0x61, 0xa7, 0x7d, 0xc3, 0x0b, 0x1a, 0xf3, 0xb6, 0xe8, 0xd1, 0x85, 0x9b,
-	0x7d, 0x49, 0xe3, 0x7d, 0xd8, 0xa2, 0x9f, 0xb2, 0x60, 0x32, 0x8c, 0xda, 0x5b, 0x4e, 0x40, 0x1a,
-	0x12, 0x1a, 0x4f, 0x8d, 0xb0, 0x75, 0xfa, 0xe1, 0xa3, 0x8d, 0xe5, 0x6a, 0x96, 0xec, 0x4a, 0x18,
-	0x78, 0x49, 0x18, 0xd5, 0x49, 0x92, 0x78, 0x41, 0x33, 0x9e, 0x3f, 0x77, 0x7f, 0x6f, 0x7a, 0xb2,
-	0x07, 0x0b, 0xf7, 0xf6, 0x07, 0x7d, 0x17, 0x8c, 0xc6, 0xdd, 0xc0, 0xbd, 0xe3, 0x05, 0x8d, 0xf0,
-	0x5e, 0x3c, 0x55, 0x2d, 0x62, 0xad, 0xd7, 0x15, 0x41, 0xb1, 0x5a, 0x35, 0x03, 0x6c, 0x72, 0xcb,
-	0xff, 0x70, 0x7a, 0xde, 0xd5, 0x8a, 0xfe, 0x70, 0x7a, 0x32, 0xed, 0xc3, 0x16, 0x7d, 0xbf, 0x05,
-	0xe3, 0xb1, 0xd7, 0x0c, 0x9c, 0xa4, 0x13, 0x91, 0x1b, 0xa4, 0x1b, 0x4f, 0x01, 0xeb, 0xc8, 0xf5,
-	0x23, 0x8e, 0x8a, 0x41, 0x72, 0xfe, 0x9c, 0xe8, 0xe3, 0xb8, 0xd9, 0x1a, 0xe3, 0x34, 0xdf, 0xbc,
-	0x55, 0xa9, 0xa7, 0xf5, 0xe8, 0x23, 0x5c, 0x95, 0x7a, 0x05, 0xf4, 0xed, 0x1f, 0xfa, 0x0e, 0x38,
-	0xcd, 0x9b, 0xd4, 0x67, 0x88, 0xa7, 0xc6, 0x98, 0x08, 0x3f, 0x7b, 0x7f, 0x6f, 0xfa, 0x74, 0x3d,
-	0x03, 0xc3, 0x3d, 0xd8, 0xe8, 0x55, 0x98, 0x6e, 0x93, 0xa8, 0xe5, 0x25, 0xab, 0x81, 0xdf, 0x95,
-	0x8a, 0xc1, 0x0d, 0xdb, 0xa4, 0x21, 0xba, 0x13, 0x4f, 0x8d, 0x5f, 0xb4, 0xde, 0x56, 0x9d, 0x7f,
-	0xab, 0xe8, 0xe6, 0xf4, 0xda, 0xfe, 0xe8, 0xf8, 0x20, 0x7a, 0xe8, 0x37, 0x2c, 0xb8, 0x60, 0xc8,
-	0xef, 0x3a, 0x89, 0x76, 0x3c, 0x97, 0xcc, 0xb9, 0x6e, 0xd8, 0x09, 0x92, 0x78, 0x6a, 0x82, 0x8d,
-	0xf9, 0xc6, 0x71, 0x68, 0x93, 0x34, 0x2b, 0x3d, 0x89, 0xfb, 0xa2, 0xc4, 0x78, 0x9f, 0x9e, 0xda,
-	0xbf, 0x55, 0x82, 0xd3, 0x59, 0xdb, 0x02, 0xfd, 0x23, 0x0b, 0x4e, 0xdd, 0xbd, 0x97, 0xac, 0x87,
-	0xdb, 0x24, 0x88, 0xe7, 0xbb, 0x54, 0x03, 0x30, 0xad, 0x3a, 0x7a, 0xc9, 0x2d, 0xd6, 0x8a, 0x99,
-	0xb9, 0x9e, 0xe6, 0x72, 0x39, 0x48, 0xa2, 0xee, 0xfc, 0x93, 0xe2, 0x9d, 0x4e, 0x5d, 0xbf, 0xb3,
-	0x6e, 0x42, 0x71, 0xb6, 0x53, 0x17, 0x3e, 0x67, 0xc1, 0xd9, 0x3c, 0x12, 0xe8, 0x34, 0x94, 0xb7,
-	0x49, 0x97, 0xdb, 0xd8, 0x98, 0xfe, 0x44, 0xaf, 0x40, 0x65, 0xc7, 0xf1, 0x3b, 0x44, 0x18, 0x80,
-	0x57, 0x8f, 0xf6, 0x22, 0xaa, 0x67, 0x98, 0x53, 0xfd, 0x96, 0xd2, 0x4b, 0x96, 0xfd, 0x3b, 0x65,
-	0x18, 0x35, 0x3e, 0xda, 0x09, 0x18, 0xb5, 0x61, 0xca, 0xa8, 0x5d, 0x29, 0x6c, 0xbe, 0xf5, 0xb5,
-	0x6a, 0xef, 0x65, 0xac, 0xda, 0xd5, 0xe2, 0x58, 0xee, 0x6b, 0xd6, 0xa2, 0x04, 0x6a, 0x61, 0x9b,
-	0x6e, 0xfe, 0xa8, 0x75, 0x34, 0x54, 0xc4, 0x27, 0x5c, 0x95, 0xe4, 0xe6, 0xc7, 0xef, 0xef, 0x4d,
-	0xd7, 0xd4, 0x5f, 0xac, 0x19, 0xd9, 0x7f, 0x68, 0xc1, 0x59, 0xa3, 0x8f, 0x0b, 0x61, 0xd0, 0x60,
-	0x5b, 0x18, 0x74, 0x11, 0x86, 0x92, 0x6e, 0x5b, 0x6e, 0x30, 0xd5, 0x48, 0xad, 0x77, 0xdb, 0x04,
-	0x33, 0xc8, 0xe3, 0xbe, 0xff, 0xfa, 0x11, 0x0b, 0x9e, 0xc8, 0x17, 0x30, 0xe8, 0x79, 0x18, 0xe6,
-	0xde, 0x05, 0xf1, 0x76, 0xfa, 0x93, 0xb0, 0x56, 0x2c, 0xa0, 0x68, 0x16, 0x6a, 0x4a, 0x3b, 0x8a,
-	0x77, 0x9c, 0x14, 0xa8, 0x35, 0xad, 0x52, 0x35, 0x0e, 0x1d, 0x34, 0xfa, 0x47, 0x18, 0xb7, 0x6a,
-	0xd0, 0xd8, 0x76, 0x9c, 0x41, 0xec, 0xdf, 0xb7, 0xe0, 0xeb, 0x07, 0x11, 0x7b, 0xc7, 0xd7, 0xc7,
-	0x3a, 0x9c, 0x6b, 0x90, 0x4d, 0xa7, 0xe3, 0x27, 0x69, 0x8e, 0xa2, 0xd3, 0xcf, 0x88, 0x87, 0xcf,
-	0x2d, 0xe6, 0x21, 0xe1, 0xfc, 0x67, 0xed, 0xff, 0x64, 0x31, 0x47, 0x80, 0x7c, 0xad, 0x13, 0xd8,
-	0x94, 0x05, 0xe9, 0x4d, 0xd9, 0x52, 0x61, 0xcb, 0xb4, 0xcf, 0xae, 0xec, 0x87, 0x2c, 0xb8, 0x60,
-	0x60, 0xad, 0x38, 0x89, 0xbb, 0x75, 0x79, 0xb7, 0x1d, 0x91, 0x38, 0xa6, 0x53, 0xea, 0x19, 0x43,
-	0x1c, 0xcf, 0x8f, 0x0a, 0x0a, 0xe5, 0x1b, 0xa4, 0xcb, 0x65, 0xf3, 0x0b, 0x50, 0xe5, 0x6b, 0x2e,
-	0x8c, 0xc4, 0x47, 0x52, 0xef, 0xb6, 0x2a, 0xda, 0xb1, 0xc2, 0x40, 0x36, 0x0c, 0x33, 0x99, 0x4b,
-	0x65, 0x10, 0x35, 0x13, 0x80, 0x7e, 0xf7, 0xdb, 0xac, 0x05, 0x0b, 0x88, 0x1d, 0xa7, 0xba, 0xb3,
-	0x16, 0x11, 0x36, 0x1f, 0x1a, 0x57, 0x3c, 0xe2, 0x37, 0x62, 0xba, 0x61, 0x74, 0x82, 0x20, 0x4c,
-	0xc4, 0xde, 0xcf, 0xd8, 0x30, 0xce, 0xe9, 0x66, 0x6c, 0xe2, 0x50, 0xa6, 0xbe, 0xb3, 0x41, 0x7c,
-	0x3e, 0xa2, 0x82, 0xe9, 0x32, 0x6b, 0xc1, 0x02, 0x62, 0xdf, 0x2f, 0xb1, 0xad, 0xa9, 0x92, 0x68,
-	0xe4, 0x24, 0xfc, 0x1a, 0x51, 0x4a, 0x05, 0xac, 0x15, 0x27, 0x8f, 0x49, 0x7f, 0xdf, 0xc6, 0x6b,
-	0x19, 0x2d, 0x80, 0x0b, 0xe5, 0xba, 0xbf, 0x7f, 0xe3, 0x4b, 0x65, 0x98, 0x4e, 0x3f, 0xd0, 0xa3,
-	0x44, 0xe8, 0x66, 0xda, 0x60, 0x94, 0xf5, 0x02, 0x1a, 0xf8, 0xd8, 0xc4, 0xeb, 0x23, 0x87, 0x4b,
-	0xc7, 0x29, 0x87, 0x4d, 0x35, 0x51, 0x3e, 0x40, 0x4d, 0x2c, 0xa8, 0x51, 0x1f, 0x62, 0x98, 0x6f,
-	0xef, 0x71, 0x1d, 0x9e, 0x5f, 0x8b, 0xc2, 0x26, 0x5b, 0x73, 0x3b, 0x84, 0x6e, 0xa6, 0x72, 0xdc,
-	0x82, 0x17, 0x61, 0x28, 0x4e, 0x48, 0x7b, 0xaa, 0x92, 0x96, 0xc1, 0xf5, 0x84, 0xb4, 0x31, 0x83,
-	0xa0, 0x6f, 0x83, 0x53, 0x89, 0x13, 0x35, 0x49, 0x12, 0x91, 0x1d, 0x8f, 0xb9, 0x93, 0xd9, 0xce,
-	0xb8, 0x36, 0x7f, 0x86, 0x9a, 0x64, 0xeb, 0x0c, 0x84, 0x25, 0x08, 0x67, 0x71, 0xed, 0xff, 0x56,
-	0x82, 0x27, 0xd3, 0xdf, 0x47, 0x6b, 0xcd, 0x6f, 0x4f, 0x69, 0xcd, 0xb7, 0x9b, 0x5a, 0xf3, 0xc1,
-	0xde, 0xf4, 0x53, 0x7d, 0x1e, 0xfb, 0x9a, 0x51, 0xaa, 0xe8, 0x6a, 0xe6, 0x0b, 0xcd, 0xf6, 0x7c,
-	0xa1, 0x67, 0xfa, 0xbc, 0x63, 0xc6, 0xda, 0x79, 0x1e, 0x86, 0x23, 0xe2, 0xc4, 0x61, 0x20, 0xbe,
-	0x93, 0x5a, 0x0c, 0x98, 0xb5, 0x62, 0x01, 0xb5, 0x7f, 0xaf, 0x96, 0x1d, 0xec, 0xab, 0xdc, 0x45,
-	0x1e, 0x46, 0xc8, 0x83, 0x21, 0xb6, 0xff, 0xe3, 0x62, 0xe7, 0xc6, 0xd1, 0x96, 0x28, 0x55, 0x31,
-	0x8a, 0xf4, 0x7c, 0x95, 0x7e, 0x35, 0xda, 0x84, 0x19, 0x0b, 0xb4, 0x0b, 0x55, 0x57, 0xee, 0xb4,
-	0x4a, 0x45, 0x78, 0x3b, 0xc5, 0x3e, 0x4b, 0x73, 0x1c, 0xa3, 0xba, 0x40, 0x6d, 0xcf, 0x14, 0x37,
-	0x44, 0xa0, 0xdc, 0xf4, 0x12, 0xf1, 0x59, 0x8f, 0xb8, 0xf1, 0xbe, 0xea, 0x19, 0xaf, 0x38, 0x42,
-	0x15, 0xd4, 0x55, 0x2f, 0xc1, 0x94, 0x3e, 0xfa, 0x8c, 0x05, 0xa3, 0xb1, 0xdb, 0x5a, 0x8b, 0xc2,
-	0x1d, 0xaf, 0x41, 0x22, 0x61, 0x80, 0x1e, 0x51, 0xec, 0xd5, 0x17, 0x56, 0x24, 0x41, 0xcd, 0x97,
-	0x3b, 0x42, 0x34, 0x04, 0x9b, 0x7c, 0xe9, 0xc6, 0xec, 0x49, 0xf1, 0xee, 0x8b, 0xc4, 0x65, 0x2b,
-	0x4e, 0x6e, 0xa8, 0xd9, 0x4c, 0x39, 0xb2, 0x41, 0xbe, 0xd8, 0x71, 0xb7, 0xe9, 0x7a, 0xd3, 0x1d,
-	0x7a, 0xea, 0xfe, 0xde, 0xf4, 0x93, 0x0b, 0xf9, 0x3c, 0x71, 0xbf, 0xce, 0xb0, 0x01, 0x6b, 0x77,
-	0x7c, 0x1f, 0x93, 0x57, 0x3b, 0x84, 0xf9, 0xd6, 0x0a, 0x18, 0xb0, 0x35, 0x4d, 0x30, 0x33, 0x60,
-	0x06, 0x04, 0x9b, 0x7c, 0xd1, 0xab, 0x30, 0xdc, 0x72, 0x92, 0xc8, 0xdb, 0x15, 0x0e, 0xb5, 0x23,
-	0x6e, 0x91, 0x56, 0x18, 0x2d, 0xcd, 0x9c, 0x59, 0x01, 0xbc, 0x11, 0x0b, 0x46, 0xa8, 0x05, 0x95,
-	0x16, 0x89, 0x9a, 0x64, 0xaa, 0x5a, 0xc4, 0x49, 0xc3, 0x0a, 0x25, 0xa5, 0x19, 0xd6, 0xa8, 0xe5,
-	0xc5, 0xda, 0x30, 0xe7, 0x82, 0x5e, 0x81, 0x6a, 0x4c, 0x7c, 0xe2, 0x52, 0xdb, 0xa9, 0xc6, 0x38,
-	0xbe, 0x6b, 0x40, 0x3b, 0x92, 0x1a, 0x2d, 0x75, 0xf1, 0x28, 0x5f, 0x60, 0xf2, 0x1f, 0x56, 0x24,
-	0xe9, 0x00, 0xb6, 0xfd, 0x4e, 0xd3, 0x0b, 0xa6, 0xa0, 0x88, 0x01, 0x5c, 0x63, 0xb4, 0x32, 0x03,
-	0xc8, 0x1b, 0xb1, 0x60, 0x64, 0xff, 0x57, 0x0b, 0x50, 0x5a, 0xa8, 0x9d, 0x80, 0xc1, 0xfc, 0x6a,
-	0xda, 0x60, 0x5e, 0x2e, 0xd2, 0xa2, 0xe9, 0x63, 0x33, 0xff, 0x62, 0x0d, 0x32, 0xea, 0xe0, 0x26,
-	0x89, 0x13, 0xd2, 0x78, 0x53, 0x84, 0xbf, 0x29, 0xc2, 0xdf, 0x14, 0xe1, 0x4a, 0x84, 0x6f, 0x64,
-	0x44, 0xf8, 0x7b, 0x8d, 0x55, 0xaf, 0x43, 0x1e, 0x3e, 0xa2, 0x62, 0x22, 0xcc, 0x1e, 0x18, 0x08,
-	0x54, 0x12, 0x5c, 0xaf, 0xaf, 0xde, 0xcc, 0x95, 0xd9, 0x1f, 0x49, 0xcb, 0xec, 0xa3, 0xb2, 0xf8,
-	0x9b, 0x20, 0xa5, 0x7f, 0xc3, 0x82, 0xb7, 0xa6, 0xa5, 0x97, 0x9c, 0x39, 0x4b, 0xcd, 0x20, 0x8c,
-	0xc8, 0xa2, 0xb7, 0xb9, 0x49, 0x22, 0x12, 0xb8, 0x24, 0x56, 0x8e, 0x1f, 0xab, 0x9f, 0xe3, 0x07,
-	0xbd, 0x1b, 0xc6, 0xee, 0xc6, 0x61, 0xb0, 0x16, 0x7a, 0x81, 0x10, 0x41, 0x74, 0xc7, 0x71, 0xfa,
-	0xfe, 0xde, 0xf4, 0x18, 0x1d, 0x51, 0xd9, 0x8e, 0x53, 0x58, 0x68, 0x01, 0x26, 0xef, 0xbe, 0xba,
-	0xe6, 0x24, 0x86, 0xab, 0x41, 0x3a, 0x05, 0xd8, 0xc9, 0xd6, 0xf5, 0xf7, 0x65, 0x80, 0xb8, 0x17,
-	0xdf, 0xfe, 0xbb, 0x25, 0x38, 0x9f, 0x79, 0x91, 0xd0, 0xf7, 0xc3, 0x4e, 0x42, 0xf7, 0x44, 0xe8,
-	0x27, 0x2c, 0x38, 0xdd, 0x4a, 0x7b, 0x33, 0x62, 0xe1, 0x0b, 0xff, 0xce, 0xc2, 0x74, 0x44, 0xc6,
-	0x5d, 0x32, 0x3f, 0x25, 0x46, 0xe8, 0x74, 0x06, 0x10, 0xe3, 0x9e, 0xbe, 0xa0, 0x57, 0xa0, 0xd6,
-	0x72, 0x76, 0x6f, 0xb5, 0x1b, 0x4e, 0x22, 0xf7, 0xaa, 0xfd, 0x5d, 0x0c, 0x9d, 0xc4, 0xf3, 0x67,
-	0x78, 0x30, 0xcd, 0xcc, 0x52, 0x90, 0xac, 0x46, 0xf5, 0x24, 0xf2, 0x82, 0x26, 0xf7, 0x80, 0xae,
-	0x48, 0x32, 0x58, 0x53, 0xb4, 0xbf, 0x64, 0x65, 0x95, 0x94, 0x1a, 0x9d, 0xc8, 0x49, 0x48, 0xb3,
-	0x8b, 0x3e, 0x06, 0x15, 0xba, 0x6f, 0x94, 0xa3, 0x72, 0xa7, 0x48, 0xcd, 0x69, 0x7c, 0x09, 0xad,
-	0x44, 0xe9, 0xbf, 0x18, 0x73, 0xa6, 0xf6, 0x4f, 0xd4, 0xb2, 0xc6, 0x02, 0x0b, 0x09, 0xb8, 0x04,
-	0xd0, 0x0c, 0xd7, 0x49, 0xab, 0xed, 0xd3, 0x61, 0xb1, 0xd8, 0xe9, 0x8f, 0xf2, 0xa3, 0x5c, 0x55,
-	0x10, 0x6c, 0x60, 0xa1, 0x1f, 0xb0, 0x00, 0x9a, 0x72, 0xce, 0x4b, 0x43, 0xe0, 0x56, 0x91, 0xaf,
-	0xa3, 0x57, 0x94, 0xee, 0x8b, 0x62, 0x88, 0x0d, 0xe6, 0xe8, 0x7b, 0x2d, 0xa8, 0x26, 0xb2, 0xfb,
-	0x5c, 0x35, 0xae, 0x17, 0xd9, 0x13, 0xf9, 0xd2, 0xda, 0x26, 0x52, 0x43, 0xa2, 0xf8, 0xa2, 0xef,
-	0xb3, 0x00, 0xe2, 0x6e, 0xe0, 0xae, 0x85, 0xbe, 0xe7, 0x76, 0x85, 0xc6, 0xbc, 0x5d, 0xa8, 0xaf,
-	0x47, 0x51, 0x9f, 0x9f, 0xa0, 0xa3, 0xa1, 0xff, 0x63, 0x83, 0x33, 0xfa, 0x38, 0x54, 0x63, 0x31,
-	0xdd, 0x84, 0x8e, 0x5c, 0x2f, 0xd6, 0xe3, 0xc4, 0x69, 0x0b, 0xf1, 0x2a, 0xfe, 0x61, 0xc5, 0x13,
-	0xfd, 0x98, 0x05, 0xa7, 0xda, 0x69, 0x1f, 0xa2, 0x50, 0x87, 0xc5, 0xc9, 0x80, 0x8c, 0x8f, 0x92,
-	0x7b, 0x5b, 0x32, 0x8d, 0x38, 0xdb, 0x0b, 0x2a, 0x01, 0xf5, 0x0c, 0x5e, 0x6d, 0x73, 0x7f, 0xe6,
-	0x88, 0x96, 0x80, 0x57, 0xb3, 0x40, 0xdc, 0x8b, 0x8f, 0xd6, 0xe0, 0x2c, 0xed, 0x5d, 0x97, 0x9b,
-	0x9f, 0x52, 0xbd, 0xc4, 0x4c, 0x19, 0x56, 0xe7, 0x9f, 0x16, 0x33, 0x84, 0x1d, 0x84, 0x64, 0x71,
-	0x70, 0xee, 0x93, 0xe8, 0x77, 0x2c, 0x78, 0xda, 0x63, 0x6a, 0xc0, 0xf4, 0xe6, 0x6b, 0x8d, 0x20,
-	0x8e, 0xec, 0x49, 0xa1, 0xb2, 0xa2, 0x9f, 0xfa, 0x99, 0xff, 0x7a, 0xf1, 0x06, 0x4f, 0x2f, 0xed,
-	0xd3, 0x25, 0xbc, 0x6f, 0x87, 0xd1, 0x37, 0xc3, 0xb8, 0x5c, 0x17, 0x6b, 0x54, 0x04, 0x33, 0x45,
-	0x5b, 0x9b, 0x9f, 0xbc, 0xbf, 0x37, 0x3d, 0xbe, 0x6e, 0x02, 0x70, 0x1a, 0xcf, 0xfe, 0xc1, 0xa1,
-	0xd4, 0x11, 0x92, 0x72, 0x70, 0x32, 0x71, 0xe3, 0x4a, 0xff, 0x8f, 0x94, 0x9e, 0x85, 0x8a, 0x1b,
-	0xe5, 0x5d, 0xd2, 0xe2, 0x46, 0x35, 0xc5, 0xd8, 0x60, 0x4e, 0x8d, 0xd2, 0x49, 0x27, 0xeb, 0x46,
-	0x15, 0x12, 0xf0, 0x95, 0x22, 0xbb, 0xd4, 0x7b, 0xe0, 0x77, 0x5e, 0x74, 0x6d, 0xb2, 0x07, 0x84,
-	0x7b, 0xbb, 0x84, 0xbe, 0x1b, 0x6a, 0x91, 0x8a, 0x91, 0x29, 0x17, 0xb1, 0x55, 0x93, 0xd3, 0x46,
-	0x74, 0x47, 0x9d, 0x0e, 0xe9, 0x68, 0x18, 0xcd, 0x11, 0xbd, 0x17, 0x26, 0xd4, 0x9f, 0x05, 0x76,
-	0x2c, 0x44, 0x85, 0x62, 0x79, 0xfe, 0x09, 0xf1, 0xd4, 0x04, 0x4e, 0x41, 0x71, 0x06, 0xdb, 0xfe,
-	0x6c, 0x29, 0x75, 0xea, 0x66, 0xc8, 0x9e, 0x01, 0x4e, 0x14, 0xbf, 0x60, 0xc1, 0x68, 0x14, 0xfa,
-	0xbe, 0x17, 0x34, 0xa9, 0x9c, 0x14, 0xca, 0xfe, 0x83, 0xc7, 0xa2, 0x6f, 0x85, 0x40, 0x64, 0x96,
-	0x39, 0xd6, 0x3c, 0xb1, 0xd9, 0x01, 0xf4, 0x32, 0x8c, 0x37, 0x88, 0x4f, 0xe8, 0xb3, 0xab, 0x11,
-	0xdd, 0x53, 0x71, 0x0f, 0xb6, 0x8a, 0x59, 0x59, 0x34, 0x81, 0x38, 0x8d, 0x6b, 0xff, 0xa9, 0x05,
-	0x53, 0xfd, 0x94, 0x01, 0x22, 0xf0, 0x94, 0x94, 0x74, 0x6a, 0x44, 0x57, 0x03, 0x49, 0x4f, 0xe8,
-	0xf3, 0xe7, 0x04, 0x9f, 0xa7, 0xd6, 0xfa, 0xa3, 0xe2, 0xfd, 0xe8, 0xa0, 0x0f, 0xc0, 0x69, 0x63,
-	0x50, 0x62, 0x35, 0xaa, 0xb5, 0xf9, 0x19, 0x6a, 0x7d, 0xcd, 0x65, 0x60, 0x0f, 0xf6, 0xa6, 0x9f,
-	0xc8, 0xb6, 0x09, 0x6d, 0xd5, 0x43, 0xc7, 0xfe, 0xe9, 0x9e, 0x4f, 0xad, 0x0c, 0x8d, 0x37, 0xac,
-	0x1e, 0x57, 0xc6, 0x77, 0x1e, 0x87, 0x72, 0x67, 0x4e, 0x0f, 0x15, 0x20, 0xd2, 0x1f, 0xe7, 0x11,
-	0x06, 0x14, 0xd8, 0xbf, 0x3d, 0x04, 0xfb, 0xf4, 0x6c, 0x80, 0x9d, 0xc3, 0xa1, 0x4f, 0x78, 0x3f,
-	0x6f, 0xa9, 0xa3, 0x3c, 0x2e, 0x40, 0x1a, 0xc7, 0x35, 0xf6, 0x7c, 0xf3, 0x16, 0xf3, 0xa0, 0x16,
-	0xe5, 0xc2, 0x4f, 0x1f, 0x1a, 0xa2, 0x2f, 0x5b, 0xe9, 0xc3, 0x48, 0x1e, 0xc8, 0xe9, 0x1d, 0x5b,
-	0x9f, 0x8c, 0x13, 0x4e, 0xde, 0x31, 0x7d, 0x2e, 0xd6, 0xef, 0xec, 0x73, 0x06, 0x60, 0xd3, 0x0b,
-	0x1c, 0xdf, 0x7b, 0x8d, 0x6e, 0xcd, 0x2a, 0xcc, 0xba, 0x60, 0xe6, 0xda, 0x15, 0xd5, 0x8a, 0x0d,
-	0x8c, 0x0b, 0xff, 0x3f, 0x8c, 0x1a, 0x6f, 0x9e, 0x13, 0x8b, 0x73, 0xd6, 0x8c, 0xc5, 0xa9, 0x19,
-	0x21, 0x34, 0x17, 0xde, 0x0b, 0xa7, 0xb3, 0x1d, 0x3c, 0xcc, 0xf3, 0xf6, 0xff, 0x1e, 0xc9, 0x9e,
-	0x0e, 0xae, 0x93, 0xa8, 0x45, 0xbb, 0xf6, 0xa6, 0x57, 0xed, 0x4d, 0xaf, 0xda, 0x9b, 0x5e, 0x35,
-	0xf3, 0x60, 0x44, 0x78, 0x8c, 0x46, 0x4e, 0xc8, 0x63, 0x94, 0xf2, 0x81, 0x55, 0x0b, 0xf7, 0x81,
-	0xd9, 0x9f, 0xe9, 0x39, 0x36, 0x58, 0x8f, 0x08, 0x41, 0x21, 0x54, 0x82, 0xb0, 0x41, 0xa4, 0x81,
-	0x7d, 0xbd, 0x18, 0x6b, 0xf1, 0x66, 0xd8, 0x30, 0x42, 0xe4, 0xe9, 0xbf, 0x18, 0x73, 0x3e, 0xf6,
-	0xa7, 0x87, 0x21, 0x65, 0xcb, 0xf2, 0xef, 0xfe, 0x8d, 0x30, 0x12, 0x91, 0x76, 0x78, 0x0b, 0x2f,
-	0x0b, 0x5d, 0xa6, 0x33, 0x8c, 0x78, 0x33, 0x96, 0x70, 0xaa, 0xf3, 0xda, 0x4e, 0xb2, 0x25, 0x94,
-	0x99, 0xd2, 0x79, 0x6b, 0x4e, 0xb2, 0x85, 0x19, 0x84, 0x9a, 0xa1, 0x49, 0xea, 0x1c, 0x5e, 0x9c,
-	0x37, 0x2b, 0x33, 0x34, 0x7d, 0x4a, 0x8f, 0x33, 0xd8, 0xe8, 0x55, 0x18, 0xda, 0x22, 0x7e, 0x4b,
-	0x7c, 0xfa, 0x7a, 0x71, 0xba, 0x86, 0xbd, 0xeb, 0x35, 0xe2, 0xb7, 0xb8, 0x24, 0xa4, 0xbf, 0x30,
-	0x63, 0x45, 0xe7, 0x7d, 0x6d, 0xbb, 0x13, 0x27, 0x61, 0xcb, 0x7b, 0x4d, 0xba, 0x59, 0xbf, 0xb3,
-	0x60, 0xc6, 0x37, 0x24, 0x7d, 0xee, 0xcf, 0x52, 0x7f, 0xb1, 0xe6, 0xcc, 0xfa, 0xd1, 0xf0, 0x22,
-	0x36, 0x65, 0xba, 0xc2, 0x5b, 0x5a, 0x74, 0x3f, 0x16, 0x25, 0x7d, 0xde, 0x0f, 0xf5, 0x17, 0x6b,
-	0xce, 0xa8, 0xab, 0xd6, 0xdf, 0x28, 0xeb, 0xc3, 0xad, 0x82, 0xfb, 0xc0, 0xd7, 0x5e, 0xee, 0x3a,
-	0x7c, 0x0e, 0x2a, 0xee, 0x96, 0x13, 0x25, 0x53, 0x63, 0x6c, 0xd2, 0xa8, 0x59, 0xbc, 0x40, 0x1b,
-	0x31, 0x87, 0xa1, 0x67, 0xa0, 0x1c, 0x91, 0x4d, 0x16, 0x37, 0x6d, 0x44, 0x6c, 0x61, 0xb2, 0x89,
-	0x69, 0xbb, 0xb2, 0xcb, 0x26, 0xfa, 0x86, 0xf2, 0xfd, 0x64, 0x29, 0x6d, 0xd8, 0xa5, 0x47, 0x86,
-	0xaf, 0x07, 0xb7, 0x13, 0xc5, 0xd2, 0x3b, 0x67, 0xac, 0x07, 0xd6, 0x8c, 0x25, 0x1c, 0x7d, 0xd2,
-	0x82, 0x91, 0xbb, 0x71, 0x18, 0x04, 0x24, 0x11, 0x4a, 0xf4, 0x76, 0xc1, 0x83, 0x75, 0x9d, 0x53,
-	0xd7, 0x7d, 0x10, 0x0d, 0x58, 0xf2, 0xa5, 0xdd, 0x25, 0xbb, 0xae, 0xdf, 0x69, 0xf4, 0x84, 0xe9,
-	0x5c, 0xe6, 0xcd, 0x58, 0xc2, 0x29, 0xaa, 0x17, 0x70, 0xd4, 0xa1, 0x34, 0xea, 0x52, 0x20, 0x50,
-	0x05, 0xdc, 0xfe, 0xf9, 0x2a, 0x9c, 0xcb, 0x5d, 0x3e, 0xd4, 0xe4, 0x62, 0x46, 0xcd, 0x15, 0xcf,
-	0x27, 0x32, 0x40, 0x8d, 0x99, 0x5c, 0xb7, 0x55, 0x2b, 0x36, 0x30, 0xd0, 0xf7, 0x00, 0xb4, 0x9d,
-	0xc8, 0x69, 0x11, 0xe5, 0x3d, 0x3f, 0xb2, 0x65, 0x43, 0xfb, 0xb1, 0x26, 0x69, 0x6a, 0x0f, 0x82,
-	0x6a, 0x8a, 0xb1, 0xc1, 0x12, 0xbd, 0x08, 0xa3, 0x11, 0xf1, 0x89, 0x13, 0xb3, 0xc0, 0xfc, 0x6c,
-	0xfe, 0x12, 0xd6, 0x20, 0x6c, 0xe2, 0xa1, 0xe7, 0x55, 0x2c, 0xdf, 0x50, 0x3a, 0xd0, 0x25, 0x1d,
-	0xcf, 0x87, 0xbe, 0x68, 0xc1, 0xc4, 0xa6, 0xe7, 0x13, 0xcd, 0x5d, 0x64, 0x1b, 0xad, 0x1e, 0xfd,
-	0x25, 0xaf, 0x98, 0x74, 0xb5, 0x0c, 0x4d, 0x35, 0xc7, 0x38, 0xc3, 0x9e, 0x7e, 0xe6, 0x1d, 0x12,
-	0x31, 0xe1, 0x3b, 0x9c, 0xfe, 0xcc, 0xb7, 0x79, 0x33, 0x96, 0x70, 0x34, 0x07, 0xa7, 0xda, 0x4e,
-	0x1c, 0x2f, 0x44, 0xa4, 0x41, 0x82, 0xc4, 0x73, 0x7c, 0x9e, 0xde, 0x53, 0xd5, 0x81, 0xee, 0x6b,
-	0x69, 0x30, 0xce, 0xe2, 0xa3, 0xf7, 0xc3, 0x93, 0xdc, 0x3d, 0xb5, 0xe2, 0xc5, 0xb1, 0x17, 0x34,
-	0xf5, 0x34, 0x10, 0x5e, 0xba, 0x69, 0x41, 0xea, 0xc9, 0xa5, 0x7c, 0x34, 0xdc, 0xef, 0x79, 0xf4,
-	0x02, 0x54, 0xe3, 0x6d, 0xaf, 0xbd, 0x10, 0x35, 0x62, 0x76, 0x34, 0x55, 0xd5, 0x3e, 0xe1, 0xba,
-	0x68, 0xc7, 0x0a, 0x03, 0xb9, 0x30, 0xc6, 0x3f, 0x09, 0x0f, 0x46, 0x14, 0x12, 0xf4, 0x1d, 0x7d,
-	0x15, 0xb9, 0x48, 0xfb, 0x9d, 0xc1, 0xce, 0xbd, 0xcb, 0xf2, 0xa0, 0x8c, 0x9f, 0xeb, 0xdc, 0x36,
-	0xc8, 0xe0, 0x14, 0xd1, 0xf4, 0x9e, 0x6e, 0x74, 0x80, 0x3d, 0xdd, 0x8b, 0x30, 0xba, 0xdd, 0xd9,
-	0x20, 0x62, 0xe4, 0x85, 0x60, 0x53, 0xb3, 0xef, 0x86, 0x06, 0x61, 0x13, 0x8f, 0xc5, 0x81, 0xb6,
-	0x3d, 0xf1, 0x2f, 0x9e, 0x1a, 0x37, 0xe2, 0x40, 0xd7, 0x96, 0x64, 0x33, 0x36, 0x71, 0x68, 0xd7,
-	0xe8, 0x58, 0xac, 0x93, 0x98, 0xa5, 0x79, 0xd0, 0xe1, 0x52, 0x5d, 0xab, 0x4b, 0x00, 0xd6, 0x38,
-	0x68, 0x0d, 0xce, 0xd2, 0x3f, 0x75, 0x96, 0xf6, 0x7c, 0xdb, 0xf1, 0xbd, 0x06, 0x0f, 0x4a, 0x3c,
-	0x95, 0x76, 0xae, 0xd6, 0x73, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x8f, 0x97, 0xd2, 0x9e, 0x13, 0x53,
-	0x84, 0xa1, 0x98, 0x0a, 0xaa, 0xe4, 0xb6, 0x13, 0x49, 0x83, 0xe7, 0x88, 0x39, 0x5a, 0x82, 0xee,
-	0x6d, 0x27, 0x32, 0x45, 0x1e, 0x63, 0x80, 0x25, 0x27, 0x74, 0x17, 0x86, 0x12, 0xdf, 0x29, 0x28,
-	0x03, 0xd4, 0xe0, 0xa8, 0xbd, 0x60, 0xcb, 0x73, 0x31, 0x66, 0x3c, 0xd0, 0xd3, 0x74, 0xf7, 0xb6,
-	0x21, 0x8f, 0xf9, 0xc4, 0x86, 0x6b, 0x23, 0xc6, 0xac, 0xd5, 0xfe, 0xdb, 0xe3, 0x39, 0x5a, 0x47,
-	0x19, 0x02, 0xe8, 0x12, 0x00, 0x9d, 0x34, 0x6b, 0x11, 0xd9, 0xf4, 0x76, 0x85, 0x21, 0xa6, 0x24,
-	0xdb, 0x4d, 0x05, 0xc1, 0x06, 0x96, 0x7c, 0xa6, 0xde, 0xd9, 0xa4, 0xcf, 0x94, 0x7a, 0x9f, 0xe1,
-	0x10, 0x6c, 0x60, 0xa1, 0x77, 0xc3, 0xb0, 0xd7, 0x72, 0x9a, 0x2a, 0x44, 0xf9, 0x69, 0x2a, 0xd2,
-	0x96, 0x58, 0xcb, 0x83, 0xbd, 0xe9, 0x09, 0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x69, 0x0b,
-	0xc6, 0xdc, 0xb0, 0xd5, 0x0a, 0x03, 0xbe, 0x7d, 0x16, 0xbe, 0x80, 0xbb, 0xc7, 0x65, 0x26, 0xcd,
-	0x2c, 0x18, 0xcc, 0xb8, 0x33, 0x40, 0xa5, 0xaa, 0x9a, 0x20, 0x9c, 0xea, 0x95, 0x29, 0xf9, 0x2a,
-	0x07, 0x48, 0xbe, 0x5f, 0xb0, 0x60, 0x92, 0x3f, 0x6b, 0xec, 0xea, 0x45, 0xa2, 0x65, 0x78, 0xcc,
-	0xaf, 0xd5, 0xe3, 0xe8, 0x50, 0x9e, 0xe6, 0x1e, 0x38, 0xee, 0xed, 0x24, 0xba, 0x0a, 0x93, 0x9b,
-	0x61, 0xe4, 0x12, 0x73, 0x20, 0x84, 0xd8, 0x56, 0x84, 0xae, 0x64, 0x11, 0x70, 0xef, 0x33, 0xe8,
-	0x36, 0x3c, 0x61, 0x34, 0x9a, 0xe3, 0xc0, 0x25, 0xf7, 0xb3, 0x82, 0xda, 0x13, 0x57, 0x72, 0xb1,
-	0x70, 0x9f, 0xa7, 0xd3, 0x42, 0xb2, 0x36, 0x80, 0x90, 0xfc, 0x08, 0x9c, 0x77, 0x7b, 0x47, 0x66,
-	0x27, 0xee, 0x6c, 0xc4, 0x5c, 0x8e, 0x57, 0xe7, 0xbf, 0x4e, 0x10, 0x38, 0xbf, 0xd0, 0x0f, 0x11,
-	0xf7, 0xa7, 0x81, 0x3e, 0x06, 0xd5, 0x88, 0xb0, 0xaf, 0x12, 0x8b, 0xac, 0xc3, 0x23, 0x7a, 0x3b,
-	0xb4, 0x05, 0xcf, 0xc9, 0x6a, 0xcd, 0x24, 0x1a, 0x62, 0xac, 0x38, 0xa2, 0x7b, 0x30, 0xd2, 0x76,
-	0x12, 0x77, 0x4b, 0xa4, 0x0f, 0x1e, 0xf9, 0x60, 0x40, 0x31, 0x67, 0xe7, 0x38, 0x46, 0x99, 0x07,
-	0xce, 0x04, 0x4b, 0x6e, 0xd4, 0x56, 0x73, 0xc3, 0x56, 0x3b, 0x0c, 0x48, 0x90, 0x48, 0x25, 0x32,
-	0xc1, 0x0f, 0x5b, 0x64, 0x2b, 0x36, 0x30, 0x7a, 0x74, 0xb9, 0x46, 0x9b, 0x9a, 0xdc, 0x47, 0x97,
-	0x1b, 0xd4, 0xfa, 0x3d, 0x4f, 0x95, 0x0d, 0x73, 0x2b, 0xde, 0xf1, 0x92, 0xad, 0xb0, 0x93, 0xc8,
-	0x5d, 0xb2, 0x50, 0x54, 0x4a, 0xd9, 0x2c, 0xe7, 0xe0, 0xe0, 0xdc, 0x27, 0xb3, 0x9a, 0xf5, 0xd4,
-	0xc3, 0x69, 0xd6, 0xd3, 0x03, 0x68, 0xd6, 0x3a, 0x9c, 0x63, 0x3d, 0x10, 0x56, 0xb2, 0x74, 0x5a,
-	0xc6, 0x53, 0x88, 0x75, 0x5e, 0x65, 0xde, 0x2c, 0xe7, 0x21, 0xe1, 0xfc, 0x67, 0x2f, 0x7c, 0x3b,
-	0x4c, 0xf6, 0x08, 0xb9, 0x43, 0x39, 0x24, 0x17, 0xe1, 0x89, 0x7c, 0x71, 0x72, 0x28, 0xb7, 0xe4,
-	0xcf, 0x67, 0x82, 0xe2, 0x8d, 0x2d, 0xda, 0x00, 0x2e, 0x6e, 0x07, 0xca, 0x24, 0xd8, 0x11, 0xda,
-	0xf5, 0xca, 0xd1, 0x66, 0xf5, 0xe5, 0x60, 0x87, 0x4b, 0x43, 0xe6, 0xc7, 0xbb, 0x1c, 0xec, 0x60,
-	0x4a, 0x1b, 0xfd, 0xb0, 0x95, 0xda, 0x40, 0x70, 0xc7, 0xf8, 0x87, 0x8f, 0x65, 0x4f, 0x3a, 0xf0,
-	0x9e, 0xc2, 0xfe, 0x37, 0x25, 0xb8, 0x78, 0x10, 0x91, 0x01, 0x86, 0xef, 0x39, 0x18, 0x8e, 0x59,
-	0x98, 0x8b, 0x50, 0x57, 0xa3, 0x74, 0x15, 0xf3, 0xc0, 0x97, 0x8f, 0x60, 0x01, 0x42, 0x3e, 0x94,
-	0x5b, 0x4e, 0x5b, 0xf8, 0x4b, 0x97, 0x8e, 0x9a, 0x59, 0x48, 0xff, 0x3b, 0xfe, 0x8a, 0xd3, 0xe6,
-	0x73, 0xde, 0x68, 0xc0, 0x94, 0x0d, 0x4a, 0xa0, 0xe2, 0x44, 0x91, 0x23, 0x63, 0x2a, 0x6e, 0x14,
-	0xc3, 0x6f, 0x8e, 0x92, 0xe4, 0x47, 0xd2, 0xa9, 0x26, 0xcc, 0x99, 0xd9, 0x3f, 0x56, 0x4d, 0xa5,
-	0xa1, 0xb1, 0x40, 0x99, 0x18, 0x86, 0x85, 0x9b, 0xd4, 0x2a, 0x3a, 0xa1, 0x93, 0xe7, 0x79, 0x33,
-	0x0f, 0x84, 0xa8, 0xc3, 0x21, 0x58, 0xa1, 0xcf, 0x59, 0xac, 0xda, 0x85, 0xcc, 0xed, 0x13, 0xbb,
-	0xfa, 0xe3, 0x29, 0xbe, 0x61, 0xd6, 0xd0, 0x90, 0x8d, 0xd8, 0xe4, 0x2e, 0x2a, 0xfa, 0xb0, 0xdd,
-	0x4c, 0x6f, 0x45, 0x1f, 0xb6, 0x3b, 0x91, 0x70, 0xb4, 0x9b, 0x13, 0x10, 0x53, 0x40, 0x11, 0x84,
-	0x01, 0x42, 0x60, 0xbe, 0x6c, 0xc1, 0xa4, 0x97, 0x8d, 0x6c, 0x10, 0x7b, 0xe0, 0x3b, 0xc5, 0xf8,
-	0x34, 0x7b, 0x03, 0x27, 0x94, 0xa1, 0xd3, 0x03, 0xc2, 0xbd, 0x9d, 0x41, 0x0d, 0x18, 0xf2, 0x82,
-	0xcd, 0x50, 0x98, 0x77, 0xf3, 0x47, 0xeb, 0xd4, 0x52, 0xb0, 0x19, 0xea, 0xd5, 0x4c, 0xff, 0x61,
-	0x46, 0x1d, 0x2d, 0xc3, 0x59, 0x99, 0x6c, 0x74, 0xcd, 0x8b, 0x93, 0x30, 0xea, 0x2e, 0x7b, 0x2d,
-	0x2f, 0x61, 0xa6, 0x59, 0x79, 0x7e, 0x8a, 0xaa, 0x37, 0x9c, 0x03, 0xc7, 0xb9, 0x4f, 0xa1, 0xd7,
-	0x60, 0x44, 0x46, 0x13, 0x54, 0x8b, 0xf0, 0x27, 0xf4, 0xce, 0x7f, 0x35, 0x99, 0xea, 0x22, 0x9c,
-	0x40, 0x32, 0x44, 0x9f, 0xb5, 0x60, 0x82, 0xff, 0xbe, 0xd6, 0x6d, 0xf0, 0xe4, 0xc7, 0x5a, 0x11,
-	0x29, 0x03, 0xf5, 0x14, 0xcd, 0x79, 0x74, 0x7f, 0x6f, 0x7a, 0x22, 0xdd, 0x86, 0x33, 0x7c, 0xed,
-	0x7f, 0x3c, 0x06, 0xbd, 0xf1, 0x17, 0xe9, 0x60, 0x0b, 0xeb, 0xc4, 0x83, 0x2d, 0xee, 0xc2, 0x50,
-	0xac, 0xe3, 0x1c, 0x0a, 0x58, 0x66, 0x82, 0xab, 0x3e, 0x86, 0xee, 0x06, 0x2e, 0x66, 0x3c, 0x50,
-	0x07, 0x86, 0x79, 0x41, 0x2d, 0xa1, 0x01, 0x8e, 0x7e, 0xf2, 0x6d, 0x16, 0xe6, 0xd2, 0x6e, 0x2d,
-	0xde, 0x8a, 0x05, 0x33, 0xb4, 0x0b, 0x23, 0x5b, 0x7c, 0x3a, 0x8a, 0xbd, 0xde, 0xca, 0x51, 0xc7,
-	0x37, 0x35, 0xc7, 0xf5, 0xe4, 0x13, 0x0d, 0x58, 0xb2, 0x63, 0xb1, 0x7d, 0x46, 0xf4, 0x11, 0x17,
-	0x24, 0xc5, 0xe5, 0x71, 0x0e, 0x1e, 0x7a, 0xf4, 0x51, 0x18, 0x8b, 0x88, 0x1b, 0x06, 0xae, 0xe7,
-	0x93, 0xc6, 0x9c, 0x3c, 0x10, 0x3b, 0x4c, 0x86, 0x1e, 0xf3, 0x26, 0x61, 0x83, 0x06, 0x4e, 0x51,
-	0x64, 0xeb, 0x4c, 0xa5, 0xf4, 0xd3, 0x0f, 0x42, 0xc4, 0xc1, 0xc7, 0x72, 0x41, 0x05, 0x04, 0x18,
-	0x4d, 0xbe, 0xce, 0xd2, 0x6d, 0x38, 0xc3, 0x17, 0x7d, 0x00, 0x20, 0xdc, 0xe0, 0x01, 0x7c, 0x73,
-	0x89, 0x38, 0x05, 0x39, 0xcc, 0xab, 0x4e, 0xf0, 0x34, 0x60, 0x49, 0x01, 0x1b, 0xd4, 0xd0, 0x0d,
-	0x00, 0xbe, 0x72, 0xd6, 0xbb, 0x6d, 0xb9, 0x21, 0x94, 0x29, 0x96, 0x50, 0x57, 0x90, 0x07, 0x7b,
-	0xd3, 0xbd, 0x3e, 0x67, 0x16, 0x65, 0x64, 0x3c, 0x8e, 0xbe, 0x0b, 0x46, 0xe2, 0x4e, 0xab, 0xe5,
-	0xa8, 0x33, 0x92, 0x02, 0x13, 0x8b, 0x39, 0x5d, 0x43, 0x30, 0xf2, 0x06, 0x2c, 0x39, 0xa2, 0xbb,
-	0x54, 0xc4, 0x0b, 0x09, 0xc5, 0x57, 0x11, 0xb7, 0x50, 0xb8, 0x27, 0xf0, 0x3d, 0x72, 0x17, 0x83,
-	0x73, 0x70, 0x1e, 0xec, 0x4d, 0x3f, 0x91, 0x6e, 0x5f, 0x0e, 0x45, 0xaa, 0x6f, 0x2e, 0x4d, 0x74,
-	0x5d, 0xd6, 0x0e, 0xa3, 0xaf, 0x2d, 0x0b, 0xcf, 0xbc, 0x4d, 0xd7, 0x0e, 0x63, 0xcd, 0xfd, 0xc7,
-	0xcc, 0x7c, 0x18, 0xad, 0xc0, 0x19, 0x37, 0x0c, 0x92, 0x28, 0xf4, 0x7d, 0x5e, 0x57, 0x90, 0xef,
-	0xcd, 0xf9, 0x19, 0xca, 0x53, 0xa2, 0xdb, 0x67, 0x16, 0x7a, 0x51, 0x70, 0xde, 0x73, 0xd4, 0x26,
-	0xcf, 0xea, 0x87, 0x89, 0x42, 0x8e, 0xd7, 0x53, 0x34, 0x85, 0x84, 0x52, 0x6e, 0xef, 0x03, 0x34,
-	0x45, 0x90, 0x3e, 0x64, 0x15, 0x5f, 0xec, 0xdd, 0x30, 0x46, 0x76, 0x13, 0x12, 0x05, 0x8e, 0x7f,
-	0x0b, 0x2f, 0xcb, 0x03, 0x0b, 0xb6, 0x30, 0x2f, 0x1b, 0xed, 0x38, 0x85, 0x85, 0x6c, 0xe5, 0x25,
-	0x33, 0x72, 0xea, 0xb9, 0x97, 0x4c, 0xfa, 0xc4, 0xec, 0x9f, 0x2b, 0xa7, 0x6c, 0xd6, 0x47, 0x72,
-	0xa4, 0xcb, 0x2a, 0x3d, 0xc9, 0x92, 0x58, 0x0c, 0x20, 0xf6, 0x62, 0x45, 0x72, 0x56, 0x51, 0x73,
-	0xab, 0x26, 0x23, 0x9c, 0xe6, 0x8b, 0xb6, 0xa1, 0xb2, 0x15, 0xc6, 0x89, 0xdc, 0xa1, 0x1d, 0x71,
-	0x33, 0x78, 0x2d, 0x8c, 0x13, 0x66, 0x68, 0xa9, 0xd7, 0xa6, 0x2d, 0x31, 0xe6, 0x3c, 0xe8, 0xde,
-	0x3f, 0xde, 0x72, 0xa2, 0x46, 0x2a, 0xd4, 0x51, 0xd9, 0xd3, 0x75, 0x0d, 0xc2, 0x26, 0x9e, 0xfd,
-	0x67, 0x56, 0xea, 0x54, 0xeb, 0x0e, 0xcb, 0x58, 0xd8, 0x21, 0x01, 0x15, 0x51, 0x66, 0x8c, 0xe3,
-	0x37, 0x67, 0xf2, 0xbf, 0xdf, 0xda, 0xaf, 0x04, 0xe8, 0x3d, 0x4a, 0x61, 0x86, 0x91, 0x30, 0xc2,
-	0x21, 0x3f, 0x61, 0xa5, 0xb3, 0xfc, 0x4b, 0x45, 0x6c, 0xdd, 0xcc, 0x4a, 0x17, 0x07, 0x16, 0x0c,
-	0xb0, 0x7f, 0xd8, 0x82, 0x91, 0x79, 0xc7, 0xdd, 0x0e, 0x37, 0x37, 0xd1, 0x0b, 0x50, 0x6d, 0x74,
-	0x22, 0xb3, 0xe0, 0x80, 0x72, 0x56, 0x2d, 0x8a, 0x76, 0xac, 0x30, 0xe8, 0xd4, 0xdf, 0x74, 0x5c,
-	0x59, 0xef, 0xa2, 0xcc, 0xa7, 0xfe, 0x15, 0xd6, 0x82, 0x05, 0x84, 0x0e, 0x7f, 0xcb, 0xd9, 0x95,
-	0x0f, 0x67, 0x8f, 0xd4, 0x56, 0x34, 0x08, 0x9b, 0x78, 0xf6, 0xaf, 0x5b, 0x30, 0x35, 0xef, 0xc4,
-	0x9e, 0x3b, 0xd7, 0x49, 0xb6, 0xe6, 0xbd, 0x64, 0xa3, 0xe3, 0x6e, 0x93, 0x84, 0xd7, 0x45, 0xa1,
-	0xbd, 0xec, 0xc4, 0x74, 0x05, 0xaa, 0x1d, 0xb3, 0xea, 0xe5, 0x2d, 0xd1, 0x8e, 0x15, 0x06, 0x7a,
-	0x0d, 0x46, 0xdb, 0x4e, 0x1c, 0xdf, 0x0b, 0xa3, 0x06, 0x26, 0x9b, 0xc5, 0x54, 0x4e, 0xaa, 0x13,
-	0x37, 0x22, 0x09, 0x26, 0x9b, 0x22, 0x40, 0x45, 0xd3, 0xc7, 0x26, 0x33, 0xfb, 0x07, 0x2c, 0x38,
-	0x3b, 0x4f, 0x9c, 0x88, 0x44, 0xac, 0xd0, 0x92, 0x7a, 0x11, 0xf4, 0x2a, 0x54, 0x13, 0xda, 0x42,
-	0x7b, 0x64, 0x15, 0xdb, 0x23, 0x16, 0x5a, 0xb2, 0x2e, 0x88, 0x63, 0xc5, 0xc6, 0xfe, 0x82, 0x05,
-	0xe7, 0xf3, 0xfa, 0xb2, 0xe0, 0x87, 0x9d, 0xc6, 0xa3, 0xe8, 0xd0, 0xdf, 0xb1, 0x60, 0x8c, 0x1d,
-	0xd7, 0x2f, 0x92, 0xc4, 0xf1, 0xfc, 0x9e, 0xf2, 0x91, 0xd6, 0x80, 0xe5, 0x23, 0x2f, 0xc2, 0xd0,
-	0x56, 0xd8, 0x22, 0xd9, 0x50, 0x93, 0x6b, 0x61, 0x8b, 0x60, 0x06, 0x41, 0xef, 0xa4, 0x93, 0xd0,
-	0x0b, 0x12, 0x87, 0x2e, 0x47, 0x79, 0x9c, 0x71, 0x8a, 0x4f, 0x40, 0xd5, 0x8c, 0x4d, 0x1c, 0xfb,
-	0x5f, 0xd5, 0x60, 0x44, 0xc4, 0x45, 0x0d, 0x5c, 0xa7, 0x47, 0x7a, 0x71, 0x4a, 0x7d, 0xbd, 0x38,
-	0x31, 0x0c, 0xbb, 0xac, 0xc6, 0xaf, 0xb0, 0xd0, 0x6f, 0x14, 0x12, 0x48, 0xc7, 0xcb, 0x06, 0xeb,
-	0x6e, 0xf1, 0xff, 0x58, 0xb0, 0x42, 0xaf, 0x5b, 0x70, 0xca, 0x0d, 0x83, 0x80, 0xb8, 0xda, 0x76,
-	0x1c, 0x2a, 0x62, 0x83, 0xb0, 0x90, 0x26, 0xaa, 0x4f, 0x82, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x5e,
-	0x86, 0x71, 0x3e, 0x66, 0xb7, 0x53, 0x67, 0x30, 0xba, 0x50, 0xa0, 0x09, 0xc4, 0x69, 0x5c, 0x34,
-	0xc3, 0xcf, 0xb2, 0x44, 0x95, 0xbd, 0x61, 0xed, 0xaa, 0x36, 0xea, 0xeb, 0x19, 0x18, 0x28, 0x02,
-	0x14, 0x91, 0xcd, 0x88, 0xc4, 0x5b, 0x22, 0x6e, 0x8c, 0xd9, 0xad, 0x23, 0x0f, 0x57, 0x44, 0x03,
-	0xf7, 0x50, 0xc2, 0x39, 0xd4, 0xd1, 0xb6, 0x70, 0x23, 0x54, 0x8b, 0x90, 0xe7, 0xe2, 0x33, 0xf7,
-	0xf5, 0x26, 0x4c, 0x43, 0x85, 0xa9, 0x2e, 0x66, 0x2f, 0x97, 0x79, 0xe2, 0x26, 0x53, 0x6c, 0x98,
-	0xb7, 0xa3, 0x45, 0x38, 0x9d, 0xa9, 0x5c, 0x18, 0x8b, 0xb3, 0x12, 0x95, 0xa4, 0x97, 0xa9, 0x79,
-	0x18, 0xe3, 0x9e, 0x27, 0x4c, 0x17, 0xd3, 0xe8, 0x01, 0x2e, 0xa6, 0xae, 0x8a, 0x4e, 0xe6, 0xa7,
-	0x18, 0xef, 0x2b, 0x64, 0x00, 0x06, 0x0a, 0x45, 0xfe, 0xa1, 0x4c, 0x28, 0xf2, 0x38, 0xeb, 0xc0,
-	0xed, 0x62, 0x3a, 0x70, 0xf8, 0xb8, 0xe3, 0x47, 0x19, 0x47, 0xfc, 0xbf, 0x2c, 0x90, 0xdf, 0x75,
-	0xc1, 0x71, 0xb7, 0x08, 0x9d, 0x32, 0x39, 0xd9, 0x1f, 0xd6, 0x61, 0xb2, 0x3f, 0xd0, 0x2c, 0xd4,
-	0xe8, 0x38, 0xf1, 0x47, 0xb9, 0xde, 0x57, 0x1e, 0x90, 0xb9, 0xb5, 0x25, 0xf1, 0x94, 0xc6, 0x41,
-	0x21, 0x4c, 0xfa, 0x4e, 0x9c, 0xb0, 0x1e, 0xd4, 0xbb, 0x81, 0xfb, 0x90, 0x25, 0x6c, 0x58, 0x26,
-	0xd8, 0x72, 0x96, 0x10, 0xee, 0xa5, 0x6d, 0xff, 0xbb, 0x0a, 0x8c, 0xa7, 0x24, 0xe3, 0x21, 0x0d,
-	0x86, 0x17, 0xa0, 0x2a, 0x75, 0x78, 0xb6, 0x90, 0x97, 0x52, 0xf4, 0x0a, 0x83, 0x2a, 0xad, 0x0d,
-	0xad, 0x55, 0xb3, 0x06, 0x8e, 0xa1, 0x70, 0xb1, 0x89, 0xc7, 0x84, 0x72, 0xe2, 0xc7, 0x0b, 0xbe,
-	0x47, 0x82, 0x84, 0x77, 0xb3, 0x18, 0xa1, 0xbc, 0xbe, 0x5c, 0x37, 0x89, 0x6a, 0xa1, 0x9c, 0x01,
-	0xe0, 0x2c, 0x7b, 0xf4, 0x69, 0x0b, 0xc6, 0x9d, 0x7b, 0xb1, 0x2e, 0x44, 0x2f, 0x82, 0x8e, 0x8f,
-	0xa8, 0xa4, 0x52, 0xb5, 0xed, 0xb9, 0x63, 0x3f, 0xd5, 0x84, 0xd3, 0x4c, 0xd1, 0x1b, 0x16, 0x20,
-	0xb2, 0x4b, 0x5c, 0x19, 0x16, 0x2d, 0xfa, 0x32, 0x5c, 0xc4, 0x0e, 0xfe, 0x72, 0x0f, 0x5d, 0x2e,
-	0xd5, 0x7b, 0xdb, 0x71, 0x4e, 0x1f, 0xd0, 0x75, 0x40, 0x0d, 0x2f, 0x76, 0x36, 0x7c, 0xb2, 0x10,
-	0xb6, 0x64, 0xf6, 0xb2, 0x38, 0x4f, 0xbf, 0x20, 0xc6, 0x19, 0x2d, 0xf6, 0x60, 0xe0, 0x9c, 0xa7,
-	0xd8, 0x2c, 0x8b, 0xc2, 0xdd, 0xee, 0xad, 0xc8, 0x67, 0x5a, 0xc2, 0x9c, 0x65, 0xa2, 0x1d, 0x2b,
-	0x0c, 0xfb, 0xcf, 0xcb, 0x6a, 0x29, 0xeb, 0x1c, 0x00, 0xc7, 0x88, 0x45, 0xb6, 0x1e, 0x3e, 0x16,
-	0x59, 0x47, 0x4a, 0xf5, 0xe6, 0xe4, 0xa7, 0x52, 0x78, 0x4b, 0x8f, 0x28, 0x85, 0xf7, 0x7b, 0xad,
-	0x54, 0xb1, 0xbc, 0xd1, 0x4b, 0x1f, 0x28, 0x36, 0xff, 0x60, 0x86, 0x47, 0x71, 0x65, 0xf4, 0x4a,
-	0x26, 0x78, 0xef, 0x05, 0xa8, 0x6e, 0xfa, 0x0e, 0xab, 0xe2, 0xc2, 0x16, 0xaa, 0x11, 0x61, 0x76,
-	0x45, 0xb4, 0x63, 0x85, 0x41, 0xa5, 0xbe, 0x41, 0xf4, 0x50, 0x52, 0xfb, 0x3f, 0x96, 0x61, 0xd4,
-	0xd0, 0xf8, 0xb9, 0xe6, 0x9b, 0xf5, 0x98, 0x99, 0x6f, 0xa5, 0x43, 0x98, 0x6f, 0xdf, 0x03, 0x35,
-	0x57, 0x6a, 0xa3, 0x62, 0xae, 0x15, 0xc8, 0xea, 0x38, 0xad, 0x90, 0x54, 0x13, 0xd6, 0x3c, 0xd1,
-	0xd5, 0x54, 0x9a, 0x68, 0xca, 0x2f, 0x90, 0x97, 0xc7, 0x29, 0x34, 0x5a, 0xef, 0x33, 0xd9, 0xf8,
-	0x80, 0xca, 0xc1, 0xf1, 0x01, 0xf6, 0x1f, 0x5a, 0xea, 0xe3, 0x9e, 0x40, 0x3d, 0xa0, 0xbb, 0xe9,
-	0x7a, 0x40, 0x97, 0x0b, 0x19, 0xe6, 0x3e, 0x85, 0x80, 0x7e, 0xc0, 0x82, 0x67, 0xf7, 0x2f, 0xb0,
-	0x8d, 0x9e, 0x83, 0x4a, 0x33, 0x0a, 0x3b, 0x6d, 0xa1, 0x83, 0x15, 0x1d, 0x56, 0xcd, 0x1c, 0x73,
-	0x18, 0xdd, 0x44, 0x6d, 0x7b, 0x41, 0x23, 0xbb, 0x89, 0xba, 0xe1, 0x05, 0x0d, 0xcc, 0x20, 0x03,
-	0x54, 0x60, 0xbd, 0x09, 0x23, 0x0b, 0x61, 0xab, 0xe5, 0x04, 0x0d, 0xf4, 0x0d, 0x30, 0xe2, 0xf2,
-	0x9f, 0xc2, 0x9f, 0xc7, 0x0e, 0xce, 0x05, 0x14, 0x4b, 0x18, 0x7a, 0x1a, 0x86, 0x9c, 0xa8, 0x29,
-	0x7d, 0x78, 0x2c, 0x20, 0x6f, 0x2e, 0x6a, 0xc6, 0x98, 0xb5, 0xda, 0x7f, 0x69, 0xc1, 0x04, 0x7d,
-	0xc4, 0x63, 0x03, 0xcc, 0x86, 0xf6, 0x79, 0x18, 0x76, 0x3a, 0xc9, 0x56, 0xd8, 0xb3, 0x27, 0x9c,
-	0x63, 0xad, 0x58, 0x40, 0x69, 0x67, 0x55, 0x51, 0x0b, 0xa3, 0xb3, 0x8b, 0x74, 0x5d, 0x31, 0x08,
-	0x35, 0xab, 0xe3, 0xce, 0x46, 0xde, 0xc9, 0x6d, 0x9d, 0x37, 0x63, 0x09, 0xa7, 0xc4, 0x36, 0xc2,
-	0x46, 0x57, 0x84, 0x19, 0x2b, 0x62, 0xf3, 0x61, 0xa3, 0x8b, 0x19, 0x04, 0x3d, 0x03, 0xe5, 0x78,
-	0xcb, 0x91, 0x31, 0x02, 0x32, 0xe2, 0xbd, 0x7e, 0x6d, 0x0e, 0xd3, 0x76, 0x95, 0xc0, 0x11, 0xf9,
-	0xd9, 0x78, 0xdf, 0x74, 0x02, 0x47, 0xe4, 0xdb, 0xff, 0x7c, 0x08, 0x58, 0xec, 0x8f, 0x13, 0x91,
-	0xc6, 0x7a, 0xc8, 0x6a, 0x26, 0x1f, 0xeb, 0x11, 0xbb, 0xde, 0x54, 0x3f, 0xce, 0xc7, 0xec, 0xc6,
-	0x51, 0x6b, 0xf9, 0xa4, 0x8f, 0x5a, 0xf3, 0x4f, 0xcf, 0x87, 0x1e, 0xa3, 0xd3, 0x73, 0xfb, 0xf3,
-	0x16, 0x20, 0x15, 0xc9, 0xa5, 0xc3, 0x5b, 0x66, 0xa1, 0xa6, 0x42, 0xc7, 0xc4, 0x7a, 0xd1, 0x22,
-	0x5a, 0x02, 0xb0, 0xc6, 0x19, 0xc0, 0x93, 0xf2, 0x9c, 0xd4, 0x9f, 0xe5, 0xb4, 0x2c, 0x61, 0x5a,
-	0x57, 0xa8, 0x53, 0xfb, 0x57, 0x4b, 0xf0, 0x04, 0x37, 0xdd, 0x56, 0x9c, 0xc0, 0x69, 0x92, 0x16,
-	0xed, 0xd5, 0xa0, 0x01, 0x4b, 0x2e, 0xdd, 0xc2, 0x7b, 0x32, 0x5b, 0xe3, 0xa8, 0xb2, 0x93, 0xcb,
-	0x19, 0x2e, 0x59, 0x96, 0x02, 0x2f, 0xc1, 0x8c, 0x38, 0x8a, 0xa1, 0x2a, 0xef, 0x83, 0x12, 0xba,
-	0xb0, 0x20, 0x46, 0x4a, 0x2d, 0x08, 0x2b, 0x87, 0x60, 0xc5, 0x88, 0x9a, 0x32, 0x7e, 0xe8, 0x6e,
-	0xd3, 0x25, 0x9f, 0x35, 0x65, 0x96, 0x45, 0x3b, 0x56, 0x18, 0x76, 0x0b, 0x4e, 0xc9, 0x31, 0x6c,
-	0xdf, 0x20, 0x5d, 0x4c, 0x36, 0xa9, 0xfe, 0x77, 0x65, 0x93, 0x71, 0x45, 0x95, 0xd2, 0xff, 0x0b,
-	0x26, 0x10, 0xa7, 0x71, 0x65, 0x19, 0xe5, 0x52, 0x7e, 0x19, 0x65, 0xfb, 0x57, 0x2d, 0xc8, 0x1a,
-	0x20, 0xcc, 0x01, 0x67, 0xde, 0x37, 0xd5, 0xaf, 0xbe, 0xfa, 0x21, 0x2a, 0xab, 0x7e, 0x08, 0x46,
-	0x9d, 0x84, 0x5a, 0x98, 0xdc, 0x1b, 0x54, 0x7e, 0xb8, 0x53, 0xcc, 0x95, 0xb0, 0xe1, 0x6d, 0x7a,
-	0xcc, 0x0b, 0x64, 0x92, 0xb3, 0x7f, 0xb4, 0x02, 0xb5, 0xc5, 0xa8, 0x7b, 0xf8, 0xb4, 0xb9, 0xde,
-	0xa4, 0xb8, 0xd2, 0xa1, 0x92, 0xe2, 0x64, 0xda, 0x5d, 0xb9, 0x6f, 0xda, 0x9d, 0x4c, 0x9b, 0x1b,
-	0x7a, 0x54, 0x69, 0x73, 0x95, 0xc7, 0x24, 0x6d, 0x6e, 0xf8, 0x31, 0x48, 0x9b, 0x1b, 0x39, 0xe1,
-	0xb4, 0x39, 0xfb, 0x7f, 0x0c, 0xc1, 0x64, 0x4f, 0x16, 0x30, 0x7a, 0x09, 0xc6, 0xd4, 0x1a, 0x95,
-	0x07, 0x00, 0x35, 0x33, 0x8c, 0x5e, 0xc3, 0x70, 0x0a, 0x73, 0x00, 0x41, 0xbd, 0x04, 0x67, 0x22,
-	0xf2, 0x6a, 0x87, 0x74, 0xc8, 0xdc, 0x66, 0x42, 0xa2, 0x3a, 0x71, 0xc3, 0xa0, 0xc1, 0x6b, 0x6e,
-	0x97, 0xe7, 0x9f, 0xbc, 0xbf, 0x37, 0x7d, 0x06, 0xf7, 0x82, 0x71, 0xde, 0x33, 0xa8, 0x0d, 0xe3,
-	0xbe, 0xb9, 0x73, 0x15, 0x73, 0xf8, 0xa1, 0x36, 0xbd, 0x4a, 0x56, 0xa5, 0x9a, 0x71, 0x9a, 0x41,
-	0x7a, 0xfb, 0x5b, 0x79, 0x44, 0xdb, 0xdf, 0x4f, 0xe9, 0xed, 0x2f, 0x8f, 0x4a, 0xfb, 0x60, 0xc1,
-	0x59, 0xe0, 0x83, 0xec, 0x7f, 0x8f, 0xb2, 0xa3, 0x7d, 0x1f, 0x54, 0x65, 0xc4, 0xee, 0x40, 0x91,
-	0xae, 0x26, 0x9d, 0x3e, 0x9a, 0xfd, 0x41, 0x09, 0x72, 0x9c, 0x36, 0x54, 0xd2, 0x6a, 0x6b, 0x3f,
-	0x25, 0x69, 0x0f, 0x67, 0xf1, 0xa3, 0x5d, 0x1e, 0xad, 0xcc, 0x6d, 0xbc, 0xf7, 0x17, 0xed, 0x74,
-	0xd2, 0x01, 0xcc, 0x4a, 0xff, 0xa9, 0x20, 0xe6, 0x4b, 0x00, 0x7a, 0xc3, 0x28, 0x2c, 0x7d, 0x15,
-	0x7e, 0xa4, 0xf7, 0x95, 0xd8, 0xc0, 0x42, 0x2f, 0xc2, 0xa8, 0x17, 0xc4, 0x89, 0xe3, 0xfb, 0xd7,
-	0xbc, 0x20, 0x11, 0xd6, 0xbf, 0x32, 0x66, 0x97, 0x34, 0x08, 0x9b, 0x78, 0x17, 0xde, 0x63, 0x7c,
-	0x97, 0xc3, 0x7c, 0xcf, 0x2d, 0x38, 0x7f, 0xd5, 0x4b, 0x94, 0x68, 0x53, 0xf3, 0x88, 0x6d, 0xf2,
-	0xa4, 0x06, 0xb2, 0xfa, 0x6a, 0x20, 0x23, 0x0d, 0xb5, 0x94, 0xce, 0x9a, 0xcd, 0xa6, 0xa1, 0xda,
-	0x2e, 0x9c, 0xbd, 0xea, 0x25, 0x57, 0x3c, 0x9f, 0x1c, 0x23, 0x93, 0x5f, 0x19, 0x86, 0x31, 0xb3,
-	0x3a, 0xc4, 0x61, 0xf4, 0xf5, 0x17, 0xe8, 0xee, 0x44, 0x0c, 0x84, 0xa7, 0x42, 0x2a, 0xee, 0x1c,
-	0xb9, 0x54, 0x45, 0xfe, 0xe0, 0x1a, 0x1b, 0x14, 0xcd, 0x13, 0x9b, 0x1d, 0x40, 0xf7, 0xa0, 0xb2,
-	0xc9, 0x32, 0x2a, 0xcb, 0x45, 0x04, 0xc3, 0xe5, 0x0d, 0xbe, 0x5e, 0x91, 0x3c, 0x27, 0x93, 0xf3,
-	0xa3, 0x46, 0x65, 0x94, 0x4e, 0xe4, 0x37, 0xf2, 0x5c, 0x84, 0xb5, 0xa2, 0x30, 0xfa, 0x69, 0x85,
-	0xca, 0x43, 0x68, 0x85, 0x94, 0x8c, 0x1e, 0x7e, 0x44, 0x32, 0x9a, 0x65, 0xc7, 0x26, 0x5b, 0x6c,
-	0xcb, 0x23, 0x12, 0xf3, 0x46, 0xd8, 0x20, 0x18, 0xd9, 0xb1, 0x29, 0x30, 0xce, 0xe2, 0xa3, 0x8f,
-	0x2b, 0x29, 0x5f, 0x2d, 0xe2, 0xc8, 0xca, 0x9c, 0xd1, 0xc7, 0x2d, 0xe0, 0x3f, 0x5f, 0x82, 0x89,
-	0xab, 0x41, 0x67, 0xed, 0xea, 0x5a, 0x67, 0xc3, 0xf7, 0xdc, 0x1b, 0xa4, 0x4b, 0xa5, 0xf8, 0x36,
-	0xe9, 0x2e, 0x2d, 0x66, 0x7d, 0x3d, 0x37, 0x68, 0x23, 0xe6, 0x30, 0x2a, 0xb7, 0x36, 0xbd, 0xa0,
-	0x49, 0xa2, 0x76, 0xe4, 0x89, 0xd3, 0x24, 0x43, 0x6e, 0x5d, 0xd1, 0x20, 0x6c, 0xe2, 0x51, 0xda,
-	0xe1, 0xbd, 0x40, 0x95, 0xea, 0x52, 0xb4, 0x57, 0x69, 0x23, 0xe6, 0x30, 0x8a, 0x94, 0x44, 0x1d,
-	0xe1, 0xac, 0x35, 0x90, 0xd6, 0x69, 0x23, 0xe6, 0x30, 0xe1, 0x7b, 0x61, 0xb1, 0x86, 0x95, 0x1e,
-	0xdf, 0x0b, 0x0b, 0xd3, 0x91, 0x70, 0x8a, 0xba, 0x4d, 0xba, 0x8b, 0x4e, 0xe2, 0x64, 0x5d, 0x27,
-	0x37, 0x78, 0x33, 0x96, 0x70, 0x56, 0xfb, 0x3b, 0x3d, 0x1c, 0x5f, 0x73, 0xb5, 0xbf, 0xd3, 0xdd,
-	0xef, 0xe3, 0xf2, 0xfb, 0xd1, 0x12, 0x8c, 0xbd, 0x79, 0x2f, 0x70, 0xce, 0xbd, 0x54, 0x77, 0x60,
-	0xb2, 0x27, 0x27, 0x7f, 0x00, 0xcb, 0xe7, 0xc0, 0x9a, 0x29, 0x36, 0x86, 0x51, 0x4a, 0x58, 0xd6,
-	0xbc, 0x5c, 0x80, 0x49, 0xbe, 0x78, 0x29, 0x27, 0x96, 0x62, 0xad, 0xea, 0x2c, 0xb0, 0xe3, 0xd2,
-	0xdb, 0x59, 0x20, 0xee, 0xc5, 0xb7, 0x7f, 0xc8, 0x82, 0xf1, 0x54, 0x99, 0x84, 0x82, 0x6c, 0x34,
-	0xb6, 0xba, 0x43, 0x16, 0x27, 0xcf, 0xf2, 0x96, 0xca, 0x4c, 0x0d, 0xeb, 0xd5, 0xad, 0x41, 0xd8,
-	0xc4, 0xb3, 0x7f, 0xab, 0x0c, 0x55, 0x19, 0xd3, 0x37, 0x40, 0x57, 0x3e, 0x67, 0xc1, 0xb8, 0x3a,
-	0xa2, 0x66, 0x67, 0x0a, 0xa5, 0x22, 0xb2, 0x36, 0x69, 0x0f, 0x94, 0x57, 0x2c, 0xd8, 0x0c, 0xf5,
-	0x86, 0x01, 0x9b, 0xcc, 0x70, 0x9a, 0x37, 0xba, 0x0d, 0x10, 0x77, 0xe3, 0x84, 0xb4, 0x8c, 0xd3,
-	0x0d, 0xdb, 0x98, 0x65, 0x33, 0x6e, 0x18, 0x11, 0x3a, 0xa7, 0x6e, 0x86, 0x0d, 0x52, 0x57, 0x98,
-	0xda, 0xc2, 0xd3, 0x6d, 0xd8, 0xa0, 0x84, 0x5e, 0x53, 0x01, 0x15, 0x43, 0x45, 0xe8, 0x75, 0x39,
-	0xbe, 0x83, 0x44, 0x54, 0x1c, 0x21, 0x82, 0xc1, 0xfe, 0xd9, 0x12, 0x9c, 0xce, 0x8e, 0x24, 0xfa,
-	0x20, 0x8c, 0xc9, 0x41, 0x33, 0x9c, 0x47, 0x32, 0x90, 0x72, 0x0c, 0x1b, 0xb0, 0x07, 0x7b, 0xd3,
-	0xd3, 0xbd, 0x17, 0xcc, 0xcf, 0x98, 0x28, 0x38, 0x45, 0x8c, 0x87, 0x37, 0x88, 0x38, 0x9c, 0xf9,
-	0xee, 0x5c, 0xbb, 0x2d, 0x62, 0x14, 0x8c, 0xf0, 0x06, 0x13, 0x8a, 0x33, 0xd8, 0x68, 0x0d, 0xce,
-	0x1a, 0x2d, 0x37, 0x89, 0xd7, 0xdc, 0xda, 0x08, 0x23, 0xb9, 0x5f, 0x7d, 0x5a, 0x87, 0x6d, 0xf7,
-	0xe2, 0xe0, 0xdc, 0x27, 0xa9, 0x61, 0xe4, 0x3a, 0x6d, 0xc7, 0xf5, 0x92, 0xae, 0x38, 0x65, 0x52,
-	0x62, 0x7c, 0x41, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x83, 0x21, 0x38, 0xcd, 0xe3, 0x94, 0x89, 0x0a,
-	0xc3, 0x47, 0x1f, 0x84, 0x5a, 0x9c, 0x38, 0x11, 0x77, 0x55, 0x59, 0x87, 0x16, 0x5d, 0xba, 0xb6,
-	0x83, 0x24, 0x82, 0x35, 0x3d, 0xf4, 0x01, 0x56, 0x18, 0xcf, 0x8b, 0xb7, 0x18, 0xf5, 0xd2, 0xc3,
-	0x39, 0xc2, 0xae, 0x28, 0x0a, 0xd8, 0xa0, 0x86, 0xbe, 0x15, 0x2a, 0xed, 0x2d, 0x27, 0x96, 0x5e,
-	0xda, 0xe7, 0xa5, 0x9c, 0x58, 0xa3, 0x8d, 0x0f, 0xf6, 0xa6, 0xcf, 0x65, 0x5f, 0x95, 0x01, 0x30,
-	0x7f, 0xc8, 0x94, 0xf2, 0x43, 0x07, 0x48, 0xf9, 0xe7, 0x61, 0xb8, 0x11, 0x75, 0xeb, 0xd7, 0xe6,
-	0xb2, 0x77, 0x0d, 0x2d, 0xb2, 0x56, 0x2c, 0xa0, 0x54, 0x26, 0x6d, 0x71, 0x96, 0x0d, 0x8a, 0x3c,
-	0x9c, 0xb6, 0x38, 0xae, 0x69, 0x10, 0x36, 0xf1, 0xd0, 0xe7, 0x7b, 0xa3, 0xd8, 0x47, 0x8e, 0x21,
-	0xcb, 0x69, 0xd0, 0xf8, 0xf5, 0xcb, 0x50, 0x13, 0x5d, 0x5d, 0x0f, 0xd1, 0x4b, 0x30, 0xc6, 0x9d,
-	0x80, 0xf3, 0x91, 0x13, 0xb8, 0x5b, 0x59, 0xe7, 0xcd, 0xba, 0x01, 0xc3, 0x29, 0x4c, 0x7b, 0x05,
-	0x86, 0x06, 0x14, 0xb2, 0x03, 0xed, 0xc9, 0xdf, 0x07, 0x55, 0x4a, 0x4e, 0x6e, 0xd0, 0x8a, 0x20,
-	0x19, 0x42, 0x55, 0x5e, 0x52, 0x8a, 0x6c, 0x28, 0x7b, 0x8e, 0x8c, 0x56, 0x52, 0x4b, 0x68, 0x29,
-	0x8e, 0x3b, 0x6c, 0xda, 0x51, 0x20, 0x7a, 0x0e, 0xca, 0x64, 0xb7, 0x9d, 0x0d, 0x4b, 0xba, 0xbc,
-	0xdb, 0xf6, 0x22, 0x12, 0x53, 0x24, 0xb2, 0xdb, 0x46, 0x17, 0xa0, 0xe4, 0x35, 0xc4, 0x8c, 0x04,
-	0x81, 0x53, 0x5a, 0x5a, 0xc4, 0x25, 0xaf, 0x61, 0xef, 0x42, 0x4d, 0xdd, 0x8a, 0x8a, 0xb6, 0xa5,
-	0x49, 0x65, 0x15, 0x11, 0xa7, 0x2e, 0xe9, 0xf6, 0x31, 0xa6, 0x3a, 0x00, 0xba, 0x68, 0x48, 0x51,
-	0x2a, 0xf8, 0x22, 0x0c, 0xb9, 0xa1, 0x28, 0xf7, 0x54, 0xd5, 0x64, 0x98, 0x2d, 0xc5, 0x20, 0xf6,
-	0x1d, 0x98, 0xb8, 0x11, 0x84, 0xf7, 0xd8, 0xfd, 0x64, 0xac, 0x1c, 0x37, 0x25, 0xbc, 0x49, 0x7f,
-	0x64, 0x2d, 0x77, 0x06, 0xc5, 0x1c, 0xa6, 0x0a, 0xfd, 0x96, 0xfa, 0x15, 0xfa, 0xb5, 0x3f, 0x61,
-	0xc1, 0x98, 0xf2, 0xc2, 0x5e, 0xdd, 0xd9, 0x1e, 0xec, 0xf4, 0xd7, 0x28, 0xcb, 0x51, 0x3a, 0xa0,
-	0x2c, 0x87, 0x3c, 0x28, 0x2e, 0xf7, 0x3b, 0x28, 0xb6, 0xff, 0xca, 0x82, 0xd3, 0xaa, 0x0b, 0xd2,
-	0x66, 0x7a, 0x09, 0xc6, 0x36, 0x3a, 0x9e, 0xdf, 0x90, 0x75, 0xc6, 0x33, 0xcb, 0x65, 0xde, 0x80,
-	0xe1, 0x14, 0x26, 0xba, 0x04, 0xb0, 0xe1, 0x05, 0x4e, 0xd4, 0x5d, 0xd3, 0x46, 0x9a, 0xd2, 0xdb,
-	0xf3, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x31, 0xa8, 0xee, 0xc8, 0xf8, 0x80, 0x72, 0xa1, 0xd5, 0x24,
-	0xc4, 0x78, 0xe8, 0x95, 0xa0, 0x02, 0x0e, 0x14, 0x47, 0xfb, 0x8b, 0x65, 0x98, 0x48, 0x57, 0x80,
-	0x18, 0xc0, 0x73, 0xf2, 0x1c, 0x54, 0x58, 0x51, 0x88, 0xec, 0xc4, 0xe2, 0x85, 0xc1, 0x39, 0x0c,
-	0xc5, 0x30, 0xcc, 0x45, 0x49, 0x31, 0x57, 0xe8, 0xaa, 0x4e, 0x2a, 0xff, 0x2c, 0x73, 0x5e, 0x8b,
-	0xc3, 0x0e, 0xc1, 0x0a, 0x7d, 0xda, 0x82, 0x91, 0xb0, 0x6d, 0x56, 0x98, 0x7d, 0x7f, 0x91, 0xd5,
-	0x31, 0x44, 0x0a, 0xba, 0xb0, 0x86, 0xd4, 0xc4, 0x93, 0x93, 0x41, 0xb2, 0xbe, 0xf0, 0x2d, 0x30,
-	0x66, 0x62, 0x1e, 0x64, 0x10, 0x55, 0x4d, 0x83, 0xe8, 0x73, 0xe6, 0x94, 0x14, 0xf5, 0x3f, 0x06,
-	0x58, 0xec, 0xb7, 0xa0, 0xe2, 0xaa, 0x80, 0xcb, 0x87, 0xba, 0x1b, 0x43, 0xd5, 0xc7, 0x63, 0xc1,
-	0x2c, 0x9c, 0x9a, 0xfd, 0x87, 0x96, 0x31, 0x3f, 0x30, 0x89, 0x97, 0x1a, 0x28, 0x82, 0x72, 0x73,
-	0x67, 0x5b, 0x18, 0x19, 0xd7, 0x0b, 0x1a, 0xde, 0xab, 0x3b, 0xdb, 0x7a, 0x85, 0x99, 0xad, 0x98,
-	0x32, 0x1b, 0xe0, 0x10, 0x21, 0x55, 0x26, 0xa6, 0x7c, 0x70, 0x99, 0x18, 0xfb, 0x8d, 0x12, 0x4c,
-	0xf6, 0x4c, 0x2a, 0xf4, 0x1a, 0x54, 0x22, 0xfa, 0x96, 0xe2, 0xf5, 0x96, 0x0b, 0x2b, 0xec, 0x12,
-	0x2f, 0x35, 0xb4, 0xf2, 0x4e, 0xb7, 0x63, 0xce, 0x12, 0x5d, 0x07, 0xa4, 0xc3, 0x82, 0xd5, 0x09,
-	0x06, 0x7f, 0x65, 0x15, 0x3b, 0x38, 0xd7, 0x83, 0x81, 0x73, 0x9e, 0x42, 0x2f, 0x67, 0x0f, 0x42,
-	0x32, 0x35, 0xcb, 0xf7, 0x3b, 0xd3, 0xb0, 0x5f, 0x37, 0xa7, 0xe0, 0x6d, 0x2d, 0x4c, 0x8f, 0xba,
-	0x39, 0xed, 0x91, 0xac, 0xe5, 0x41, 0x25, 0xab, 0xfd, 0x4b, 0x25, 0x18, 0x4f, 0xd5, 0x20, 0x46,
-	0x3e, 0x54, 0x89, 0xcf, 0xce, 0xeb, 0xa5, 0xf6, 0x3d, 0xea, 0x75, 0x46, 0x4a, 0x4e, 0x5e, 0x16,
-	0x74, 0xb1, 0xe2, 0xf0, 0x78, 0x44, 0x39, 0xbe, 0x04, 0x63, 0xb2, 0x43, 0xef, 0x77, 0x5a, 0x7e,
-	0x76, 0xf8, 0x2e, 0x1b, 0x30, 0x9c, 0xc2, 0xb4, 0x7f, 0xad, 0x0c, 0x53, 0x3c, 0xc0, 0xa1, 0xa1,
-	0x16, 0x83, 0x0a, 0x54, 0xfa, 0x41, 0x5d, 0x29, 0xdc, 0x2a, 0xe2, 0x42, 0xff, 0x7e, 0x8c, 0x06,
-	0x0a, 0xce, 0xff, 0x89, 0x4c, 0x70, 0x3e, 0xdf, 0xaa, 0x37, 0x8f, 0xa9, 0x47, 0x5f, 0x5b, 0xd1,
-	0xfa, 0xff, 0xa4, 0x04, 0xa7, 0x32, 0x57, 0x33, 0xa2, 0x2f, 0xa6, 0x6f, 0xf3, 0xb1, 0x8a, 0x38,
-	0xfe, 0xdb, 0xf7, 0xb6, 0xbe, 0xc3, 0xdd, 0xe9, 0xf3, 0x88, 0x96, 0x8a, 0xfd, 0xfb, 0x25, 0x98,
-	0x48, 0xdf, 0x29, 0xf9, 0x18, 0x8e, 0xd4, 0xdb, 0xa1, 0xc6, 0xae, 0x4d, 0xbb, 0x41, 0xba, 0xf2,
-	0x94, 0x91, 0xdf, 0x50, 0x25, 0x1b, 0xb1, 0x86, 0x3f, 0x16, 0x57, 0x25, 0xd9, 0xff, 0xd4, 0x82,
-	0x73, 0xfc, 0x2d, 0xb3, 0xf3, 0xf0, 0x6f, 0xe5, 0x8d, 0xee, 0x2b, 0xc5, 0x76, 0x30, 0x53, 0xe1,
-	0xfe, 0xa0, 0xf1, 0xa5, 0xc6, 0xcb, 0x59, 0xd1, 0xdb, 0xf4, 0x54, 0x78, 0x0c, 0x3b, 0x7b, 0xa8,
-	0xc9, 0x60, 0xff, 0xfb, 0x12, 0x8c, 0xae, 0x2e, 0x2c, 0x29, 0x11, 0x3e, 0x0b, 0x35, 0x37, 0x22,
-	0x8e, 0x76, 0xff, 0x98, 0xe1, 0x73, 0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0xf0, 0xd3, 0x38,
-	0xbb, 0x8b, 0xe2, 0xd1, 0xa9, 0x31, 0x96, 0x70, 0xf4, 0x02, 0x54, 0x59, 0x92, 0xfa, 0xad, 0x48,
-	0x6a, 0x1c, 0xbd, 0xb5, 0x66, 0xed, 0x78, 0x19, 0x2b, 0x0c, 0x4a, 0xb8, 0x11, 0xba, 0x31, 0x45,
-	0xce, 0x78, 0x64, 0x16, 0x69, 0x33, 0x5e, 0xc6, 0x12, 0xce, 0x6a, 0x8c, 0x32, 0xaf, 0x05, 0x45,
-	0xae, 0xa4, 0x3b, 0xcd, 0xdd, 0x1b, 0x14, 0x5d, 0xe3, 0x1c, 0xa6, 0x16, 0x6d, 0x26, 0x51, 0x74,
-	0x64, 0xb0, 0x44, 0x51, 0xfb, 0xf7, 0xcb, 0x50, 0xd3, 0x4e, 0x35, 0x4f, 0x54, 0x66, 0x29, 0xe4,
-	0x06, 0x85, 0x7a, 0x37, 0x70, 0x15, 0x69, 0x1e, 0x4d, 0x60, 0x14, 0x66, 0xf9, 0x7e, 0x0b, 0x46,
-	0xbd, 0xc0, 0x4b, 0x3c, 0x87, 0xf9, 0x06, 0x8b, 0xb9, 0xe6, 0x5e, 0xb1, 0x5b, 0xe2, 0x94, 0xc3,
-	0xc8, 0x3c, 0xf2, 0x57, 0xcc, 0xb0, 0xc9, 0x19, 0x7d, 0x54, 0xe4, 0x25, 0x96, 0x0b, 0x2b, 0x6f,
-	0x54, 0xcd, 0x24, 0x23, 0xb6, 0xa9, 0x8d, 0x9d, 0x44, 0x05, 0x55, 0x05, 0xc3, 0x94, 0x94, 0xba,
-	0xc9, 0x47, 0xed, 0x62, 0x58, 0x33, 0xe6, 0x8c, 0xec, 0x18, 0x50, 0xef, 0x58, 0x1c, 0x32, 0xe7,
-	0x6b, 0x16, 0x6a, 0x4e, 0x27, 0x09, 0x5b, 0x74, 0x98, 0x44, 0xc0, 0x80, 0xce, 0x6a, 0x93, 0x00,
-	0xac, 0x71, 0xec, 0x2f, 0x56, 0x20, 0x53, 0x27, 0x05, 0xed, 0x42, 0x4d, 0x55, 0x4a, 0x29, 0x26,
-	0x87, 0x5a, 0xcf, 0x28, 0xd5, 0x19, 0xd5, 0x84, 0x35, 0x33, 0xd4, 0x94, 0x6e, 0x56, 0xbe, 0xda,
-	0xdf, 0x97, 0x75, 0xb3, 0x7e, 0xc7, 0x60, 0xa7, 0x6e, 0x74, 0xae, 0xce, 0xf2, 0xca, 0x98, 0x33,
-	0x07, 0x7a, 0x64, 0x0f, 0xba, 0xe8, 0xff, 0x93, 0xe2, 0xde, 0x3d, 0x4c, 0xe2, 0x8e, 0x9f, 0x88,
-	0xd9, 0xf0, 0xbe, 0x02, 0x57, 0x19, 0x27, 0xac, 0xeb, 0x8d, 0xf1, 0xff, 0xd8, 0x60, 0x9a, 0xf6,
-	0x9b, 0x0f, 0x1f, 0xab, 0xdf, 0x7c, 0xa4, 0x50, 0xbf, 0xf9, 0x25, 0x00, 0x36, 0xb7, 0x79, 0x6e,
-	0x4a, 0x95, 0xb9, 0x33, 0x95, 0x8a, 0xc1, 0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x9b, 0x20, 0x5d, 0x30,
-	0x0f, 0x4d, 0xcb, 0xfa, 0x7c, 0xfc, 0x44, 0x90, 0xa5, 0x05, 0xa7, 0x4a, 0xe9, 0xfd, 0x82, 0x05,
-	0x66, 0x55, 0x3f, 0xf4, 0x2a, 0x2f, 0x1f, 0x68, 0x15, 0x71, 0xc2, 0x64, 0xd0, 0x9d, 0x59, 0x71,
-	0xda, 0x99, 0x68, 0x27, 0x59, 0x43, 0xf0, 0xc2, 0x7b, 0xa0, 0x2a, 0xa1, 0x87, 0x32, 0x96, 0x3f,
-	0x0e, 0x67, 0x64, 0x89, 0x11, 0x79, 0x18, 0x24, 0xa2, 0x0e, 0x4e, 0x26, 0xc3, 0xe4, 0x17, 0x2d,
-	0xb8, 0x98, 0xed, 0x40, 0xbc, 0x12, 0x06, 0x5e, 0x12, 0x46, 0x75, 0x92, 0x24, 0x5e, 0xd0, 0x64,
-	0x55, 0x9e, 0xef, 0x39, 0x91, 0xbc, 0xe9, 0x8b, 0x09, 0xca, 0x3b, 0x4e, 0x14, 0x60, 0xd6, 0x8a,
-	0xba, 0x30, 0xcc, 0x03, 0xe8, 0xc5, 0x2e, 0xe8, 0x88, 0x6b, 0x23, 0x67, 0x38, 0xf4, 0x36, 0x8c,
-	0x07, 0xef, 0x63, 0xc1, 0xd0, 0xfe, 0x8a, 0x05, 0x68, 0x75, 0x87, 0x44, 0x91, 0xd7, 0x30, 0x42,
-	0xfe, 0xd9, 0xfd, 0xb5, 0xc6, 0x3d, 0xb5, 0x66, 0x01, 0x9c, 0xcc, 0xfd, 0xb5, 0xc6, 0xbf, 0xfc,
-	0xfb, 0x6b, 0x4b, 0x87, 0xbb, 0xbf, 0x16, 0xad, 0xc2, 0xb9, 0x16, 0xdf, 0xc6, 0xf1, 0x3b, 0x21,
-	0xf9, 0x9e, 0x4e, 0xd5, 0x6a, 0x38, 0x7f, 0x7f, 0x6f, 0xfa, 0xdc, 0x4a, 0x1e, 0x02, 0xce, 0x7f,
-	0xce, 0x7e, 0x0f, 0x20, 0x1e, 0xfa, 0xba, 0x90, 0x17, 0xae, 0xda, 0xd7, 0xcd, 0x61, 0x7f, 0xa9,
-	0x02, 0xa7, 0x32, 0xf7, 0xc0, 0xd0, 0x2d, 0x74, 0x6f, 0x7c, 0xec, 0x91, 0xf5, 0x77, 0x6f, 0xf7,
-	0x06, 0x8a, 0xb8, 0x0d, 0xa0, 0xe2, 0x05, 0xed, 0x4e, 0x52, 0x4c, 0xa9, 0x18, 0xde, 0x89, 0x25,
-	0x4a, 0xd0, 0x38, 0x97, 0xa0, 0x7f, 0x31, 0x67, 0x53, 0x64, 0xfc, 0x6e, 0x6a, 0x93, 0x33, 0xf4,
-	0x88, 0xdc, 0x2c, 0x9f, 0xd4, 0xd1, 0xb4, 0x95, 0x22, 0x7c, 0xc8, 0x99, 0xc9, 0x72, 0xdc, 0xa1,
-	0x56, 0x3f, 0x57, 0x82, 0x51, 0xe3, 0xa3, 0xa1, 0x9f, 0x4c, 0xd7, 0xbc, 0xb5, 0x8a, 0x7b, 0x25,
-	0x46, 0x7f, 0x46, 0x57, 0xb5, 0xe5, 0xaf, 0xf4, 0x7c, 0x6f, 0xb9, 0xdb, 0x07, 0x7b, 0xd3, 0xa7,
-	0x33, 0x05, 0x6d, 0x53, 0x25, 0x70, 0x2f, 0x7c, 0x37, 0x9c, 0xca, 0x90, 0xc9, 0x79, 0xe5, 0x75,
-	0xf3, 0x95, 0x8f, 0xec, 0xee, 0x33, 0x87, 0xec, 0x67, 0xe8, 0x90, 0x89, 0x0a, 0x15, 0xa1, 0x4f,
-	0x06, 0xf0, 0x75, 0x66, 0xf6, 0x17, 0xa5, 0x01, 0x0b, 0xd1, 0xbc, 0x0d, 0xaa, 0xed, 0xd0, 0xf7,
-	0x5c, 0x4f, 0x95, 0xcc, 0x67, 0xa5, 0x6f, 0xd6, 0x44, 0x1b, 0x56, 0x50, 0x74, 0x0f, 0x6a, 0x77,
-	0xef, 0x25, 0xfc, 0x98, 0x51, 0x1c, 0x65, 0x14, 0x75, 0xba, 0xa8, 0x8c, 0x16, 0x75, 0x8e, 0x89,
-	0x35, 0x2f, 0x64, 0xc3, 0x30, 0x53, 0x82, 0x32, 0x5b, 0x95, 0x1d, 0xb3, 0x30, 0xed, 0x18, 0x63,
-	0x01, 0xb1, 0xff, 0xed, 0x28, 0x9c, 0xcd, 0xbb, 0x8c, 0x0b, 0x7d, 0x0c, 0x86, 0x79, 0x1f, 0x8b,
-	0xb9, 0xef, 0x31, 0x8f, 0xc7, 0x55, 0x46, 0x50, 0x74, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee,
-	0x3b, 0x1b, 0x62, 0x86, 0x1c, 0x0f, 0xf7, 0x65, 0x47, 0x73, 0x5f, 0x76, 0x38, 0x77, 0xdf, 0xd9,
-	0x40, 0xbb, 0x50, 0x69, 0x7a, 0x09, 0x71, 0x84, 0x73, 0xe6, 0xce, 0xb1, 0x30, 0x27, 0x0e, 0xb7,
-	0xd2, 0xd8, 0x4f, 0xcc, 0x19, 0xa2, 0x2f, 0x5b, 0x70, 0x6a, 0x23, 0x5d, 0x01, 0x4b, 0x08, 0x4f,
-	0xe7, 0x18, 0x2e, 0x5c, 0x4b, 0x33, 0xe2, 0x17, 0x38, 0x67, 0x1a, 0x71, 0xb6, 0x3b, 0xe8, 0x53,
-	0x16, 0x8c, 0x6c, 0x7a, 0xbe, 0x71, 0xa3, 0xcd, 0x31, 0x7c, 0x9c, 0x2b, 0x8c, 0x81, 0xde, 0x71,
-	0xf0, 0xff, 0x31, 0x96, 0x9c, 0xfb, 0x69, 0xaa, 0xe1, 0xa3, 0x6a, 0xaa, 0x91, 0x47, 0xa4, 0xa9,
-	0x3e, 0x6b, 0x41, 0x4d, 0x8d, 0xb4, 0xa8, 0x24, 0xf4, 0xc1, 0x63, 0xfc, 0xe4, 0xdc, 0x23, 0xa5,
-	0xfe, 0x62, 0xcd, 0x1c, 0xbd, 0x6e, 0xc1, 0xa8, 0xf3, 0x5a, 0x27, 0x22, 0x0d, 0xb2, 0x13, 0xb6,
-	0x63, 0x51, 0xe2, 0xf7, 0x95, 0xe2, 0x3b, 0x33, 0x47, 0x99, 0x2c, 0x92, 0x9d, 0xd5, 0x76, 0x2c,
-	0x32, 0xe9, 0x75, 0x03, 0x36, 0xbb, 0x80, 0xbe, 0x4f, 0xeb, 0x71, 0x28, 0xa2, 0xd0, 0x7b, 0x5e,
-	0x6f, 0x06, 0x2a, 0x0c, 0x41, 0xe0, 0x29, 0x37, 0x0c, 0x12, 0x2f, 0xe8, 0x90, 0xd5, 0x00, 0x93,
-	0x76, 0x78, 0x33, 0x4c, 0xae, 0x84, 0x9d, 0xa0, 0x71, 0x39, 0x8a, 0xc2, 0x88, 0x95, 0x4a, 0x32,
-	0xae, 0xf9, 0x5d, 0xe8, 0x8f, 0x8a, 0xf7, 0xa3, 0x73, 0x14, 0x9b, 0x61, 0xaf, 0x04, 0xd3, 0x07,
-	0x0c, 0x36, 0x7a, 0x09, 0xc6, 0xc2, 0xa8, 0xe9, 0x04, 0xde, 0x6b, 0x66, 0xf5, 0x3f, 0x65, 0x90,
-	0xae, 0x1a, 0x30, 0x9c, 0xc2, 0x34, 0xcb, 0x42, 0x95, 0x0e, 0x28, 0x0b, 0x75, 0x11, 0x86, 0x22,
-	0xd2, 0x0e, 0xb3, 0xfb, 0x2a, 0x96, 0x70, 0xca, 0x20, 0xe8, 0x19, 0x28, 0x3b, 0x6d, 0x4f, 0x38,
-	0x17, 0xd5, 0x76, 0x71, 0x6e, 0x6d, 0x09, 0xd3, 0xf6, 0x54, 0x95, 0xba, 0xca, 0x89, 0x54, 0xa9,
-	0xa3, 0x1a, 0x53, 0x1c, 0x9f, 0x0d, 0x6b, 0x8d, 0x99, 0x3e, 0xd6, 0xb2, 0xdf, 0x28, 0xc3, 0x33,
-	0xfb, 0x2e, 0x2d, 0x1d, 0xb2, 0x6e, 0xed, 0x13, 0xb2, 0x2e, 0x87, 0xa7, 0x74, 0xd0, 0xf0, 0x94,
-	0xfb, 0x0c, 0xcf, 0xa7, 0xa8, 0xc4, 0x90, 0x55, 0x13, 0x85, 0x92, 0x38, 0x62, 0x1a, 0x41, 0xbf,
-	0x22, 0x8c, 0x42, 0x58, 0x48, 0x28, 0xd6, 0x7c, 0xe9, 0x76, 0x29, 0x55, 0x12, 0xa9, 0x52, 0x84,
-	0xc6, 0xec, 0x5b, 0xb9, 0x90, 0x8b, 0x89, 0x7e, 0x75, 0x96, 0xec, 0x5f, 0x1e, 0x82, 0xe7, 0x06,
-	0x50, 0x74, 0xe6, 0x2c, 0xb6, 0x06, 0x9c, 0xc5, 0x5f, 0xe3, 0x9f, 0xe9, 0x33, 0xb9, 0x9f, 0x09,
-	0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec, 0x04, 0x22, 0x88, 0x89, 0xdb, 0x89, 0x78, 0xfa, 0x8e,
-	0x91, 0x8d, 0xbe, 0x24, 0xda, 0xb1, 0xc2, 0xa0, 0xdb, 0x5f, 0xd7, 0xa1, 0xcb, 0x7f, 0xa4, 0xa0,
-	0x12, 0x38, 0x66, 0x62, 0x3b, 0xb7, 0xbe, 0x16, 0xe6, 0xa8, 0x04, 0xe0, 0x6c, 0xec, 0x5f, 0xb7,
-	0xe0, 0x42, 0x7f, 0x6b, 0x04, 0xbd, 0x13, 0x46, 0x37, 0x58, 0x30, 0xe5, 0x0a, 0x0b, 0x99, 0x12,
-	0x53, 0x87, 0xbd, 0xaf, 0x6e, 0xc6, 0x26, 0x0e, 0x5a, 0x80, 0x49, 0x33, 0x0a, 0x73, 0xc5, 0x88,
-	0xb5, 0x62, 0xfe, 0x92, 0xf5, 0x2c, 0x10, 0xf7, 0xe2, 0xa3, 0x19, 0x80, 0xc4, 0x4b, 0x7c, 0xc2,
-	0x9f, 0xe6, 0x13, 0x8d, 0x39, 0x14, 0xd7, 0x55, 0x2b, 0x36, 0x30, 0xec, 0xaf, 0x96, 0xf3, 0x5f,
-	0x83, 0x5b, 0xb9, 0x87, 0x99, 0xfd, 0x62, 0x6e, 0x97, 0x06, 0x90, 0xd0, 0xe5, 0x93, 0x96, 0xd0,
-	0x43, 0xfd, 0x24, 0x34, 0x5a, 0x84, 0xd3, 0xc6, 0xc5, 0xc1, 0xbc, 0x88, 0x12, 0x3f, 0x94, 0x52,
-	0x15, 0x10, 0xd7, 0x32, 0x70, 0xdc, 0xf3, 0xc4, 0x63, 0x3e, 0x55, 0x7f, 0xa3, 0x04, 0xe7, 0xfb,
-	0x6e, 0x2c, 0x4e, 0x48, 0x03, 0x99, 0x9f, 0x7f, 0xe8, 0x64, 0x3e, 0xbf, 0xf9, 0x51, 0x2a, 0x07,
-	0x7e, 0x94, 0x41, 0xd4, 0xf9, 0x1f, 0x94, 0xfa, 0x2e, 0x16, 0xba, 0x11, 0xfd, 0x6b, 0x3b, 0x92,
-	0x2f, 0xc3, 0xb8, 0xd3, 0x6e, 0x73, 0x3c, 0x96, 0x99, 0x91, 0xa9, 0xca, 0x3a, 0x67, 0x02, 0x71,
-	0x1a, 0x77, 0xa0, 0x81, 0xfd, 0x13, 0x0b, 0x6a, 0x98, 0x6c, 0x72, 0x09, 0x87, 0xee, 0x8a, 0x21,
-	0xb2, 0x8a, 0xb8, 0x1a, 0x83, 0x0e, 0x6c, 0xec, 0xb1, 0xc2, 0x0b, 0x79, 0x83, 0x7d, 0xd4, 0xba,
-	0x1a, 0xea, 0xba, 0xe1, 0x72, 0xff, 0xeb, 0x86, 0xed, 0x5f, 0xa9, 0xd1, 0xd7, 0x6b, 0x87, 0x0b,
-	0x11, 0x69, 0xc4, 0xf4, 0xfb, 0x76, 0x22, 0x5f, 0x4c, 0x12, 0xf5, 0x7d, 0x6f, 0xe1, 0x65, 0x4c,
-	0xdb, 0x53, 0xe7, 0x93, 0xa5, 0x43, 0xd5, 0xa4, 0x2c, 0x1f, 0x58, 0x93, 0xf2, 0x65, 0x18, 0x8f,
-	0xe3, 0xad, 0xb5, 0xc8, 0xdb, 0x71, 0x12, 0x72, 0x83, 0xc8, 0x82, 0x51, 0xba, 0x3e, 0x5b, 0xfd,
-	0x9a, 0x06, 0xe2, 0x34, 0x2e, 0xba, 0x0a, 0x93, 0xba, 0x32, 0x24, 0x89, 0x12, 0x96, 0xf2, 0xc8,
-	0x67, 0x82, 0x2a, 0x06, 0xa4, 0x6b, 0x49, 0x0a, 0x04, 0xdc, 0xfb, 0x0c, 0x95, 0xb9, 0xa9, 0x46,
-	0xda, 0x91, 0xe1, 0xb4, 0xcc, 0x4d, 0xd1, 0xa1, 0x7d, 0xe9, 0x79, 0x02, 0xad, 0xc0, 0x19, 0x3e,
-	0x31, 0xe6, 0xda, 0x6d, 0xe3, 0x8d, 0x46, 0xd2, 0xf7, 0x11, 0x5c, 0xed, 0x45, 0xc1, 0x79, 0xcf,
-	0xa1, 0x17, 0x61, 0x54, 0x35, 0x2f, 0x2d, 0x8a, 0xa3, 0x35, 0xe5, 0xda, 0x53, 0x64, 0x96, 0x1a,
-	0xd8, 0xc4, 0x43, 0xef, 0x87, 0x27, 0xf5, 0x5f, 0x9e, 0x42, 0xcf, 0xcf, 0x9b, 0x17, 0x45, 0xd1,
-	0x5d, 0x75, 0xdd, 0xdd, 0xd5, 0x5c, 0xb4, 0x06, 0xee, 0xf7, 0x3c, 0xda, 0x80, 0x0b, 0x0a, 0x74,
-	0x39, 0x48, 0x58, 0x92, 0x6b, 0x4c, 0xe6, 0x9d, 0x98, 0x45, 0x4e, 0x00, 0x7b, 0x4f, 0x5b, 0x50,
-	0xbf, 0x70, 0xd5, 0x4b, 0xae, 0xe5, 0x61, 0xe2, 0x65, 0xbc, 0x0f, 0x15, 0x34, 0x0b, 0x35, 0x12,
-	0x38, 0x1b, 0x3e, 0x59, 0x5d, 0x58, 0x12, 0x3b, 0x52, 0x9d, 0x1d, 0x21, 0x01, 0x58, 0xe3, 0xa8,
-	0xf8, 0xfe, 0xb1, 0x7e, 0xf1, 0xfd, 0x68, 0x0d, 0xce, 0x36, 0xdd, 0x36, 0xb5, 0x32, 0x3d, 0x97,
-	0xcc, 0xb9, 0x2c, 0xa0, 0x98, 0x7e, 0x18, 0x7e, 0x51, 0x84, 0x4a, 0x94, 0xba, 0xba, 0xb0, 0xd6,
-	0x83, 0x83, 0x73, 0x9f, 0x64, 0x81, 0xe7, 0x51, 0xb8, 0xdb, 0x9d, 0x3a, 0x93, 0x09, 0x3c, 0xa7,
-	0x8d, 0x98, 0xc3, 0xd0, 0x75, 0x40, 0x2c, 0x59, 0xf0, 0x5a, 0x92, 0xb4, 0x95, 0x59, 0x3b, 0x75,
-	0x36, 0x5d, 0x82, 0xf3, 0x4a, 0x0f, 0x06, 0xce, 0x79, 0x8a, 0x5a, 0x3d, 0x41, 0xc8, 0xa8, 0x4f,
-	0x3d, 0x99, 0xb6, 0x7a, 0x6e, 0xf2, 0x66, 0x2c, 0xe1, 0xe8, 0x43, 0x30, 0xd5, 0x89, 0x09, 0xdb,
-	0x30, 0xdf, 0x09, 0xa3, 0x6d, 0x3f, 0x74, 0x1a, 0x4b, 0xec, 0x5e, 0xe3, 0xa4, 0x3b, 0x35, 0xc5,
-	0x98, 0x5f, 0x14, 0xcf, 0x4e, 0xdd, 0xea, 0x83, 0x87, 0xfb, 0x52, 0xc8, 0xd6, 0x90, 0x3d, 0x3f,
-	0x60, 0x0d, 0xd9, 0x35, 0x38, 0x2b, 0xf5, 0xda, 0xea, 0xc2, 0x92, 0x7a, 0xe9, 0xa9, 0x0b, 0xe9,
-	0x8b, 0x12, 0x97, 0x72, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x1f, 0x5b, 0x30, 0xae, 0x24, 0xd8, 0x09,
-	0x24, 0x2d, 0xfb, 0xe9, 0xa4, 0xe5, 0xab, 0x47, 0xd7, 0x01, 0xac, 0xe7, 0x7d, 0x52, 0x6c, 0x7e,
-	0x69, 0x1c, 0x40, 0xeb, 0x09, 0xa5, 0xa2, 0xad, 0xbe, 0x2a, 0xfa, 0xb1, 0x95, 0xd1, 0x79, 0x35,
-	0x41, 0x2b, 0x8f, 0xb6, 0x26, 0x68, 0x1d, 0xce, 0xc9, 0x29, 0xc5, 0x8f, 0x94, 0xaf, 0x85, 0xb1,
-	0x12, 0xf9, 0xc6, 0xcd, 0x97, 0x4b, 0x79, 0x48, 0x38, 0xff, 0xd9, 0x94, 0x6d, 0x37, 0x72, 0xa0,
-	0x6d, 0xa7, 0xa4, 0xdc, 0xf2, 0xa6, 0xbc, 0x97, 0x36, 0x23, 0xe5, 0x96, 0xaf, 0xd4, 0xb1, 0xc6,
-	0xc9, 0x57, 0x75, 0xb5, 0x82, 0x54, 0x1d, 0x1c, 0x5a, 0xd5, 0x49, 0xa1, 0x3b, 0xda, 0x57, 0xe8,
-	0xca, 0xa3, 0xab, 0xb1, 0xbe, 0x47, 0x57, 0xef, 0x85, 0x09, 0x2f, 0xd8, 0x22, 0x91, 0x97, 0x90,
-	0x06, 0x5b, 0x0b, 0x4c, 0x20, 0x57, 0xb5, 0xa1, 0xb3, 0x94, 0x82, 0xe2, 0x0c, 0x76, 0x5a, 0x53,
-	0x4c, 0x0c, 0xa0, 0x29, 0xfa, 0xe8, 0xe7, 0x53, 0xc5, 0xe8, 0xe7, 0xd3, 0x47, 0xd7, 0xcf, 0x93,
-	0xc7, 0xaa, 0x9f, 0x51, 0x21, 0xfa, 0x79, 0x20, 0xd5, 0x67, 0x6c, 0xd2, 0xcf, 0x1e, 0xb0, 0x49,
-	0xef, 0xa7, 0x9c, 0xcf, 0x3d, 0xb4, 0x72, 0xce, 0xd7, 0xbb, 0x4f, 0xbc, 0xa9, 0x77, 0x8b, 0xd0,
-	0xbb, 0xf4, 0xfb, 0x37, 0x48, 0x3b, 0xd9, 0x9a, 0x7a, 0x8a, 0x4d, 0x56, 0xf5, 0xfd, 0x17, 0x69,
-	0x23, 0xe6, 0x30, 0xfb, 0xb3, 0x25, 0x38, 0xa7, 0xd5, 0x17, 0x15, 0x1a, 0xde, 0x26, 0x15, 0xe0,
-	0xec, 0x46, 0x78, 0x7e, 0x2a, 0x6e, 0xe4, 0xd3, 0xeb, 0x8a, 0x02, 0x0a, 0x82, 0x0d, 0x2c, 0x96,
-	0x96, 0x4e, 0x22, 0x76, 0x17, 0x51, 0x56, 0xb7, 0x2d, 0x88, 0x76, 0xac, 0x30, 0xe8, 0x48, 0xd1,
-	0xdf, 0xa2, 0x2a, 0x4a, 0xb6, 0xca, 0xfd, 0x82, 0x06, 0x61, 0x13, 0x0f, 0xbd, 0x8d, 0x33, 0x61,
-	0x72, 0x95, 0xea, 0xb7, 0x31, 0xbe, 0xf7, 0x54, 0xa2, 0x54, 0x41, 0x65, 0x77, 0x58, 0xd9, 0x84,
-	0x4a, 0x6f, 0x77, 0x58, 0x80, 0xa9, 0xc2, 0xb0, 0xff, 0xa7, 0x05, 0xe7, 0x73, 0x87, 0xe2, 0x04,
-	0x6c, 0x96, 0xdd, 0xb4, 0xcd, 0x52, 0x2f, 0x6a, 0xdf, 0x6a, 0xbc, 0x45, 0x1f, 0xfb, 0xe5, 0x3f,
-	0x58, 0x30, 0xa1, 0xf1, 0x4f, 0xe0, 0x55, 0xbd, 0xf4, 0xab, 0x16, 0xb7, 0x45, 0xaf, 0xf5, 0xbc,
-	0xdb, 0xaf, 0x95, 0x40, 0xdd, 0x3c, 0x31, 0xe7, 0x26, 0x83, 0xe5, 0xa4, 0x75, 0x61, 0x98, 0x85,
-	0x99, 0xc4, 0xc5, 0x84, 0xd0, 0xa5, 0xf9, 0xb3, 0x90, 0x15, 0x7d, 0xea, 0xc7, 0xfe, 0xc6, 0x58,
-	0x30, 0x64, 0x37, 0x65, 0xf1, 0xa2, 0xfe, 0x0d, 0x91, 0x5d, 0xad, 0x6f, 0xca, 0x12, 0xed, 0x58,
-	0x61, 0x50, 0xad, 0xea, 0xb9, 0x61, 0xb0, 0xe0, 0x3b, 0x71, 0x2c, 0x0c, 0x3d, 0xa5, 0x55, 0x97,
-	0x24, 0x00, 0x6b, 0x1c, 0x16, 0x81, 0xe2, 0xc5, 0x6d, 0xdf, 0xe9, 0x1a, 0x8e, 0x18, 0xa3, 0xfa,
-	0x97, 0x02, 0x61, 0x13, 0xcf, 0x6e, 0xc1, 0x54, 0xfa, 0x25, 0x16, 0xc9, 0x26, 0x0b, 0xff, 0x1e,
-	0x68, 0x38, 0x67, 0xa1, 0xe6, 0xb0, 0xa7, 0x96, 0x3b, 0x8e, 0x90, 0x09, 0x3a, 0x08, 0x5a, 0x02,
-	0xb0, 0xc6, 0xb1, 0xbf, 0x19, 0xce, 0xe4, 0x8c, 0xd9, 0x00, 0x51, 0x76, 0xbf, 0x54, 0x82, 0x53,
-	0xe9, 0x27, 0x63, 0x96, 0x20, 0xc9, 0xfb, 0xec, 0xc5, 0x6e, 0xb8, 0x43, 0xa2, 0x2e, 0xed, 0x86,
-	0x95, 0x49, 0x90, 0xec, 0xc1, 0xc0, 0x39, 0x4f, 0xb1, 0x4b, 0x60, 0x1a, 0xea, 0xd5, 0xe5, 0xf4,
-	0xb8, 0x5d, 0xe4, 0xf4, 0xd0, 0x23, 0x6b, 0x46, 0x06, 0x29, 0x96, 0xd8, 0xe4, 0x4f, 0x8d, 0x24,
-	0x96, 0xde, 0x31, 0xdf, 0xf1, 0xfc, 0xc4, 0x0b, 0xc4, 0x2b, 0x8b, 0x89, 0xa3, 0x8c, 0xa4, 0x95,
-	0x5e, 0x14, 0x9c, 0xf7, 0x9c, 0xfd, 0x95, 0x21, 0x50, 0x65, 0x52, 0x58, 0xe4, 0x66, 0x41, 0x71,
-	0xaf, 0x87, 0x4d, 0xb3, 0x55, 0x5f, 0x7a, 0x68, 0xbf, 0x50, 0x2a, 0xee, 0x4a, 0x33, 0x7d, 0xee,
-	0x6a, 0xc0, 0xd6, 0x35, 0x08, 0x9b, 0x78, 0xb4, 0x27, 0xbe, 0xb7, 0x43, 0xf8, 0x43, 0xc3, 0xe9,
-	0x9e, 0x2c, 0x4b, 0x00, 0xd6, 0x38, 0xac, 0xce, 0xba, 0xb7, 0xb9, 0x29, 0xfc, 0x42, 0xba, 0xce,
-	0xba, 0xb7, 0xb9, 0x89, 0x19, 0x84, 0x5f, 0x13, 0x16, 0x6e, 0x8b, 0x8d, 0x81, 0x71, 0x4d, 0x58,
-	0xb8, 0x8d, 0x19, 0x84, 0x7e, 0xa5, 0x20, 0x8c, 0x5a, 0x8e, 0xef, 0xbd, 0x46, 0x1a, 0x8a, 0x8b,
-	0xd8, 0x10, 0xa8, 0xaf, 0x74, 0xb3, 0x17, 0x05, 0xe7, 0x3d, 0x47, 0x27, 0x74, 0x3b, 0x22, 0x0d,
-	0xcf, 0x4d, 0x4c, 0x6a, 0x90, 0x9e, 0xd0, 0x6b, 0x3d, 0x18, 0x38, 0xe7, 0x29, 0x34, 0x07, 0xa7,
-	0x64, 0x99, 0x1b, 0x59, 0x1a, 0x72, 0x34, 0x5d, 0x5f, 0x0e, 0xa7, 0xc1, 0x38, 0x8b, 0x4f, 0x25,
-	0x56, 0x4b, 0x94, 0x2b, 0x66, 0xfb, 0x07, 0x43, 0x62, 0xc9, 0x32, 0xc6, 0x58, 0x61, 0xd8, 0x9f,
-	0x2c, 0x53, 0x0d, 0xdb, 0xa7, 0x2a, 0xf8, 0x89, 0xc5, 0x59, 0xa7, 0x67, 0xe4, 0xd0, 0x00, 0x33,
-	0xf2, 0xdd, 0x30, 0x76, 0x37, 0x0e, 0x03, 0x15, 0xc3, 0x5c, 0xe9, 0x1b, 0xc3, 0x6c, 0x60, 0xe5,
-	0xc7, 0x30, 0x0f, 0x17, 0x15, 0xc3, 0x3c, 0xf2, 0x90, 0x31, 0xcc, 0xff, 0xba, 0x02, 0xea, 0x1e,
-	0xd8, 0x9b, 0x24, 0xb9, 0x17, 0x46, 0xdb, 0x5e, 0xd0, 0x64, 0x25, 0x5b, 0xbe, 0x6c, 0xc9, 0xaa,
-	0x2f, 0xcb, 0x66, 0x6e, 0xef, 0x66, 0x41, 0x77, 0x79, 0xa6, 0x98, 0xcd, 0xac, 0x1b, 0x8c, 0x78,
-	0x2c, 0x4c, 0xa6, 0xba, 0x8c, 0x70, 0xf3, 0xa7, 0x7a, 0x84, 0xbe, 0x1b, 0x40, 0x3a, 0xd1, 0x37,
-	0xa5, 0x04, 0x5e, 0x2a, 0xa6, 0x7f, 0x98, 0x6c, 0x6a, 0xfb, 0x76, 0x5d, 0x31, 0xc1, 0x06, 0x43,
-	0xf4, 0x59, 0x9d, 0xf7, 0xcc, 0x93, 0x9d, 0x3e, 0x7a, 0x2c, 0x63, 0x33, 0x48, 0xd6, 0x33, 0x86,
-	0x11, 0x2f, 0x68, 0xd2, 0x79, 0x22, 0x62, 0x3d, 0xdf, 0x9a, 0x57, 0x11, 0x6c, 0x39, 0x74, 0x1a,
-	0xf3, 0x8e, 0xef, 0x04, 0x2e, 0x89, 0x96, 0x38, 0xba, 0xde, 0x18, 0x89, 0x06, 0x2c, 0x09, 0xf5,
-	0x5c, 0x56, 0x5b, 0x19, 0xe4, 0xb2, 0xda, 0x0b, 0xdf, 0x0e, 0x93, 0x3d, 0x1f, 0xf3, 0x50, 0x49,
-	0xce, 0x47, 0xa8, 0x05, 0xf6, 0xcb, 0xc3, 0x5a, 0x69, 0xdd, 0x0c, 0x1b, 0xfc, 0xee, 0xd3, 0x48,
-	0x7f, 0x51, 0x61, 0xbf, 0x16, 0x38, 0x45, 0x94, 0x9a, 0x31, 0x1a, 0xb1, 0xc9, 0x92, 0xce, 0xd1,
-	0xb6, 0x13, 0x91, 0xe0, 0xb8, 0xe7, 0xe8, 0x9a, 0x62, 0x82, 0x0d, 0x86, 0x68, 0x2b, 0x95, 0x8d,
-	0x77, 0xe5, 0xe8, 0xd9, 0x78, 0xac, 0x3e, 0x6b, 0xde, 0x15, 0x81, 0xaf, 0x5b, 0x30, 0x11, 0xa4,
-	0x66, 0x6e, 0x31, 0x01, 0xf8, 0xf9, 0xab, 0x82, 0x5f, 0x23, 0x9e, 0x6e, 0xc3, 0x19, 0xfe, 0x79,
-	0x2a, 0xad, 0x72, 0x48, 0x95, 0xa6, 0xef, 0x5e, 0x1e, 0xee, 0x77, 0xf7, 0x32, 0x0a, 0xd4, 0xa5,
-	0xf8, 0x23, 0x45, 0xd4, 0x34, 0x49, 0xdd, 0x88, 0x0f, 0x39, 0xb7, 0xe1, 0xdf, 0x31, 0x93, 0x75,
-	0x0f, 0x7f, 0x39, 0xfa, 0x78, 0xbf, 0xa4, 0x5e, 0xfb, 0xff, 0x0c, 0xc1, 0x69, 0x39, 0x22, 0x32,
-	0x79, 0x87, 0xea, 0x47, 0xce, 0x57, 0xdb, 0xca, 0x4a, 0x3f, 0x5e, 0x93, 0x00, 0xac, 0x71, 0xa8,
-	0x3d, 0xd6, 0x89, 0xc9, 0x6a, 0x9b, 0x04, 0xcb, 0xde, 0x46, 0x2c, 0x0e, 0xcc, 0xd5, 0x42, 0xb9,
-	0xa5, 0x41, 0xd8, 0xc4, 0x63, 0x19, 0xc5, 0xae, 0x59, 0xd6, 0x43, 0x67, 0x14, 0x0b, 0x43, 0x55,
-	0xc2, 0xd1, 0x8f, 0xe7, 0x5e, 0x53, 0x52, 0x4c, 0xca, 0x6b, 0x4f, 0xce, 0xd2, 0xe1, 0xee, 0x27,
-	0x41, 0xff, 0xd0, 0x82, 0x73, 0xbc, 0x55, 0x8e, 0xe4, 0xad, 0x76, 0xc3, 0x49, 0x48, 0x5c, 0xcc,
-	0xf5, 0x72, 0x39, 0xfd, 0xd3, 0x7e, 0xef, 0x3c, 0xb6, 0x38, 0xbf, 0x37, 0xe8, 0x8b, 0x16, 0x9c,
-	0xda, 0x4e, 0x95, 0xe5, 0x92, 0xaa, 0xe3, 0xa8, 0x35, 0x6b, 0x52, 0x44, 0xf5, 0x52, 0x4b, 0xb7,
-	0xc7, 0x38, 0xcb, 0xdd, 0xfe, 0x4b, 0x0b, 0x4c, 0x31, 0x7a, 0xf2, 0xd5, 0xbc, 0x0e, 0x6f, 0x0a,
-	0x4a, 0xeb, 0xb2, 0xd2, 0xd7, 0xba, 0x7c, 0x06, 0xca, 0x1d, 0xaf, 0x21, 0xf6, 0x17, 0xfa, 0x88,
-	0x7e, 0x69, 0x11, 0xd3, 0x76, 0xfb, 0x4f, 0x2b, 0xda, 0x27, 0x21, 0x32, 0x4a, 0xff, 0x5a, 0xbc,
-	0xf6, 0xa6, 0x2a, 0xd3, 0xcb, 0xdf, 0xfc, 0x66, 0x4f, 0x99, 0xde, 0x6f, 0x3d, 0x7c, 0xc2, 0x30,
-	0x1f, 0xa0, 0x7e, 0x55, 0x7a, 0x47, 0x0e, 0xc8, 0x16, 0xbe, 0x0b, 0x55, 0xba, 0x05, 0x63, 0xce,
-	0xc5, 0x6a, 0xaa, 0x53, 0xd5, 0x6b, 0xa2, 0xfd, 0xc1, 0xde, 0xf4, 0xb7, 0x1c, 0xbe, 0x5b, 0xf2,
-	0x69, 0xac, 0xe8, 0xa3, 0x18, 0x6a, 0xf4, 0x37, 0x4b, 0x6c, 0x16, 0x9b, 0xbb, 0x5b, 0x4a, 0x66,
-	0x4a, 0x40, 0x21, 0x59, 0xd3, 0x9a, 0x0f, 0x0a, 0xa0, 0x46, 0x11, 0x39, 0x53, 0xbe, 0x07, 0x5c,
-	0x53, 0xe9, 0xc5, 0x12, 0xf0, 0x60, 0x6f, 0xfa, 0xe5, 0xc3, 0x33, 0x55, 0x8f, 0x63, 0xcd, 0xc2,
-	0x50, 0x8d, 0xa3, 0xfd, 0x54, 0xa3, 0xfd, 0x7f, 0x87, 0xf4, 0xfc, 0x16, 0x15, 0x9c, 0xff, 0x5a,
-	0xcc, 0xef, 0x97, 0x32, 0xf3, 0xfb, 0x62, 0xcf, 0xfc, 0x9e, 0xa0, 0x63, 0x96, 0x53, 0x57, 0xfa,
-	0xa4, 0x8d, 0x85, 0x83, 0x7d, 0x12, 0xcc, 0x4a, 0x7a, 0xb5, 0xe3, 0x45, 0x24, 0x5e, 0x8b, 0x3a,
-	0x81, 0x17, 0x34, 0xd9, 0x94, 0xad, 0x9a, 0x56, 0x52, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0xe3, 0x4f,
-	0xe7, 0xc5, 0x1d, 0x67, 0x87, 0xcf, 0x3c, 0xa3, 0x7a, 0x66, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0xb6,
-	0xe0, 0x69, 0x49, 0x60, 0x91, 0xf8, 0x84, 0xbe, 0x10, 0x0b, 0x3d, 0x8c, 0x5a, 0x3c, 0x31, 0x80,
-	0x47, 0x8f, 0x7c, 0xbd, 0xa0, 0xf0, 0x34, 0xde, 0x07, 0x17, 0xef, 0x4b, 0xc9, 0xfe, 0x23, 0x16,
-	0x6c, 0x60, 0xd4, 0x77, 0xa0, 0xb3, 0xcf, 0xf7, 0x5a, 0x9e, 0x2c, 0xf2, 0xa9, 0x66, 0xdf, 0x32,
-	0x6d, 0xc4, 0x1c, 0x86, 0xee, 0xc1, 0xc8, 0x86, 0xe3, 0x6e, 0x87, 0x9b, 0x9b, 0xc5, 0x5c, 0xcd,
-	0x35, 0xcf, 0x89, 0xb1, 0x02, 0xdf, 0x23, 0xe2, 0xcf, 0x03, 0xfd, 0x13, 0x4b, 0x6e, 0xfc, 0x5a,
-	0x08, 0x76, 0xd3, 0xb7, 0x70, 0xdc, 0x19, 0xd7, 0x42, 0xf0, 0x0b, 0xc0, 0x25, 0xdc, 0xfe, 0xbd,
-	0x0a, 0x9c, 0x92, 0xb1, 0x63, 0xd7, 0xbc, 0x98, 0x85, 0x1b, 0x98, 0x17, 0x24, 0x94, 0x0e, 0xbc,
-	0x20, 0xe1, 0xc3, 0x00, 0x0d, 0xd2, 0xf6, 0xc3, 0x2e, 0xb3, 0x23, 0x87, 0x0e, 0x6d, 0x47, 0xaa,
-	0xad, 0xc7, 0xa2, 0xa2, 0x82, 0x0d, 0x8a, 0xa2, 0x08, 0x2a, 0xbf, 0x6f, 0x21, 0x53, 0x04, 0xd5,
-	0xb8, 0xeb, 0x6f, 0xf8, 0x64, 0xef, 0xfa, 0xf3, 0xe0, 0x14, 0xef, 0xa2, 0x2a, 0xb8, 0xf0, 0x10,
-	0x75, 0x15, 0x58, 0xca, 0xda, 0x62, 0x9a, 0x0c, 0xce, 0xd2, 0x35, 0x2f, 0xf2, 0xab, 0x9e, 0xf4,
-	0x45, 0x7e, 0x6f, 0x87, 0x9a, 0xfc, 0xce, 0xf1, 0x54, 0x4d, 0x17, 0x03, 0x92, 0xd3, 0x20, 0xc6,
-	0x1a, 0xde, 0x53, 0x3b, 0x06, 0x1e, 0x55, 0xed, 0x18, 0xfb, 0xf5, 0x32, 0xdd, 0x80, 0xf0, 0x7e,
-	0x1d, 0xfa, 0x1e, 0xcc, 0x6b, 0xc6, 0x3d, 0x98, 0x87, 0xfb, 0x9e, 0xd5, 0xcc, 0x7d, 0x99, 0x4f,
-	0xc3, 0x50, 0xe2, 0x34, 0x65, 0x86, 0x2d, 0x83, 0xae, 0x3b, 0xcd, 0x18, 0xb3, 0xd6, 0xc3, 0xd4,
-	0x8c, 0x7e, 0x19, 0xc6, 0x63, 0xaf, 0x19, 0x38, 0x49, 0x27, 0x22, 0xc6, 0xb9, 0xa3, 0x8e, 0xc0,
-	0x31, 0x81, 0x38, 0x8d, 0x8b, 0x3e, 0x65, 0x01, 0x44, 0x44, 0x6d, 0x6f, 0x86, 0x8b, 0x98, 0x43,
-	0x4a, 0x0c, 0x48, 0xba, 0x66, 0xcd, 0x0f, 0xb5, 0xad, 0x31, 0xd8, 0xda, 0x9f, 0xb1, 0x60, 0xb2,
-	0xe7, 0x29, 0xd4, 0x86, 0x61, 0x97, 0xdd, 0x56, 0x5a, 0x4c, 0x9d, 0xcb, 0xf4, 0xcd, 0xa7, 0x5c,
-	0x8f, 0xf1, 0x36, 0x2c, 0xf8, 0xd8, 0xbf, 0x32, 0x06, 0x67, 0xeb, 0x0b, 0x2b, 0xf2, 0x96, 0xa3,
-	0x63, 0x4b, 0x19, 0xce, 0xe3, 0x71, 0x72, 0x29, 0xc3, 0x7d, 0xb8, 0xfb, 0x46, 0xca, 0xb0, 0x6f,
-	0xa4, 0x0c, 0xa7, 0xf3, 0x37, 0xcb, 0x45, 0xe4, 0x6f, 0xe6, 0xf5, 0x60, 0x90, 0xfc, 0xcd, 0x63,
-	0xcb, 0x21, 0xde, 0xb7, 0x43, 0x87, 0xca, 0x21, 0x56, 0x09, 0xd6, 0x85, 0xa4, 0x8b, 0xf5, 0xf9,
-	0x54, 0xb9, 0x09, 0xd6, 0x2a, 0xb9, 0x95, 0xa7, 0x42, 0x0a, 0xa5, 0xf7, 0x4a, 0xf1, 0x1d, 0x18,
-	0x20, 0xb9, 0x55, 0x64, 0x63, 0x9a, 0x09, 0xd5, 0x23, 0x45, 0x24, 0x54, 0xe7, 0x75, 0xe7, 0xc0,
-	0x84, 0xea, 0x97, 0x61, 0xdc, 0xf5, 0xc3, 0x80, 0xac, 0x45, 0x61, 0x12, 0xba, 0xa1, 0xbc, 0xa7,
-	0x5e, 0x5f, 0xf3, 0x69, 0x02, 0x71, 0x1a, 0xb7, 0x5f, 0x36, 0x76, 0xed, 0xa8, 0xd9, 0xd8, 0xf0,
-	0x88, 0xb2, 0xb1, 0x8d, 0x7c, 0xe3, 0xd1, 0x22, 0xf2, 0x8d, 0xf3, 0xbe, 0xc8, 0x40, 0xf9, 0xc6,
-	0x6f, 0x58, 0x30, 0xee, 0xdc, 0x63, 0xfb, 0x16, 0x2e, 0x85, 0xd9, 0x69, 0xde, 0xe8, 0xa5, 0x8f,
-	0x1c, 0xc3, 0x84, 0xbd, 0x53, 0xd7, 0x6c, 0xe6, 0x27, 0x59, 0x0e, 0x88, 0xd9, 0x84, 0xd3, 0x1d,
-	0x39, 0x4a, 0x8e, 0xf2, 0x97, 0x4a, 0xf0, 0x75, 0x07, 0x76, 0x01, 0xdd, 0x03, 0x48, 0x9c, 0xa6,
-	0x98, 0xa8, 0xe2, 0xcc, 0xeb, 0x88, 0x41, 0xc3, 0xeb, 0x92, 0x9e, 0xc8, 0x9f, 0x53, 0xe4, 0xb1,
-	0xc1, 0x8a, 0xc5, 0x0a, 0x87, 0x7e, 0x4f, 0x89, 0x6a, 0x1c, 0xfa, 0x04, 0x33, 0x08, 0x35, 0x84,
-	0x22, 0xd2, 0xa4, 0xc6, 0x7d, 0x39, 0x6d, 0x08, 0x61, 0xd6, 0x8a, 0x05, 0x14, 0xbd, 0x08, 0xa3,
-	0x8e, 0xef, 0xf3, 0x5c, 0x3e, 0x12, 0x8b, 0xfb, 0x77, 0x75, 0x61, 0x5a, 0x0d, 0xc2, 0x26, 0x9e,
-	0xfd, 0x17, 0x25, 0x98, 0x3e, 0x40, 0xa6, 0xf4, 0xe4, 0x70, 0x57, 0x06, 0xce, 0xe1, 0x16, 0xb9,
-	0x48, 0xc3, 0x7d, 0x72, 0x91, 0x5e, 0x84, 0xd1, 0x84, 0x38, 0x2d, 0x11, 0x66, 0x98, 0xad, 0xb7,
-	0xb8, 0xae, 0x41, 0xd8, 0xc4, 0xa3, 0x52, 0x6c, 0xc2, 0x71, 0x5d, 0x12, 0xc7, 0x32, 0xd9, 0x48,
-	0x38, 0xc4, 0x0b, 0xcb, 0x64, 0x62, 0xe7, 0x0c, 0x73, 0x29, 0x16, 0x38, 0xc3, 0x32, 0x3b, 0xe0,
-	0xb5, 0x01, 0x07, 0xfc, 0xa7, 0x4a, 0xf0, 0xcc, 0xbe, 0xda, 0x6d, 0xe0, 0x3c, 0xb0, 0x4e, 0x4c,
-	0xa2, 0xec, 0xc4, 0xb9, 0x15, 0x93, 0x08, 0x33, 0x08, 0x1f, 0xa5, 0x76, 0x5b, 0x85, 0x88, 0x17,
-	0x9f, 0x38, 0xc9, 0x47, 0x29, 0xc5, 0x02, 0x67, 0x58, 0x3e, 0xec, 0xb4, 0xfc, 0xbd, 0x21, 0x78,
-	0x6e, 0x00, 0x1b, 0xa0, 0xc0, 0x04, 0xd3, 0x74, 0xf2, 0x74, 0xf9, 0x11, 0x25, 0x4f, 0x3f, 0xdc,
-	0x70, 0xbd, 0x99, 0x73, 0x3d, 0x50, 0x22, 0xeb, 0xcf, 0x94, 0xe0, 0x42, 0x7f, 0x83, 0x05, 0x7d,
-	0x1b, 0x9c, 0x8a, 0x54, 0x28, 0xa1, 0x99, 0x77, 0x7d, 0x86, 0xbb, 0xc3, 0x52, 0x20, 0x9c, 0xc5,
-	0x45, 0x33, 0x00, 0x6d, 0x27, 0xd9, 0x8a, 0x2f, 0xef, 0x7a, 0x71, 0x22, 0x0a, 0xd5, 0x4d, 0xf0,
-	0x43, 0x5a, 0xd9, 0x8a, 0x0d, 0x0c, 0xca, 0x8e, 0xfd, 0x5b, 0x0c, 0x6f, 0x86, 0x09, 0x7f, 0x88,
-	0x6f, 0x3d, 0xcf, 0xc8, 0x6b, 0x1d, 0x0d, 0x10, 0xce, 0xe2, 0x52, 0x76, 0x2c, 0x0c, 0x80, 0x77,
-	0x74, 0x48, 0x67, 0x6a, 0x2f, 0xab, 0x56, 0x6c, 0x60, 0x64, 0x33, 0xca, 0x2b, 0x07, 0x67, 0x94,
-	0xdb, 0xff, 0xa2, 0x04, 0xe7, 0xfb, 0x1a, 0xbc, 0x83, 0x89, 0xa9, 0xc7, 0x2f, 0xab, 0xfb, 0x21,
-	0x57, 0xd8, 0xa1, 0xb2, 0x81, 0xed, 0x3f, 0xe9, 0x33, 0xd3, 0x44, 0xa6, 0xef, 0xc3, 0x17, 0x45,
-	0x79, 0xfc, 0xc6, 0xb3, 0x27, 0xb9, 0x77, 0xe8, 0x10, 0xc9, 0xbd, 0x99, 0x8f, 0x51, 0x19, 0x50,
-	0x3b, 0xfc, 0x97, 0xa1, 0xbe, 0xc3, 0x4b, 0x37, 0xc8, 0x03, 0x1d, 0x36, 0x2c, 0xc2, 0x69, 0x2f,
-	0x60, 0x17, 0xf5, 0xd6, 0x3b, 0x1b, 0xa2, 0x76, 0x19, 0x2f, 0xd0, 0xab, 0x52, 0x6b, 0x96, 0x32,
-	0x70, 0xdc, 0xf3, 0xc4, 0x63, 0x98, 0x6c, 0xfd, 0x70, 0x43, 0x7a, 0x48, 0xc9, 0xbd, 0x0a, 0xe7,
-	0xe4, 0x50, 0x6c, 0x39, 0x11, 0x69, 0x08, 0x65, 0x1b, 0x8b, 0x64, 0xaa, 0xf3, 0x3c, 0x21, 0x2b,
-	0x07, 0x01, 0xe7, 0x3f, 0xc7, 0x6e, 0x55, 0x0d, 0xdb, 0x9e, 0x2b, 0xb6, 0x82, 0xfa, 0x56, 0x55,
-	0xda, 0x88, 0x39, 0x4c, 0xeb, 0x8b, 0xda, 0xc9, 0xe8, 0x8b, 0x0f, 0x43, 0x4d, 0x8d, 0x37, 0xcf,
-	0x85, 0x50, 0x93, 0xbc, 0x27, 0x17, 0x42, 0xcd, 0x70, 0x03, 0x8b, 0xce, 0x0e, 0xba, 0x51, 0xc9,
-	0xac, 0x56, 0xca, 0x8f, 0xb6, 0xdb, 0xef, 0x82, 0x31, 0xe5, 0x0b, 0x1c, 0xf4, 0x6e, 0x5b, 0xfb,
-	0xaf, 0x4a, 0x90, 0xb9, 0xc6, 0x0d, 0xed, 0x42, 0xad, 0x11, 0x75, 0x79, 0x63, 0x31, 0x05, 0xa2,
-	0x17, 0x25, 0x39, 0x7d, 0x66, 0xa6, 0x9a, 0xb0, 0x66, 0x86, 0x3e, 0xc6, 0x6b, 0x31, 0x0b, 0xd6,
-	0xa5, 0x22, 0x12, 0xee, 0xeb, 0x8a, 0x9e, 0x79, 0x79, 0xa5, 0x6c, 0xc3, 0x06, 0x3f, 0x94, 0x40,
-	0x6d, 0x4b, 0x5e, 0x57, 0x57, 0x8c, 0xb8, 0x53, 0xb7, 0xdf, 0x71, 0x13, 0x4d, 0xfd, 0xc5, 0x9a,
-	0x91, 0xfd, 0xc7, 0x25, 0x38, 0x9b, 0xfe, 0x00, 0xe2, 0x8c, 0xf3, 0x67, 0x2d, 0x78, 0xd2, 0x77,
-	0xe2, 0xa4, 0xde, 0x61, 0x1b, 0x85, 0xcd, 0x8e, 0xbf, 0x9a, 0x29, 0xdb, 0x7d, 0x54, 0x67, 0x8b,
-	0x22, 0x9c, 0xbd, 0xde, 0x70, 0xfe, 0xa9, 0xfb, 0x7b, 0xd3, 0x4f, 0x2e, 0xe7, 0x33, 0xc7, 0xfd,
-	0x7a, 0x85, 0x5e, 0xb7, 0xe0, 0xb4, 0xdb, 0x89, 0x22, 0x12, 0x24, 0xba, 0xab, 0xfc, 0x2b, 0xde,
-	0x2c, 0x64, 0x20, 0x75, 0x07, 0xcf, 0x52, 0x81, 0xba, 0x90, 0xe1, 0x85, 0x7b, 0xb8, 0xdb, 0x3f,
-	0x48, 0x35, 0x67, 0xdf, 0xf7, 0xfc, 0x1b, 0x76, 0x1f, 0xe3, 0x9f, 0x0d, 0xc3, 0x78, 0xaa, 0x36,
-	0x79, 0xea, 0xb0, 0xcf, 0x3a, 0xf0, 0xb0, 0x8f, 0xa5, 0xff, 0x75, 0x02, 0x79, 0x55, 0xbd, 0x91,
-	0xfe, 0xd7, 0x09, 0x08, 0xe6, 0x30, 0x31, 0xa4, 0xb8, 0x13, 0x88, 0xd3, 0x47, 0x73, 0x48, 0x71,
-	0x27, 0xc0, 0x02, 0x8a, 0x3e, 0x61, 0xc1, 0x18, 0x5b, 0x7c, 0xe2, 0x54, 0x55, 0x28, 0xb4, 0xeb,
-	0x05, 0x2c, 0x77, 0x59, 0x87, 0x9f, 0x85, 0x99, 0x9a, 0x2d, 0x38, 0xc5, 0x11, 0x7d, 0xda, 0x82,
-	0x9a, 0xba, 0x17, 0x57, 0x9c, 0x8d, 0xd4, 0x8b, 0x2d, 0xfd, 0x9e, 0x91, 0x7a, 0xaa, 0x06, 0x37,
-	0xd6, 0x8c, 0x51, 0xac, 0xce, 0x31, 0x47, 0x8e, 0xe7, 0x1c, 0x13, 0x72, 0xce, 0x30, 0xdf, 0x0e,
-	0xb5, 0x96, 0x13, 0x78, 0x9b, 0x24, 0x4e, 0xf8, 0xd1, 0xa2, 0xbc, 0xe9, 0x43, 0x36, 0x62, 0x0d,
-	0xa7, 0xc6, 0x7e, 0xcc, 0x5e, 0x2c, 0x31, 0xce, 0x02, 0x99, 0xb1, 0x5f, 0xd7, 0xcd, 0xd8, 0xc4,
-	0x31, 0x0f, 0x2e, 0xe1, 0x91, 0x1e, 0x5c, 0x8e, 0x1e, 0x70, 0x70, 0x59, 0x87, 0x73, 0x4e, 0x27,
-	0x09, 0xaf, 0x11, 0xc7, 0x9f, 0x4b, 0x12, 0xd2, 0x6a, 0x27, 0x31, 0x2f, 0x67, 0x3f, 0xc6, 0x5c,
-	0xc0, 0x2a, 0x30, 0xae, 0x4e, 0xfc, 0xcd, 0x1e, 0x24, 0x9c, 0xff, 0xac, 0xfd, 0xcf, 0x2c, 0x38,
-	0x97, 0x3b, 0x15, 0x1e, 0xdf, 0x94, 0x04, 0xfb, 0x47, 0x2a, 0x70, 0x26, 0xe7, 0xe6, 0x02, 0xd4,
-	0x35, 0x17, 0x89, 0x55, 0x44, 0x74, 0x5f, 0x3a, 0x58, 0x4d, 0x7e, 0x9b, 0x9c, 0x95, 0x71, 0xb8,
-	0x58, 0x04, 0x1d, 0x0f, 0x50, 0x3e, 0xd9, 0x78, 0x00, 0x63, 0xae, 0x0f, 0x3d, 0xd2, 0xb9, 0x5e,
-	0x39, 0x60, 0xae, 0xff, 0x9c, 0x05, 0x53, 0xad, 0x3e, 0xd7, 0x90, 0x89, 0xf3, 0xa4, 0xdb, 0xc7,
-	0x73, 0xc9, 0xd9, 0xfc, 0xd3, 0xf7, 0xf7, 0xa6, 0xfb, 0xde, 0xfe, 0x86, 0xfb, 0xf6, 0xca, 0xfe,
-	0x4a, 0x19, 0x98, 0xbd, 0xc6, 0xaa, 0x53, 0x77, 0xd1, 0xc7, 0xcd, 0x0b, 0x50, 0xac, 0xa2, 0x2e,
-	0xeb, 0xe0, 0xc4, 0xd5, 0x05, 0x2a, 0x7c, 0x04, 0xf3, 0xee, 0x53, 0xc9, 0x4a, 0xc2, 0xd2, 0x00,
-	0x92, 0xd0, 0x97, 0x37, 0xcd, 0x94, 0x8b, 0xbf, 0x69, 0xa6, 0x96, 0xbd, 0x65, 0x66, 0xff, 0x4f,
-	0x3c, 0xf4, 0x58, 0x7e, 0xe2, 0x5f, 0xb3, 0xb8, 0xe0, 0xc9, 0x7c, 0x05, 0x6d, 0x6e, 0x58, 0xfb,
-	0x98, 0x1b, 0x2f, 0x40, 0x35, 0x16, 0x92, 0x59, 0x98, 0x25, 0x3a, 0x6a, 0x4c, 0xb4, 0x63, 0x85,
-	0x41, 0x77, 0x5d, 0x8e, 0xef, 0x87, 0xf7, 0x2e, 0xb7, 0xda, 0x49, 0x57, 0x18, 0x28, 0x6a, 0x5b,
-	0x30, 0xa7, 0x20, 0xd8, 0xc0, 0x42, 0xdf, 0x00, 0x23, 0xbc, 0x8c, 0x44, 0x43, 0x78, 0x77, 0x46,
-	0xe9, 0x42, 0xe4, 0x45, 0x26, 0x1a, 0x58, 0xc2, 0xec, 0x2d, 0x30, 0xf6, 0x15, 0x0f, 0x7f, 0xdb,
-	0xf5, 0xc1, 0x17, 0x58, 0xda, 0x7f, 0xbf, 0x24, 0x58, 0xf1, 0x7d, 0x82, 0x0e, 0x23, 0xb4, 0x0e,
-	0x19, 0x46, 0xf8, 0x31, 0x00, 0x37, 0x6c, 0xb5, 0xe9, 0xce, 0x79, 0x3d, 0x2c, 0x66, 0xbb, 0xb5,
-	0xa0, 0xe8, 0xe9, 0x71, 0xd5, 0x6d, 0xd8, 0xe0, 0x97, 0x12, 0xee, 0xe5, 0x03, 0x85, 0x7b, 0x4a,
-	0xce, 0x0d, 0xed, 0x2f, 0xe7, 0xec, 0xbf, 0xb0, 0x20, 0x65, 0xf7, 0xa1, 0x36, 0x54, 0x68, 0x77,
-	0xbb, 0x42, 0x64, 0xac, 0x16, 0x67, 0x64, 0x52, 0x59, 0x2d, 0xd6, 0x21, 0xfb, 0x89, 0x39, 0x23,
-	0xe4, 0x8b, 0x90, 0xc9, 0x42, 0xb6, 0x3f, 0x26, 0xc3, 0x6b, 0x61, 0xb8, 0xcd, 0xc3, 0x89, 0x74,
-	0xf8, 0xa5, 0xfd, 0x12, 0x4c, 0xf6, 0x74, 0x8a, 0xdd, 0x90, 0x1d, 0xca, 0x3d, 0xbc, 0xb1, 0x7e,
-	0x58, 0x3d, 0x07, 0xcc, 0x61, 0xf6, 0xcf, 0x58, 0x70, 0x3a, 0x4b, 0x1e, 0xbd, 0x61, 0xc1, 0x64,
-	0x9c, 0xa5, 0x77, 0x5c, 0x63, 0xa7, 0x52, 0x23, 0x7a, 0x40, 0xb8, 0xb7, 0x13, 0xf6, 0x7f, 0x17,
-	0xfa, 0xe0, 0x8e, 0x17, 0x34, 0xc2, 0x7b, 0xca, 0x52, 0xb2, 0xfa, 0x5a, 0x4a, 0x54, 0x40, 0xb8,
-	0x5b, 0xa4, 0xd1, 0xf1, 0x7b, 0x0a, 0x48, 0xd4, 0x45, 0x3b, 0x56, 0x18, 0x2c, 0x5f, 0xbe, 0x23,
-	0x76, 0xae, 0x99, 0x49, 0xb9, 0x28, 0xda, 0xb1, 0xc2, 0x40, 0xef, 0x86, 0x31, 0xe3, 0x25, 0xe5,
-	0xbc, 0x64, 0xdb, 0x0e, 0x43, 0x87, 0xc7, 0x38, 0x85, 0x85, 0x66, 0x00, 0x94, 0xd5, 0x25, 0x75,
-	0x36, 0x73, 0xb5, 0x2b, 0xd1, 0x18, 0x63, 0x03, 0x83, 0x55, 0xa7, 0xf0, 0x3b, 0x31, 0x3b, 0x4b,
-	0x1e, 0xd6, 0xf7, 0x35, 0x2c, 0x88, 0x36, 0xac, 0xa0, 0x54, 0xbc, 0xb5, 0x9c, 0xa0, 0xe3, 0xf8,
-	0x74, 0x84, 0x84, 0xf3, 0x4c, 0x2d, 0xc3, 0x15, 0x05, 0xc1, 0x06, 0x16, 0x7d, 0xe3, 0xc4, 0x6b,
-	0x91, 0x0f, 0x84, 0x81, 0x0c, 0x69, 0xd7, 0xe1, 0x05, 0xa2, 0x1d, 0x2b, 0x0c, 0xf4, 0x12, 0x8c,
-	0x3a, 0x41, 0x83, 0x9b, 0x88, 0x61, 0x24, 0x4e, 0x29, 0xd5, 0xfe, 0xf3, 0x56, 0x4c, 0xe6, 0x34,
-	0x14, 0x9b, 0xa8, 0xd9, 0xcb, 0x2a, 0x60, 0xc0, 0xcb, 0xf0, 0xfe, 0xdc, 0x82, 0x53, 0xba, 0x26,
-	0x11, 0xf3, 0xb1, 0xa5, 0x9c, 0x8b, 0xd6, 0x81, 0xce, 0xc5, 0x74, 0xd5, 0x91, 0xd2, 0x40, 0x55,
-	0x47, 0xcc, 0x82, 0x20, 0xe5, 0x7d, 0x0b, 0x82, 0x7c, 0x03, 0x8c, 0x6c, 0x93, 0xae, 0x51, 0x39,
-	0x84, 0x69, 0x87, 0x1b, 0xbc, 0x09, 0x4b, 0x18, 0xb2, 0x61, 0xd8, 0x75, 0x54, 0x89, 0xc2, 0x31,
-	0x11, 0x9d, 0x36, 0xc7, 0x90, 0x04, 0xc4, 0x5e, 0x85, 0x9a, 0x3a, 0xd6, 0x97, 0xbe, 0x3e, 0x2b,
-	0xdf, 0xd7, 0x37, 0xd0, 0xb5, 0xfa, 0xf3, 0x1b, 0xbf, 0xf9, 0xd5, 0x67, 0xdf, 0xf2, 0xbb, 0x5f,
-	0x7d, 0xf6, 0x2d, 0x7f, 0xf4, 0xd5, 0x67, 0xdf, 0xf2, 0x89, 0xfb, 0xcf, 0x5a, 0xbf, 0x79, 0xff,
-	0x59, 0xeb, 0x77, 0xef, 0x3f, 0x6b, 0xfd, 0xd1, 0xfd, 0x67, 0xad, 0xaf, 0xdc, 0x7f, 0xd6, 0x7a,
-	0xfd, 0x3f, 0x3f, 0xfb, 0x96, 0x0f, 0xe4, 0x26, 0x51, 0xd0, 0x1f, 0xef, 0x70, 0x1b, 0xb3, 0x3b,
-	0xef, 0x62, 0x71, 0xfc, 0x74, 0x3d, 0xcf, 0x1a, 0x93, 0x78, 0x56, 0xae, 0xe7, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0x5d, 0x36, 0xf5, 0x14, 0x04, 0x01, 0x00,
+	// 13361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x90, 0x24, 0xc9,
+	0x55, 0x98, 0xaa, 0x3f, 0x66, 0xba, 0xdf, 0x7c, 0xed, 0xe6, 0xee, 0xde, 0xcd, 0xee, 0x7d, 0xcc,
+	0x52, 0x07, 0xa7, 0xc3, 0x3a, 0xcd, 0x70, 0xab, 0x3b, 0x71, 0xe6, 0xe0, 0xd0, 0x7c, 0xec, 0xc7,
+	0xec, 0xce, 0xec, 0x8c, 0x72, 0x66, 0x77, 0xa5, 0x93, 0x4e, 0x52, 0x4d, 0x75, 0xce, 0x4c, 0xed,
+	0x54, 0x57, 0xf5, 0x55, 0x55, 0xcf, 0x6e, 0x1f, 0x42, 0x48, 0x48, 0x02, 0x09, 0x21, 0xe9, 0x8c,
+	0x1c, 0xb6, 0xb0, 0x2d, 0x21, 0x02, 0x6c, 0x13, 0x38, 0x08, 0x30, 0xfc, 0x30, 0x61, 0x20, 0x88,
+	0x40, 0x84, 0x02, 0xc2, 0xc6, 0x60, 0x82, 0x0f, 0x19, 0xc3, 0x58, 0x5a, 0xdb, 0x61, 0xc2, 0x11,
+	0x26, 0x02, 0xdb, 0x3f, 0x1c, 0x6b, 0x87, 0xc3, 0x91, 0xdf, 0x59, 0xd5, 0xd5, 0x33, 0x3d, 0xdb,
+	0x35, 0xbb, 0x8b, 0x7c, 0xff, 0xba, 0xf3, 0xbd, 0xca, 0x97, 0x95, 0x95, 0xf9, 0xde, 0xcb, 0x97,
+	0xef, 0x03, 0x96, 0xb6, 0xbc, 0x64, 0xbb, 0xbd, 0x31, 0xed, 0x86, 0xcd, 0x19, 0x27, 0xda, 0x0a,
+	0x5b, 0x51, 0x78, 0x93, 0xfd, 0x78, 0xbb, 0xdb, 0x98, 0xd9, 0x7d, 0xc7, 0x4c, 0x6b, 0x67, 0x6b,
+	0xc6, 0x69, 0x79, 0xf1, 0x8c, 0xd3, 0x6a, 0xf9, 0x9e, 0xeb, 0x24, 0x5e, 0x18, 0xcc, 0xec, 0x3e,
+	0xe7, 0xf8, 0xad, 0x6d, 0xe7, 0xb9, 0x99, 0x2d, 0x12, 0x90, 0xc8, 0x49, 0x48, 0x63, 0xba, 0x15,
+	0x85, 0x49, 0x88, 0xbe, 0x57, 0xf7, 0x36, 0x2d, 0x7b, 0x63, 0x3f, 0x3e, 0xe8, 0x36, 0xa6, 0x77,
+	0xdf, 0x31, 0xdd, 0xda, 0xd9, 0x9a, 0xa6, 0xbd, 0x4d, 0x1b, 0xbd, 0x4d, 0xcb, 0xde, 0xce, 0xbc,
+	0xdd, 0x18, 0xcb, 0x56, 0xb8, 0x15, 0xce, 0xb0, 0x4e, 0x37, 0xda, 0x9b, 0xec, 0x1f, 0xfb, 0xc3,
+	0x7e, 0x71, 0x62, 0x67, 0xec, 0x9d, 0x17, 0xe3, 0x69, 0x2f, 0xa4, 0xc3, 0x9b, 0x71, 0xc3, 0x88,
+	0xcc, 0xec, 0x76, 0x0d, 0xe8, 0xcc, 0x25, 0x8d, 0x43, 0x6e, 0x27, 0x24, 0x88, 0xbd, 0x30, 0x88,
+	0xdf, 0x4e, 0x87, 0x40, 0xa2, 0x5d, 0x12, 0x99, 0xaf, 0x67, 0x20, 0xe4, 0xf5, 0xf4, 0xbc, 0xee,
+	0xa9, 0xe9, 0xb8, 0xdb, 0x5e, 0x40, 0xa2, 0x8e, 0x7e, 0xbc, 0x49, 0x12, 0x27, 0xef, 0xa9, 0x99,
+	0x5e, 0x4f, 0x45, 0xed, 0x20, 0xf1, 0x9a, 0xa4, 0xeb, 0x81, 0x77, 0x1e, 0xf4, 0x40, 0xec, 0x6e,
+	0x93, 0xa6, 0xd3, 0xf5, 0xdc, 0x3b, 0x7a, 0x3d, 0xd7, 0x4e, 0x3c, 0x7f, 0xc6, 0x0b, 0x92, 0x38,
+	0x89, 0xb2, 0x0f, 0xd9, 0xff, 0xc8, 0x82, 0xb1, 0xd9, 0x1b, 0x6b, 0xb3, 0xed, 0x64, 0x7b, 0x3e,
+	0x0c, 0x36, 0xbd, 0x2d, 0xf4, 0x02, 0x8c, 0xb8, 0x7e, 0x3b, 0x4e, 0x48, 0x74, 0xd5, 0x69, 0x92,
+	0x49, 0xeb, 0xac, 0xf5, 0x4c, 0x7d, 0xee, 0xc4, 0xef, 0xec, 0x4d, 0xbd, 0xe5, 0xce, 0xde, 0xd4,
+	0xc8, 0xbc, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0x27, 0x0c, 0x47, 0xa1, 0x4f, 0x66, 0xf1, 0xd5, 0xc9,
+	0x12, 0x7b, 0x64, 0x42, 0x3c, 0x32, 0x8c, 0x79, 0x33, 0x96, 0x70, 0x8a, 0xda, 0x8a, 0xc2, 0x4d,
+	0xcf, 0x27, 0x93, 0xe5, 0x34, 0xea, 0x2a, 0x6f, 0xc6, 0x12, 0x6e, 0xff, 0x64, 0x09, 0x26, 0x66,
+	0x5b, 0xad, 0x4b, 0xc4, 0xf1, 0x93, 0xed, 0xb5, 0xc4, 0x49, 0xda, 0x31, 0xda, 0x82, 0xa1, 0x98,
+	0xfd, 0x12, 0x63, 0x5b, 0x11, 0x4f, 0x0f, 0x71, 0xf8, 0xdd, 0xbd, 0xa9, 0xef, 0xcb, 0x5b, 0xd1,
+	0x5b, 0x5e, 0x12, 0xb6, 0xe2, 0xb7, 0x93, 0x60, 0xcb, 0x0b, 0x08, 0x9b, 0x97, 0x6d, 0xd6, 0xeb,
+	0xb4, 0xd9, 0xf9, 0x7c, 0xd8, 0x20, 0x58, 0x74, 0x4f, 0xc7, 0xd9, 0x24, 0x71, 0xec, 0x6c, 0x91,
+	0xec, 0x2b, 0x2d, 0xf3, 0x66, 0x2c, 0xe1, 0x28, 0x02, 0xe4, 0x3b, 0x71, 0xb2, 0x1e, 0x39, 0x41,
+	0xec, 0xd1, 0x25, 0xbd, 0xee, 0x35, 0xf9, 0xdb, 0x8d, 0x9c, 0xfb, 0x5b, 0xd3, 0xfc, 0xc3, 0x4c,
+	0x9b, 0x1f, 0x46, 0xef, 0x03, 0xba, 0x6e, 0xa6, 0x77, 0x9f, 0x9b, 0xa6, 0x4f, 0xcc, 0x3d, 0x72,
+	0x67, 0x6f, 0x0a, 0x2d, 0x75, 0xf5, 0x84, 0x73, 0x7a, 0xb7, 0xff, 0xa4, 0x04, 0x30, 0xdb, 0x6a,
+	0xad, 0x46, 0xe1, 0x4d, 0xe2, 0x26, 0xe8, 0x43, 0x50, 0xa3, 0x5d, 0x35, 0x9c, 0xc4, 0x61, 0x13,
+	0x33, 0x72, 0xee, 0xbb, 0xfa, 0x23, 0xbc, 0xb2, 0x41, 0x9f, 0x5f, 0x26, 0x89, 0x33, 0x87, 0xc4,
+	0x0b, 0x82, 0x6e, 0xc3, 0xaa, 0x57, 0x14, 0x40, 0x25, 0x6e, 0x11, 0x97, 0x4d, 0xc6, 0xc8, 0xb9,
+	0xa5, 0xe9, 0x41, 0x76, 0xfa, 0xb4, 0x1e, 0xf9, 0x5a, 0x8b, 0xb8, 0x73, 0xa3, 0x82, 0x72, 0x85,
+	0xfe, 0xc3, 0x8c, 0x0e, 0xda, 0x55, 0x1f, 0x9a, 0x4f, 0xe4, 0xd5, 0xc2, 0x28, 0xb2, 0x5e, 0xe7,
+	0xc6, 0xd3, 0x0b, 0x47, 0x7e, 0x77, 0xfb, 0x2f, 0x2c, 0x18, 0xd7, 0xc8, 0x4b, 0x5e, 0x9c, 0xa0,
+	0xf7, 0x77, 0x4d, 0xee, 0x74, 0x7f, 0x93, 0x4b, 0x9f, 0x66, 0x53, 0x7b, 0x4c, 0x10, 0xab, 0xc9,
+	0x16, 0x63, 0x62, 0x9b, 0x50, 0xf5, 0x12, 0xd2, 0x8c, 0x27, 0x4b, 0x67, 0xcb, 0xcf, 0x8c, 0x9c,
+	0xbb, 0x54, 0xd4, 0x7b, 0xce, 0x8d, 0x09, 0xa2, 0xd5, 0x45, 0xda, 0x3d, 0xe6, 0x54, 0xec, 0xaf,
+	0x4e, 0x98, 0xef, 0x47, 0x27, 0x1c, 0x3d, 0x07, 0x23, 0x71, 0xd8, 0x8e, 0x5c, 0x82, 0x49, 0x2b,
+	0xa4, 0x1b, 0xab, 0x4c, 0x97, 0x3b, 0xdd, 0xf0, 0x6b, 0xba, 0x19, 0x9b, 0x38, 0xe8, 0x73, 0x16,
+	0x8c, 0x36, 0x48, 0x9c, 0x78, 0x01, 0xa3, 0x2f, 0x07, 0xbf, 0x3e, 0xf0, 0xe0, 0x65, 0xe3, 0x82,
+	0xee, 0x7c, 0xee, 0xa4, 0x78, 0x91, 0x51, 0xa3, 0x31, 0xc6, 0x29, 0xfa, 0x94, 0x71, 0x35, 0x48,
+	0xec, 0x46, 0x5e, 0x8b, 0xfe, 0x17, 0xac, 0x45, 0x31, 0xae, 0x05, 0x0d, 0xc2, 0x26, 0x1e, 0x0a,
+	0xa0, 0x4a, 0x19, 0x53, 0x3c, 0x59, 0x61, 0xe3, 0x5f, 0x1c, 0x6c, 0xfc, 0x62, 0x52, 0x29, 0xcf,
+	0xd3, 0xb3, 0x4f, 0xff, 0xc5, 0x98, 0x93, 0x41, 0xff, 0xd2, 0x82, 0x49, 0xc1, 0x38, 0x31, 0xe1,
+	0x13, 0x7a, 0x63, 0xdb, 0x4b, 0x88, 0xef, 0xc5, 0xc9, 0x64, 0x95, 0x8d, 0xe1, 0xfd, 0x83, 0x8d,
+	0x61, 0x3e, 0xdd, 0x3b, 0x26, 0x71, 0x12, 0x79, 0x2e, 0xc5, 0xa1, 0xcb, 0x60, 0xee, 0xac, 0x18,
+	0xd6, 0xe4, 0x7c, 0x8f, 0x51, 0xe0, 0x9e, 0xe3, 0x43, 0x5f, 0xb0, 0xe0, 0x4c, 0xe0, 0x34, 0x49,
+	0xdc, 0x72, 0x58, 0xc7, 0x0c, 0x3c, 0xe7, 0x3b, 0xee, 0x0e, 0x1b, 0xfe, 0x10, 0x1b, 0xfe, 0x4c,
+	0x7f, 0x5b, 0xe3, 0x62, 0x14, 0xb6, 0x5b, 0x57, 0xbc, 0xa0, 0x31, 0x67, 0x8b, 0x11, 0x9d, 0xb9,
+	0xda, 0xb3, 0x6b, 0xbc, 0x0f, 0x59, 0xf4, 0x33, 0x16, 0x1c, 0x0f, 0xa3, 0xd6, 0xb6, 0x13, 0x90,
+	0x86, 0x84, 0xc6, 0x93, 0xc3, 0x6c, 0x9f, 0x7e, 0x60, 0xb0, 0xb9, 0x5c, 0xc9, 0x76, 0xbb, 0x1c,
+	0x06, 0x5e, 0x12, 0x46, 0x6b, 0x24, 0x49, 0xbc, 0x60, 0x2b, 0x9e, 0x3b, 0x75, 0x67, 0x6f, 0xea,
+	0x78, 0x17, 0x16, 0xee, 0x1e, 0x0f, 0xfa, 0x01, 0x18, 0x89, 0x3b, 0x81, 0x7b, 0xc3, 0x0b, 0x1a,
+	0xe1, 0xad, 0x78, 0xb2, 0x56, 0xc4, 0x5e, 0x5f, 0x53, 0x1d, 0x8a, 0xdd, 0xaa, 0x09, 0x60, 0x93,
+	0x5a, 0xfe, 0x87, 0xd3, 0xeb, 0xae, 0x5e, 0xf4, 0x87, 0xd3, 0x8b, 0x69, 0x1f, 0xb2, 0xe8, 0x47,
+	0x2d, 0x18, 0x8b, 0xbd, 0xad, 0xc0, 0x49, 0xda, 0x11, 0xb9, 0x42, 0x3a, 0xf1, 0x24, 0xb0, 0x81,
+	0x5c, 0x1e, 0x70, 0x56, 0x8c, 0x2e, 0xe7, 0x4e, 0x89, 0x31, 0x8e, 0x99, 0xad, 0x31, 0x4e, 0xd3,
+	0xcd, 0xdb, 0x95, 0x7a, 0x59, 0x8f, 0x3c, 0xc0, 0x5d, 0xa9, 0x77, 0x40, 0xcf, 0xf1, 0xa1, 0x77,
+	0xc1, 0x31, 0xde, 0xa4, 0x3e, 0x43, 0x3c, 0x39, 0xca, 0x58, 0xf8, 0xc9, 0x3b, 0x7b, 0x53, 0xc7,
+	0xd6, 0x32, 0x30, 0xdc, 0x85, 0x8d, 0x5e, 0x83, 0xa9, 0x16, 0x89, 0x9a, 0x5e, 0xb2, 0x12, 0xf8,
+	0x1d, 0x29, 0x18, 0xdc, 0xb0, 0x45, 0x1a, 0x62, 0x38, 0xf1, 0xe4, 0xd8, 0x59, 0xeb, 0x99, 0xda,
+	0xdc, 0x5b, 0xc5, 0x30, 0xa7, 0x56, 0xf7, 0x47, 0xc7, 0x07, 0xf5, 0x87, 0xbe, 0x66, 0xc1, 0x19,
+	0x83, 0x7f, 0xaf, 0x91, 0x68, 0xd7, 0x73, 0xc9, 0xac, 0xeb, 0x86, 0xed, 0x20, 0x89, 0x27, 0xc7,
+	0xd9, 0x9c, 0x6f, 0x1c, 0x85, 0x34, 0x49, 0x93, 0xd2, 0x8b, 0xb8, 0x27, 0x4a, 0x8c, 0xf7, 0x19,
+	0x29, 0x95, 0x3b, 0x74, 0xa7, 0x51, 0x9d, 0x2c, 0x6c, 0x27, 0x93, 0x13, 0x69, 0xb9, 0xb3, 0xa6,
+	0x41, 0xd8, 0xc4, 0x43, 0x2f, 0xc3, 0x78, 0x2b, 0x6a, 0x07, 0x64, 0x7d, 0x3b, 0x22, 0xf1, 0x76,
+	0xe8, 0x37, 0x26, 0x8f, 0xb1, 0x27, 0x1f, 0x11, 0x4f, 0x8e, 0xaf, 0xa6, 0xa0, 0x38, 0x83, 0x6d,
+	0xff, 0x6e, 0x09, 0x8e, 0x65, 0x55, 0x1a, 0xf4, 0x4f, 0x2c, 0x98, 0xb8, 0x79, 0x2b, 0x59, 0x0f,
+	0x77, 0x48, 0x10, 0xcf, 0x75, 0xa8, 0xe0, 0x61, 0xc2, 0x7c, 0xe4, 0x9c, 0x5b, 0xac, 0xf2, 0x34,
+	0x7d, 0x39, 0x4d, 0xe5, 0x7c, 0x90, 0x44, 0x9d, 0xb9, 0x47, 0xc5, 0xd8, 0x27, 0x2e, 0xdf, 0x58,
+	0x37, 0xa1, 0x38, 0x3b, 0xa8, 0x33, 0x9f, 0xb1, 0xe0, 0x64, 0x5e, 0x17, 0xe8, 0x18, 0x94, 0x77,
+	0x48, 0x87, 0xab, 0xf6, 0x98, 0xfe, 0x44, 0xaf, 0x42, 0x75, 0xd7, 0xf1, 0xdb, 0x44, 0xe8, 0x9d,
+	0x17, 0x07, 0x7b, 0x11, 0x35, 0x32, 0xcc, 0x7b, 0xfd, 0x9e, 0xd2, 0x8b, 0x96, 0xfd, 0xfb, 0x65,
+	0x18, 0x31, 0xd6, 0xca, 0x7d, 0xd0, 0xa5, 0xc3, 0x94, 0x2e, 0xbd, 0x5c, 0xd8, 0x32, 0xef, 0xa9,
+	0x4c, 0xdf, 0xca, 0x28, 0xd3, 0x2b, 0xc5, 0x91, 0xdc, 0x57, 0x9b, 0x46, 0x09, 0xd4, 0xc3, 0x16,
+	0x3d, 0x73, 0x52, 0xa5, 0xac, 0x52, 0xc4, 0x27, 0x5c, 0x91, 0xdd, 0xcd, 0x8d, 0xdd, 0xd9, 0x9b,
+	0xaa, 0xab, 0xbf, 0x58, 0x13, 0xb2, 0xff, 0xd4, 0x82, 0x93, 0xc6, 0x18, 0xe7, 0xc3, 0xa0, 0xc1,
+	0x4e, 0x4e, 0xe8, 0x2c, 0x54, 0x92, 0x4e, 0x4b, 0x9e, 0x6b, 0xd5, 0x4c, 0xad, 0x77, 0x5a, 0x04,
+	0x33, 0xc8, 0xc3, 0x7e, 0xec, 0xbb, 0x09, 0xa7, 0x52, 0x6c, 0xad, 0x45, 0x82, 0x06, 0x09, 0xdc,
+	0x0e, 0x7d, 0xb3, 0x40, 0x9f, 0xd8, 0xd5, 0x9b, 0xb1, 0xa3, 0x3a, 0x83, 0xa0, 0x19, 0xa8, 0x2b,
+	0x61, 0x2c, 0xde, 0xed, 0xb8, 0x40, 0xab, 0x6b, 0x09, 0xae, 0x71, 0xec, 0x2f, 0x58, 0xf0, 0x48,
+	0x3e, 0x0f, 0x45, 0x4f, 0xc3, 0x10, 0x37, 0xa0, 0x08, 0x7a, 0xfa, 0xf3, 0xb3, 0x56, 0x2c, 0xa0,
+	0x87, 0xa6, 0xa9, 0x5e, 0xa3, 0xdc, 0xeb, 0x35, 0xec, 0x3f, 0xb2, 0xe0, 0xdb, 0xfb, 0xe1, 0xec,
+	0x47, 0x37, 0xc6, 0x35, 0x38, 0xd5, 0x20, 0x9b, 0x4e, 0xdb, 0x4f, 0xd2, 0x14, 0xc5, 0xa0, 0x9f,
+	0x10, 0x0f, 0x9f, 0x5a, 0xc8, 0x43, 0xc2, 0xf9, 0xcf, 0xda, 0xff, 0xc1, 0x62, 0xb6, 0x0e, 0xf9,
+	0x5a, 0xf7, 0xe1, 0xdc, 0x19, 0xa4, 0xcf, 0x9d, 0x8b, 0x85, 0xb1, 0x84, 0x1e, 0x07, 0xcf, 0xcf,
+	0x5a, 0x70, 0xc6, 0xc0, 0x5a, 0x76, 0x12, 0x77, 0xfb, 0xfc, 0xed, 0x56, 0x44, 0xe2, 0x98, 0x2e,
+	0xa9, 0x27, 0x0c, 0xd6, 0x3f, 0x37, 0x22, 0x7a, 0x28, 0x5f, 0x21, 0x1d, 0x2e, 0x07, 0x9e, 0x85,
+	0x1a, 0xdf, 0xdf, 0x61, 0x24, 0x3e, 0x92, 0x7a, 0xb7, 0x15, 0xd1, 0x8e, 0x15, 0x06, 0xb2, 0x61,
+	0x88, 0xf1, 0x77, 0xca, 0xef, 0xa8, 0x26, 0x04, 0xf4, 0xbb, 0x5f, 0x67, 0x2d, 0x58, 0x40, 0xec,
+	0x38, 0x35, 0x9c, 0xd5, 0x88, 0xb0, 0xf5, 0xd0, 0xb8, 0xe0, 0x11, 0xbf, 0x11, 0xd3, 0x33, 0xb1,
+	0x13, 0x04, 0x61, 0x22, 0x8e, 0xb7, 0xc6, 0x99, 0x78, 0x56, 0x37, 0x63, 0x13, 0x87, 0x12, 0xf5,
+	0x9d, 0x0d, 0xe2, 0xf3, 0x19, 0x15, 0x44, 0x97, 0x58, 0x0b, 0x16, 0x10, 0xfb, 0x4e, 0x89, 0x9d,
+	0xbe, 0x15, 0xf7, 0x24, 0xf7, 0xc3, 0x74, 0x13, 0xa5, 0xc4, 0xcd, 0x6a, 0x71, 0xbc, 0x9f, 0xf4,
+	0x36, 0xdf, 0xbc, 0x9e, 0x91, 0x38, 0xb8, 0x50, 0xaa, 0xfb, 0x9b, 0x70, 0xbe, 0x54, 0x86, 0xa9,
+	0xf4, 0x03, 0x5d, 0x02, 0x8b, 0xea, 0x6d, 0x06, 0xa1, 0xac, 0xa1, 0xd3, 0xc0, 0xc7, 0x26, 0x5e,
+	0x0f, 0x9e, 0x5f, 0x3a, 0x4a, 0x9e, 0x6f, 0x8a, 0xa4, 0xf2, 0x01, 0x22, 0x69, 0x5e, 0xcd, 0x7a,
+	0x85, 0x61, 0xbe, 0xad, 0xcb, 0x3a, 0x7a, 0x7a, 0x35, 0x0a, 0xb7, 0xd8, 0x9e, 0xdb, 0x25, 0x54,
+	0x3b, 0xcd, 0xb1, 0x7c, 0x9e, 0x85, 0x4a, 0x9c, 0x90, 0xd6, 0x64, 0x35, 0xcd, 0x83, 0xd7, 0x12,
+	0xd2, 0xc2, 0x0c, 0x82, 0xbe, 0x0f, 0x26, 0x12, 0x27, 0xda, 0x22, 0x49, 0x44, 0x76, 0x3d, 0x66,
+	0x31, 0x67, 0x87, 0xff, 0xfa, 0xdc, 0x09, 0xaa, 0xfe, 0xad, 0x33, 0x10, 0x96, 0x20, 0x9c, 0xc5,
+	0xb5, 0xff, 0x6b, 0x09, 0x1e, 0x4d, 0x7f, 0x1f, 0x2d, 0xa1, 0xbf, 0x3f, 0x25, 0xa1, 0xdf, 0x66,
+	0x4a, 0xe8, 0xbb, 0x7b, 0x53, 0x8f, 0xf5, 0x78, 0xec, 0x6f, 0x8c, 0x00, 0x47, 0x17, 0x33, 0x5f,
+	0x68, 0xa6, 0xeb, 0x0b, 0x3d, 0xd1, 0xe3, 0x1d, 0x33, 0x9a, 0xd5, 0xd3, 0x30, 0x14, 0x11, 0x27,
+	0x0e, 0x03, 0xf1, 0x9d, 0xd4, 0x66, 0xc0, 0xac, 0x15, 0x0b, 0xa8, 0xfd, 0x87, 0xf5, 0xec, 0x64,
+	0x5f, 0xe4, 0xb7, 0x00, 0x61, 0x84, 0x3c, 0xa8, 0xb0, 0x23, 0x2e, 0x67, 0x3b, 0x57, 0x06, 0xdb,
+	0xa2, 0x54, 0xc4, 0xa8, 0xae, 0xe7, 0x6a, 0xf4, 0xab, 0xd1, 0x26, 0xcc, 0x48, 0xa0, 0xdb, 0x50,
+	0x73, 0xe5, 0x61, 0xb2, 0x54, 0x84, 0x41, 0x57, 0x1c, 0x25, 0x35, 0xc5, 0x51, 0x2a, 0x0b, 0xd4,
+	0x09, 0x54, 0x51, 0x43, 0x04, 0xca, 0x5b, 0x5e, 0x22, 0x3e, 0xeb, 0x80, 0xb6, 0x85, 0x8b, 0x9e,
+	0xf1, 0x8a, 0xc3, 0x54, 0x40, 0x5d, 0xf4, 0x12, 0x4c, 0xfb, 0x47, 0x9f, 0xb4, 0x60, 0x24, 0x76,
+	0x9b, 0xab, 0x51, 0xb8, 0xeb, 0x35, 0x48, 0x24, 0x94, 0xdd, 0x01, 0xd9, 0xde, 0xda, 0xfc, 0xb2,
+	0xec, 0x50, 0xd3, 0xe5, 0xb6, 0x1e, 0x0d, 0xc1, 0x26, 0x5d, 0x7a, 0x08, 0x7c, 0x54, 0xbc, 0xfb,
+	0x02, 0x71, 0xd9, 0x8e, 0x93, 0x36, 0x03, 0xb6, 0x52, 0x06, 0x56, 0xfe, 0x17, 0xda, 0xee, 0x0e,
+	0xdd, 0x6f, 0x7a, 0x40, 0x8f, 0xdd, 0xd9, 0x9b, 0x7a, 0x74, 0x3e, 0x9f, 0x26, 0xee, 0x35, 0x18,
+	0x36, 0x61, 0xad, 0xb6, 0xef, 0x63, 0xf2, 0x5a, 0x9b, 0x30, 0xf3, 0x61, 0x01, 0x13, 0xb6, 0xaa,
+	0x3b, 0xcc, 0x4c, 0x98, 0x01, 0xc1, 0x26, 0x5d, 0xf4, 0x1a, 0x0c, 0x35, 0x9d, 0x24, 0xf2, 0x6e,
+	0x0b, 0x9b, 0xe1, 0x80, 0xc7, 0xb1, 0x65, 0xd6, 0x97, 0x26, 0xce, 0xb4, 0x00, 0xde, 0x88, 0x05,
+	0x21, 0xd4, 0x84, 0x6a, 0x93, 0x44, 0x5b, 0x64, 0xb2, 0x56, 0xc4, 0x65, 0xca, 0x32, 0xed, 0x4a,
+	0x13, 0xac, 0x53, 0xcd, 0x8b, 0xb5, 0x61, 0x4e, 0x05, 0xbd, 0x0a, 0xb5, 0x98, 0xf8, 0xc4, 0xa5,
+	0xba, 0x53, 0x9d, 0x51, 0x7c, 0x47, 0x9f, 0x7a, 0x24, 0x55, 0x5a, 0xd6, 0xc4, 0xa3, 0x7c, 0x83,
+	0xc9, 0x7f, 0x58, 0x75, 0x49, 0x27, 0xb0, 0xe5, 0xb7, 0xb7, 0xbc, 0x60, 0x12, 0x8a, 0x98, 0xc0,
+	0x55, 0xd6, 0x57, 0x66, 0x02, 0x79, 0x23, 0x16, 0x84, 0xec, 0xff, 0x6c, 0x01, 0x4a, 0x33, 0xb5,
+	0xfb, 0xa0, 0x30, 0xbf, 0x96, 0x56, 0x98, 0x97, 0x8a, 0xd4, 0x68, 0x7a, 0xe8, 0xcc, 0xbf, 0x56,
+	0x87, 0x8c, 0x38, 0xb8, 0x4a, 0xe2, 0x84, 0x34, 0xde, 0x64, 0xe1, 0x6f, 0xb2, 0xf0, 0x37, 0x59,
+	0xb8, 0x62, 0xe1, 0x1b, 0x19, 0x16, 0xfe, 0xb2, 0xb1, 0xeb, 0xb5, 0x57, 0xc7, 0x07, 0x95, 0xdb,
+	0x87, 0x39, 0x02, 0x03, 0x81, 0x72, 0x82, 0xcb, 0x6b, 0x2b, 0x57, 0x73, 0x79, 0xf6, 0x07, 0xd3,
+	0x3c, 0x7b, 0x50, 0x12, 0xff, 0x3f, 0x70, 0xe9, 0xaf, 0x59, 0xf0, 0xd6, 0x34, 0xf7, 0x92, 0x2b,
+	0x67, 0x71, 0x2b, 0x08, 0x23, 0xb2, 0xe0, 0x6d, 0x6e, 0x92, 0x88, 0x04, 0x2e, 0x89, 0xfb, 0xb0,
+	0x5f, 0x3d, 0x0f, 0xa3, 0x37, 0xe3, 0x30, 0x58, 0x0d, 0xbd, 0x40, 0xb0, 0x20, 0x7a, 0xe2, 0x38,
+	0x76, 0x67, 0x6f, 0x6a, 0x94, 0xce, 0xa8, 0x6c, 0xc7, 0x29, 0x2c, 0x34, 0x0f, 0xc7, 0x6f, 0xbe,
+	0xb6, 0xea, 0x24, 0x86, 0xa9, 0x41, 0x1a, 0x05, 0xd8, 0xe5, 0xdd, 0xe5, 0x77, 0x67, 0x80, 0xb8,
+	0x1b, 0xdf, 0xfe, 0x87, 0x25, 0x38, 0x9d, 0x79, 0x91, 0xd0, 0xf7, 0xc3, 0x76, 0x42, 0xcf, 0x44,
+	0xe8, 0xcb, 0x16, 0x1c, 0x6b, 0xa6, 0xad, 0x19, 0xb1, 0xb0, 0xbb, 0xbf, 0xa7, 0x30, 0x19, 0x91,
+	0x31, 0x97, 0xcc, 0x4d, 0x8a, 0x19, 0x3a, 0x96, 0x01, 0xc4, 0xb8, 0x6b, 0x2c, 0xe8, 0x55, 0xa8,
+	0x37, 0x9d, 0xdb, 0xd7, 0x5a, 0x0d, 0x27, 0x91, 0x67, 0xd5, 0xde, 0x26, 0x86, 0x76, 0xe2, 0xf9,
+	0xd3, 0xdc, 0x5f, 0x68, 0x7a, 0x31, 0x48, 0x56, 0xa2, 0xb5, 0x24, 0xf2, 0x82, 0x2d, 0x6e, 0x6d,
+	0x5d, 0x96, 0xdd, 0x60, 0xdd, 0xa3, 0xfd, 0x25, 0x2b, 0x2b, 0xa4, 0xd4, 0xec, 0x44, 0x4e, 0x42,
+	0xb6, 0x3a, 0xe8, 0xc3, 0x50, 0xa5, 0xe7, 0x46, 0x39, 0x2b, 0x37, 0x8a, 0x94, 0x9c, 0xc6, 0x97,
+	0xd0, 0x42, 0x94, 0xfe, 0x8b, 0x31, 0x27, 0x6a, 0x7f, 0xb9, 0x9e, 0x55, 0x16, 0x98, 0xd7, 0xc3,
+	0x39, 0x80, 0xad, 0x70, 0x9d, 0x34, 0x5b, 0x3e, 0x9d, 0x16, 0x8b, 0x5d, 0x70, 0x29, 0x3b, 0xca,
+	0x45, 0x05, 0xc1, 0x06, 0x16, 0xfa, 0xb4, 0x05, 0xb0, 0x25, 0xd7, 0xbc, 0x54, 0x04, 0xae, 0x15,
+	0xf9, 0x3a, 0x7a, 0x47, 0xe9, 0xb1, 0x28, 0x82, 0xd8, 0x20, 0x8e, 0x7e, 0xd8, 0x82, 0x5a, 0x22,
+	0x87, 0xcf, 0x45, 0xe3, 0x7a, 0x91, 0x23, 0x91, 0x2f, 0xad, 0x75, 0x22, 0x35, 0x25, 0x8a, 0x2e,
+	0xfa, 0x11, 0x0b, 0x20, 0xee, 0x04, 0xee, 0x6a, 0xe8, 0x7b, 0x6e, 0x47, 0x48, 0xcc, 0xeb, 0x85,
+	0xda, 0x7a, 0x54, 0xef, 0x73, 0xe3, 0x74, 0x36, 0xf4, 0x7f, 0x6c, 0x50, 0x46, 0x1f, 0x81, 0x5a,
+	0x2c, 0x96, 0x9b, 0x90, 0x91, 0xeb, 0xc5, 0x5a, 0x9c, 0x78, 0xdf, 0x82, 0xbd, 0x8a, 0x7f, 0x58,
+	0xd1, 0x44, 0x7f, 0xdf, 0x82, 0x89, 0x56, 0xda, 0x86, 0x28, 0xc4, 0x61, 0x71, 0x3c, 0x20, 0x63,
+	0xa3, 0xe4, 0xd6, 0x96, 0x4c, 0x23, 0xce, 0x8e, 0x82, 0x72, 0x40, 0xbd, 0x82, 0x57, 0x5a, 0xdc,
+	0x9e, 0x39, 0xac, 0x39, 0xe0, 0xc5, 0x2c, 0x10, 0x77, 0xe3, 0xa3, 0x55, 0x38, 0x49, 0x47, 0xd7,
+	0xe1, 0xea, 0xa7, 0x14, 0x2f, 0x31, 0x13, 0x86, 0xb5, 0xb9, 0xc7, 0xc5, 0x0a, 0x61, 0x97, 0x2e,
+	0x59, 0x1c, 0x9c, 0xfb, 0x24, 0xfa, 0x7d, 0x0b, 0x1e, 0xf7, 0x98, 0x18, 0x30, 0xad, 0xf9, 0x5a,
+	0x22, 0x08, 0xaf, 0x04, 0x52, 0x28, 0xaf, 0xe8, 0x25, 0x7e, 0xe6, 0xbe, 0x5d, 0xbc, 0xc1, 0xe3,
+	0x8b, 0xfb, 0x0c, 0x09, 0xef, 0x3b, 0x60, 0xf4, 0xdd, 0x30, 0x26, 0xf7, 0xc5, 0x2a, 0x65, 0xc1,
+	0x4c, 0xd0, 0xd6, 0xe7, 0x8e, 0xdf, 0xd9, 0x9b, 0x1a, 0x5b, 0x37, 0x01, 0x38, 0x8d, 0x67, 0xff,
+	0x58, 0x25, 0x75, 0x5d, 0xa5, 0x0c, 0x9c, 0x8c, 0xdd, 0xb8, 0xd2, 0xfe, 0x23, 0xb9, 0x67, 0xa1,
+	0xec, 0x46, 0x59, 0x97, 0x34, 0xbb, 0x51, 0x4d, 0x31, 0x36, 0x88, 0x53, 0xa5, 0xf4, 0xb8, 0x93,
+	0x35, 0xa3, 0x0a, 0x0e, 0xf8, 0x6a, 0x91, 0x43, 0xea, 0xbe, 0x5c, 0x3c, 0x2d, 0x86, 0x76, 0xbc,
+	0x0b, 0x84, 0xbb, 0x87, 0x84, 0x7e, 0x10, 0xea, 0x91, 0x72, 0x03, 0x2a, 0x17, 0x71, 0x54, 0x93,
+	0xcb, 0x46, 0x0c, 0x47, 0xdd, 0x0e, 0x69, 0x87, 0x1f, 0x4d, 0x11, 0xbd, 0x0c, 0xe3, 0xea, 0xcf,
+	0x3c, 0xbb, 0x16, 0xa2, 0x4c, 0xb1, 0xac, 0x6f, 0xf6, 0x71, 0x0a, 0x8a, 0x33, 0xd8, 0xf6, 0xa7,
+	0x4a, 0xa9, 0x5b, 0x37, 0x83, 0xf7, 0xf4, 0x71, 0x7b, 0xf9, 0x39, 0x0b, 0x46, 0xa2, 0xd0, 0xf7,
+	0xbd, 0x60, 0x8b, 0xf2, 0x49, 0x21, 0xec, 0xdf, 0x77, 0x24, 0xf2, 0x56, 0x30, 0x44, 0xa6, 0x99,
+	0x63, 0x4d, 0x13, 0x9b, 0x03, 0x40, 0x2f, 0xc1, 0x58, 0x83, 0xf8, 0x84, 0x3e, 0xbb, 0x12, 0xd1,
+	0x33, 0x15, 0xb7, 0x60, 0x2b, 0xb7, 0x9c, 0x05, 0x13, 0x88, 0xd3, 0xb8, 0xf6, 0x5f, 0x58, 0x30,
+	0xd9, 0x4b, 0x18, 0x20, 0x02, 0x8f, 0x49, 0x4e, 0xa7, 0x66, 0x74, 0x25, 0x90, 0xfd, 0x09, 0x79,
+	0xfe, 0x94, 0xa0, 0xf3, 0xd8, 0x6a, 0x6f, 0x54, 0xbc, 0x5f, 0x3f, 0xe8, 0x15, 0x38, 0x66, 0x4c,
+	0x4a, 0xac, 0x66, 0xb5, 0x3e, 0x37, 0x4d, 0xb5, 0xaf, 0xd9, 0x0c, 0xec, 0xee, 0xde, 0xd4, 0x23,
+	0xd9, 0x36, 0x21, 0xad, 0xba, 0xfa, 0xb1, 0x7f, 0xb6, 0xeb, 0x53, 0x2b, 0x45, 0xe3, 0x8b, 0x56,
+	0x97, 0x29, 0xe3, 0x3d, 0x47, 0x21, 0xdc, 0x99, 0xd1, 0x43, 0xf9, 0xc0, 0xf4, 0xc6, 0x79, 0x80,
+	0xce, 0x0b, 0xf6, 0xbf, 0xae, 0xc0, 0x3e, 0x23, 0x3b, 0x82, 0x9b, 0x6f, 0xf4, 0xe3, 0x96, 0xba,
+	0xca, 0xe3, 0x0c, 0xa4, 0x71, 0x54, 0x73, 0xcf, 0x0f, 0x6f, 0x31, 0x77, 0xa0, 0x51, 0x26, 0xfc,
+	0xf4, 0xa5, 0x21, 0xfa, 0x8a, 0x95, 0xbe, 0x8c, 0xe4, 0xbe, 0xaa, 0xde, 0x91, 0x8d, 0xc9, 0xb8,
+	0xe1, 0xe4, 0x03, 0xd3, 0xf7, 0x62, 0xbd, 0xee, 0x3e, 0xa7, 0x01, 0x36, 0xbd, 0xc0, 0xf1, 0xbd,
+	0xd7, 0xe9, 0xd1, 0xac, 0xca, 0xb4, 0x0b, 0xa6, 0xae, 0x5d, 0x50, 0xad, 0xd8, 0xc0, 0x38, 0xf3,
+	0xb7, 0x61, 0xc4, 0x78, 0xf3, 0x1c, 0xbf, 0x9f, 0x93, 0xa6, 0xdf, 0x4f, 0xdd, 0x70, 0xd7, 0x39,
+	0xf3, 0x32, 0x1c, 0xcb, 0x0e, 0xf0, 0x30, 0xcf, 0xdb, 0xff, 0x6b, 0x38, 0x7b, 0x3b, 0xb8, 0x4e,
+	0xa2, 0x26, 0x1d, 0xda, 0x9b, 0x56, 0xb5, 0x37, 0xad, 0x6a, 0x6f, 0x5a, 0xd5, 0xcc, 0x8b, 0x11,
+	0x61, 0x31, 0x1a, 0xbe, 0x4f, 0x16, 0xa3, 0x94, 0x0d, 0xac, 0x56, 0xb8, 0x0d, 0xcc, 0xfe, 0x64,
+	0xd7, 0xb5, 0xc1, 0x7a, 0x44, 0x08, 0x0a, 0xa1, 0x1a, 0x84, 0x0d, 0x22, 0x15, 0xec, 0xcb, 0xc5,
+	0x68, 0x8b, 0x57, 0xc3, 0x86, 0x11, 0x05, 0x40, 0xff, 0xc5, 0x98, 0xd3, 0xb1, 0x3f, 0x31, 0x04,
+	0x29, 0x5d, 0x96, 0x7f, 0xf7, 0xef, 0x84, 0xe1, 0x88, 0xb4, 0xc2, 0x6b, 0x78, 0x49, 0xc8, 0x32,
+	0x1d, 0x44, 0xc5, 0x9b, 0xb1, 0x84, 0x53, 0x99, 0xd7, 0x72, 0x92, 0x6d, 0x21, 0xcc, 0x94, 0xcc,
+	0x5b, 0x75, 0x92, 0x6d, 0xcc, 0x20, 0x54, 0x0d, 0x4d, 0x52, 0xf7, 0xf0, 0xe2, 0xbe, 0x59, 0xa9,
+	0xa1, 0xe9, 0x5b, 0x7a, 0x9c, 0xc1, 0x46, 0xaf, 0x41, 0x65, 0x9b, 0xf8, 0x4d, 0xf1, 0xe9, 0xd7,
+	0x8a, 0x93, 0x35, 0xec, 0x5d, 0x2f, 0x11, 0xbf, 0xc9, 0x39, 0x21, 0xfd, 0x85, 0x19, 0x29, 0xba,
+	0xee, 0xeb, 0x3b, 0xed, 0x38, 0x09, 0x9b, 0xde, 0xeb, 0xd2, 0xcc, 0xfa, 0x9e, 0x82, 0x09, 0x5f,
+	0x91, 0xfd, 0x73, 0x7b, 0x96, 0xfa, 0x8b, 0x35, 0x65, 0x36, 0x8e, 0x86, 0x17, 0xb1, 0x25, 0xd3,
+	0x11, 0xd6, 0xd2, 0xa2, 0xc7, 0xb1, 0x20, 0xfb, 0xe7, 0xe3, 0x50, 0x7f, 0xb1, 0xa6, 0x8c, 0x3a,
+	0x6a, 0xff, 0x8d, 0xb0, 0x31, 0x5c, 0x2b, 0x78, 0x0c, 0x7c, 0xef, 0xe5, 0xee, 0xc3, 0xa7, 0xa0,
+	0xea, 0x6e, 0x3b, 0x51, 0x32, 0x39, 0xca, 0x16, 0x8d, 0x5a, 0xc5, 0xf3, 0xb4, 0x11, 0x73, 0x18,
+	0x7a, 0x02, 0xca, 0x11, 0xd9, 0x64, 0xae, 0xe1, 0x86, 0xc7, 0x16, 0x26, 0x9b, 0x98, 0xb6, 0x2b,
+	0xbd, 0x6c, 0xbc, 0xa7, 0x2b, 0xdf, 0x4f, 0x97, 0xd2, 0x8a, 0x5d, 0x7a, 0x66, 0xf8, 0x7e, 0x70,
+	0xdb, 0x51, 0x2c, 0xad, 0x73, 0xc6, 0x7e, 0x60, 0xcd, 0x58, 0xc2, 0xd1, 0xc7, 0x2c, 0x18, 0xbe,
+	0x19, 0x87, 0x41, 0x40, 0x12, 0x21, 0x44, 0xaf, 0x17, 0x3c, 0x59, 0x97, 0x79, 0xef, 0x7a, 0x0c,
+	0xa2, 0x01, 0x4b, 0xba, 0x74, 0xb8, 0xe4, 0xb6, 0xeb, 0xb7, 0x1b, 0x5d, 0x6e, 0x3a, 0xe7, 0x79,
+	0x33, 0x96, 0x70, 0x8a, 0xea, 0x05, 0x1c, 0xb5, 0x92, 0x46, 0x5d, 0x0c, 0x04, 0xaa, 0x80, 0xdb,
+	0xbf, 0x52, 0x4b, 0x79, 0x7c, 0xea, 0xed, 0x43, 0x55, 0x2e, 0xa6, 0xd4, 0x5c, 0xf0, 0x7c, 0x22,
+	0x1d, 0xd4, 0x98, 0xca, 0x75, 0x5d, 0xb5, 0x62, 0x03, 0x03, 0xfd, 0x10, 0x40, 0xcb, 0x89, 0x9c,
+	0x26, 0x51, 0xd6, 0xf3, 0x81, 0x35, 0x1b, 0x3a, 0x8e, 0x55, 0xd9, 0xa7, 0xb6, 0x20, 0xa8, 0xa6,
+	0x18, 0x1b, 0x24, 0xd1, 0x0b, 0x30, 0x12, 0x11, 0x9f, 0x38, 0x31, 0x8b, 0x3d, 0xc8, 0x86, 0x68,
+	0x61, 0x0d, 0xc2, 0x26, 0x1e, 0x7a, 0x5a, 0xf9, 0xf2, 0x55, 0xd2, 0x8e, 0x2e, 0x69, 0x7f, 0x3e,
+	0xf4, 0x79, 0x0b, 0xc6, 0x37, 0x3d, 0x9f, 0x68, 0xea, 0x22, 0xa0, 0x6a, 0x65, 0xf0, 0x97, 0xbc,
+	0x60, 0xf6, 0xab, 0x79, 0x68, 0xaa, 0x39, 0xc6, 0x19, 0xf2, 0xf4, 0x33, 0xef, 0x92, 0x88, 0x31,
+	0xdf, 0xa1, 0xf4, 0x67, 0xbe, 0xce, 0x9b, 0xb1, 0x84, 0xa3, 0x59, 0x98, 0x68, 0x39, 0x71, 0x3c,
+	0x1f, 0x91, 0x06, 0x09, 0x12, 0xcf, 0xf1, 0x79, 0x04, 0x53, 0x4d, 0x3b, 0xd5, 0xaf, 0xa6, 0xc1,
+	0x38, 0x8b, 0x8f, 0xde, 0x0b, 0x8f, 0x72, 0xf3, 0xd4, 0xb2, 0x17, 0xc7, 0x5e, 0xb0, 0xa5, 0x97,
+	0x81, 0xb0, 0xd2, 0x4d, 0x89, 0xae, 0x1e, 0x5d, 0xcc, 0x47, 0xc3, 0xbd, 0x9e, 0x47, 0xcf, 0x42,
+	0x2d, 0xde, 0xf1, 0x5a, 0xf3, 0x51, 0x23, 0x66, 0x57, 0x53, 0x35, 0x6d, 0x13, 0x5e, 0x13, 0xed,
+	0x58, 0x61, 0x20, 0x17, 0x46, 0xf9, 0x27, 0xe1, 0xce, 0x88, 0x82, 0x83, 0xbe, 0xbd, 0xa7, 0x20,
+	0x17, 0x91, 0xcd, 0xd3, 0xd8, 0xb9, 0x75, 0x5e, 0x5e, 0x94, 0xf1, 0x7b, 0x9d, 0xeb, 0x46, 0x37,
+	0x38, 0xd5, 0x69, 0xfa, 0x4c, 0x37, 0xd2, 0xc7, 0x99, 0xee, 0x05, 0x18, 0xd9, 0x69, 0x6f, 0x10,
+	0x31, 0xf3, 0x82, 0xb1, 0xa9, 0xd5, 0x77, 0x45, 0x83, 0xb0, 0x89, 0xc7, 0xfc, 0x40, 0x5b, 0x9e,
+	0xf8, 0x17, 0x4f, 0x8e, 0x19, 0x7e, 0xa0, 0xab, 0x8b, 0xb2, 0x19, 0x9b, 0x38, 0x74, 0x68, 0x74,
+	0x2e, 0xd6, 0x49, 0xcc, 0x22, 0x59, 0xe8, 0x74, 0xa9, 0xa1, 0xad, 0x49, 0x00, 0xd6, 0x38, 0x68,
+	0x15, 0x4e, 0xd2, 0x3f, 0x6b, 0x2c, 0xb2, 0xfb, 0xba, 0xe3, 0x7b, 0x0d, 0xee, 0x94, 0x38, 0x91,
+	0x36, 0xae, 0xae, 0xe5, 0xe0, 0xe0, 0xdc, 0x27, 0xed, 0x9f, 0x2c, 0xa5, 0x2d, 0x27, 0x26, 0x0b,
+	0x43, 0x31, 0x65, 0x54, 0xc9, 0x75, 0x27, 0x92, 0x0a, 0xcf, 0x80, 0x61, 0x68, 0xa2, 0xdf, 0xeb,
+	0x4e, 0x64, 0xb2, 0x3c, 0x46, 0x00, 0x4b, 0x4a, 0xe8, 0x26, 0x54, 0x12, 0xdf, 0x29, 0x28, 0xc8,
+	0xd5, 0xa0, 0xa8, 0xad, 0x60, 0x4b, 0xb3, 0x31, 0x66, 0x34, 0xd0, 0xe3, 0xf4, 0xf4, 0xb6, 0x21,
+	0xaf, 0xf9, 0xc4, 0x81, 0x6b, 0x23, 0xc6, 0xac, 0xd5, 0xfe, 0xbb, 0x63, 0x39, 0x52, 0x47, 0x29,
+	0x02, 0xe8, 0x1c, 0x00, 0x5d, 0x34, 0xab, 0x11, 0xd9, 0xf4, 0x6e, 0x0b, 0x45, 0x4c, 0x71, 0xb6,
+	0xab, 0x0a, 0x82, 0x0d, 0x2c, 0xf9, 0xcc, 0x5a, 0x7b, 0x93, 0x3e, 0x53, 0xea, 0x7e, 0x86, 0x43,
+	0xb0, 0x81, 0x85, 0x9e, 0x87, 0x21, 0xaf, 0xe9, 0x6c, 0x29, 0x17, 0xe5, 0xc7, 0x29, 0x4b, 0x5b,
+	0x64, 0x2d, 0x77, 0xf7, 0xa6, 0xc6, 0xd5, 0x80, 0x58, 0x13, 0x16, 0xb8, 0xe8, 0x67, 0x2d, 0x18,
+	0x75, 0xc3, 0x66, 0x33, 0x0c, 0xf8, 0xf1, 0x59, 0xd8, 0x02, 0x6e, 0x1e, 0x95, 0x9a, 0x34, 0x3d,
+	0x6f, 0x10, 0xe3, 0xc6, 0x00, 0x15, 0x8d, 0x6b, 0x82, 0x70, 0x6a, 0x54, 0x26, 0xe7, 0xab, 0x1e,
+	0xc0, 0xf9, 0x7e, 0xd5, 0x82, 0xe3, 0xfc, 0x59, 0xe3, 0x54, 0x2f, 0x62, 0x49, 0xc3, 0x23, 0x7e,
+	0xad, 0x2e, 0x43, 0x87, 0xb2, 0x34, 0x77, 0xc1, 0x71, 0xf7, 0x20, 0xd1, 0x45, 0x38, 0xbe, 0x19,
+	0x46, 0x2e, 0x31, 0x27, 0x42, 0xb0, 0x6d, 0xd5, 0xd1, 0x85, 0x2c, 0x02, 0xee, 0x7e, 0x06, 0x5d,
+	0x87, 0x47, 0x8c, 0x46, 0x73, 0x1e, 0x38, 0xe7, 0x7e, 0x52, 0xf4, 0xf6, 0xc8, 0x85, 0x5c, 0x2c,
+	0xdc, 0xe3, 0xe9, 0x34, 0x93, 0xac, 0xf7, 0xc1, 0x24, 0x3f, 0x08, 0xa7, 0xdd, 0xee, 0x99, 0xd9,
+	0x8d, 0xdb, 0x1b, 0x31, 0xe7, 0xe3, 0xb5, 0xb9, 0x6f, 0x13, 0x1d, 0x9c, 0x9e, 0xef, 0x85, 0x88,
+	0x7b, 0xf7, 0x81, 0x3e, 0x0c, 0xb5, 0x88, 0xb0, 0xaf, 0x12, 0x8b, 0xc0, 0xca, 0x01, 0xad, 0x1d,
+	0x5a, 0x83, 0xe7, 0xdd, 0x6a, 0xc9, 0x24, 0x1a, 0x62, 0xac, 0x28, 0xa2, 0x5b, 0x30, 0xdc, 0x72,
+	0x12, 0x77, 0x5b, 0x44, 0x48, 0x0e, 0x7c, 0x31, 0xa0, 0x88, 0xb3, 0x7b, 0x1c, 0x23, 0x93, 0x05,
+	0x27, 0x82, 0x25, 0x35, 0xaa, 0xab, 0xb9, 0x61, 0xb3, 0x15, 0x06, 0x24, 0x48, 0xa4, 0x10, 0x19,
+	0xe7, 0x97, 0x2d, 0xb2, 0x15, 0x1b, 0x18, 0x5d, 0xb2, 0x5c, 0xa3, 0x4d, 0x1e, 0xdf, 0x47, 0x96,
+	0x1b, 0xbd, 0xf5, 0x7a, 0x9e, 0x0a, 0x1b, 0x66, 0x56, 0xbc, 0xe1, 0x25, 0xdb, 0x61, 0x3b, 0x91,
+	0xa7, 0x64, 0x21, 0xa8, 0x94, 0xb0, 0x59, 0xca, 0xc1, 0xc1, 0xb9, 0x4f, 0x66, 0x25, 0xeb, 0xc4,
+	0xbd, 0x49, 0xd6, 0x63, 0x7d, 0x48, 0xd6, 0x35, 0x38, 0xc5, 0x46, 0x20, 0xb4, 0x64, 0x69, 0xb4,
+	0x8c, 0x27, 0x11, 0x1b, 0xbc, 0x8a, 0xbc, 0x59, 0xca, 0x43, 0xc2, 0xf9, 0xcf, 0x9e, 0xf9, 0x7e,
+	0x38, 0xde, 0xc5, 0xe4, 0x0e, 0x65, 0x90, 0x5c, 0x80, 0x47, 0xf2, 0xd9, 0xc9, 0xa1, 0xcc, 0x92,
+	0xbf, 0x92, 0x71, 0x8a, 0x37, 0x8e, 0x68, 0x7d, 0x98, 0xb8, 0x1d, 0x28, 0x93, 0x60, 0x57, 0x48,
+	0xd7, 0x0b, 0x83, 0xad, 0xea, 0xf3, 0xc1, 0x2e, 0xe7, 0x86, 0xcc, 0x8e, 0x77, 0x3e, 0xd8, 0xc5,
+	0xb4, 0x6f, 0xf4, 0x13, 0x56, 0xea, 0x00, 0xc1, 0x0d, 0xe3, 0x1f, 0x38, 0x92, 0x33, 0x69, 0xdf,
+	0x67, 0x0a, 0xfb, 0xf7, 0x4a, 0x70, 0xf6, 0xa0, 0x4e, 0xfa, 0x98, 0xbe, 0xa7, 0x60, 0x28, 0x66,
+	0x6e, 0x2e, 0x42, 0x5c, 0x8d, 0xd0, 0x5d, 0xcc, 0x1d, 0x5f, 0x3e, 0x88, 0x05, 0x08, 0xf9, 0x50,
+	0x6e, 0x3a, 0x2d, 0x61, 0x2f, 0x5d, 0x1c, 0x34, 0x8a, 0x91, 0xfe, 0x77, 0xfc, 0x65, 0xa7, 0xc5,
+	0xd7, 0xbc, 0xd1, 0x80, 0x29, 0x19, 0x94, 0x40, 0xd5, 0x89, 0x22, 0x47, 0xfa, 0x54, 0x5c, 0x29,
+	0x86, 0xde, 0x2c, 0xed, 0x92, 0x5f, 0x49, 0xa7, 0x9a, 0x30, 0x27, 0x66, 0x7f, 0xb9, 0x9e, 0x0a,
+	0x43, 0x63, 0x8e, 0x32, 0x31, 0x0c, 0x09, 0x33, 0xa9, 0x55, 0x74, 0xf0, 0x28, 0x0f, 0x65, 0x67,
+	0x16, 0x08, 0x91, 0x6a, 0x44, 0x90, 0x42, 0x9f, 0xb1, 0x58, 0x42, 0x0f, 0x19, 0xdb, 0x27, 0x4e,
+	0xf5, 0x47, 0x93, 0x5f, 0xc4, 0x4c, 0x13, 0x22, 0x1b, 0xb1, 0x49, 0x5d, 0x24, 0x2d, 0x62, 0xa7,
+	0x99, 0xee, 0xa4, 0x45, 0xec, 0x74, 0x22, 0xe1, 0xe8, 0x76, 0x8e, 0x43, 0x4c, 0x01, 0x79, 0x1e,
+	0xfa, 0x70, 0x81, 0xf9, 0x8a, 0x05, 0xc7, 0xbd, 0xac, 0x67, 0x83, 0x38, 0x03, 0xdf, 0x28, 0xc6,
+	0xa6, 0xd9, 0xed, 0x38, 0xa1, 0x14, 0x9d, 0x2e, 0x10, 0xee, 0x1e, 0x0c, 0x6a, 0x40, 0xc5, 0x0b,
+	0x36, 0x43, 0xa1, 0xde, 0xcd, 0x0d, 0x36, 0xa8, 0xc5, 0x60, 0x33, 0xd4, 0xbb, 0x99, 0xfe, 0xc3,
+	0xac, 0x77, 0xb4, 0x04, 0x27, 0x65, 0xb0, 0xd1, 0x25, 0x2f, 0x4e, 0xc2, 0xa8, 0xb3, 0xe4, 0x35,
+	0xbd, 0x84, 0xa9, 0x66, 0xe5, 0xb9, 0x49, 0x2a, 0xde, 0x70, 0x0e, 0x1c, 0xe7, 0x3e, 0x85, 0x5e,
+	0x87, 0x61, 0xe9, 0x4d, 0x50, 0x2b, 0xc2, 0x9e, 0xd0, 0xbd, 0xfe, 0xd5, 0x62, 0x5a, 0x13, 0xee,
+	0x04, 0x92, 0x20, 0xfa, 0x94, 0x05, 0xe3, 0xfc, 0xf7, 0xa5, 0x4e, 0x83, 0x07, 0x3f, 0xd6, 0x8b,
+	0x08, 0x19, 0x58, 0x4b, 0xf5, 0x39, 0x87, 0xee, 0xec, 0x4d, 0x8d, 0xa7, 0xdb, 0x70, 0x86, 0x2e,
+	0xfa, 0x84, 0x05, 0xf5, 0x06, 0x8b, 0x37, 0x8e, 0x57, 0x02, 0x91, 0xa9, 0x63, 0xad, 0xc0, 0xed,
+	0x28, 0x23, 0x99, 0xb5, 0x86, 0xba, 0x20, 0xa9, 0x61, 0x4d, 0xd8, 0xfe, 0xa7, 0xa3, 0xd0, 0xed,
+	0x06, 0x92, 0xf6, 0xf9, 0xb0, 0xee, 0xbb, 0xcf, 0xc7, 0x4d, 0xa8, 0xc4, 0xda, 0xdd, 0xa2, 0x80,
+	0xdd, 0x2e, 0xa8, 0xea, 0xdb, 0xf0, 0x4e, 0xe0, 0x62, 0x46, 0x03, 0xb5, 0x61, 0x88, 0xa7, 0x2e,
+	0x13, 0x82, 0x68, 0xf0, 0x0b, 0x78, 0x33, 0x05, 0x9a, 0xb6, 0xae, 0xf1, 0x56, 0x2c, 0x88, 0xa1,
+	0xdb, 0x30, 0xbc, 0xcd, 0x77, 0x85, 0x38, 0x72, 0x2e, 0x0f, 0x3a, 0xbf, 0xa9, 0xad, 0xa6, 0xf7,
+	0x80, 0x68, 0xc0, 0x92, 0x1c, 0x73, 0x31, 0x34, 0x9c, 0xa0, 0x38, 0x3f, 0x2b, 0x2e, 0x9c, 0xb4,
+	0x7f, 0x0f, 0xa8, 0x0f, 0xc1, 0x68, 0x44, 0xdc, 0x30, 0x70, 0x3d, 0x9f, 0x34, 0x66, 0xe5, 0xbd,
+	0xdc, 0x61, 0x02, 0x05, 0x99, 0x51, 0x0b, 0x1b, 0x7d, 0xe0, 0x54, 0x8f, 0x6c, 0xbb, 0xab, 0x2c,
+	0x06, 0xf4, 0x83, 0x10, 0x71, 0xff, 0xb2, 0x54, 0x50, 0xce, 0x04, 0xd6, 0x27, 0xdf, 0xee, 0xe9,
+	0x36, 0x9c, 0xa1, 0x8b, 0x5e, 0x01, 0x08, 0x37, 0xb8, 0x1f, 0xe1, 0x6c, 0x22, 0x2e, 0x63, 0x0e,
+	0xf3, 0xaa, 0xe3, 0x3c, 0x1a, 0x59, 0xf6, 0x80, 0x8d, 0xde, 0xd0, 0x15, 0x00, 0xbe, 0x73, 0xd6,
+	0x3b, 0x2d, 0x79, 0x2e, 0x95, 0x91, 0x9e, 0xb0, 0xa6, 0x20, 0x77, 0xf7, 0xa6, 0xba, 0x4d, 0xdf,
+	0xcc, 0xd9, 0xc9, 0x78, 0x1c, 0xfd, 0x00, 0x0c, 0xc7, 0xed, 0x66, 0xd3, 0x51, 0x57, 0x35, 0x05,
+	0xc6, 0x37, 0xf3, 0x7e, 0x0d, 0xfe, 0xcc, 0x1b, 0xb0, 0xa4, 0x88, 0x6e, 0x52, 0x49, 0x23, 0x18,
+	0x25, 0xdf, 0x45, 0x5c, 0x51, 0xe2, 0x06, 0xc9, 0x77, 0xca, 0xc3, 0x14, 0xce, 0xc1, 0xb9, 0xbb,
+	0x37, 0xf5, 0x48, 0xba, 0x7d, 0x29, 0x14, 0x11, 0xc7, 0xb9, 0x7d, 0xa2, 0xcb, 0x32, 0x4b, 0x1b,
+	0x7d, 0x6d, 0x99, 0xe2, 0xe7, 0x19, 0x9d, 0xa5, 0x8d, 0x35, 0xf7, 0x9e, 0x33, 0xf3, 0x61, 0xb4,
+	0x0c, 0x27, 0xdc, 0x30, 0x48, 0xa2, 0xd0, 0xf7, 0x79, 0x06, 0x47, 0x6e, 0x22, 0xe0, 0x57, 0x39,
+	0x8f, 0x89, 0x61, 0x9f, 0x98, 0xef, 0x46, 0xc1, 0x79, 0xcf, 0xd1, 0xa3, 0x41, 0x56, 0x4c, 0x8d,
+	0x17, 0x72, 0xcb, 0x9f, 0xea, 0x53, 0x70, 0x28, 0x65, 0x7d, 0xdf, 0x5f, 0x60, 0xd9, 0x41, 0xfa,
+	0xae, 0x57, 0x7c, 0xb1, 0xe7, 0x61, 0x94, 0xdc, 0x4e, 0x48, 0x14, 0x38, 0xfe, 0x35, 0xbc, 0x24,
+	0xef, 0x4d, 0xd8, 0xc6, 0x3c, 0x6f, 0xb4, 0xe3, 0x14, 0x16, 0xb2, 0x95, 0xb1, 0xce, 0x08, 0xed,
+	0xe7, 0xc6, 0x3a, 0x69, 0x9a, 0xb3, 0x7f, 0xa9, 0x9c, 0x52, 0x9d, 0x1f, 0xc8, 0xcd, 0x32, 0xcb,
+	0xa9, 0x25, 0x93, 0x8f, 0x31, 0x80, 0x38, 0x12, 0x16, 0x49, 0x59, 0x39, 0xef, 0xad, 0x98, 0x84,
+	0x70, 0x9a, 0x2e, 0xda, 0x81, 0xea, 0x76, 0x18, 0x27, 0xf2, 0xa0, 0x38, 0xe0, 0x99, 0xf4, 0x52,
+	0x18, 0x27, 0x4c, 0xdf, 0x53, 0xaf, 0x4d, 0x5b, 0x62, 0xcc, 0x69, 0xb0, 0x2c, 0x4c, 0xdb, 0x4e,
+	0xd4, 0x48, 0x79, 0x5c, 0xea, 0x2c, 0x4c, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xbf, 0x58, 0xa9, 0xcb,
+	0xb5, 0x1b, 0x2c, 0x70, 0x62, 0x97, 0x04, 0x94, 0x45, 0x99, 0xae, 0x96, 0xdf, 0x9d, 0x09, 0x43,
+	0x7f, 0x6b, 0xaf, 0x64, 0xab, 0xb7, 0x68, 0x0f, 0xd3, 0xac, 0x0b, 0xc3, 0x2b, 0xf3, 0xa3, 0x56,
+	0x3a, 0xd9, 0x40, 0xa9, 0x88, 0x13, 0xa4, 0x99, 0x70, 0xe3, 0xc0, 0xbc, 0x05, 0xf6, 0x4f, 0x58,
+	0x30, 0x3c, 0xe7, 0xb8, 0x3b, 0xe1, 0xe6, 0x26, 0x7a, 0x16, 0x6a, 0x8d, 0x76, 0x64, 0xe6, 0x3d,
+	0x50, 0x36, 0xb3, 0x05, 0xd1, 0x8e, 0x15, 0x06, 0x5d, 0xfa, 0x9b, 0x8e, 0x2b, 0xd3, 0x6e, 0x94,
+	0xf9, 0xd2, 0xbf, 0xc0, 0x5a, 0xb0, 0x80, 0xd0, 0xe9, 0x6f, 0x3a, 0xb7, 0xe5, 0xc3, 0xd9, 0x9b,
+	0xbd, 0x65, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0xdb, 0x16, 0x4c, 0xce, 0x39, 0xb1, 0xe7, 0xce, 0xb6,
+	0x93, 0xed, 0x39, 0x2f, 0xd9, 0x68, 0xbb, 0x3b, 0x24, 0xe1, 0xe9, 0x59, 0xe8, 0x28, 0xdb, 0x31,
+	0xdd, 0x81, 0xea, 0xe0, 0xae, 0x46, 0x79, 0x4d, 0xb4, 0x63, 0x85, 0x81, 0x5e, 0x87, 0x91, 0x96,
+	0x13, 0xc7, 0xb7, 0xc2, 0xa8, 0x81, 0xc9, 0x66, 0x31, 0xc9, 0xa2, 0xd6, 0x88, 0x1b, 0x91, 0x04,
+	0x93, 0x4d, 0xe1, 0x27, 0xa3, 0xfb, 0xc7, 0x26, 0x31, 0xfb, 0xd3, 0x16, 0x9c, 0x9c, 0x23, 0x4e,
+	0x44, 0x22, 0x96, 0x5b, 0x4a, 0xbd, 0x08, 0x7a, 0x0d, 0x6a, 0x09, 0x6d, 0xa1, 0x23, 0xb2, 0x8a,
+	0x1d, 0x11, 0xf3, 0x70, 0x59, 0x17, 0x9d, 0x63, 0x45, 0xc6, 0xfe, 0x9c, 0x05, 0xa7, 0xf3, 0xc6,
+	0x32, 0xef, 0x87, 0xed, 0xc6, 0x83, 0x18, 0xd0, 0x3f, 0xb0, 0x60, 0x94, 0x79, 0x0d, 0x2c, 0x90,
+	0xc4, 0xf1, 0xfc, 0xae, 0x44, 0x9d, 0x56, 0x9f, 0x89, 0x3a, 0xcf, 0x42, 0x65, 0x3b, 0x6c, 0x92,
+	0xac, 0xc7, 0xcb, 0xa5, 0xb0, 0x49, 0x30, 0x83, 0xa0, 0xe7, 0xe8, 0x22, 0xf4, 0x82, 0xc4, 0xa1,
+	0xdb, 0x51, 0xde, 0xaa, 0x4c, 0xf0, 0x05, 0xa8, 0x9a, 0xb1, 0x89, 0x63, 0x7f, 0x1e, 0x60, 0x58,
+	0xb8, 0x67, 0xf5, 0x9d, 0x2e, 0x48, 0x1a, 0x93, 0x4a, 0x3d, 0x8d, 0x49, 0x31, 0x0c, 0xb9, 0x2c,
+	0x9b, 0xb2, 0xd0, 0xd0, 0xaf, 0x14, 0xe2, 0xcf, 0xc7, 0x13, 0x34, 0xeb, 0x61, 0xf1, 0xff, 0x58,
+	0x90, 0x42, 0x6f, 0x58, 0x30, 0xe1, 0x86, 0x41, 0x40, 0x5c, 0xad, 0x3b, 0x56, 0x8a, 0x38, 0x20,
+	0xcc, 0xa7, 0x3b, 0xd5, 0x17, 0xd2, 0x19, 0x00, 0xce, 0x92, 0x47, 0x2f, 0xc1, 0x18, 0x9f, 0xb3,
+	0xeb, 0xa9, 0xab, 0x20, 0x9d, 0x92, 0xd1, 0x04, 0xe2, 0x34, 0x2e, 0x9a, 0xe6, 0x57, 0x6a, 0x22,
+	0x9f, 0xe1, 0x90, 0xb6, 0x98, 0x1b, 0x99, 0x0c, 0x0d, 0x0c, 0x14, 0x01, 0x8a, 0xc8, 0x66, 0x44,
+	0xe2, 0x6d, 0xe1, 0xbe, 0xc6, 0xf4, 0xd6, 0xe1, 0x7b, 0xcb, 0xe5, 0x81, 0xbb, 0x7a, 0xc2, 0x39,
+	0xbd, 0xa3, 0x1d, 0x61, 0xcd, 0xa8, 0x15, 0xc1, 0xcf, 0xc5, 0x67, 0xee, 0x69, 0xd4, 0x98, 0x82,
+	0x2a, 0x13, 0x5d, 0x4c, 0x5f, 0x2e, 0xf3, 0xf8, 0x51, 0x26, 0xd8, 0x30, 0x6f, 0x47, 0x0b, 0x70,
+	0x2c, 0x93, 0x23, 0x32, 0x16, 0x57, 0x36, 0x2a, 0x56, 0x30, 0x93, 0x5d, 0x32, 0xc6, 0x5d, 0x4f,
+	0x98, 0x96, 0xae, 0x91, 0x03, 0x2c, 0x5d, 0x1d, 0xe5, 0x24, 0xcd, 0x2f, 0x53, 0xde, 0x5d, 0xc8,
+	0x04, 0xf4, 0xe5, 0x11, 0xfd, 0xd9, 0x8c, 0x47, 0xf4, 0x18, 0x1b, 0xc0, 0xf5, 0x62, 0x06, 0x70,
+	0x0f, 0xee, 0xcf, 0x4b, 0x70, 0x92, 0x1e, 0xce, 0xe7, 0xc3, 0xc0, 0x6d, 0x47, 0x11, 0x09, 0x5c,
+	0x61, 0x71, 0x1a, 0xd7, 0x16, 0xa7, 0xb5, 0x1c, 0x38, 0xce, 0x7d, 0xea, 0x41, 0x3a, 0x47, 0xff,
+	0x4f, 0x0b, 0xe4, 0x2a, 0x99, 0x77, 0xdc, 0x6d, 0x42, 0x17, 0x60, 0x4e, 0x48, 0x8b, 0x75, 0x98,
+	0x90, 0x16, 0x34, 0x03, 0x75, 0x3a, 0xeb, 0xfc, 0x51, 0xae, 0x45, 0x28, 0x7b, 0xca, 0xec, 0xea,
+	0xa2, 0x78, 0x4a, 0xe3, 0xa0, 0x10, 0x8e, 0xfb, 0x4e, 0x9c, 0xb0, 0x11, 0xc8, 0x14, 0x9a, 0xf7,
+	0x90, 0x97, 0x87, 0x85, 0xb7, 0x2d, 0x65, 0x3b, 0xc2, 0xdd, 0x7d, 0xdb, 0xff, 0xb6, 0x0a, 0x63,
+	0x29, 0x3e, 0x7b, 0x48, 0xf5, 0xe3, 0x59, 0xa8, 0x49, 0x8d, 0x20, 0x9b, 0x9d, 0x4c, 0xa9, 0x0d,
+	0x0a, 0x83, 0x8a, 0xc0, 0x0d, 0x2d, 0xa3, 0xb3, 0xea, 0x92, 0x21, 0xbe, 0xb1, 0x89, 0xc7, 0x58,
+	0x7c, 0xe2, 0xc7, 0xf3, 0xbe, 0x47, 0x82, 0x84, 0x0f, 0xb3, 0x18, 0x16, 0xbf, 0xbe, 0xb4, 0x66,
+	0x76, 0xaa, 0x59, 0x7c, 0x06, 0x80, 0xb3, 0xe4, 0xd1, 0x27, 0x2c, 0x18, 0x73, 0x6e, 0xc5, 0xba,
+	0x80, 0x80, 0xf0, 0xa4, 0x1e, 0x50, 0xe4, 0xa5, 0x6a, 0x12, 0xf0, 0xdb, 0x8a, 0x54, 0x13, 0x4e,
+	0x13, 0x45, 0x5f, 0xb4, 0x00, 0x91, 0xdb, 0xc4, 0x95, 0xbe, 0xde, 0x62, 0x2c, 0x43, 0x45, 0xd8,
+	0x03, 0xce, 0x77, 0xf5, 0xcb, 0x65, 0x44, 0x77, 0x3b, 0xce, 0x19, 0x03, 0xba, 0x0c, 0xa8, 0xe1,
+	0xc5, 0xce, 0x86, 0x4f, 0xe6, 0xc3, 0xa6, 0x0c, 0xc9, 0x16, 0x4e, 0x02, 0x67, 0xc4, 0x3c, 0xa3,
+	0x85, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x5b, 0x65, 0x51, 0x78, 0xbb, 0x73, 0x2d, 0xf2, 0x99, 0xcc,
+	0x31, 0x57, 0x99, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0xcb, 0xb2, 0xda, 0xca, 0x3a, 0xb0, 0xc1, 0x31,
+	0x1c, 0xac, 0xad, 0x7b, 0x77, 0xb0, 0xd6, 0xee, 0x5f, 0xdd, 0x89, 0x06, 0x52, 0x71, 0xc9, 0xa5,
+	0x07, 0x14, 0x97, 0xfc, 0xc3, 0x56, 0x2a, 0x03, 0xe0, 0xc8, 0xb9, 0x57, 0x8a, 0x0d, 0xaa, 0x98,
+	0xe6, 0xae, 0x69, 0x19, 0x29, 0x95, 0xf1, 0x48, 0x7c, 0x16, 0x6a, 0x9b, 0xbe, 0xc3, 0x52, 0xd3,
+	0xb0, 0x8d, 0x6a, 0xb8, 0xcd, 0x5d, 0x10, 0xed, 0x58, 0x61, 0x50, 0xae, 0x6f, 0x74, 0x7a, 0x28,
+	0xae, 0xfd, 0x67, 0x65, 0x18, 0x31, 0xf4, 0x87, 0x5c, 0x65, 0xd0, 0x7a, 0xc8, 0x94, 0xc1, 0xd2,
+	0x21, 0x94, 0xc1, 0x1f, 0x82, 0xba, 0x2b, 0xa5, 0x51, 0x31, 0xe5, 0x20, 0xb2, 0x32, 0x4e, 0x0b,
+	0x24, 0xd5, 0x84, 0x35, 0x4d, 0x74, 0x31, 0x15, 0xfb, 0x9a, 0xb2, 0x32, 0xe4, 0x05, 0xa7, 0x0a,
+	0x89, 0xd6, 0xfd, 0x4c, 0xd6, 0xe9, 0xa1, 0x7a, 0xb0, 0xd3, 0x83, 0xfd, 0xa7, 0x96, 0xfa, 0xb8,
+	0xf7, 0x21, 0xc9, 0xd1, 0xcd, 0x74, 0x92, 0xa3, 0xf3, 0x85, 0x4c, 0x73, 0x8f, 0xec, 0x46, 0x9f,
+	0xb6, 0xe0, 0xc9, 0xfd, 0x13, 0xa3, 0xa3, 0xa7, 0xa0, 0xba, 0x15, 0x85, 0xed, 0x96, 0x90, 0xc1,
+	0xaa, 0x1f, 0x96, 0x85, 0x1e, 0x73, 0x18, 0x3d, 0x92, 0xed, 0x78, 0x41, 0x23, 0x7b, 0x24, 0xbb,
+	0xe2, 0x05, 0x0d, 0xcc, 0x20, 0x7d, 0xa4, 0x95, 0xbd, 0x0a, 0xc3, 0xf3, 0x61, 0xb3, 0xe9, 0x04,
+	0x0d, 0xf4, 0x1d, 0x30, 0xec, 0xf2, 0x9f, 0xc2, 0x3a, 0xc8, 0xbc, 0x01, 0x04, 0x14, 0x4b, 0x18,
+	0x7a, 0x1c, 0x2a, 0x4e, 0xb4, 0x25, 0x2d, 0x82, 0xcc, 0xcb, 0x70, 0x36, 0xda, 0x8a, 0x31, 0x6b,
+	0xb5, 0xff, 0xda, 0x82, 0x71, 0xfa, 0x88, 0xc7, 0x26, 0x98, 0x4d, 0xed, 0xd3, 0x30, 0xe4, 0xb4,
+	0x93, 0xed, 0xb0, 0xeb, 0x84, 0x39, 0xcb, 0x5a, 0xb1, 0x80, 0xd2, 0xc1, 0xaa, 0x4c, 0x1d, 0xc6,
+	0x60, 0x17, 0xe8, 0xbe, 0x62, 0x10, 0xaa, 0xa4, 0xc7, 0xed, 0x8d, 0xbc, 0xeb, 0xe8, 0x35, 0xde,
+	0x8c, 0x25, 0x9c, 0x76, 0xb6, 0x11, 0x36, 0x3a, 0xc2, 0x77, 0x5a, 0x75, 0x36, 0x17, 0x36, 0x3a,
+	0x98, 0x41, 0xd0, 0x13, 0x50, 0x8e, 0xb7, 0x1d, 0xe9, 0xf8, 0x20, 0xdd, 0xf8, 0xd7, 0x2e, 0xcd,
+	0x62, 0xda, 0xae, 0xa2, 0x52, 0x22, 0x3f, 0xeb, 0xc4, 0x9c, 0x8e, 0x4a, 0x89, 0x7c, 0xfb, 0x97,
+	0x2b, 0xc0, 0x1c, 0x9a, 0x9c, 0x88, 0x34, 0xd6, 0x43, 0x96, 0x74, 0xfa, 0x48, 0xfd, 0x06, 0xf4,
+	0x11, 0xfd, 0x61, 0xf6, 0x1d, 0x30, 0xee, 0x8f, 0xcb, 0xf7, 0xfb, 0xfe, 0x38, 0xdf, 0x25, 0xa0,
+	0xf2, 0x10, 0xb9, 0x04, 0xd8, 0x3f, 0x6e, 0x01, 0x52, 0xee, 0x69, 0xda, 0x67, 0x67, 0x06, 0xea,
+	0xca, 0x1f, 0x4e, 0xec, 0x17, 0xcd, 0xa2, 0x25, 0x00, 0x6b, 0x9c, 0x3e, 0xec, 0x32, 0x4f, 0x49,
+	0xf9, 0x59, 0x4e, 0xf3, 0x12, 0x26, 0x75, 0x85, 0x38, 0xb5, 0x7f, 0xab, 0x04, 0x8f, 0x70, 0xd5,
+	0x6d, 0xd9, 0x09, 0x9c, 0x2d, 0xd2, 0xa4, 0xa3, 0xea, 0xd7, 0x0b, 0xcb, 0x85, 0x8a, 0x17, 0x78,
+	0x32, 0x04, 0x65, 0x50, 0xde, 0xc9, 0xf9, 0x0c, 0xe7, 0x2c, 0x8b, 0x81, 0x97, 0x60, 0xd6, 0x39,
+	0x8a, 0xa1, 0x26, 0xeb, 0x78, 0x09, 0x59, 0x58, 0x10, 0x21, 0x25, 0x16, 0x84, 0x96, 0x43, 0xb0,
+	0x22, 0x44, 0x55, 0x19, 0x3f, 0x74, 0x77, 0xe8, 0x96, 0xcf, 0xaa, 0x32, 0x4b, 0xa2, 0x1d, 0x2b,
+	0x0c, 0xbb, 0x09, 0x13, 0x72, 0x0e, 0x5b, 0x57, 0x48, 0x07, 0x93, 0x4d, 0x2a, 0xff, 0x5d, 0xd9,
+	0x64, 0x94, 0x16, 0x53, 0xf2, 0x7f, 0xde, 0x04, 0xe2, 0x34, 0xae, 0xcc, 0x0d, 0x5d, 0xca, 0xcf,
+	0x0d, 0x6d, 0xff, 0x96, 0x05, 0x59, 0x05, 0x84, 0x99, 0xf3, 0xcc, 0x3a, 0x61, 0xbd, 0x12, 0xd4,
+	0x1f, 0x22, 0x5d, 0xec, 0xfb, 0x61, 0xc4, 0x49, 0xa8, 0x86, 0xc9, 0x6d, 0x4b, 0xe5, 0x7b, 0xbb,
+	0x13, 0x5d, 0x0e, 0x1b, 0xde, 0xa6, 0xc7, 0x6c, 0x4a, 0x66, 0x77, 0xf6, 0x9f, 0x55, 0xa1, 0xbe,
+	0x10, 0x75, 0x0e, 0x1f, 0x0b, 0xd8, 0x1d, 0xe9, 0x57, 0x3a, 0x54, 0xa4, 0x9f, 0x8c, 0x25, 0x2c,
+	0xf7, 0x8c, 0x25, 0x94, 0xb1, 0x80, 0x95, 0x07, 0x15, 0x0b, 0x58, 0x7d, 0x48, 0x62, 0x01, 0x87,
+	0x1e, 0x82, 0x58, 0xc0, 0xe1, 0x07, 0x16, 0x0b, 0x58, 0x3b, 0x38, 0x16, 0xb0, 0x9e, 0x1f, 0x0b,
+	0x68, 0xff, 0xf7, 0x0a, 0x1c, 0xef, 0x0a, 0x8f, 0x46, 0x2f, 0xc2, 0xa8, 0xda, 0xe7, 0xf2, 0x4a,
+	0xa2, 0x6e, 0xc6, 0x17, 0x68, 0x18, 0x4e, 0x61, 0xf6, 0xc1, 0xec, 0x17, 0xe1, 0x44, 0x44, 0x5e,
+	0x6b, 0x93, 0x36, 0x99, 0xdd, 0x4c, 0x48, 0xb4, 0x46, 0xdc, 0x30, 0x68, 0xf0, 0x64, 0xe4, 0xe5,
+	0xb9, 0x47, 0xef, 0xec, 0x4d, 0x9d, 0xc0, 0xdd, 0x60, 0x9c, 0xf7, 0x0c, 0x6a, 0xc1, 0x98, 0x6f,
+	0x9e, 0x7e, 0xc5, 0x3e, 0xb8, 0xa7, 0x83, 0xb3, 0xe2, 0x77, 0xa9, 0x66, 0x9c, 0x26, 0x90, 0x3e,
+	0x42, 0x57, 0x1f, 0xd0, 0x11, 0xfa, 0xe3, 0xfa, 0x08, 0xcd, 0xdd, 0xf5, 0xde, 0x57, 0x70, 0x78,
+	0x7c, 0x3f, 0x67, 0xe8, 0x41, 0x4e, 0xc5, 0xef, 0x86, 0x9a, 0x74, 0x65, 0xee, 0xcb, 0x05, 0xd8,
+	0xec, 0xa7, 0x87, 0x76, 0x70, 0xb7, 0x04, 0x39, 0x86, 0x1f, 0xca, 0xad, 0xf5, 0x89, 0x21, 0xc5,
+	0xad, 0x0f, 0x77, 0x6a, 0x40, 0xb7, 0xb9, 0x1b, 0x37, 0xd7, 0x13, 0xdf, 0x5b, 0xb4, 0xe1, 0x4a,
+	0x7b, 0x76, 0xab, 0x1d, 0xaa, 0xbc, 0xbb, 0xcf, 0x01, 0xe8, 0x43, 0xa7, 0x38, 0x2d, 0x28, 0x87,
+	0x28, 0x7d, 0x36, 0xc5, 0x06, 0x16, 0x7a, 0x01, 0x46, 0xbc, 0x20, 0x4e, 0x1c, 0xdf, 0xbf, 0xe4,
+	0x05, 0x89, 0x38, 0x41, 0x28, 0x85, 0x78, 0x51, 0x83, 0xb0, 0x89, 0x77, 0xe6, 0x9d, 0xc6, 0x77,
+	0x39, 0xcc, 0xf7, 0xdc, 0x86, 0xd3, 0x17, 0xbd, 0x44, 0xb1, 0x47, 0xb5, 0x8e, 0xd8, 0x41, 0x51,
+	0x4a, 0x31, 0xab, 0xa7, 0x14, 0x33, 0xe2, 0x73, 0x4b, 0xe9, 0x70, 0xe2, 0x6c, 0x7c, 0xae, 0xed,
+	0xc2, 0xc9, 0x8b, 0x5e, 0x72, 0xc1, 0xf3, 0xc9, 0x11, 0x12, 0xf9, 0xcd, 0x21, 0x18, 0x35, 0xd3,
+	0x66, 0x1c, 0x46, 0xe6, 0x7f, 0x8e, 0x9e, 0x70, 0xc4, 0x44, 0x78, 0xca, 0xc9, 0xe3, 0xc6, 0xc0,
+	0x39, 0x3c, 0xf2, 0x27, 0xd7, 0x38, 0xe4, 0x68, 0x9a, 0xd8, 0x1c, 0x00, 0xba, 0x05, 0xd5, 0x4d,
+	0x16, 0x6a, 0x5a, 0x2e, 0xc2, 0x3d, 0x2f, 0x6f, 0xf2, 0xf5, 0x8e, 0xe4, 0xc1, 0xaa, 0x9c, 0x1e,
+	0x55, 0x4c, 0xa3, 0x74, 0x86, 0x03, 0x23, 0x00, 0x48, 0x68, 0x3c, 0x0a, 0xa3, 0x97, 0x54, 0xa8,
+	0xde, 0x83, 0x54, 0x48, 0xf1, 0xe8, 0xa1, 0x07, 0xc4, 0xa3, 0x59, 0xd8, 0x70, 0xb2, 0xcd, 0x8e,
+	0x4d, 0x22, 0x62, 0x71, 0x98, 0x4d, 0x82, 0x11, 0x36, 0x9c, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x88,
+	0xe2, 0xf2, 0xb5, 0x22, 0x2e, 0xd1, 0xcc, 0x15, 0x7d, 0xd4, 0x0c, 0xfe, 0xc7, 0x4b, 0x30, 0x7e,
+	0x31, 0x68, 0xaf, 0x5e, 0x5c, 0x6d, 0x6f, 0xf8, 0x9e, 0x7b, 0x85, 0x74, 0x28, 0x17, 0xdf, 0x21,
+	0x9d, 0xc5, 0x85, 0xac, 0xbd, 0xe8, 0x0a, 0x6d, 0xc4, 0x1c, 0x46, 0xf9, 0xd6, 0xa6, 0x17, 0x6c,
+	0x91, 0xa8, 0x15, 0x79, 0xe2, 0x46, 0xca, 0xe0, 0x5b, 0x17, 0x34, 0x08, 0x9b, 0x78, 0xb4, 0xef,
+	0xf0, 0x56, 0xa0, 0x72, 0x98, 0xa9, 0xbe, 0x57, 0x68, 0x23, 0xe6, 0x30, 0x8a, 0x94, 0x44, 0x6d,
+	0x61, 0xf0, 0x35, 0x90, 0xd6, 0x69, 0x23, 0xe6, 0x30, 0x61, 0xbf, 0x61, 0xde, 0x8f, 0xd5, 0x2e,
+	0xfb, 0x0d, 0x73, 0x1c, 0x92, 0x70, 0x8a, 0xba, 0x43, 0x3a, 0x0b, 0x4e, 0xe2, 0x64, 0xcd, 0x2f,
+	0x57, 0x78, 0x33, 0x96, 0x70, 0x96, 0x14, 0x3d, 0x3d, 0x1d, 0x7f, 0xe3, 0x92, 0xa2, 0xa7, 0x87,
+	0xdf, 0xc3, 0x6c, 0xf8, 0xf7, 0x4a, 0x30, 0xfa, 0x66, 0x4d, 0xe8, 0x9c, 0xe2, 0x60, 0x37, 0xe0,
+	0x78, 0x57, 0xb2, 0x82, 0x3e, 0x34, 0x9f, 0x03, 0x93, 0xc9, 0xd8, 0x18, 0x46, 0x68, 0xc7, 0x32,
+	0x19, 0xe8, 0x3c, 0x1c, 0xe7, 0x9b, 0x97, 0x52, 0x62, 0xb1, 0xe7, 0x2a, 0x01, 0x05, 0xbb, 0x72,
+	0xbd, 0x9e, 0x05, 0xe2, 0x6e, 0x7c, 0xfb, 0xb3, 0x16, 0x8c, 0xa5, 0xf2, 0x47, 0x14, 0xa4, 0xa3,
+	0xb1, 0xdd, 0x1d, 0x32, 0xcf, 0x7d, 0x16, 0xd0, 0x55, 0x66, 0x62, 0x58, 0xef, 0x6e, 0x0d, 0xc2,
+	0x26, 0x9e, 0xfd, 0xbb, 0x65, 0xa8, 0x49, 0x2f, 0xc3, 0x3e, 0x86, 0xf2, 0x19, 0x0b, 0xc6, 0xd4,
+	0x35, 0x37, 0xbb, 0x97, 0x28, 0x15, 0x11, 0xce, 0x4a, 0x47, 0xa0, 0x2c, 0x6b, 0xc1, 0x66, 0xa8,
+	0x0f, 0x0c, 0xd8, 0x24, 0x86, 0xd3, 0xb4, 0xd1, 0x75, 0x80, 0xb8, 0x13, 0x27, 0xa4, 0x69, 0xdc,
+	0x90, 0xd8, 0xc6, 0x2a, 0x9b, 0x76, 0xc3, 0x88, 0xd0, 0x35, 0x75, 0x35, 0x6c, 0x90, 0x35, 0x85,
+	0xa9, 0x35, 0x3c, 0xdd, 0x86, 0x8d, 0x9e, 0xd0, 0xeb, 0xca, 0xc5, 0xa3, 0x52, 0x84, 0x5c, 0x97,
+	0xf3, 0xdb, 0x8f, 0x8f, 0xc7, 0x00, 0x5e, 0x10, 0xf6, 0x2f, 0x96, 0xe0, 0x58, 0x76, 0x26, 0xd1,
+	0xfb, 0x60, 0x54, 0x4e, 0x9a, 0x61, 0x80, 0x92, 0xae, 0x9d, 0xa3, 0xd8, 0x80, 0xdd, 0xdd, 0x9b,
+	0x9a, 0xd2, 0x2e, 0x9e, 0x33, 0x74, 0xf2, 0x66, 0x76, 0x0d, 0x2f, 0x58, 0xba, 0x0c, 0x52, 0x9d,
+	0x71, 0x17, 0x09, 0xe1, 0x19, 0x34, 0xd7, 0x99, 0x6d, 0xb5, 0x84, 0x9f, 0x83, 0xe1, 0x22, 0x61,
+	0x42, 0x71, 0x06, 0x1b, 0xad, 0xc2, 0x49, 0xa3, 0xe5, 0x2a, 0xf1, 0xb6, 0xb6, 0x37, 0xc2, 0x48,
+	0x9e, 0x57, 0x1f, 0xd7, 0x8e, 0xe4, 0xdd, 0x38, 0x38, 0xf7, 0x49, 0xaa, 0x18, 0xb9, 0x4e, 0xcb,
+	0x71, 0xbd, 0xa4, 0x23, 0x6e, 0xaa, 0x14, 0x1b, 0x9f, 0x17, 0xed, 0x58, 0x61, 0xd8, 0xbf, 0x3c,
+	0x04, 0xe3, 0xdc, 0x73, 0x9a, 0x88, 0x00, 0x0c, 0x74, 0x06, 0x4a, 0x5e, 0x43, 0x78, 0x7a, 0x80,
+	0x78, 0xb4, 0xb4, 0xb8, 0x80, 0x4b, 0x5e, 0x03, 0xbd, 0x0f, 0xea, 0x71, 0xe2, 0x44, 0xdc, 0x10,
+	0x76, 0xf8, 0xea, 0x57, 0x3a, 0x1d, 0x86, 0xec, 0x04, 0xeb, 0xfe, 0xd0, 0x2b, 0x2c, 0x97, 0xa0,
+	0x17, 0x6f, 0x0f, 0x62, 0x66, 0xbb, 0xa0, 0x7a, 0xc0, 0x46, 0x6f, 0xe8, 0x7b, 0xa1, 0xda, 0xda,
+	0x76, 0x62, 0x99, 0x77, 0xe7, 0x69, 0xc9, 0x41, 0x56, 0x69, 0xe3, 0xdd, 0xbd, 0xa9, 0x53, 0x62,
+	0x12, 0x54, 0x74, 0x04, 0x03, 0x60, 0xfe, 0x90, 0xc9, 0xff, 0xab, 0x07, 0xf0, 0xff, 0xa7, 0x61,
+	0xa8, 0x11, 0x75, 0xd6, 0x2e, 0xcd, 0x0a, 0xb1, 0xad, 0x56, 0xf9, 0x02, 0x6b, 0xc5, 0x02, 0x4a,
+	0xb9, 0xd5, 0x36, 0x27, 0xd9, 0xa0, 0xc8, 0xc3, 0x69, 0x5d, 0xe4, 0x92, 0x06, 0x61, 0x13, 0x2f,
+	0xcf, 0xe3, 0xbe, 0x56, 0xbc, 0xc7, 0x3d, 0x26, 0x6e, 0x18, 0x35, 0xfa, 0xf5, 0xb8, 0x47, 0xd3,
+	0x00, 0x51, 0xe8, 0xfb, 0x1b, 0x8e, 0xbb, 0xb3, 0xb8, 0x20, 0xfc, 0xd4, 0xd8, 0xc7, 0xc0, 0xaa,
+	0x15, 0x1b, 0x18, 0xe8, 0x47, 0x2d, 0x7a, 0x80, 0xf4, 0x12, 0xcf, 0x61, 0x6b, 0xb7, 0x98, 0xf8,
+	0x0d, 0xf5, 0xe5, 0x16, 0x79, 0xcf, 0x61, 0x64, 0x1e, 0x49, 0x15, 0x31, 0x6c, 0x52, 0x46, 0xcf,
+	0xc3, 0x68, 0x23, 0xea, 0xa8, 0x92, 0x65, 0x2c, 0x35, 0x81, 0x88, 0x0a, 0x58, 0x30, 0xda, 0x71,
+	0x0a, 0xcb, 0xde, 0xab, 0xc0, 0xb1, 0xec, 0x7a, 0x49, 0x6f, 0x0d, 0xeb, 0x48, 0xb7, 0x46, 0xe9,
+	0x68, 0xb6, 0x46, 0x79, 0xc0, 0xad, 0x51, 0xe9, 0x7b, 0x6b, 0x54, 0x0f, 0xb3, 0x35, 0x86, 0xee,
+	0x7d, 0x6b, 0x0c, 0x3f, 0xf0, 0xad, 0x91, 0x5d, 0x60, 0xb5, 0xbe, 0x16, 0xd8, 0x4f, 0x5b, 0x30,
+	0x29, 0xde, 0xd3, 0xc8, 0xd2, 0xb8, 0xa6, 0x0a, 0xc0, 0x05, 0xed, 0xe6, 0x86, 0x70, 0x58, 0x2e,
+	0xeb, 0x69, 0xbc, 0xca, 0x5a, 0xb1, 0x80, 0xa2, 0x27, 0xa0, 0xdc, 0x8e, 0xfc, 0xec, 0xe5, 0xc9,
+	0x35, 0xbc, 0x84, 0x69, 0x3b, 0x7a, 0x19, 0xaa, 0x71, 0x22, 0xef, 0x92, 0xea, 0x73, 0xcf, 0xe8,
+	0x12, 0x0a, 0x4e, 0x42, 0x3f, 0xfb, 0xa3, 0xf9, 0x03, 0x20, 0x98, 0x3f, 0x66, 0xff, 0x9e, 0x05,
+	0x75, 0x81, 0xb2, 0x1e, 0xa2, 0x17, 0x61, 0x94, 0x5f, 0x2f, 0xcc, 0x45, 0x4e, 0xe0, 0x6e, 0x67,
+	0x4d, 0xba, 0xeb, 0x06, 0x0c, 0xa7, 0x30, 0xbb, 0xb2, 0x5e, 0x96, 0x8a, 0xf8, 0x66, 0x6a, 0x60,
+	0xc6, 0xe8, 0xf7, 0xcf, 0x7a, 0x69, 0xbf, 0x0e, 0x27, 0xf3, 0x9e, 0x42, 0xf3, 0xcc, 0xf9, 0x8a,
+	0xa7, 0x30, 0xe5, 0x6f, 0xf5, 0x56, 0xc3, 0xf9, 0x8a, 0xb5, 0xdf, 0xdd, 0x9b, 0x3a, 0x61, 0x3c,
+	0xa2, 0xd2, 0x90, 0xaa, 0x07, 0xe9, 0xb7, 0x70, 0x5a, 0x5e, 0xf6, 0x5b, 0xcc, 0xae, 0x2e, 0x62,
+	0xda, 0x6e, 0x2f, 0x43, 0xa5, 0x4f, 0xf5, 0xb3, 0x2f, 0x6b, 0xe5, 0xbb, 0xa1, 0x46, 0xbb, 0x93,
+	0xa6, 0xab, 0x22, 0xba, 0x0c, 0xa1, 0x26, 0x6b, 0x68, 0x23, 0x1b, 0xca, 0x9e, 0x23, 0x7d, 0x41,
+	0x95, 0x72, 0xb1, 0x18, 0xc7, 0x6d, 0xc6, 0x5b, 0x28, 0x10, 0x3d, 0x05, 0x65, 0x72, 0xbb, 0x95,
+	0x75, 0xfa, 0x3c, 0x7f, 0xbb, 0xe5, 0x45, 0x24, 0xa6, 0x48, 0xe4, 0x76, 0x4b, 0x68, 0x1a, 0x7c,
+	0xfd, 0x65, 0x34, 0x0d, 0xfb, 0x36, 0xd4, 0x55, 0xd1, 0x6e, 0xb4, 0x23, 0x0f, 0x9b, 0x56, 0x11,
+	0x31, 0x45, 0xb2, 0xdf, 0x1e, 0xc7, 0xcc, 0x36, 0x80, 0xce, 0x33, 0x55, 0xd4, 0xe1, 0xe4, 0x2c,
+	0x54, 0xdc, 0x50, 0x64, 0x08, 0xac, 0xe9, 0x6e, 0xd8, 0x29, 0x93, 0x41, 0xec, 0x1b, 0x30, 0x7e,
+	0x25, 0x08, 0x6f, 0xb1, 0x92, 0x96, 0xac, 0x82, 0x03, 0xed, 0x78, 0x93, 0xfe, 0xc8, 0xda, 0x34,
+	0x18, 0x14, 0x73, 0x98, 0xca, 0x0d, 0x5f, 0xea, 0x95, 0x1b, 0xde, 0xfe, 0xa8, 0x05, 0xa3, 0xea,
+	0x8e, 0xeb, 0xe2, 0xee, 0x4e, 0x7f, 0xbe, 0x35, 0x46, 0x26, 0xa7, 0xd2, 0x01, 0x99, 0x9c, 0xa4,
+	0x1b, 0x4e, 0xb9, 0x97, 0x1b, 0x8e, 0xfd, 0x7f, 0x2d, 0x38, 0xa6, 0x86, 0x20, 0x4f, 0x93, 0x2f,
+	0xc2, 0xe8, 0x46, 0xdb, 0xf3, 0x1b, 0xb2, 0x34, 0x45, 0x86, 0x65, 0xcc, 0x19, 0x30, 0x9c, 0xc2,
+	0x44, 0xe7, 0x00, 0x36, 0xbc, 0xc0, 0x89, 0x3a, 0xab, 0xfa, 0xf8, 0xaa, 0x4e, 0x34, 0x73, 0x0a,
+	0x82, 0x0d, 0x2c, 0xf4, 0x61, 0xa8, 0xed, 0x4a, 0xef, 0xab, 0x72, 0xa1, 0x09, 0x88, 0xc4, 0x7c,
+	0xe8, 0x9d, 0xa0, 0xdc, 0xb9, 0x14, 0x45, 0xfb, 0xf3, 0x65, 0x18, 0x4f, 0x27, 0x0d, 0xea, 0xc3,
+	0xa6, 0xfc, 0x14, 0x54, 0x59, 0x1e, 0xa1, 0xec, 0xc2, 0xe2, 0xb5, 0x24, 0x38, 0x0c, 0xc5, 0x30,
+	0xc4, 0xd9, 0x69, 0x31, 0x15, 0xde, 0xd5, 0x20, 0xd5, 0xcd, 0x15, 0xbb, 0x1a, 0x14, 0x57, 0xc9,
+	0x82, 0x14, 0xfa, 0x84, 0x05, 0xc3, 0x61, 0xcb, 0x4c, 0x4a, 0xfe, 0xde, 0x22, 0x13, 0x2a, 0x89,
+	0xac, 0x25, 0xe2, 0x9c, 0xa8, 0x16, 0x9e, 0x5c, 0x0c, 0x92, 0xf4, 0x99, 0xef, 0x81, 0x51, 0x13,
+	0xf3, 0xa0, 0xa3, 0x62, 0xcd, 0x3c, 0x2a, 0x7e, 0xc6, 0x5c, 0x92, 0x22, 0x65, 0x54, 0x1f, 0x9b,
+	0xfd, 0x1a, 0x54, 0x5d, 0xe5, 0xce, 0x7e, 0x4f, 0xe5, 0x94, 0xd4, 0x35, 0x2a, 0x73, 0x15, 0xe4,
+	0xbd, 0xd9, 0x7f, 0x6a, 0x19, 0xeb, 0x03, 0x93, 0x78, 0xb1, 0x81, 0x22, 0x28, 0x6f, 0xed, 0xee,
+	0x08, 0x4d, 0xf2, 0x72, 0x41, 0xd3, 0x7b, 0x71, 0x77, 0x47, 0xef, 0x30, 0xb3, 0x15, 0x53, 0x62,
+	0x7d, 0x5c, 0xaf, 0xa6, 0x32, 0x8b, 0x95, 0xfb, 0x28, 0x26, 0xff, 0xc5, 0x12, 0x1c, 0xef, 0x5a,
+	0x54, 0xe8, 0x75, 0xa8, 0x46, 0xf4, 0x2d, 0xc5, 0xeb, 0x2d, 0x15, 0x96, 0x0b, 0x2c, 0x5e, 0x34,
+	0x74, 0xb3, 0x74, 0x3b, 0xe6, 0x24, 0xd1, 0x65, 0x40, 0x3a, 0x84, 0x43, 0xdd, 0xed, 0xf2, 0x57,
+	0x56, 0x9e, 0xd9, 0xb3, 0x5d, 0x18, 0x38, 0xe7, 0x29, 0xf4, 0x52, 0xf6, 0x8a, 0x38, 0x53, 0xe6,
+	0x62, 0xbf, 0xdb, 0x5e, 0xfb, 0x0d, 0x73, 0x09, 0x5e, 0xd7, 0xcc, 0x74, 0x50, 0xb3, 0x5d, 0x17,
+	0x67, 0x2d, 0xf7, 0xcb, 0x59, 0xed, 0x5f, 0x2f, 0xc1, 0x58, 0x2a, 0x6d, 0x3d, 0xf2, 0xa1, 0x46,
+	0x7c, 0xe6, 0x0d, 0x25, 0xa5, 0xef, 0xa0, 0x15, 0xf0, 0x14, 0x9f, 0x3c, 0x2f, 0xfa, 0xc5, 0x8a,
+	0xc2, 0xc3, 0xe1, 0x43, 0xfe, 0x22, 0x8c, 0xca, 0x01, 0xbd, 0xd7, 0x69, 0xfa, 0xd9, 0xe9, 0x3b,
+	0x6f, 0xc0, 0x70, 0x0a, 0xd3, 0xfe, 0x6a, 0x19, 0x26, 0xb9, 0xfb, 0x58, 0x43, 0x6d, 0x06, 0xe5,
+	0x06, 0xfa, 0x63, 0xba, 0xb8, 0x04, 0x9f, 0xc8, 0x8d, 0x41, 0x0b, 0xce, 0xe6, 0x13, 0xea, 0x2b,
+	0x90, 0xea, 0xcb, 0x99, 0x40, 0x2a, 0x6e, 0xc4, 0xdc, 0x3a, 0xa2, 0x11, 0x1d, 0x3e, 0xb2, 0xea,
+	0x41, 0xc6, 0x42, 0xfd, 0x5c, 0x09, 0x26, 0x32, 0xd5, 0x7c, 0xd1, 0xe7, 0xd3, 0x05, 0xe0, 0xac,
+	0x22, 0x1c, 0x23, 0xf6, 0x2d, 0xf0, 0x7a, 0xb8, 0x32, 0x70, 0x0f, 0x68, 0xab, 0xd8, 0x7f, 0x54,
+	0x82, 0xf1, 0x74, 0x19, 0xe2, 0x87, 0x70, 0xa6, 0xde, 0x06, 0x75, 0x56, 0x69, 0xf3, 0x0a, 0xe9,
+	0x48, 0xff, 0x0b, 0x5e, 0xd4, 0x50, 0x36, 0x62, 0x0d, 0x7f, 0x28, 0xaa, 0xeb, 0xd9, 0xff, 0xcc,
+	0x82, 0x53, 0xfc, 0x2d, 0xb3, 0xeb, 0xf0, 0xef, 0xe4, 0xcd, 0xee, 0xab, 0xc5, 0x0e, 0x30, 0x53,
+	0x14, 0xe5, 0xa0, 0xf9, 0xa5, 0xca, 0xcb, 0x49, 0x31, 0xda, 0xf4, 0x52, 0x78, 0x08, 0x07, 0x7b,
+	0xa8, 0xc5, 0x60, 0xff, 0x71, 0x09, 0x46, 0x56, 0xe6, 0x17, 0x15, 0x0b, 0x9f, 0x81, 0xba, 0x1b,
+	0x11, 0x47, 0xdb, 0xf8, 0x4c, 0xe7, 0x64, 0x09, 0xc0, 0x1a, 0x87, 0x9e, 0xa2, 0xb8, 0x73, 0x7f,
+	0x9c, 0x3d, 0x45, 0x71, 0xdf, 0xff, 0x18, 0x4b, 0x38, 0x7a, 0x16, 0x6a, 0x2c, 0xa1, 0xc8, 0xb5,
+	0x48, 0x4a, 0x1c, 0x7d, 0xb4, 0x66, 0xed, 0x78, 0x09, 0x2b, 0x0c, 0xda, 0x71, 0x23, 0x74, 0x63,
+	0x8a, 0x9c, 0x31, 0xbb, 0x2d, 0xd0, 0x66, 0xbc, 0x84, 0x25, 0x9c, 0xa5, 0xa5, 0x66, 0x46, 0x29,
+	0x8a, 0x5c, 0x4d, 0x0f, 0x9a, 0x5b, 0xaf, 0x28, 0xba, 0xc6, 0x39, 0x4c, 0xfa, 0xf2, 0x4c, 0x50,
+	0xff, 0x70, 0x7f, 0x41, 0xfd, 0xf6, 0x1f, 0x95, 0xa1, 0xae, 0x2d, 0xa7, 0x9e, 0xc8, 0xa2, 0x55,
+	0x48, 0xd1, 0x9d, 0xb5, 0x4e, 0xe0, 0xaa, 0xae, 0xb9, 0x9f, 0x95, 0x91, 0x44, 0x2b, 0x6b, 0x79,
+	0x2e, 0x3d, 0x30, 0xcb, 0xf3, 0x87, 0x44, 0x0c, 0x79, 0xb9, 0xb0, 0x8c, 0x78, 0xb5, 0x4c, 0xe0,
+	0x78, 0x8b, 0xea, 0xd8, 0x49, 0x54, 0x50, 0x22, 0x49, 0x4c, 0xbb, 0x52, 0xc5, 0xdf, 0xd4, 0x29,
+	0x86, 0x35, 0x63, 0x4e, 0xc8, 0x8e, 0x01, 0x75, 0xcf, 0xc5, 0x21, 0x23, 0x6a, 0x67, 0xa0, 0xee,
+	0xb4, 0x93, 0xb0, 0x49, 0xa7, 0x49, 0xb8, 0x52, 0xe9, 0x98, 0x61, 0x09, 0xc0, 0x1a, 0xc7, 0xfe,
+	0xa9, 0x21, 0xc8, 0xe4, 0xb4, 0x42, 0xb7, 0xa1, 0xae, 0xb2, 0x5a, 0x15, 0x93, 0xef, 0x42, 0xaf,
+	0x28, 0x35, 0x18, 0xd5, 0x84, 0x35, 0x31, 0xb4, 0x25, 0x6d, 0xe9, 0x7c, 0xb7, 0xbf, 0x3b, 0x6b,
+	0x4b, 0x7f, 0x57, 0x7f, 0xfe, 0x08, 0x74, 0xad, 0xce, 0xf0, 0x64, 0xca, 0xd3, 0x07, 0x9a, 0xdd,
+	0xcb, 0x07, 0x98, 0xdd, 0x3f, 0x26, 0x4a, 0xb5, 0x62, 0x12, 0xb7, 0xfd, 0x44, 0xac, 0x86, 0x77,
+	0x17, 0xb8, 0xcb, 0x78, 0xc7, 0x3a, 0x45, 0x25, 0xff, 0x8f, 0x0d, 0xa2, 0xe9, 0xcb, 0x91, 0xa1,
+	0x23, 0xbd, 0x1c, 0x19, 0x2e, 0xf4, 0x72, 0xe4, 0x1c, 0x00, 0x5b, 0xdb, 0x3c, 0xf2, 0xaf, 0xc6,
+	0xcc, 0x99, 0x4a, 0xc4, 0x60, 0x05, 0xc1, 0x06, 0x16, 0xfa, 0xac, 0x05, 0x13, 0x2d, 0x12, 0x34,
+	0xbc, 0x60, 0x6b, 0xb6, 0xd5, 0x8a, 0xc2, 0x5d, 0xc7, 0x17, 0xd9, 0x1b, 0xaf, 0x0e, 0x3e, 0xeb,
+	0x37, 0x9c, 0x5d, 0x22, 0x7b, 0x15, 0x85, 0x60, 0xd3, 0xa4, 0x70, 0x96, 0xb6, 0xfd, 0x5d, 0x90,
+	0xce, 0xf9, 0x8a, 0xa6, 0x64, 0x8a, 0x59, 0xee, 0xbb, 0xc1, 0x52, 0x4a, 0xa4, 0xb2, 0xc1, 0xfe,
+	0xaa, 0x05, 0x66, 0x62, 0x5a, 0xf4, 0x1a, 0xcf, 0x80, 0x6b, 0x15, 0xe1, 0x0b, 0x60, 0xf4, 0x3b,
+	0xbd, 0xec, 0xb4, 0x32, 0x7e, 0xa9, 0x32, 0x0d, 0xee, 0x99, 0x77, 0x42, 0x4d, 0x42, 0x0f, 0xa5,
+	0xbc, 0x7f, 0x04, 0x4e, 0xc8, 0xf4, 0x54, 0xf2, 0xda, 0x5e, 0xf8, 0x87, 0xdd, 0x9f, 0x78, 0xc2,
+	0x5f, 0xb3, 0xe0, 0x6c, 0x76, 0x00, 0xf1, 0x72, 0x18, 0x78, 0x49, 0x18, 0xad, 0x91, 0x24, 0xf1,
+	0x82, 0x2d, 0x56, 0xa8, 0xe0, 0x96, 0x13, 0xc9, 0x62, 0x95, 0x8c, 0x71, 0xdf, 0x70, 0xa2, 0x00,
+	0xb3, 0x56, 0xd4, 0x81, 0x21, 0x1e, 0x2e, 0x25, 0x4e, 0x65, 0x03, 0xee, 0xd5, 0x9c, 0xe9, 0xd0,
+	0xc7, 0x42, 0x1e, 0xaa, 0x85, 0x05, 0x41, 0xfb, 0x1b, 0x16, 0xa0, 0x95, 0x5d, 0x12, 0x45, 0x5e,
+	0xc3, 0x08, 0xf0, 0x62, 0x25, 0xd8, 0x8d, 0x52, 0xeb, 0x66, 0xf2, 0xb4, 0x4c, 0x09, 0x76, 0xe3,
+	0x5f, 0x7e, 0x09, 0xf6, 0xd2, 0xe1, 0x4a, 0xb0, 0xa3, 0x15, 0x38, 0xd5, 0xe4, 0xc7, 0x4a, 0x5e,
+	0xd6, 0x98, 0x9f, 0x31, 0x55, 0x9e, 0x9f, 0xd3, 0x77, 0xf6, 0xa6, 0x4e, 0x2d, 0xe7, 0x21, 0xe0,
+	0xfc, 0xe7, 0xec, 0x77, 0x02, 0xe2, 0x81, 0x0e, 0xf3, 0x79, 0x81, 0x05, 0x3d, 0xcd, 0x2e, 0xf6,
+	0x97, 0xaa, 0x30, 0x91, 0x29, 0x65, 0x46, 0x8f, 0xf4, 0xdd, 0x91, 0x0c, 0x03, 0xeb, 0x13, 0xdd,
+	0xc3, 0xeb, 0x2b, 0x36, 0x22, 0x80, 0xaa, 0x17, 0xb4, 0xda, 0x49, 0x31, 0x69, 0xc6, 0xf8, 0x20,
+	0x16, 0x69, 0x87, 0xc6, 0x3d, 0x09, 0xfd, 0x8b, 0x39, 0x99, 0x22, 0x23, 0x2d, 0x52, 0x87, 0xae,
+	0xca, 0x03, 0x32, 0xfb, 0x7c, 0x4c, 0xc7, 0x3d, 0x54, 0x8b, 0xb0, 0x69, 0x67, 0x16, 0xcb, 0x51,
+	0x3b, 0xc5, 0xfe, 0x52, 0x09, 0x46, 0x8c, 0x8f, 0x86, 0x7e, 0x3a, 0x9d, 0xb6, 0xdd, 0x2a, 0xee,
+	0x95, 0x58, 0xff, 0xd3, 0x3a, 0x31, 0x3b, 0x7f, 0xa5, 0xa7, 0xbb, 0x33, 0xb6, 0xdf, 0xdd, 0x9b,
+	0x3a, 0x96, 0xc9, 0xc9, 0x9e, 0xca, 0xe2, 0x7e, 0xe6, 0x07, 0x61, 0x22, 0xd3, 0x4d, 0xce, 0x2b,
+	0xaf, 0x9b, 0xaf, 0x3c, 0xb0, 0xf9, 0xd1, 0x9c, 0xb2, 0x5f, 0xa0, 0x53, 0x26, 0xb2, 0x1b, 0x85,
+	0x3e, 0xe9, 0xc3, 0xf6, 0x9a, 0x39, 0xef, 0x94, 0xfa, 0x4c, 0x62, 0xf6, 0x0c, 0xd4, 0x5a, 0xa1,
+	0xef, 0xb9, 0x9e, 0xaa, 0xfa, 0xc2, 0xd2, 0xa6, 0xad, 0x8a, 0x36, 0xac, 0xa0, 0xe8, 0x16, 0xd4,
+	0x6f, 0xde, 0x4a, 0xf8, 0xb5, 0xa7, 0xb8, 0x5a, 0x29, 0xea, 0xb6, 0x53, 0x29, 0x51, 0xea, 0x5e,
+	0x15, 0x6b, 0x5a, 0xc8, 0x86, 0x21, 0x26, 0x04, 0x65, 0x6e, 0x02, 0x76, 0xed, 0xc3, 0xa4, 0x63,
+	0x8c, 0x05, 0xc4, 0xfe, 0x37, 0x23, 0x70, 0x32, 0xaf, 0x9e, 0x24, 0xfa, 0x30, 0x0c, 0xf1, 0x31,
+	0x16, 0x53, 0xb2, 0x38, 0x8f, 0xc6, 0x45, 0xd6, 0xa1, 0x18, 0x16, 0xfb, 0x8d, 0x05, 0x4d, 0x41,
+	0xdd, 0x77, 0x36, 0xc4, 0x0a, 0x39, 0x1a, 0xea, 0x4b, 0x8e, 0xa6, 0xbe, 0xe4, 0x70, 0xea, 0xbe,
+	0xb3, 0x81, 0x6e, 0x43, 0x75, 0xcb, 0x4b, 0x88, 0x23, 0x8c, 0x45, 0x37, 0x8e, 0x84, 0x38, 0x71,
+	0xb8, 0x96, 0xc6, 0x7e, 0x62, 0x4e, 0x10, 0x7d, 0xc5, 0x82, 0x89, 0x8d, 0x74, 0xf6, 0x44, 0xc1,
+	0x3c, 0x9d, 0x23, 0xa8, 0x19, 0x9a, 0x26, 0xc4, 0x55, 0xcf, 0x4c, 0x23, 0xce, 0x0e, 0x07, 0x7d,
+	0xdc, 0x82, 0xe1, 0x4d, 0xcf, 0x37, 0x8a, 0xb2, 0x1d, 0xc1, 0xc7, 0xb9, 0xc0, 0x08, 0xe8, 0x13,
+	0x10, 0xff, 0x1f, 0x63, 0x49, 0xb9, 0x97, 0xa4, 0x1a, 0x1a, 0x54, 0x52, 0x0d, 0x3f, 0x20, 0x49,
+	0xf5, 0x29, 0x0b, 0xea, 0x6a, 0xa6, 0x85, 0xff, 0xdf, 0xfb, 0x8e, 0xf0, 0x93, 0x73, 0x0b, 0x99,
+	0xfa, 0x8b, 0x35, 0x71, 0xf4, 0x86, 0x05, 0x23, 0xce, 0xeb, 0xed, 0x88, 0x34, 0xc8, 0x6e, 0xd8,
+	0x8a, 0xc5, 0x39, 0xe7, 0xd5, 0xe2, 0x07, 0x33, 0x4b, 0x89, 0x2c, 0x90, 0xdd, 0x95, 0x56, 0x2c,
+	0xf2, 0xa6, 0xe8, 0x06, 0x6c, 0x0e, 0x01, 0xfd, 0x88, 0x96, 0xe3, 0x50, 0x44, 0xad, 0x92, 0xbc,
+	0xd1, 0xf4, 0x95, 0x06, 0x88, 0xc0, 0x63, 0x6e, 0x18, 0x24, 0x5e, 0xd0, 0x26, 0x2b, 0x01, 0x26,
+	0xad, 0xf0, 0x6a, 0x98, 0x5c, 0x08, 0xdb, 0x41, 0xe3, 0x7c, 0x14, 0x85, 0x11, 0x4b, 0xb3, 0x67,
+	0x54, 0xaa, 0x9f, 0xef, 0x8d, 0x8a, 0xf7, 0xeb, 0x67, 0x10, 0x9d, 0x61, 0xaf, 0x04, 0x53, 0x07,
+	0x4c, 0x36, 0x7a, 0x11, 0x46, 0xc3, 0x68, 0xcb, 0x09, 0xbc, 0xd7, 0xcd, 0xcc, 0xb1, 0x4a, 0x21,
+	0x5d, 0x31, 0x60, 0x38, 0x85, 0x69, 0xa6, 0x14, 0x2c, 0x1d, 0x90, 0x52, 0xf0, 0x2c, 0x54, 0x22,
+	0xd2, 0x0a, 0xb3, 0xe7, 0x2a, 0x96, 0x5e, 0x80, 0x41, 0xa4, 0x07, 0x55, 0x25, 0xdf, 0x83, 0x2a,
+	0x95, 0xe1, 0xb4, 0x7a, 0x5f, 0x32, 0x9c, 0x52, 0x89, 0x29, 0xae, 0xf3, 0x86, 0xb4, 0xc4, 0x4c,
+	0x5f, 0xb3, 0xd9, 0x5f, 0x2c, 0xc3, 0x13, 0xfb, 0x6e, 0x2d, 0x1d, 0x5c, 0x64, 0xed, 0x13, 0x5c,
+	0x24, 0xa7, 0xa7, 0x74, 0xd0, 0xf4, 0x94, 0x7b, 0x4c, 0xcf, 0xc7, 0x29, 0xc7, 0x90, 0x19, 0x77,
+	0x85, 0x90, 0x18, 0x30, 0xe0, 0xab, 0x57, 0x02, 0x5f, 0xc1, 0x2c, 0x24, 0x14, 0x6b, 0xba, 0xf4,
+	0xb8, 0x94, 0x4a, 0x80, 0x57, 0x2d, 0x42, 0x62, 0xf6, 0xcc, 0x7a, 0xcb, 0xd9, 0x44, 0xaf, 0xac,
+	0x7a, 0xf6, 0x6f, 0x54, 0xe0, 0xa9, 0x3e, 0x04, 0x9d, 0xb9, 0x8a, 0xad, 0x3e, 0x57, 0xf1, 0xdf,
+	0xf0, 0xcf, 0xf4, 0xc9, 0xdc, 0xcf, 0x84, 0x8b, 0xff, 0x4c, 0xfb, 0x7f, 0x21, 0x76, 0x23, 0x12,
+	0xc4, 0xc4, 0x6d, 0x47, 0x3c, 0xd0, 0xd2, 0xc8, 0x3d, 0xb2, 0x28, 0xda, 0xb1, 0xc2, 0xa0, 0xc7,
+	0x5f, 0xd7, 0xa1, 0xdb, 0x7f, 0xb8, 0xa0, 0x84, 0x67, 0x66, 0x1a, 0x13, 0xae, 0x7d, 0xcd, 0xcf,
+	0x52, 0x0e, 0xc0, 0xc9, 0xd8, 0xbf, 0x6d, 0xc1, 0x99, 0xde, 0xda, 0x08, 0x7a, 0x0e, 0x46, 0x36,
+	0x98, 0x83, 0xeb, 0x32, 0x73, 0xe1, 0x12, 0x4b, 0x87, 0xbd, 0xaf, 0x6e, 0xc6, 0x26, 0x0e, 0x9a,
+	0x87, 0xe3, 0xa6, 0x67, 0xec, 0xb2, 0xe1, 0xfb, 0xc5, 0xec, 0x25, 0xeb, 0x59, 0x20, 0xee, 0xc6,
+	0x47, 0xd3, 0x00, 0x89, 0x97, 0xf8, 0x84, 0x3f, 0xcd, 0x17, 0x1a, 0x33, 0x70, 0xae, 0xab, 0x56,
+	0x6c, 0x60, 0xd8, 0xdf, 0x2c, 0xe7, 0xbf, 0x06, 0xd7, 0x72, 0x0f, 0xb3, 0xfa, 0xf7, 0xf7, 0x71,
+	0x4d, 0x71, 0xe8, 0xf2, 0xfd, 0xe6, 0xd0, 0x95, 0x5e, 0x1c, 0x1a, 0x2d, 0xc0, 0xb1, 0x56, 0xc6,
+	0xc3, 0x59, 0x5c, 0x92, 0xa9, 0xec, 0xb9, 0x5d, 0x1e, 0xd0, 0x5d, 0x4f, 0x3c, 0xe4, 0x4b, 0xf5,
+	0x6b, 0x25, 0x38, 0xdd, 0xf3, 0x60, 0x71, 0x9f, 0x24, 0x90, 0xf9, 0xf9, 0x2b, 0xf7, 0xe7, 0xf3,
+	0x9b, 0x1f, 0xa5, 0x7a, 0xe0, 0x47, 0xe9, 0x47, 0x9c, 0xff, 0x49, 0xa9, 0xe7, 0x66, 0xa1, 0x07,
+	0xd1, 0x6f, 0xd9, 0x99, 0x7c, 0x09, 0xc6, 0x9c, 0x56, 0x8b, 0xe3, 0xb1, 0x18, 0xba, 0x4c, 0x46,
+	0xef, 0x59, 0x13, 0x88, 0xd3, 0xb8, 0x7d, 0x4d, 0xec, 0x9f, 0x5b, 0x50, 0xc7, 0x64, 0x93, 0x73,
+	0x38, 0x74, 0x53, 0x4c, 0x91, 0x55, 0x44, 0x59, 0x25, 0x3a, 0xb1, 0xb1, 0xc7, 0xd2, 0xec, 0xe4,
+	0x4d, 0xf6, 0xa0, 0x59, 0x94, 0x54, 0x96, 0x9c, 0x72, 0xef, 0x2c, 0x39, 0xf6, 0x6f, 0xd6, 0xe9,
+	0xeb, 0xb5, 0xc2, 0xf9, 0x88, 0x34, 0x62, 0x19, 0x98, 0x61, 0xf5, 0x08, 0xcc, 0x30, 0xef, 0x4b,
+	0x4b, 0x87, 0xca, 0x40, 0x5c, 0x3e, 0x30, 0x03, 0xf1, 0x4b, 0x30, 0x16, 0xc7, 0xdb, 0xab, 0x91,
+	0xb7, 0xeb, 0x24, 0xe4, 0x0a, 0x91, 0xe9, 0x01, 0x75, 0x36, 0xce, 0xb5, 0x4b, 0x1a, 0x88, 0xd3,
+	0xb8, 0xe8, 0x22, 0x1c, 0xd7, 0x79, 0x80, 0x49, 0x94, 0xb0, 0xe0, 0x74, 0xbe, 0x12, 0x54, 0xea,
+	0x37, 0x9d, 0x39, 0x58, 0x20, 0xe0, 0xee, 0x67, 0x28, 0xcf, 0x4d, 0x35, 0xd2, 0x81, 0x0c, 0xa5,
+	0x79, 0x6e, 0xaa, 0x1f, 0x3a, 0x96, 0xae, 0x27, 0xd0, 0x32, 0x9c, 0xe0, 0x0b, 0x63, 0xb6, 0xd5,
+	0x32, 0xde, 0x68, 0x38, 0x5d, 0xcb, 0xe6, 0x62, 0x37, 0x0a, 0xce, 0x7b, 0x0e, 0xbd, 0x00, 0x23,
+	0xaa, 0x79, 0x71, 0x41, 0x5c, 0xf5, 0x29, 0xd3, 0x9e, 0xea, 0x66, 0xb1, 0x81, 0x4d, 0x3c, 0xf4,
+	0x5e, 0x78, 0x54, 0xff, 0xe5, 0xc9, 0x4e, 0xf8, 0xfd, 0xb7, 0x0c, 0x84, 0x53, 0x15, 0x5b, 0x2f,
+	0xe6, 0xa2, 0x35, 0x70, 0xaf, 0xe7, 0xd1, 0x06, 0x9c, 0x51, 0xa0, 0xf3, 0x41, 0xc2, 0xd2, 0x11,
+	0xc4, 0x64, 0xce, 0x89, 0x99, 0x27, 0x07, 0xb0, 0xf7, 0xb4, 0x45, 0xef, 0x67, 0x2e, 0x7a, 0xc9,
+	0xa5, 0x3c, 0x4c, 0xbc, 0x84, 0xf7, 0xe9, 0x05, 0xcd, 0x40, 0x9d, 0x04, 0xce, 0x86, 0x4f, 0x56,
+	0xe6, 0x17, 0xc5, 0x89, 0x54, 0x47, 0x6b, 0x48, 0x00, 0xd6, 0x38, 0x2a, 0xde, 0x60, 0xb4, 0x57,
+	0xbc, 0x01, 0x5a, 0x85, 0x93, 0x5b, 0x6e, 0x8b, 0x6a, 0x99, 0x9e, 0x4b, 0x66, 0x5d, 0xe6, 0xe0,
+	0x4c, 0x3f, 0x0c, 0x2f, 0x32, 0xa4, 0x42, 0x5a, 0x2f, 0xce, 0xaf, 0x76, 0xe1, 0xe0, 0xdc, 0x27,
+	0x99, 0x23, 0x7c, 0x14, 0xde, 0xee, 0x4c, 0x9e, 0xc8, 0x38, 0xc2, 0xd3, 0x46, 0xcc, 0x61, 0xe8,
+	0x32, 0x20, 0x16, 0xd6, 0x7d, 0x29, 0x49, 0x5a, 0x4a, 0xad, 0x9d, 0x3c, 0x99, 0x4e, 0xb8, 0x7c,
+	0xa1, 0x0b, 0x03, 0xe7, 0x3c, 0x45, 0xb5, 0x9e, 0x20, 0x64, 0xbd, 0x4f, 0x3e, 0x9a, 0xd6, 0x7a,
+	0xae, 0xf2, 0x66, 0x2c, 0xe1, 0xe8, 0xfd, 0x30, 0xd9, 0x8e, 0x09, 0x3b, 0x30, 0xdf, 0x08, 0xa3,
+	0x1d, 0x3f, 0x74, 0x1a, 0x8b, 0xac, 0x34, 0x7f, 0xd2, 0x99, 0x9c, 0x64, 0xc4, 0xcf, 0x8a, 0x67,
+	0x27, 0xaf, 0xf5, 0xc0, 0xc3, 0x3d, 0x7b, 0xc8, 0x66, 0x0c, 0x3f, 0xdd, 0x67, 0xc6, 0xf0, 0x55,
+	0x38, 0x29, 0xe5, 0xda, 0xca, 0xfc, 0xa2, 0x7a, 0xe9, 0xc9, 0x33, 0xe9, 0x5a, 0xbf, 0x8b, 0x39,
+	0x38, 0x38, 0xf7, 0x49, 0xfb, 0xdf, 0x5b, 0x30, 0xa6, 0x38, 0xd8, 0x7d, 0x48, 0x2f, 0xe1, 0xa7,
+	0xd3, 0x4b, 0x5c, 0x1c, 0x5c, 0x06, 0xb0, 0x91, 0xf7, 0x08, 0xf9, 0xf9, 0xf5, 0x31, 0x00, 0x2d,
+	0x27, 0x94, 0x88, 0xb6, 0x7a, 0x8a, 0xe8, 0x87, 0x96, 0x47, 0xe7, 0x65, 0x80, 0xae, 0x3e, 0xd8,
+	0x0c, 0xd0, 0x6b, 0x70, 0x4a, 0x2e, 0x29, 0x7e, 0xa5, 0x7c, 0x29, 0x8c, 0x15, 0xcb, 0x37, 0x8a,
+	0x37, 0x2f, 0xe6, 0x21, 0xe1, 0xfc, 0x67, 0x53, 0xba, 0xdd, 0xf0, 0x81, 0xba, 0x9d, 0xe2, 0x72,
+	0x4b, 0x9b, 0xb2, 0xb4, 0x7a, 0x86, 0xcb, 0x2d, 0x5d, 0x58, 0xc3, 0x1a, 0x27, 0x5f, 0xd4, 0xd5,
+	0x0b, 0x12, 0x75, 0x70, 0x68, 0x51, 0x27, 0x99, 0xee, 0x48, 0x4f, 0xa6, 0x2b, 0xaf, 0xae, 0x46,
+	0x7b, 0x5e, 0x5d, 0xbd, 0x0c, 0xe3, 0x5e, 0xb0, 0x4d, 0x22, 0x2f, 0x21, 0x0d, 0xb6, 0x17, 0x18,
+	0x43, 0xae, 0x69, 0x45, 0x67, 0x31, 0x05, 0xc5, 0x19, 0xec, 0xb4, 0xa4, 0x18, 0xef, 0x43, 0x52,
+	0xf4, 0x90, 0xcf, 0x13, 0xc5, 0xc8, 0xe7, 0x63, 0x83, 0xcb, 0xe7, 0xe3, 0x47, 0x2a, 0x9f, 0x51,
+	0x21, 0xf2, 0xb9, 0x2f, 0xd1, 0x67, 0x1c, 0xd2, 0x4f, 0x1e, 0x70, 0x48, 0xef, 0x25, 0x9c, 0x4f,
+	0xdd, 0xb3, 0x70, 0xce, 0x97, 0xbb, 0x8f, 0xbc, 0x29, 0x77, 0x8b, 0x90, 0xbb, 0xf4, 0xfb, 0x37,
+	0x48, 0x2b, 0xd9, 0x9e, 0x7c, 0x8c, 0x2d, 0x56, 0xf5, 0xfd, 0x17, 0x68, 0x23, 0xe6, 0x30, 0xfb,
+	0x53, 0x25, 0x38, 0xa5, 0xc5, 0x17, 0x65, 0x1a, 0xde, 0x26, 0x65, 0xe0, 0x04, 0x9d, 0x03, 0xe0,
+	0xb7, 0xe2, 0x46, 0xe6, 0x13, 0x9d, 0xfb, 0x45, 0x41, 0xb0, 0x81, 0xc5, 0x12, 0x88, 0x90, 0x88,
+	0xd5, 0xb1, 0xcb, 0xca, 0xb6, 0x79, 0xd1, 0x8e, 0x15, 0x06, 0x9d, 0x29, 0xfa, 0x5b, 0xe4, 0xaf,
+	0xca, 0xd6, 0x34, 0x99, 0xd7, 0x20, 0x6c, 0xe2, 0xa1, 0x67, 0x38, 0x11, 0xc6, 0x57, 0xa9, 0x7c,
+	0x1b, 0xe5, 0x67, 0x4f, 0xc5, 0x4a, 0x15, 0x54, 0x0e, 0x87, 0x25, 0xb8, 0xa9, 0x76, 0x0f, 0x87,
+	0x39, 0xbc, 0x2a, 0x0c, 0xfb, 0x7f, 0x58, 0x70, 0x3a, 0x77, 0x2a, 0xee, 0x83, 0xce, 0x72, 0x3b,
+	0xad, 0xb3, 0xac, 0x15, 0x75, 0x6e, 0x35, 0xde, 0xa2, 0x87, 0xfe, 0xf2, 0xef, 0x2c, 0x18, 0xd7,
+	0xf8, 0xf7, 0xe1, 0x55, 0xbd, 0xf4, 0xab, 0x16, 0x77, 0x44, 0xaf, 0x77, 0xbd, 0xdb, 0x57, 0x4b,
+	0xa0, 0xea, 0x0c, 0xcd, 0xba, 0x49, 0x7f, 0x31, 0x72, 0x1d, 0x18, 0x62, 0x6e, 0x26, 0x71, 0x31,
+	0x2e, 0x74, 0x69, 0xfa, 0xcc, 0x65, 0x45, 0xdf, 0xfa, 0xb1, 0xbf, 0x31, 0x16, 0x04, 0x59, 0x95,
+	0x45, 0x5e, 0xc2, 0xa5, 0x21, 0xa2, 0xbd, 0x75, 0x95, 0x45, 0xd1, 0x8e, 0x15, 0x06, 0x95, 0xaa,
+	0x9e, 0x1b, 0x06, 0xf3, 0xbe, 0x13, 0xc7, 0x42, 0xd1, 0x53, 0x52, 0x75, 0x51, 0x02, 0xb0, 0xc6,
+	0x61, 0x1e, 0x28, 0x5e, 0xdc, 0xf2, 0x9d, 0x8e, 0x61, 0x88, 0x31, 0xf2, 0x34, 0x2a, 0x10, 0x36,
+	0xf1, 0xec, 0x26, 0x4c, 0xa6, 0x5f, 0x62, 0x81, 0x6c, 0x32, 0x77, 0xf4, 0xbe, 0xa6, 0x73, 0x06,
+	0xea, 0x0e, 0x7b, 0x6a, 0xa9, 0xed, 0x08, 0x9e, 0xa0, 0x9d, 0xb2, 0x25, 0x00, 0x6b, 0x1c, 0xfb,
+	0xbb, 0xe1, 0x44, 0xce, 0x9c, 0xf5, 0xe1, 0x65, 0xf7, 0xeb, 0x25, 0x98, 0x48, 0x3f, 0x19, 0xb3,
+	0x80, 0x4d, 0x3e, 0x66, 0x2f, 0x76, 0xc3, 0x5d, 0x12, 0x75, 0xe8, 0x30, 0xac, 0x4c, 0xc0, 0x66,
+	0x17, 0x06, 0xce, 0x79, 0x8a, 0x15, 0x10, 0x6b, 0xa8, 0x57, 0x97, 0xcb, 0xe3, 0x7a, 0x91, 0xcb,
+	0x43, 0xcf, 0xac, 0xe9, 0x19, 0xa4, 0x48, 0x62, 0x93, 0x3e, 0x55, 0x92, 0x58, 0xb8, 0xc9, 0x5c,
+	0xdb, 0xf3, 0x13, 0x2f, 0x10, 0xaf, 0x2c, 0x16, 0x8e, 0x52, 0x92, 0x96, 0xbb, 0x51, 0x70, 0xde,
+	0x73, 0xf6, 0x37, 0x2a, 0xa0, 0x12, 0x5a, 0x31, 0xcf, 0xcd, 0x82, 0xfc, 0x5e, 0x0f, 0x1b, 0xf6,
+	0xab, 0xbe, 0x74, 0x65, 0x3f, 0x57, 0x2a, 0x6e, 0x4a, 0x33, 0x6d, 0xee, 0x6a, 0xc2, 0xd6, 0x35,
+	0x08, 0x9b, 0x78, 0x74, 0x24, 0xbe, 0xb7, 0x4b, 0xf8, 0x43, 0x43, 0xe9, 0x91, 0x2c, 0x49, 0x00,
+	0xd6, 0x38, 0xac, 0xaa, 0x86, 0xb7, 0xb9, 0x29, 0xec, 0x42, 0xba, 0xaa, 0x86, 0xb7, 0xb9, 0x89,
+	0x19, 0x84, 0x97, 0x98, 0x0c, 0x77, 0xc4, 0xc1, 0xc0, 0x28, 0x31, 0x19, 0xee, 0x60, 0x06, 0xa1,
+	0x5f, 0x29, 0x08, 0xa3, 0xa6, 0xe3, 0x7b, 0xaf, 0x93, 0x86, 0xa2, 0x22, 0x0e, 0x04, 0xea, 0x2b,
+	0x5d, 0xed, 0x46, 0xc1, 0x79, 0xcf, 0xd1, 0x05, 0xdd, 0x8a, 0x48, 0xc3, 0x73, 0x13, 0xb3, 0x37,
+	0x48, 0x2f, 0xe8, 0xd5, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x85, 0x09, 0x99, 0x90, 0x4c, 0x26,
+	0xf1, 0x1d, 0x49, 0x67, 0x02, 0xc5, 0x69, 0x30, 0xce, 0xe2, 0x53, 0x8e, 0xd5, 0x14, 0xc9, 0xe9,
+	0xd9, 0xf9, 0xc1, 0xe0, 0x58, 0x32, 0x69, 0x3d, 0x56, 0x18, 0xf6, 0x5e, 0x09, 0x90, 0x5a, 0x62,
+	0x91, 0xb7, 0x99, 0xf0, 0xc2, 0xb9, 0x1f, 0x00, 0x68, 0x90, 0x84, 0xb8, 0xf7, 0x9a, 0xff, 0x48,
+	0x69, 0x25, 0x0b, 0xaa, 0x17, 0x6c, 0xf4, 0x88, 0x5e, 0x01, 0xa0, 0xe3, 0xf6, 0x77, 0x07, 0xc9,
+	0x80, 0x84, 0x55, 0x0f, 0xd8, 0xe8, 0x0d, 0x4d, 0x03, 0xb0, 0x64, 0x1a, 0xab, 0x4e, 0xb2, 0x2d,
+	0x1d, 0xf4, 0x44, 0x50, 0x80, 0x6c, 0xc5, 0x06, 0x06, 0x55, 0x5e, 0x9a, 0xd2, 0x0d, 0xb9, 0xa2,
+	0xdd, 0xf9, 0x94, 0xe7, 0xb1, 0x82, 0x72, 0xfd, 0xcb, 0xdf, 0xbc, 0x44, 0x1c, 0x2a, 0x0e, 0xf8,
+	0x25, 0x84, 0xa1, 0x7f, 0x49, 0x08, 0x36, 0xb0, 0xec, 0x9f, 0x2f, 0xc1, 0xc9, 0xd4, 0x04, 0xcb,
+	0xc4, 0x6c, 0x0f, 0xef, 0x5e, 0xbe, 0x0d, 0x43, 0x64, 0x97, 0x85, 0x88, 0x73, 0x27, 0xaf, 0xd5,
+	0x62, 0xf8, 0xa9, 0x5e, 0x5e, 0x5a, 0xda, 0xb2, 0xbf, 0x31, 0x16, 0xf4, 0xec, 0x8f, 0x95, 0xa9,
+	0xbe, 0xd7, 0xa3, 0x22, 0xc9, 0x7d, 0xf3, 0xfa, 0x4f, 0xcf, 0x69, 0xa5, 0x8f, 0x39, 0x7d, 0x1e,
+	0x46, 0x6f, 0xc6, 0x61, 0xa0, 0x3c, 0xea, 0xab, 0x3d, 0x3d, 0xea, 0x0d, 0xac, 0x7c, 0x8f, 0xfa,
+	0xa1, 0xa2, 0x3c, 0xea, 0x87, 0xef, 0xd1, 0xa3, 0xfe, 0x5f, 0x55, 0x41, 0x55, 0xb4, 0xbf, 0x4a,
+	0x92, 0x5b, 0x61, 0xb4, 0xe3, 0x05, 0x5b, 0x2c, 0xa1, 0xd1, 0x57, 0x2c, 0x99, 0x17, 0x6a, 0xc9,
+	0x8c, 0x7c, 0xdf, 0x2c, 0xa8, 0x2a, 0x79, 0x8a, 0xd8, 0xf4, 0xba, 0x41, 0x88, 0x7b, 0x66, 0x65,
+	0xf2, 0x4f, 0x89, 0x4b, 0xa7, 0xd4, 0x88, 0xd0, 0x0f, 0x02, 0xc8, 0x2b, 0x9d, 0x4d, 0xa9, 0x0f,
+	0x2c, 0x16, 0x33, 0x3e, 0x4c, 0x36, 0xf5, 0x6e, 0x5f, 0x57, 0x44, 0xb0, 0x41, 0x10, 0x7d, 0x4a,
+	0x67, 0x05, 0xe0, 0xa1, 0x80, 0x1f, 0x3a, 0x92, 0xb9, 0xe9, 0x27, 0x27, 0x00, 0x86, 0x61, 0x2f,
+	0xd8, 0xa2, 0xeb, 0x44, 0x78, 0x1e, 0xbf, 0x35, 0x2f, 0x93, 0xe8, 0x52, 0xe8, 0x34, 0xe6, 0x1c,
+	0xdf, 0x09, 0x5c, 0x12, 0x2d, 0x72, 0x74, 0x7d, 0x4c, 0x17, 0x0d, 0x58, 0x76, 0xd4, 0x55, 0x76,
+	0xbf, 0xda, 0x4f, 0xd9, 0xfd, 0x33, 0xdf, 0x0f, 0xc7, 0xbb, 0x3e, 0xe6, 0xa1, 0x52, 0x00, 0x0c,
+	0x90, 0x43, 0xf4, 0x37, 0x86, 0xb4, 0x0a, 0x75, 0x35, 0x6c, 0xf0, 0x2a, 0xee, 0x91, 0xfe, 0xa2,
+	0x42, 0xb6, 0x15, 0xb8, 0x44, 0x94, 0xd2, 0x63, 0x34, 0x62, 0x93, 0x24, 0x5d, 0xa3, 0x2d, 0x27,
+	0x22, 0xc1, 0x51, 0xaf, 0xd1, 0x55, 0x45, 0x04, 0x1b, 0x04, 0xd1, 0x76, 0x2a, 0x56, 0xf5, 0xc2,
+	0xe0, 0xb1, 0xaa, 0x2c, 0xaf, 0x7b, 0x5e, 0xb1, 0xe3, 0x37, 0x2c, 0x18, 0x0f, 0x52, 0x2b, 0xb7,
+	0x98, 0x70, 0x90, 0xfc, 0x5d, 0x31, 0x87, 0xee, 0xec, 0x4d, 0x8d, 0xa7, 0xdb, 0x70, 0x86, 0x7e,
+	0x9e, 0x82, 0x55, 0x3d, 0xa4, 0x82, 0x65, 0xc3, 0x10, 0x0b, 0xdc, 0x4e, 0xdd, 0x80, 0xb3, 0xa0,
+	0xee, 0x18, 0x0b, 0x08, 0x0a, 0x60, 0x88, 0x67, 0xa1, 0x16, 0x4e, 0x21, 0x03, 0x66, 0xfc, 0x31,
+	0x53, 0x59, 0x73, 0x7a, 0xbc, 0x05, 0x0b, 0x2a, 0xe8, 0x86, 0x19, 0xca, 0x5e, 0x3b, 0xb4, 0x3a,
+	0x35, 0xd6, 0x2b, 0xe4, 0xdd, 0xfe, 0xdf, 0x15, 0x38, 0x26, 0x67, 0x44, 0x86, 0x92, 0x51, 0xf9,
+	0xc8, 0xe9, 0xea, 0x93, 0x9b, 0x92, 0x8f, 0x97, 0x24, 0x00, 0x6b, 0x1c, 0x7a, 0x3a, 0x68, 0xc7,
+	0x64, 0xa5, 0x45, 0x82, 0x25, 0x6f, 0x23, 0x16, 0x9a, 0x93, 0xda, 0x28, 0xd7, 0x34, 0x08, 0x9b,
+	0x78, 0x2c, 0xde, 0xde, 0x35, 0x93, 0xde, 0xe8, 0x78, 0x7b, 0x71, 0x6c, 0x92, 0x70, 0xf4, 0x93,
+	0xb9, 0x25, 0xd2, 0x8a, 0x09, 0x08, 0xef, 0x8a, 0xa0, 0x3b, 0x5c, 0x6d, 0x34, 0xf4, 0x8f, 0x2d,
+	0x38, 0xc5, 0x5b, 0xe5, 0x4c, 0x5e, 0x6b, 0x35, 0x9c, 0x84, 0xc4, 0xc5, 0x94, 0xb6, 0xcd, 0x19,
+	0x9f, 0xbe, 0x85, 0xc9, 0x23, 0x8b, 0xf3, 0x47, 0x83, 0x3e, 0x6f, 0xc1, 0xc4, 0x4e, 0x2a, 0x69,
+	0x9d, 0x14, 0x1d, 0x83, 0x66, 0x74, 0x4a, 0x75, 0xaa, 0xb7, 0x5a, 0xba, 0x3d, 0xc6, 0x59, 0xea,
+	0xf6, 0x5f, 0x5b, 0x60, 0xb2, 0xd1, 0xfb, 0x9f, 0xeb, 0xee, 0xf0, 0xaa, 0xa0, 0xd4, 0x2e, 0xab,
+	0x3d, 0xb5, 0xcb, 0x27, 0xa0, 0xdc, 0xf6, 0x1a, 0xe2, 0xb4, 0xab, 0x1d, 0x46, 0x16, 0x17, 0x30,
+	0x6d, 0xb7, 0xff, 0xa2, 0xaa, 0x2d, 0x64, 0x22, 0xde, 0xfa, 0x5b, 0xe2, 0xb5, 0x37, 0x55, 0x7a,
+	0x7f, 0xfe, 0xe6, 0x57, 0xbb, 0xd2, 0xfb, 0x7f, 0xef, 0xe1, 0xc3, 0xe9, 0xf9, 0x04, 0xf5, 0xca,
+	0xee, 0x3f, 0x7c, 0x40, 0x2c, 0xfd, 0x4d, 0xa8, 0x6d, 0x87, 0x21, 0x2b, 0x67, 0x24, 0xca, 0x62,
+	0xc9, 0x41, 0xd5, 0x2e, 0x89, 0xf6, 0xbb, 0x7b, 0x53, 0xdf, 0x73, 0xf8, 0x61, 0xc9, 0xa7, 0xb1,
+	0xea, 0x1f, 0xc5, 0x50, 0xa7, 0xbf, 0x59, 0xd8, 0xbf, 0x30, 0x35, 0x5c, 0x53, 0x3c, 0x53, 0x02,
+	0x0a, 0xc9, 0x29, 0xa0, 0xe9, 0xa0, 0x00, 0xea, 0x14, 0x91, 0x13, 0xe5, 0x16, 0x89, 0x55, 0x15,
+	0x7c, 0x2f, 0x01, 0x77, 0xf7, 0xa6, 0x5e, 0x3a, 0x3c, 0x51, 0xf5, 0x38, 0xd6, 0x24, 0x0c, 0xd1,
+	0x38, 0xd2, 0x4b, 0x34, 0xda, 0xff, 0xa7, 0xa2, 0xd7, 0xb7, 0xc8, 0x81, 0xfb, 0x2d, 0xb1, 0xbe,
+	0x5f, 0xcc, 0xac, 0xef, 0xb3, 0x5d, 0xeb, 0x7b, 0x9c, 0xce, 0x59, 0x4e, 0x3d, 0x8a, 0xfb, 0xad,
+	0x2c, 0x1c, 0x6c, 0x21, 0x63, 0x5a, 0xd2, 0x6b, 0x6d, 0x2f, 0x22, 0xf1, 0x6a, 0xd4, 0x0e, 0xbc,
+	0x60, 0x8b, 0x2d, 0xd9, 0x9a, 0xa9, 0x25, 0xa5, 0xc0, 0x38, 0x8b, 0x8f, 0x9e, 0x85, 0x5a, 0x2c,
+	0x12, 0x1c, 0xb0, 0x95, 0x67, 0xe4, 0x96, 0x95, 0x89, 0x0f, 0xb0, 0xc2, 0x40, 0xdb, 0xf0, 0xb8,
+	0xec, 0x60, 0x81, 0xf8, 0x84, 0xbe, 0x10, 0x73, 0x84, 0x8d, 0x9a, 0x3c, 0x4c, 0x85, 0xfb, 0x32,
+	0x7d, 0xbb, 0xe8, 0xe1, 0x71, 0xbc, 0x0f, 0x2e, 0xde, 0xb7, 0x27, 0xfb, 0xeb, 0xcc, 0xf5, 0xc5,
+	0xc8, 0x7e, 0x42, 0x57, 0x9f, 0xef, 0x35, 0x3d, 0x99, 0x02, 0x57, 0xad, 0xbe, 0x25, 0xda, 0x88,
+	0x39, 0x0c, 0xdd, 0x82, 0xe1, 0x0d, 0xc7, 0xdd, 0x09, 0x37, 0x37, 0x8b, 0x29, 0x0b, 0x3a, 0xc7,
+	0x3b, 0x63, 0x85, 0x41, 0x86, 0xc5, 0x9f, 0xbb, 0xfa, 0x27, 0x96, 0xd4, 0x78, 0x39, 0xa9, 0xcd,
+	0x88, 0xc4, 0xdb, 0xc2, 0x8c, 0x6c, 0x94, 0x93, 0x62, 0xcd, 0x58, 0xc2, 0xed, 0xcf, 0x0f, 0xc3,
+	0x84, 0xf4, 0x64, 0x94, 0x56, 0x26, 0xb3, 0xb0, 0x52, 0xe9, 0xc0, 0xc2, 0x4a, 0xcc, 0xec, 0xd7,
+	0xf2, 0xc3, 0x0e, 0xd3, 0x23, 0x2b, 0x83, 0x98, 0xfd, 0x64, 0x2f, 0xd8, 0xe8, 0x51, 0xa4, 0x08,
+	0xae, 0xe6, 0x16, 0x23, 0xd0, 0x75, 0x86, 0x87, 0xee, 0x6f, 0x9d, 0x61, 0x0f, 0x26, 0xf8, 0x10,
+	0x55, 0x3a, 0x92, 0x7b, 0xc8, 0x3a, 0xc2, 0x02, 0x28, 0x17, 0xd2, 0xdd, 0xe0, 0x6c, 0xbf, 0x66,
+	0x11, 0xe1, 0xda, 0xfd, 0x2e, 0x22, 0xfc, 0x36, 0xa8, 0x47, 0x2a, 0x71, 0x79, 0x5d, 0xa7, 0xca,
+	0xd2, 0x59, 0xcb, 0x35, 0xfc, 0x21, 0xca, 0xe9, 0xdf, 0x81, 0xd1, 0x6d, 0x83, 0xa1, 0x09, 0x73,
+	0xb8, 0x14, 0x9d, 0xa9, 0x0a, 0x42, 0x83, 0x57, 0x08, 0x4a, 0x91, 0x42, 0xef, 0x82, 0x63, 0x4e,
+	0x3b, 0x09, 0x65, 0xd9, 0x83, 0x0b, 0x51, 0xd8, 0x64, 0x16, 0xf5, 0xf2, 0xdc, 0xc9, 0x3b, 0x7b,
+	0x53, 0xc7, 0x66, 0x33, 0x30, 0xdc, 0x85, 0x6d, 0xbf, 0x51, 0xa6, 0xa7, 0x27, 0x3e, 0xa9, 0x87,
+	0x2e, 0x20, 0x7e, 0xc9, 0x28, 0x20, 0x7e, 0xb8, 0xc5, 0x58, 0xcb, 0x14, 0x1a, 0x7f, 0x1c, 0x2a,
+	0x89, 0xb3, 0x25, 0x6d, 0xe1, 0x0c, 0xba, 0xee, 0x6c, 0xc5, 0x98, 0xb5, 0x1e, 0x26, 0xe7, 0xff,
+	0x4b, 0x30, 0x16, 0x7b, 0x5b, 0x81, 0x93, 0xb4, 0x23, 0x62, 0x5c, 0xe1, 0x6b, 0x67, 0x36, 0x13,
+	0x88, 0xd3, 0xb8, 0xe8, 0xe3, 0x16, 0x40, 0x44, 0xd4, 0xd9, 0x6c, 0xa8, 0x88, 0x0d, 0xa0, 0x78,
+	0x98, 0xec, 0xd7, 0x4c, 0xe7, 0xa3, 0xce, 0x64, 0x06, 0x59, 0xfb, 0x93, 0x16, 0x1c, 0xef, 0x7a,
+	0x0a, 0xb5, 0x60, 0xc8, 0x65, 0x65, 0xde, 0x8b, 0x49, 0x61, 0x9b, 0x2e, 0x19, 0xcf, 0x85, 0x30,
+	0x6f, 0xc3, 0x82, 0x8e, 0xfd, 0x9b, 0xa3, 0x70, 0x72, 0x6d, 0x7e, 0x59, 0x66, 0x97, 0x3f, 0xb2,
+	0xe8, 0xfb, 0x3c, 0x1a, 0xf7, 0x2f, 0xfa, 0xbe, 0x07, 0x75, 0xdf, 0x88, 0xbe, 0xf7, 0x8d, 0xe8,
+	0xfb, 0x74, 0x28, 0x74, 0xb9, 0x88, 0x50, 0xe8, 0xbc, 0x11, 0xf4, 0x13, 0x0a, 0x7d, 0x64, 0xe1,
+	0xf8, 0xfb, 0x0e, 0xe8, 0x50, 0xe1, 0xf8, 0x2a, 0x57, 0x41, 0x21, 0x91, 0x97, 0x3d, 0x3e, 0x55,
+	0x6e, 0xae, 0x02, 0x15, 0x27, 0xce, 0xa3, 0x8a, 0x85, 0xc4, 0x7e, 0xb5, 0xf8, 0x01, 0xf4, 0x11,
+	0x27, 0x2e, 0x02, 0x9b, 0xcd, 0xdc, 0x04, 0xc3, 0x45, 0xe4, 0x26, 0xc8, 0x1b, 0xce, 0x81, 0xb9,
+	0x09, 0x5e, 0x82, 0x31, 0xd7, 0x0f, 0x03, 0xb2, 0x1a, 0x85, 0x49, 0xe8, 0x86, 0xbe, 0x38, 0x56,
+	0xea, 0xfa, 0xe8, 0x26, 0x10, 0xa7, 0x71, 0x7b, 0x25, 0x36, 0xa8, 0x0f, 0x9a, 0xd8, 0x00, 0x1e,
+	0x50, 0x62, 0x03, 0x23, 0x74, 0x7f, 0xa4, 0x88, 0xd0, 0xfd, 0xbc, 0x2f, 0xd2, 0x57, 0xe8, 0xfe,
+	0x17, 0x2d, 0x18, 0x73, 0x6e, 0x31, 0x11, 0xcf, 0xb9, 0x30, 0x13, 0xe3, 0x23, 0xe7, 0x3e, 0x78,
+	0x04, 0x0b, 0xf6, 0xc6, 0x9a, 0x26, 0x33, 0x77, 0x9c, 0x85, 0x53, 0x99, 0x4d, 0x38, 0x3d, 0x90,
+	0x41, 0xc2, 0xfd, 0xbf, 0x54, 0x82, 0x6f, 0x3b, 0x70, 0x08, 0xe8, 0x16, 0x40, 0xe2, 0x6c, 0x89,
+	0x85, 0x2a, 0x2e, 0xec, 0x06, 0xf4, 0xbf, 0x5f, 0x97, 0xfd, 0x89, 0x50, 0x54, 0xd5, 0x3d, 0x36,
+	0x48, 0x31, 0xb7, 0xfb, 0xd0, 0xef, 0xca, 0x3e, 0x8f, 0x43, 0x9f, 0x60, 0x06, 0xa1, 0x8a, 0x50,
+	0x44, 0xb6, 0xe8, 0xc9, 0xa4, 0x9c, 0x56, 0x84, 0x30, 0x6b, 0xc5, 0x02, 0x8a, 0x5e, 0x80, 0x11,
+	0xc7, 0xf7, 0x79, 0x58, 0x2c, 0xe1, 0x7e, 0x55, 0x86, 0xf5, 0x78, 0x56, 0x83, 0xb0, 0x89, 0x67,
+	0xff, 0x55, 0x09, 0xa6, 0x0e, 0xe0, 0x29, 0x5d, 0xe9, 0x10, 0xaa, 0x7d, 0xa7, 0x43, 0x10, 0x61,
+	0x7d, 0x43, 0x3d, 0xc2, 0xfa, 0x5e, 0x80, 0x91, 0x84, 0x38, 0x4d, 0xe1, 0xb1, 0x9b, 0x4d, 0xa5,
+	0xba, 0xae, 0x41, 0xd8, 0xc4, 0xa3, 0x5c, 0x6c, 0xdc, 0x71, 0x5d, 0x12, 0xc7, 0x32, 0x6e, 0x4f,
+	0x58, 0xf3, 0x0b, 0x0b, 0x0a, 0x64, 0x97, 0x24, 0xb3, 0x29, 0x12, 0x38, 0x43, 0x32, 0x3b, 0xe1,
+	0xf5, 0x3e, 0x27, 0xfc, 0x67, 0x4a, 0xf0, 0xc4, 0xbe, 0xd2, 0xad, 0xef, 0x90, 0xca, 0x76, 0x4c,
+	0xa2, 0xec, 0xc2, 0xb9, 0x16, 0x93, 0x08, 0x33, 0x08, 0x9f, 0xa5, 0x56, 0x4b, 0x45, 0x5b, 0x14,
+	0x1f, 0x83, 0xcc, 0x67, 0x29, 0x45, 0x02, 0x67, 0x48, 0xde, 0xeb, 0xb2, 0xfc, 0xc3, 0x0a, 0x3c,
+	0xd5, 0x87, 0x0e, 0x50, 0x60, 0xac, 0x76, 0x3a, 0x0f, 0x41, 0xf9, 0x01, 0xe5, 0x21, 0xb8, 0xb7,
+	0xe9, 0x7a, 0x33, 0x7d, 0x41, 0x5f, 0x31, 0xe1, 0xbf, 0x50, 0x82, 0x33, 0xbd, 0x15, 0x16, 0xf4,
+	0x7d, 0x30, 0x11, 0x29, 0xaf, 0x5c, 0x33, 0x85, 0xc1, 0x09, 0x6e, 0xcb, 0x4b, 0x81, 0x70, 0x16,
+	0x17, 0x4d, 0x03, 0xb4, 0x9c, 0x64, 0x3b, 0x3e, 0x7f, 0xdb, 0x63, 0x25, 0xbd, 0x94, 0x47, 0xd5,
+	0xaa, 0x6a, 0xc5, 0x06, 0x06, 0x25, 0xc7, 0xfe, 0x2d, 0x84, 0x57, 0xc3, 0x84, 0x3f, 0xc4, 0x8f,
+	0x9e, 0x27, 0x64, 0x2d, 0x6b, 0x03, 0x84, 0xb3, 0xb8, 0x94, 0x1c, 0xf3, 0x61, 0xe0, 0x03, 0xad,
+	0xe8, 0xa4, 0x07, 0x4b, 0xaa, 0x15, 0x1b, 0x18, 0xd9, 0xe4, 0x0c, 0xd5, 0x83, 0x93, 0x33, 0xd8,
+	0xff, 0xa2, 0x04, 0xa7, 0x7b, 0x2a, 0xbc, 0xfd, 0xb1, 0xa9, 0x87, 0x2f, 0x41, 0xc2, 0x3d, 0xee,
+	0xb0, 0x43, 0x05, 0xd6, 0xdb, 0x7f, 0xde, 0x63, 0xa5, 0x89, 0xa0, 0xf9, 0x7b, 0xcf, 0x2f, 0xf4,
+	0xf0, 0xcd, 0x67, 0x57, 0x9c, 0x7c, 0xe5, 0x10, 0x71, 0xf2, 0x99, 0x8f, 0x51, 0xed, 0x53, 0x3a,
+	0xfc, 0xa7, 0x4a, 0xcf, 0xe9, 0xa5, 0x07, 0xe4, 0xbe, 0x6e, 0x4a, 0x16, 0xe0, 0x98, 0x17, 0xb8,
+	0x7e, 0xbb, 0x41, 0xd6, 0xda, 0x1b, 0x22, 0x0d, 0x20, 0xcf, 0xbd, 0xad, 0xa2, 0xd4, 0x16, 0x33,
+	0x70, 0xdc, 0xf5, 0xc4, 0x43, 0x98, 0xb7, 0xe0, 0xde, 0xa6, 0xf4, 0x90, 0x9c, 0x7b, 0x05, 0x4e,
+	0xc9, 0xa9, 0xd8, 0x76, 0x22, 0xd2, 0x10, 0xc2, 0x36, 0x16, 0x71, 0x89, 0xa7, 0x79, 0x6c, 0x63,
+	0x0e, 0x02, 0xce, 0x7f, 0x8e, 0x95, 0x92, 0x0f, 0x5b, 0x9e, 0x2b, 0x8e, 0x82, 0xba, 0x94, 0x3c,
+	0x6d, 0xc4, 0x1c, 0xa6, 0xe5, 0x45, 0xfd, 0xfe, 0xc8, 0x8b, 0x0f, 0x40, 0x5d, 0xcd, 0x37, 0x77,
+	0x6b, 0x55, 0x8b, 0xbc, 0x2b, 0xac, 0x48, 0xad, 0x70, 0x03, 0x8b, 0xae, 0x0e, 0x7a, 0x50, 0xc9,
+	0xec, 0x56, 0x4a, 0x8f, 0xb6, 0xdb, 0xef, 0x80, 0x51, 0x65, 0x0b, 0xec, 0xb7, 0xa0, 0xbf, 0xfd,
+	0xc7, 0x15, 0xc8, 0x14, 0xe0, 0x44, 0xb7, 0xa1, 0xde, 0x88, 0x3a, 0xbc, 0xb1, 0x98, 0xdc, 0xef,
+	0x0b, 0xb2, 0x3b, 0x7d, 0xe1, 0xa7, 0x9a, 0xb0, 0x26, 0x86, 0x3e, 0xcc, 0xd3, 0xac, 0x0b, 0xd2,
+	0xa5, 0x22, 0x72, 0x57, 0xac, 0xa9, 0xfe, 0xcc, 0x8a, 0xdd, 0xb2, 0x0d, 0x1b, 0xf4, 0x50, 0x02,
+	0xf5, 0x6d, 0x59, 0xbe, 0xb2, 0x18, 0x76, 0xa7, 0xaa, 0x61, 0x72, 0x15, 0x4d, 0xfd, 0xc5, 0x9a,
+	0x10, 0x7a, 0x0f, 0x8c, 0x37, 0x9d, 0xc0, 0xdb, 0x24, 0x71, 0xb2, 0xe4, 0x74, 0xc2, 0x76, 0x22,
+	0x38, 0xde, 0x77, 0xc9, 0xa0, 0xd2, 0xe5, 0x14, 0xf4, 0xee, 0xde, 0xd4, 0x23, 0xb2, 0x7e, 0x6b,
+	0x1a, 0x82, 0x33, 0xfd, 0xa0, 0x1f, 0x00, 0x50, 0x53, 0x2b, 0xdd, 0x8a, 0x0b, 0xfb, 0x90, 0xfa,
+	0xd6, 0x49, 0x91, 0xc0, 0x06, 0x39, 0xfb, 0xcb, 0x15, 0x38, 0x99, 0x57, 0xf6, 0xf5, 0xcd, 0xd5,
+	0xf5, 0x2d, 0xb2, 0xba, 0x5e, 0x86, 0x71, 0xfd, 0xb9, 0x2f, 0x39, 0xf1, 0xb6, 0x38, 0x96, 0xab,
+	0x60, 0xe8, 0x85, 0x14, 0x14, 0x67, 0xb0, 0xed, 0x6f, 0x74, 0x2d, 0x10, 0x71, 0x03, 0xf4, 0x8b,
+	0x16, 0x3c, 0xea, 0x3b, 0x71, 0xb2, 0xd6, 0x66, 0x07, 0xe4, 0xcd, 0xb6, 0xbf, 0x92, 0xa9, 0x44,
+	0x31, 0xa8, 0x91, 0x51, 0x75, 0x9c, 0x2d, 0xcb, 0x3c, 0xf7, 0xd8, 0x9d, 0xbd, 0xa9, 0x47, 0x97,
+	0xf2, 0x89, 0xe3, 0x5e, 0xa3, 0x42, 0x6f, 0x58, 0x70, 0xcc, 0x6d, 0x47, 0x11, 0x09, 0x12, 0x3d,
+	0xd4, 0x52, 0x11, 0xe5, 0x0a, 0xba, 0x06, 0xc8, 0x2e, 0xc1, 0xe6, 0x33, 0xb4, 0x70, 0x17, 0x75,
+	0xf4, 0xe9, 0x4c, 0x49, 0xe0, 0x42, 0x0e, 0xa0, 0xbd, 0xea, 0x29, 0xef, 0x5f, 0x16, 0x18, 0xdd,
+	0x82, 0xe1, 0x6d, 0x7e, 0x33, 0x5e, 0x8c, 0x67, 0x5b, 0xba, 0xd8, 0xbe, 0x3e, 0xa2, 0x8b, 0x06,
+	0x2c, 0xa9, 0xd9, 0x5f, 0xa3, 0x6a, 0x73, 0xcf, 0x8f, 0xfd, 0x66, 0x31, 0xed, 0xbe, 0x8b, 0x69,
+	0xff, 0x7c, 0x0d, 0xc6, 0x52, 0xf5, 0x4a, 0x52, 0x2e, 0x0e, 0xd6, 0x81, 0x2e, 0x0e, 0x2c, 0x04,
+	0xbf, 0x1d, 0x88, 0x1a, 0xa2, 0x66, 0x08, 0x7e, 0x3b, 0x20, 0x98, 0xc3, 0xc4, 0xe7, 0xc0, 0xed,
+	0x40, 0xf8, 0x5c, 0x98, 0x9f, 0x03, 0xb7, 0x03, 0x2c, 0xa0, 0xe8, 0xa3, 0x16, 0x8c, 0x32, 0xbe,
+	0x2a, 0x7c, 0x49, 0x84, 0x26, 0x7c, 0xb9, 0x00, 0x4e, 0x2e, 0x6b, 0xf3, 0xb0, 0xf9, 0x30, 0x5b,
+	0x70, 0x8a, 0x22, 0xfa, 0x84, 0x05, 0x75, 0xe9, 0xa1, 0x2c, 0x2f, 0x55, 0xd7, 0x8a, 0x2d, 0x07,
+	0x93, 0x11, 0x68, 0xaa, 0x0e, 0x06, 0xd6, 0x84, 0x51, 0xac, 0xbc, 0x37, 0x86, 0x8f, 0xc6, 0x7b,
+	0x03, 0x72, 0x3c, 0x37, 0xde, 0x06, 0x75, 0x29, 0x09, 0xe4, 0xf2, 0xe1, 0xd5, 0xbf, 0x64, 0x23,
+	0xd6, 0x70, 0xf4, 0x1c, 0x8c, 0xc4, 0xec, 0xc5, 0x12, 0xc3, 0x03, 0x82, 0x71, 0x8b, 0x35, 0xdd,
+	0x8c, 0x4d, 0x1c, 0xd3, 0x5d, 0x03, 0x1e, 0xa8, 0xbb, 0xc6, 0xc8, 0x01, 0xee, 0x1a, 0x6b, 0x70,
+	0xca, 0x69, 0x27, 0xe1, 0x25, 0xe2, 0xf8, 0xb3, 0x49, 0x42, 0x9a, 0xad, 0x24, 0xe6, 0x25, 0x6e,
+	0xb8, 0xbb, 0x82, 0x72, 0x07, 0x96, 0x31, 0x6a, 0x29, 0x24, 0x9c, 0xff, 0x6c, 0xae, 0xfb, 0xc3,
+	0xd8, 0x61, 0xdc, 0x1f, 0xd0, 0x0f, 0x41, 0xdd, 0x11, 0x65, 0x6b, 0xe2, 0xc9, 0xf1, 0x22, 0xca,
+	0x34, 0x77, 0xd5, 0xcc, 0xd1, 0x81, 0xcb, 0x92, 0x10, 0xd6, 0x34, 0xed, 0x7f, 0x6e, 0xc1, 0xa9,
+	0xdc, 0xd5, 0xfc, 0xf0, 0xc6, 0x92, 0xd9, 0x5f, 0xa8, 0xc2, 0x89, 0x9c, 0x82, 0x4c, 0xa8, 0x63,
+	0xee, 0x73, 0xab, 0x08, 0xe1, 0x95, 0xf6, 0x32, 0x96, 0xcb, 0x2b, 0x67, 0x73, 0x1f, 0xce, 0x89,
+	0x4c, 0x3b, 0x72, 0x95, 0xef, 0xaf, 0x23, 0x97, 0xb1, 0x5d, 0x2b, 0x0f, 0x74, 0xbb, 0x56, 0x0f,
+	0xd8, 0xae, 0xbf, 0x64, 0xc1, 0x64, 0xb3, 0x47, 0x75, 0x55, 0x71, 0x97, 0x7e, 0xfd, 0x68, 0x6a,
+	0xb7, 0xce, 0x3d, 0x7e, 0x67, 0x6f, 0xaa, 0x67, 0x51, 0x5b, 0xdc, 0x73, 0x54, 0xf6, 0xd7, 0xab,
+	0xc0, 0x4e, 0x13, 0xac, 0xc8, 0x45, 0x07, 0x7d, 0xc4, 0xac, 0xeb, 0x66, 0x15, 0x55, 0x83, 0x8c,
+	0x77, 0xae, 0xea, 0xc2, 0xf1, 0x19, 0xcc, 0x2b, 0x13, 0x97, 0x65, 0xe6, 0xa5, 0x3e, 0x98, 0xb9,
+	0x2f, 0x0b, 0xe8, 0x95, 0x8b, 0x2f, 0xa0, 0x57, 0xcf, 0x16, 0xcf, 0xdb, 0xff, 0x13, 0x57, 0x1e,
+	0xc6, 0x4f, 0x8c, 0xbe, 0x13, 0x86, 0x13, 0xaf, 0x49, 0xe8, 0xc1, 0xac, 0x9a, 0xbe, 0x70, 0x5a,
+	0xe7, 0xcd, 0x58, 0xc2, 0x59, 0xa5, 0x24, 0x93, 0xdb, 0x8b, 0x45, 0xbb, 0x5e, 0xe4, 0x12, 0x90,
+	0x7d, 0x73, 0x0d, 0xc8, 0x6c, 0xc1, 0x29, 0xda, 0xf4, 0xf4, 0xc7, 0xb4, 0xb6, 0xf5, 0xed, 0x88,
+	0xc4, 0xdb, 0xa1, 0xdf, 0x10, 0xb7, 0xad, 0x4a, 0x0f, 0x5d, 0x4d, 0x41, 0x71, 0x06, 0xdb, 0x7e,
+	0x17, 0x3c, 0x92, 0x4f, 0x99, 0xaa, 0x81, 0xb7, 0xbc, 0xa0, 0x11, 0xde, 0xca, 0x7a, 0xea, 0xdd,
+	0x60, 0xad, 0x58, 0x40, 0xed, 0xaf, 0x5a, 0x9c, 0x65, 0x67, 0xd6, 0xaf, 0xd6, 0x35, 0xad, 0x7d,
+	0x74, 0xcd, 0x67, 0xa1, 0x26, 0xc3, 0xc5, 0x85, 0x4e, 0xaa, 0x1d, 0xa5, 0x45, 0x3b, 0x56, 0x18,
+	0xe8, 0x1c, 0x80, 0xe3, 0xfb, 0xe1, 0xad, 0xf3, 0xcd, 0x56, 0xd2, 0x11, 0xda, 0xa9, 0x3a, 0xee,
+	0xcf, 0x2a, 0x08, 0x36, 0xb0, 0xd0, 0x77, 0xc0, 0x30, 0xcf, 0xe3, 0xd5, 0x10, 0x77, 0x02, 0x23,
+	0xf4, 0xa3, 0xf2, 0x2c, 0x5f, 0x0d, 0x2c, 0x61, 0xf6, 0x17, 0x2c, 0x30, 0x0c, 0x06, 0xe8, 0x45,
+	0x19, 0xeb, 0xcb, 0x0d, 0xa9, 0x59, 0x4b, 0xbe, 0x99, 0xbe, 0x1a, 0xa7, 0x30, 0xfb, 0x28, 0x69,
+	0xce, 0x9c, 0x9a, 0x5b, 0xe1, 0x35, 0xbc, 0x94, 0x0d, 0xec, 0xc2, 0xbc, 0x19, 0x4b, 0xb8, 0xfd,
+	0x53, 0x25, 0x31, 0x2a, 0x7e, 0x22, 0xd7, 0x5e, 0xf6, 0xd6, 0x21, 0xbd, 0xec, 0x3f, 0x0c, 0xe0,
+	0x86, 0xcd, 0x96, 0x13, 0x91, 0xc6, 0x7a, 0x58, 0x8c, 0xc9, 0x65, 0x5e, 0xf5, 0xa7, 0xbf, 0x81,
+	0x6e, 0xc3, 0x06, 0xbd, 0x94, 0x08, 0x2d, 0x1f, 0x28, 0x42, 0x53, 0xd2, 0xa4, 0xb2, 0xbf, 0x34,
+	0xb1, 0xff, 0xca, 0x82, 0xd4, 0x01, 0x01, 0xb5, 0xa0, 0x4a, 0x87, 0xdb, 0x11, 0x8c, 0x79, 0xa5,
+	0xb8, 0xd3, 0x08, 0x95, 0x88, 0x82, 0xdb, 0xb1, 0x9f, 0x98, 0x13, 0x42, 0xbe, 0x88, 0x28, 0x28,
+	0x15, 0x55, 0x17, 0x51, 0x12, 0xbc, 0x14, 0x86, 0x3b, 0xdc, 0x61, 0x55, 0x47, 0x27, 0xd8, 0x2f,
+	0xc2, 0xf1, 0xae, 0x41, 0xd1, 0xbd, 0xc6, 0x52, 0x90, 0x65, 0xf7, 0x1a, 0x4b, 0xbe, 0x85, 0x39,
+	0xcc, 0xfe, 0x05, 0x0b, 0x8e, 0x65, 0xbb, 0x47, 0x5f, 0xb4, 0xe0, 0x78, 0x9c, 0xed, 0xef, 0xa8,
+	0xe6, 0x4e, 0x45, 0x0e, 0x76, 0x81, 0x70, 0xf7, 0x20, 0xec, 0x9f, 0x2b, 0xf1, 0xf1, 0x9a, 0x2a,
+	0x2f, 0xda, 0x90, 0x55, 0x49, 0xf9, 0x0e, 0x58, 0xca, 0x56, 0x25, 0x1d, 0x28, 0x98, 0x47, 0x14,
+	0x24, 0x3d, 0x0b, 0x95, 0x5b, 0xce, 0x2e, 0x3f, 0x24, 0x97, 0xf5, 0x16, 0x66, 0x51, 0x1b, 0x0c,
+	0xc2, 0x18, 0x11, 0x1b, 0x11, 0xf3, 0x0f, 0x2f, 0xa7, 0x2f, 0x0d, 0x66, 0x15, 0x04, 0x1b, 0x58,
+	0xe8, 0x15, 0xfd, 0xcc, 0x3d, 0x85, 0x17, 0x8c, 0x9b, 0x7d, 0xcf, 0x26, 0xd8, 0xe8, 0xcd, 0xfe,
+	0x6f, 0x65, 0xce, 0x27, 0x38, 0x6b, 0x56, 0xaa, 0xbb, 0xd5, 0x53, 0x75, 0xa7, 0x7c, 0xd7, 0xdd,
+	0x26, 0x8d, 0xb6, 0xdf, 0x95, 0x18, 0x6d, 0x4d, 0xb4, 0x63, 0x85, 0xc1, 0xf2, 0x40, 0xb5, 0x85,
+	0x39, 0x2d, 0xb3, 0x7f, 0x17, 0x44, 0x3b, 0x56, 0x18, 0xe8, 0x79, 0x18, 0x35, 0xd6, 0x83, 0xdc,
+	0xc2, 0x5c, 0x90, 0x19, 0xed, 0x38, 0x85, 0x85, 0xa6, 0x01, 0xd4, 0x31, 0x40, 0x2a, 0x91, 0xec,
+	0x95, 0x95, 0xac, 0x8e, 0xb1, 0x81, 0xc1, 0xb2, 0xae, 0xf9, 0xed, 0x98, 0x39, 0x76, 0x0d, 0xe9,
+	0xc4, 0x25, 0xf3, 0xa2, 0x0d, 0x2b, 0x28, 0xfd, 0x58, 0x4d, 0x27, 0x68, 0x3b, 0x3e, 0x9d, 0x21,
+	0x71, 0x93, 0xa5, 0x3e, 0xd6, 0xb2, 0x82, 0x60, 0x03, 0x8b, 0xbe, 0x31, 0x15, 0xf7, 0xaf, 0x84,
+	0x81, 0x0c, 0x8e, 0xd3, 0xbe, 0x7e, 0xa2, 0x1d, 0x2b, 0x0c, 0xf4, 0x22, 0x8c, 0x38, 0x41, 0x83,
+	0x9f, 0x59, 0xc2, 0x48, 0xb8, 0x0c, 0x29, 0x09, 0x7c, 0x2d, 0x26, 0xb3, 0x1a, 0x8a, 0x4d, 0xd4,
+	0x6c, 0x11, 0x36, 0xe8, 0xb3, 0xe8, 0xf4, 0x5f, 0x5a, 0x30, 0xa1, 0x73, 0x6d, 0xb2, 0x0b, 0xaf,
+	0xd4, 0x4d, 0x9f, 0x75, 0xe0, 0x4d, 0x5f, 0x3a, 0x9b, 0x5e, 0xa9, 0xaf, 0x6c, 0x7a, 0x66, 0xa2,
+	0xbb, 0xf2, 0xbe, 0x89, 0xee, 0xbe, 0x03, 0x86, 0x77, 0x48, 0xc7, 0xc8, 0x88, 0xc7, 0x84, 0xee,
+	0x15, 0xde, 0x84, 0x25, 0x0c, 0xd9, 0x30, 0xe4, 0x3a, 0x2a, 0xf5, 0xf6, 0xa8, 0x70, 0x15, 0x9f,
+	0x65, 0x48, 0x02, 0x62, 0xaf, 0x40, 0x5d, 0xf9, 0xd8, 0xc9, 0x8b, 0x37, 0x2b, 0xff, 0xe2, 0x8d,
+	0xb2, 0x41, 0xc3, 0x5d, 0x50, 0xb3, 0x41, 0xe6, 0x64, 0x28, 0xbc, 0x07, 0xe7, 0x36, 0x7e, 0xe7,
+	0x9b, 0x4f, 0xbe, 0xe5, 0x0f, 0xbe, 0xf9, 0xe4, 0x5b, 0xbe, 0xfe, 0xcd, 0x27, 0xdf, 0xf2, 0xd1,
+	0x3b, 0x4f, 0x5a, 0xbf, 0x73, 0xe7, 0x49, 0xeb, 0x0f, 0xee, 0x3c, 0x69, 0x7d, 0xfd, 0xce, 0x93,
+	0xd6, 0x37, 0xee, 0x3c, 0x69, 0xbd, 0xf1, 0x1f, 0x9f, 0x7c, 0xcb, 0x2b, 0xb9, 0xe1, 0x98, 0xf4,
+	0xc7, 0xdb, 0xdd, 0xc6, 0xcc, 0xee, 0x3b, 0x18, 0x13, 0xa1, 0x7b, 0x74, 0xc6, 0x58, 0xc4, 0x33,
+	0x92, 0xf5, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x96, 0x82, 0xd6, 0x92, 0x16, 0x01,
+	0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5929,6 +6246,18 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PruneThreshold)
+	copy(dAtA[i:], m.PruneThreshold)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PruneThreshold)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i -= len(m.SyncTimeout)
+	copy(dAtA[i:], m.SyncTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncTimeout)))
+	i--
+	dAtA[i] = 0x7a
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6263,6 +6592,39 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8373,6 +8735,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8996,6 +9372,11 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncConcurrencyLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SyncConcurrencyLimit))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -9836,6 +10217,16 @@ func (m *DrySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Ref)
+	copy(dAtA[i:], m.Ref)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
+	i--
+	dAtA[i] = 0x42
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -10629,6 +11020,108 @@ func (m *HostResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydrateHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.RollbackID != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RollbackID))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.HydratedSHA)
+	copy(dAtA[i:], m.HydratedSHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HydratedSHA)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.DrySHA)
+	copy(dAtA[i:], m.DrySHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DrySHA)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x22
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10649,6 +11142,15 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10704,6 +11206,42 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratePullRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratePullRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratePullRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.State)
+	copy(dAtA[i:], m.State)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Number))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateTo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10724,6 +11262,18 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.TargetBranch)
 	copy(dAtA[i:], m.TargetBranch)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
@@ -10732,6 +11282,39 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydrateToPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateToPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateToPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11754,6 +12337,18 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingApproval != nil {
+		{
+			size, err := m.PendingApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -13710,6 +14305,134 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceDriftEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDriftEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDriftEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.SelfHealed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FieldPaths) > 0 {
+		for iNdEx := len(m.FieldPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldPaths[iNdEx])
+			copy(dAtA[i:], m.FieldPaths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ResolvedAt != nil {
+		{
+			size, err := m.ResolvedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DetectedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceDriftHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDriftHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDriftHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14367,6 +15090,16 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRollbackFrom != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AutoRollbackFrom))
+		i--
+		dAtA[i] = 0x60
+	}
+	i -= len(m.HealthStatus)
+	copy(dAtA[i:], m.HealthStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthStatus)))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15325,6 +16058,90 @@ func (m *SourceHydrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DrySources) > 0 {
+		for iNdEx := len(m.DrySources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrySources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.ManifestLayout)
+	copy(dAtA[i:], m.ManifestLayout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestLayout)))
+	i--
+	dAtA[i] = 0x22
+	if m.HydrateTo != nil {
+		{
+			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.SyncSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DrySource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceHydratorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceHydratorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceHydratorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DrySourcesHash)
+	copy(dAtA[i:], m.DrySourcesHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DrySourcesHash)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ManifestLayout)
+	copy(dAtA[i:], m.ManifestLayout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestLayout)))
+	i--
+	dAtA[i] = 0x22
 	if m.HydrateTo != nil {
 		{
 			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
@@ -15380,6 +16197,32 @@ func (m *SourceHydratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.CurrentOperation != nil {
 		{
 			size, err := m.CurrentOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -15427,6 +16270,15 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15470,6 +16322,25 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.AutoRollbackFrom != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AutoRollbackFrom))
+		i--
+		dAtA[i] = 0x68
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -15726,6 +16597,28 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PruneThreshold)
+	copy(dAtA[i:], m.PruneThreshold)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PruneThreshold)))
+	i--
+	dAtA[i] = 0x3a
+	if m.AutoRollback != nil {
+		{
+			size, err := m.AutoRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x2a
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15774,6 +16667,34 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPolicyAutoRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyAutoRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyAutoRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15851,6 +16772,11 @@ func (m *SyncSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -16027,6 +16953,54 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWaveApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaveApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaveApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16362,6 +17336,10 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.SyncTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PruneThreshold)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16419,6 +17397,19 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17243,6 +18234,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17493,6 +18490,9 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.SyncConcurrencyLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.SyncConcurrencyLimit))
+	}
 	return n
 }
 
@@ -17773,6 +18773,10 @@ func (m *DrySource) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18048,6 +19052,43 @@ func (m *HostResourceInfo) Size() (n int) {
 	return n
 }
 
+func (m *HydrateHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DrySHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HydratedSHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SourceHydrator.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RollbackID != nil {
+		n += 1 + sovGenerated(uint64(*m.RollbackID))
+	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *HydrateOperation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18070,6 +19111,26 @@ func (m *HydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HydratePullRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.State)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18081,6 +19142,23 @@ func (m *HydrateTo) Size() (n int) {
 	_ = l
 	l = len(m.TargetBranch)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HydrateToPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18483,6 +19561,10 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if m.PendingApproval != nil {
+		l = m.PendingApproval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19174,6 +20256,57 @@ func (m *ResourceDiff) Size() (n int) {
 	return n
 }
 
+func (m *ResourceDriftEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DetectedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ResolvedAt != nil {
+		l = m.ResolvedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.FieldPaths) > 0 {
+		for _, s := range m.FieldPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *ResourceDriftHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResourceIgnoreDifferences) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19450,6 +20583,11 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AutoRollbackFrom != nil {
+		n += 1 + sovGenerated(uint64(*m.AutoRollbackFrom))
+	}
 	return n
 }
 
@@ -19779,6 +20917,35 @@ func (m *SourceHydrator) Size() (n int) {
 		l = m.HydrateTo.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.ManifestLayout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DrySources) > 0 {
+		for _, e := range m.DrySources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceHydratorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DrySource.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SyncSource.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.HydrateTo != nil {
+		l = m.HydrateTo.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ManifestLayout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DrySourcesHash)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19796,6 +20963,16 @@ func (m *SourceHydratorStatus) Size() (n int) {
 		l = m.CurrentOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19811,6 +20988,12 @@ func (m *SuccessfulHydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19863,6 +21046,15 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	if m.AutoRollbackFrom != nil {
+		n += 1 + sovGenerated(uint64(*m.AutoRollbackFrom))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19942,6 +21134,25 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AutoRollback != nil {
+		l = m.AutoRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PruneThreshold)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncPolicyAutoRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19970,6 +21181,8 @@ func (m *SyncSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20032,6 +21245,24 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncWaveApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Wave))
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20230,6 +21461,8 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`SyncTimeout:` + fmt.Sprintf("%v", this.SyncTimeout) + `,`,
+		`PruneThreshold:` + fmt.Sprintf("%v", this.PruneThreshold) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20279,6 +21512,17 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -20836,6 +22080,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20846,6 +22095,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21031,6 +22281,7 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`SyncConcurrencyLimit:` + valueToStringGenerated(this.SyncConcurrencyLimit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21238,6 +22489,8 @@ func (this *DrySource) String() string {
 		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
 		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21478,6 +22731,26 @@ func (this *HostResourceInfo) String() string {
 	}, "")
 	return s
 }
+func (this *HydrateHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateHistory{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
+		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
+		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorRecord", "SourceHydratorRecord", 1), `&`, ``, 1) + `,`,
+		`RollbackID:` + valueToStringGenerated(this.RollbackID) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HydrateOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -21489,7 +22762,20 @@ func (this *HydrateOperation) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
-		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorRecord", "SourceHydratorRecord", 1), `&`, ``, 1) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratePullRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratePullRequestStatus{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21500,6 +22786,18 @@ func (this *HydrateTo) String() string {
 	}
 	s := strings.Join([]string{`&HydrateTo{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydrateToPullRequest", "HydrateToPullRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydrateToPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateToPullRequest{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21844,6 +23142,7 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`PendingApproval:` + strings.Replace(this.PendingApproval.String(), "SyncWaveApproval", "SyncWaveApproval", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22345,6 +23644,39 @@ func (this *ResourceDiff) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceDriftEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceDriftEvent{`,
+		`DetectedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DetectedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ResolvedAt:` + strings.Replace(fmt.Sprintf("%v", this.ResolvedAt), "Time", "v1.Time", 1) + `,`,
+		`FieldPaths:` + fmt.Sprintf("%v", this.FieldPaths) + `,`,
+		`Managers:` + fmt.Sprintf("%v", this.Managers) + `,`,
+		`SelfHealed:` + fmt.Sprintf("%v", this.SelfHealed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceDriftHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]ResourceDriftEvent{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(strings.Replace(f.String(), "ResourceDriftEvent", "ResourceDriftEvent", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&ResourceDriftHistory{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceIgnoreDifferences) String() string {
 	if this == nil {
 		return "nil"
@@ -22537,6 +23869,8 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`HealthStatus:` + fmt.Sprintf("%v", this.HealthStatus) + `,`,
+		`AutoRollbackFrom:` + valueToStringGenerated(this.AutoRollbackFrom) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22753,10 +24087,31 @@ func (this *SourceHydrator) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDrySources := "[]DrySource{"
+	for _, f := range this.DrySources {
+		repeatedStringForDrySources += strings.Replace(strings.Replace(f.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDrySources += "}"
 	s := strings.Join([]string{`&SourceHydrator{`,
 		`DrySource:` + strings.Replace(strings.Replace(this.DrySource.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + `,`,
 		`SyncSource:` + strings.Replace(strings.Replace(this.SyncSource.String(), "SyncSource", "SyncSource", 1), `&`, ``, 1) + `,`,
 		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
+		`ManifestLayout:` + fmt.Sprintf("%v", this.ManifestLayout) + `,`,
+		`DrySources:` + repeatedStringForDrySources + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SourceHydratorRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SourceHydratorRecord{`,
+		`DrySource:` + strings.Replace(strings.Replace(this.DrySource.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + `,`,
+		`SyncSource:` + strings.Replace(strings.Replace(this.SyncSource.String(), "SyncSource", "SyncSource", 1), `&`, ``, 1) + `,`,
+		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
+		`ManifestLayout:` + fmt.Sprintf("%v", this.ManifestLayout) + `,`,
+		`DrySourcesHash:` + fmt.Sprintf("%v", this.DrySourcesHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22765,9 +24120,16 @@ func (this *SourceHydratorStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHistory := "[]HydrateHistory{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "HydrateHistory", "HydrateHistory", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
 	s := strings.Join([]string{`&SourceHydratorStatus{`,
 		`LastSuccessfulOperation:` + strings.Replace(this.LastSuccessfulOperation.String(), "SuccessfulHydrateOperation", "SuccessfulHydrateOperation", 1) + `,`,
 		`CurrentOperation:` + strings.Replace(this.CurrentOperation.String(), "HydrateOperation", "HydrateOperation", 1) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequestStatus", "HydratePullRequestStatus", 1) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22779,7 +24141,8 @@ func (this *SuccessfulHydrateOperation) String() string {
 	s := strings.Join([]string{`&SuccessfulHydrateOperation{`,
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
-		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorRecord", "SourceHydratorRecord", 1), `&`, ``, 1) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22798,6 +24161,11 @@ func (this *SyncOperation) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForApprovals := "[]SyncWaveApproval{"
+	for _, f := range this.Approvals {
+		repeatedStringForApprovals += strings.Replace(strings.Replace(f.String(), "SyncWaveApproval", "SyncWaveApproval", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForApprovals += "}"
 	s := strings.Join([]string{`&SyncOperation{`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
@@ -22810,6 +24178,8 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`AutoRollbackFrom:` + valueToStringGenerated(this.AutoRollbackFrom) + `,`,
+		`Approvals:` + repeatedStringForApprovals + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22861,6 +24231,19 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`AutoRollback:` + strings.Replace(this.AutoRollback.String(), "SyncPolicyAutoRollback", "SyncPolicyAutoRollback", 1) + `,`,
+		`PruneThreshold:` + fmt.Sprintf("%v", this.PruneThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyAutoRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyAutoRollback{`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22885,6 +24268,7 @@ func (this *SyncSource) String() string {
 	s := strings.Join([]string{`&SyncSource{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22933,6 +24317,19 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWaveApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWaveApproval{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -24034,6 +25431,70 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24569,6 +26030,120 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31450,6 +33025,40 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33579,6 +35188,26 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncConcurrencyLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncConcurrencyLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36142,6 +37771,70 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38761,7 +40454,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
+func (m *HydrateHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38784,13 +40477,32 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
@@ -38823,7 +40535,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
@@ -38859,7 +40571,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
@@ -38891,7 +40603,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -38923,7 +40635,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
@@ -38955,7 +40667,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
@@ -38987,7 +40699,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
@@ -39020,59 +40732,62 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.RollbackID = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HydrateTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39100,7 +40815,7 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39123,7 +40838,7 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39146,15 +40861,84 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39182,11 +40966,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39214,61 +40998,43 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39296,11 +41062,44 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39328,7 +41127,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39351,7 +41150,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HydratePullRequestStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39374,17 +41173,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratePullRequestStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratePullRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.IssuedAt = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39394,16 +41193,16 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39413,14 +41212,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39448,7 +41260,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.State = HydratePullRequestState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39471,7 +41283,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39494,15 +41306,47 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39529,8 +41373,10 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PullRequest == nil {
+				m.PullRequest = &HydrateToPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39555,7 +41401,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *HydrateToPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39578,15 +41424,15 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateToPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateToPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39614,11 +41460,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = PullRequestProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39646,28 +41492,8 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39689,7 +41515,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39712,15 +41538,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39748,11 +41574,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39780,7 +41606,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39803,7 +41629,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39826,15 +41652,15 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39862,43 +41688,11 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39926,7 +41720,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39949,7 +41743,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39972,17 +41766,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39992,27 +41786,33 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40040,11 +41840,61 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40071,8 +41921,8 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, KustomizeVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40097,7 +41947,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40120,15 +41970,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40156,11 +42006,11 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40188,49 +42038,13 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40240,107 +42054,12 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Options[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40362,7 +42081,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40385,15 +42104,15 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40421,13 +42140,13 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40437,24 +42156,23 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40477,7 +42195,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40500,17 +42218,17 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40520,28 +42238,27 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40569,11 +42286,11 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40601,7 +42318,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40624,7 +42341,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40647,17 +42364,17 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40667,28 +42384,27 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40716,13 +42432,13 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40732,23 +42448,699 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, KustomizeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42648,6 +45040,42 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingApproval == nil {
+				m.PendingApproval = &SyncWaveApproval{}
+			}
+			if err := m.PendingApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48006,21 +50434,455 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
-			}
+			m.IconClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48048,7 +50910,7 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48071,7 +50933,7 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48094,17 +50956,17 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48114,30 +50976,29 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48147,25 +51008,23 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48188,7 +51047,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48211,17 +51070,17 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48231,28 +51090,109 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48279,11 +51219,31 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MergeBuiltinActions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48305,7 +51265,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48328,15 +51288,15 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48364,13 +51324,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48380,31 +51340,29 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48414,15 +51372,27 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48450,11 +51420,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconClass = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48482,61 +51452,43 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.TargetState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48564,11 +51516,31 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Diff = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48596,61 +51568,43 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48678,8 +51632,28 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48701,7 +51675,7 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+func (m *ResourceDriftEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48724,17 +51698,17 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDriftEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDriftEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48744,27 +51718,28 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			if err := m.DetectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48791,14 +51766,80 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResolvedAt == nil {
+				m.ResolvedAt = &v1.Time{}
+			}
+			if err := m.ResolvedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldPaths = append(m.FieldPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -48815,7 +51856,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MergeBuiltinActions = bool(v != 0)
+			m.SelfHealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48837,7 +51878,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *ResourceDriftHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48860,10 +51901,10 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDriftHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDriftHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -48996,157 +52037,9 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
-			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49156,76 +52049,26 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Events = append(m.Events, ResourceDriftEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51889,6 +54732,58 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthStatus = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollbackFrom", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRollbackFrom = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54517,21 +57412,283 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54541,27 +57698,31 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			if m.HydrateTo == nil {
+				m.HydrateTo = &HydrateTo{}
+			}
+			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestLayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54589,63 +57750,13 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ManifestLayout = HydratedManifestLayout(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54655,23 +57766,25 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.DrySources = append(m.DrySources, DrySource{})
+			if err := m.DrySources[len(m.DrySources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54694,7 +57807,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
+func (m *SourceHydratorRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54717,10 +57830,10 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydratorRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydratorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -54825,6 +57938,70 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestLayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestLayout = HydratedManifestLayout(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySourcesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySourcesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54947,6 +58124,76 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, HydrateHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55094,6 +58341,38 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55384,13 +58663,266 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollbackFrom", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRollbackFrom = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, SyncWaveApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55400,29 +58932,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55450,27 +58980,8 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
-			}
-			m.SelfHealAttemptsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55492,7 +59003,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55515,17 +59026,17 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55535,27 +59046,29 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55583,13 +59096,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55599,27 +59112,62 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55647,7 +59195,43 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55670,7 +59254,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55693,15 +59277,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55728,14 +59312,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55763,11 +59349,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55794,13 +59380,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55827,14 +59416,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55862,11 +59453,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55893,13 +59484,45 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			if m.AutoRollback == nil {
+				m.AutoRollback = &SyncPolicyAutoRollback{}
 			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55921,7 +59544,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutoRollback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55944,51 +59567,15 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutoRollback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutoRollback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -56016,79 +59603,7 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -56335,6 +59850,38 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56810,6 +60357,175 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWaveApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaveApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaveApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
+			}
+			m.Wave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -99,6 +100,25 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of applications which must be synced and healthy before this application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency is a reference to an application another application depends on
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application. Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+}
+
+// QualifiedName returns the full qualified name of the application the dependency refers to, i.e. <namespace>/<appname>
+func (d ApplicationDependency) QualifiedName() string {
+	if d.Namespace == "" {
+		return d.Name
+	}
+	return d.Namespace + "/" + d.Name
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -255,7 +275,7 @@ func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 			targetRevision = spec.SourceHydrator.HydrateTo.TargetBranch
 		}
 		return ApplicationSource{
-			RepoURL:        spec.SourceHydrator.DrySource.RepoURL,
+			RepoURL:        spec.SourceHydrator.getSyncRepoURL(),
 			Path:           spec.SourceHydrator.SyncSource.Path,
 			TargetRevision: targetRevision,
 		}
@@ -405,43 +425,135 @@ type SourceHydrator struct {
 	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
 	// SyncSource specifies where to sync hydrated manifests from.
 	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
-	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
-	// have to move manifests to the SyncSource, e.g. by pull request.
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. Manifests are then moved to the
+	// SyncSource by pull request, either opened by Argo CD (see HydrateTo.PullRequest) or by an external system.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// ManifestLayout determines how hydrated manifests are split into files. Defaults to SingleFile.
+	ManifestLayout HydratedManifestLayout `json:"manifestLayout,omitempty" protobuf:"bytes,4,opt,name=manifestLayout"`
+	// DrySources specifies additional dry sources, hydrated together with the DrySource like the sources of a
+	// multi-source application. They may be git, Helm or OCI sources. Sources with a Ref and no Path or Chart are not
+	// rendered, and only provide value files to the other sources, e.g. `$values/env/values.yaml`.
+	DrySources []DrySource `json:"drySources,omitempty" protobuf:"bytes,5,rep,name=drySources"`
 }
 
+// HydratedManifestLayout determines how hydrated manifests are split into files
+// +kubebuilder:validation:Enum=SingleFile;PerResource;PerResourceByKind
+type HydratedManifestLayout string
+
+const (
+	// HydratedManifestLayoutSingleFile writes all manifests to a single manifest.yaml file.
+	HydratedManifestLayoutSingleFile HydratedManifestLayout = "SingleFile"
+	// HydratedManifestLayoutPerResource writes each manifest to its own file, named
+	// <kind>.<group>_<namespace>_<name>.yaml.
+	HydratedManifestLayoutPerResource HydratedManifestLayout = "PerResource"
+	// HydratedManifestLayoutPerResourceByKind writes each manifest to its own file like PerResource, in one
+	// subdirectory per kind.
+	HydratedManifestLayoutPerResourceByKind HydratedManifestLayout = "PerResourceByKind"
+)
+
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
 	return ApplicationSource{
-		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
-		RepoURL:        s.DrySource.RepoURL,
+		RepoURL:        s.getSyncRepoURL(),
 		Path:           s.SyncSource.Path,
 		TargetRevision: s.SyncSource.TargetBranch,
 	}
 }
 
+// getSyncRepoURL returns the URL of the repository hydrated manifests are written to and synced from.
+func (s SourceHydrator) getSyncRepoURL() string {
+	if s.SyncSource.RepoURL != "" {
+		return s.SyncSource.RepoURL
+	}
+	// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
+	return s.DrySource.RepoURL
+}
+
 // GetDrySource gets the dry source when a source hydrator is configured.
 func (s SourceHydrator) GetDrySource() ApplicationSource {
-	return ApplicationSource{
-		RepoURL:        s.DrySource.RepoURL,
-		Path:           s.DrySource.Path,
-		TargetRevision: s.DrySource.TargetRevision,
+	return s.DrySource.ToApplicationSource()
+}
+
+// GetDrySources gets all the dry sources when a source hydrator is configured: the DrySource, followed by the
+// additional DrySources.
+func (s SourceHydrator) GetDrySources() ApplicationSources {
+	sources := make(ApplicationSources, 0, len(s.DrySources)+1)
+	sources = append(sources, s.GetDrySource())
+	for _, source := range s.DrySources {
+		sources = append(sources, source.ToApplicationSource())
 	}
+	return sources
+}
+
+// HasMultipleDrySources returns true if additional dry sources are configured.
+func (s SourceHydrator) HasMultipleDrySources() bool {
+	return len(s.DrySources) > 0
 }
 
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	if !s.DrySource.Equals(hydrator.DrySource) || s.SyncSource != hydrator.SyncSource || !s.HydrateTo.DeepEquals(hydrator.HydrateTo) || s.ManifestLayout != hydrator.ManifestLayout {
+		return false
+	}
+	return slices.EqualFunc(s.DrySources, hydrator.DrySources, DrySource.Equals)
+}
+
+// Record returns the record of the SourceHydrator stored in the status of the hydrate operations.
+func (s SourceHydrator) Record() SourceHydratorRecord {
+	return SourceHydratorRecord{
+		DrySource:      s.DrySource,
+		SyncSource:     s.SyncSource,
+		HydrateTo:      s.HydrateTo,
+		ManifestLayout: s.ManifestLayout,
+		DrySourcesHash: hashDrySources(s.DrySources),
+	}
+}
+
+// hashDrySources returns a hash of the given additional dry sources, or an empty string if there are none.
+func hashDrySources(sources []DrySource) string {
+	if len(sources) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(sources)
+	if err != nil {
+		// Dry sources are plain data, so this should never happen. An empty hash makes the record differ from the spec,
+		// which at worst triggers an extra hydration.
+		return ""
+	}
+	return strconv.FormatUint(xxhash.Sum64(data), 16)
+}
+
+// SourceHydratorRecord is the hydrator config used for a hydrate operation, as recorded in the status of the source
+// hydrator. The additional dry sources are only recorded as a hash, to keep the status small. Their resolved revisions
+// are recorded along with the operation.
+type SourceHydratorRecord struct {
+	// DrySource specifies where the dry "don't repeat yourself" manifest source lives.
+	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
+	// SyncSource specifies where to sync hydrated manifests from.
+	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to.
+	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// ManifestLayout determines how hydrated manifests are split into files.
+	ManifestLayout HydratedManifestLayout `json:"manifestLayout,omitempty" protobuf:"bytes,4,opt,name=manifestLayout"`
+	// DrySourcesHash is a hash of the additional dry sources. It is empty if there are none.
+	DrySourcesHash string `json:"drySourcesHash,omitempty" protobuf:"bytes,6,opt,name=drySourcesHash"`
+}
+
+// DeepEquals returns true if the SourceHydratorRecord is deeply equal to the given SourceHydratorRecord.
+func (r SourceHydratorRecord) DeepEquals(record SourceHydratorRecord) bool {
+	return r.DrySource.Equals(record.DrySource) && r.SyncSource == record.SyncSource && r.HydrateTo.DeepEquals(record.HydrateTo) && r.ManifestLayout == record.ManifestLayout && r.DrySourcesHash == record.DrySourcesHash
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
 type DrySource struct {
-	// RepoURL is the URL to the git repository that contains the application manifests
+	// RepoURL is the URL to the repository (Git, Helm or OCI) that contains the application manifests. The
+	// DrySource of a SourceHydrator must be a Git repository.
 	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
 	// TargetRevision defines the revision of the source to hydrate
 	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
-	// Path is a directory path within the Git repository where the manifests are located
-	Path string `json:"path" protobuf:"bytes,3,name=path"`
+	// Path is a directory path within the Git repository where the manifests are located. It is required for the
+	// DrySource of a SourceHydrator, and for the DrySources without a Chart or a Ref.
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
 	// Helm specifies helm specific options
 	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,4,opt,name=helm"`
 	// Kustomize specifies kustomize specific options
@@ -450,6 +562,26 @@ type DrySource struct {
 	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,6,opt,name=directory"`
 	// Plugin specifies config management plugin specific options
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,7,opt,name=plugin"`
+	// Chart is a Helm chart name, and must be specified for sources of a Helm repository
+	Chart string `json:"chart,omitempty" protobuf:"bytes,8,opt,name=chart"`
+	// Ref is reference to another source within the dry sources. This field is used to refer to the files of this
+	// source, e.g. Helm value files, from the other dry sources.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,9,opt,name=ref"`
+}
+
+// ToApplicationSource returns the application source rendering the manifests of the dry source.
+func (in DrySource) ToApplicationSource() ApplicationSource {
+	return ApplicationSource{
+		RepoURL:        in.RepoURL,
+		Path:           in.Path,
+		TargetRevision: in.TargetRevision,
+		Helm:           in.Helm,
+		Kustomize:      in.Kustomize,
+		Directory:      in.Directory,
+		Plugin:         in.Plugin,
+		Chart:          in.Chart,
+		Ref:            in.Ref,
+	}
 }
 
 func (in DrySource) Equals(other DrySource) bool {
@@ -466,11 +598,12 @@ func (in DrySource) Equals(other DrySource) bool {
 	return reflect.DeepEqual(sourceCopy, otherCopy)
 }
 
-// SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
-// associated DrySource config in the SourceHydrator.
+// SyncSource specifies a location from which hydrated manifests may be synced. Unless RepoURL is set, it is assumed
+// based on the associated DrySource config in the SourceHydrator.
 type SyncSource struct {
 	// TargetBranch is the branch from which hydrated manifests will be synced.
 	// If HydrateTo is not set, this is also the branch to which hydrated manifests are committed.
+	// If RepoURL is set, this is the tag of the OCI artifact from which hydrated manifests will be synced instead.
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
 	// Path is a directory path within the git repository where hydrated manifests should be committed to and synced
 	// from. The Path should never point to the root of the repo. If hydrateTo is set, this is just the path from which
@@ -480,6 +613,15 @@ type SyncSource struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^.{2,}|[^./]$`
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// RepoURL is the URL of an OCI repository (oci://...) to push hydrated manifests to and sync them from, instead of
+	// committing them to the dry source's git repository. The hydrated manifests are pushed as an OCI artifact tagged
+	// with the dry SHA and with TargetBranch (or HydrateTo's TargetBranch, if set).
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
+}
+
+// IsOCI returns true if hydrated manifests are pushed to an OCI repository instead of committed to a git repository.
+func (s SyncSource) IsOCI() bool {
+	return strings.HasPrefix(s.RepoURL, "oci://")
 }
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
@@ -487,6 +629,9 @@ type SyncSource struct {
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// PullRequest configures Argo CD to open a pull request from TargetBranch into the SyncSource's TargetBranch when
+	// hydrated manifests are pushed to TargetBranch. If not set, an external system has to move the manifests.
+	PullRequest *HydrateToPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,2,opt,name=pullRequest"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -498,10 +643,40 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
+	if in.TargetBranch != to.TargetBranch {
+		return false
+	}
+	if in.PullRequest == nil || to.PullRequest == nil {
+		return in.PullRequest == to.PullRequest
+	}
 	// Compare de-referenced structs.
-	return *in == *to
+	return *in.PullRequest == *to.PullRequest
 }
 
+// HydrateToPullRequest configures the pull request which promotes hydrated manifests from the HydrateTo branch to the
+// SyncSource branch. The commit server opens the pull request with the same write credentials it uses to push the
+// hydrated manifests, and keeps using the open pull request for subsequent hydrations until it is merged or closed.
+type HydrateToPullRequest struct {
+	// Provider is the SCM provider hosting the repository
+	Provider PullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the base URL of the provider's API. It is required for Gitea and Bitbucket Server. For other providers,
+	// it defaults to the public API, e.g. https://api.github.com.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+}
+
+// PullRequestProvider is the SCM provider used to open pull requests
+// +kubebuilder:validation:Enum=GitHub;GitLab;Gitea;BitbucketServer;BitbucketCloud;AzureDevOps
+type PullRequestProvider string
+
+const (
+	PullRequestProviderGitHub          PullRequestProvider = "GitHub"
+	PullRequestProviderGitLab          PullRequestProvider = "GitLab"
+	PullRequestProviderGitea           PullRequestProvider = "Gitea"
+	PullRequestProviderBitbucketServer PullRequestProvider = "BitbucketServer"
+	PullRequestProviderBitbucketCloud  PullRequestProvider = "BitbucketCloud"
+	PullRequestProviderAzureDevOps     PullRequestProvider = "AzureDevOps"
+)
+
 // RefreshType specifies how to refresh the sources of a given application
 type RefreshType string
 
@@ -510,6 +685,18 @@ const (
 	RefreshTypeHard   RefreshType = "hard"
 )
 
+// RefreshSource specifies what requested the refresh of a given application
+type RefreshSource string
+
+const (
+	// RefreshSourceDefault is a refresh requested by the controller, by automation or without a source
+	RefreshSourceDefault RefreshSource = ""
+	// RefreshSourceUser is a refresh requested by a user through the API server, e.g. from the UI or the CLI
+	RefreshSourceUser RefreshSource = "user"
+	// RefreshSourceWebhook is a refresh requested by a Git webhook
+	RefreshSourceWebhook RefreshSource = "webhook"
+)
+
 type HydrateType string
 
 const (
@@ -1210,8 +1397,96 @@ type SourceHydratorStatus struct {
 	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
 	// CurrentOperation holds the status of the hydrate operation
 	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+	// PullRequest holds the status of the pull request promoting hydrated manifests to the sync branch, if the hydrator
+	// is configured to open one
+	PullRequest *HydratePullRequestStatus `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+	// History contains information about the most recent hydrate operations and rollbacks, oldest first. It is bounded
+	// by the application's revision history limit.
+	History HydrateHistories `json:"history,omitempty" protobuf:"bytes,4,opt,name=history"`
+}
+
+// HydrateHistory contains information about a completed hydrate operation, or a rollback of the hydrated manifests to a
+// previous hydrate operation
+type HydrateHistory struct {
+	// ID is an auto incrementing identifier of the HydrateHistory
+	ID int64 `json:"id" protobuf:"varint,1,opt,name=id"`
+	// StartedAt indicates when the hydrate operation started
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,2,opt,name=startedAt"`
+	// FinishedAt indicates when the hydrate operation finished
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,3,opt,name=finishedAt"`
+	// Phase indicates the result of the hydrate operation
+	Phase HydrateOperationPhase `json:"phase" protobuf:"bytes,4,opt,name=phase"`
+	// Message contains a message describing the result of the hydrate operation
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// DrySHA holds the resolved revision (sha) of the dry source the manifests were hydrated from
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,6,opt,name=drySHA"`
+	// HydratedSHA holds the revision (sha) of the hydrated commit
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,7,opt,name=hydratedSHA"`
+	// SourceHydrator holds the hydrator config used for the hydrate operation
+	SourceHydrator SourceHydratorRecord `json:"sourceHydrator,omitempty" protobuf:"bytes,8,opt,name=sourceHydrator"`
+	// RollbackID holds the ID of the history entry whose hydrated manifests were restored, if this entry is a rollback
+	RollbackID *int64 `json:"rollbackID,omitempty" protobuf:"varint,9,opt,name=rollbackID"`
+	// InitiatedBy contains information about who initiated the rollback. It is empty for hydrate operations.
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// DryRevisions holds the resolved revisions of all the dry sources the manifests were hydrated from, in the order
+	// of SourceHydrator.GetDrySources. It is only set if additional dry sources are configured.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,11,rep,name=dryRevisions"`
+}
+
+// HydrateHistories is an array of hydrate history, oldest first and newest last
+type HydrateHistories []HydrateHistory
+
+// AddHydrateHistory appends the given entry to the hydrate history with the next ID, and truncates the history to the
+// given limit
+func (status *SourceHydratorStatus) AddHydrateHistory(entry HydrateHistory, limit int) {
+	entry.ID = 0
+	if len(status.History) > 0 {
+		entry.ID = status.History[len(status.History)-1].ID + 1
+	}
+	history := append(status.History, entry)
+	if limit < 0 {
+		limit = 0
+	}
+	if i := len(history) - limit; i > 0 {
+		history = history[i:]
+	}
+	status.History = history
 }
 
+// FindHydrateHistory returns the hydrate history entry with the given ID, or nil if there is none
+func (status *SourceHydratorStatus) FindHydrateHistory(id int64) *HydrateHistory {
+	for i := range status.History {
+		if status.History[i].ID == id {
+			return &status.History[i]
+		}
+	}
+	return nil
+}
+
+// HydratePullRequestStatus contains information about the pull request promoting hydrated manifests from the
+// hydrateTo branch to the syncSource branch
+type HydratePullRequestStatus struct {
+	// Number is the number of the pull request
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// URL is the web URL of the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// State indicates whether the pull request is open, merged or closed
+	State HydratePullRequestState `json:"state" protobuf:"bytes,3,opt,name=state"`
+}
+
+// HydratePullRequestState indicates the state of a pull request opened by the hydrator
+// +kubebuilder:validation:Enum=Open;Merged;Closed
+type HydratePullRequestState string
+
+const (
+	// HydratePullRequestStateOpen indicates that the pull request is open
+	HydratePullRequestStateOpen HydratePullRequestState = "Open"
+	// HydratePullRequestStateMerged indicates that the pull request was merged
+	HydratePullRequestStateMerged HydratePullRequestState = "Merged"
+	// HydratePullRequestStateClosed indicates that the pull request was closed without being merged
+	HydratePullRequestStateClosed HydratePullRequestState = "Closed"
+)
+
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
 	for i := range status.Resources {
 		res := status.Resources[i]
@@ -1237,7 +1512,10 @@ type HydrateOperation struct {
 	// HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
-	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	SourceHydrator SourceHydratorRecord `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// DryRevisions holds the resolved revisions of all the dry sources as of the most recent reconciliation, in the
+	// order of SourceHydrator.GetDrySources. It is only set if additional dry sources are configured.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,8,rep,name=dryRevisions"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1247,7 +1525,10 @@ type SuccessfulHydrateOperation struct {
 	// HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
-	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	SourceHydrator SourceHydratorRecord `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// DryRevisions holds the resolved revisions of all the dry sources as of the most recent reconciliation, in the
+	// order of SourceHydrator.GetDrySources. It is only set if additional dry sources are configured.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,8,rep,name=dryRevisions"`
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
@@ -1339,6 +1620,17 @@ func (in RevisionHistories) LastRevisionHistory() RevisionHistory {
 	return in[len(in)-1]
 }
 
+// LastHealthyRevisionHistory returns the most recent history item, other than the last one, which was observed Healthy
+// and which records the source it was deployed from, or nil if there is none
+func (in RevisionHistories) LastHealthyRevisionHistory() *RevisionHistory {
+	for i := len(in) - 2; i >= 0; i-- {
+		if in[i].HealthStatus == health.HealthStatusHealthy && (!in[i].Source.IsZero() || !in[i].Sources.IsZero()) {
+			return &in[i]
+		}
+	}
+	return nil
+}
+
 // Trunc truncates the list of history items to size n
 func (in RevisionHistories) Trunc(n int) RevisionHistories {
 	if n < 0 {
@@ -1398,6 +1690,21 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// AutoRollbackFrom is the ID of the revision history entry whose degraded health triggered this operation, if it is
+	// an automatic rollback
+	AutoRollbackFrom *int64 `json:"autoRollbackFrom,omitempty" protobuf:"bytes,13,opt,name=autoRollbackFrom"`
+	// Approvals holds the approvals of the sync waves which require approval before they are synced
+	Approvals []SyncWaveApproval `json:"approvals,omitempty" protobuf:"bytes,14,rep,name=approvals"`
+}
+
+// IsWaveApproved returns true if the given wave of the given sync phase was approved
+func (o *SyncOperation) IsWaveApproved(phase synccommon.SyncPhase, wave int64) bool {
+	for _, approval := range o.Approvals {
+		if approval.Phase == phase && approval.Wave == wave {
+			return true
+		}
+	}
+	return false
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1421,6 +1728,21 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// PendingApproval is the sync wave which has to be approved before the operation continues, if the operation is
+	// waiting for approval
+	PendingApproval *SyncWaveApproval `json:"pendingApproval,omitempty" protobuf:"bytes,9,opt,name=pendingApproval"`
+}
+
+// SyncWaveApproval identifies a wave of a sync phase and, once the wave was approved, who approved it and when
+type SyncWaveApproval struct {
+	// Phase is the sync phase of the wave
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the number of the wave
+	Wave int64 `json:"wave" protobuf:"bytes,2,opt,name=wave"`
+	// ApprovedBy is the user who approved the wave
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,3,opt,name=approvedBy"`
+	// ApprovedAt is the time the wave was approved at
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,4,opt,name=approvedAt"`
 }
 
 type Info struct {
@@ -1477,6 +1799,14 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Timeout is the maximum amount of time a sync operation may run before it is terminated. Default unit is seconds,
+	// but could also be a duration (e.g. "2m", "1h"). If unset or 0, the sync timeout of the project applies.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// AutoRollback will roll the application back to its last healthy revision if it turns Degraded shortly after a sync
+	AutoRollback *SyncPolicyAutoRollback `json:"autoRollback,omitempty" protobuf:"bytes,6,opt,name=autoRollback"`
+	// PruneThreshold is the maximum number (e.g. "10") or percentage (e.g. "50%") of the resources of the application a
+	// sync may prune without confirmation. If unset, the prune threshold of the project applies.
+	PruneThreshold string `json:"pruneThreshold,omitempty" protobuf:"bytes,7,opt,name=pruneThreshold"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1490,7 +1820,54 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Timeout == "" && p.AutoRollback == nil && p.PruneThreshold == "")
+}
+
+// GetTimeout returns the maximum amount of time a sync operation may run, or 0 if the sync policy doesn't limit it
+func (p *SyncPolicy) GetTimeout() (time.Duration, error) {
+	if p == nil || p.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := parseStringToDuration(p.Timeout)
+	if err != nil {
+		return 0, err
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("timeout %s must not be negative", p.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetPruneThreshold returns the maximum number or percentage of resources a sync may prune without confirmation, or nil
+// if the sync policy doesn't limit it
+func (p *SyncPolicy) GetPruneThreshold() (*intstr.IntOrString, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return ParsePruneThreshold(p.PruneThreshold)
+}
+
+// ParsePruneThreshold parses a prune threshold given as a number of resources, e.g. "10", or as a percentage of the
+// resources of an application, e.g. "50%". It returns nil if the threshold is empty.
+func ParsePruneThreshold(threshold string) (*intstr.IntOrString, error) {
+	if threshold == "" {
+		return nil, nil
+	}
+	value := intstr.Parse(threshold)
+	if value.Type == intstr.Int {
+		if value.IntVal < 0 {
+			return nil, fmt.Errorf("prune threshold %s must not be negative", threshold)
+		}
+		return &value, nil
+	}
+	percent, found := strings.CutSuffix(threshold, "%")
+	if !found {
+		return nil, fmt.Errorf("prune threshold %s must be a number of resources or a percentage", threshold)
+	}
+	if val, err := strconv.Atoi(percent); err != nil || val < 0 || val > 100 {
+		return nil, fmt.Errorf("prune threshold %s must be a percentage between 0%% and 100%%", threshold)
+	}
+	return &value, nil
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1569,6 +1946,28 @@ type SyncPolicyAutomated struct {
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
 }
 
+// SyncPolicyAutoRollback controls the automatic rollback of an application whose health degrades after a sync
+type SyncPolicyAutoRollback struct {
+	// Window is the amount of time after a successful sync during which the application health is watched. Default
+	// unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 5 minutes.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+}
+
+// GetWindow returns the amount of time after a successful sync during which a degraded application is rolled back
+func (r *SyncPolicyAutoRollback) GetWindow() (time.Duration, error) {
+	if r == nil || r.Window == "" {
+		return DefaultAutoRollbackWindow, nil
+	}
+	window, err := parseStringToDuration(r.Window)
+	if err != nil {
+		return 0, err
+	}
+	if window <= 0 {
+		return 0, fmt.Errorf("window %s must be positive", r.Window)
+	}
+	return window, nil
+}
+
 // SyncStrategy controls the manner in which a sync is performed
 type SyncStrategy struct {
 	// Apply will perform a `kubectl apply` to perform the sync.
@@ -1773,6 +2172,12 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// HealthStatus is Healthy if the application was observed Healthy while this revision was deployed, Degraded if it was
+	// only observed Degraded, and empty if neither was observed yet
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,11,opt,name=healthStatus"`
+	// AutoRollbackFrom is the ID of the revision history entry whose degraded health triggered the automatic rollback
+	// to this revision
+	AutoRollbackFrom *int64 `json:"autoRollbackFrom,omitempty" protobuf:"bytes,12,opt,name=autoRollbackFrom"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1840,6 +2245,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionHydratorValidationError indicates that the hydrated manifests failed the validation of the commit server
+	ApplicationConditionHydratorValidationError = "HydratorValidationError"
+	// ApplicationConditionDependencyNotReady indicates that applications the application depends on are not synced and healthy yet
+	ApplicationConditionDependencyNotReady = "DependencyNotReady"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2215,6 +2624,61 @@ func (r *ResourceDiff) FullName() string {
 	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
 }
 
+// ResourceDriftHistory holds the timeline of the drifts of a managed resource, i.e. of the periods during which its live
+// state diverged from a desired state the application had already been synced to
+type ResourceDriftHistory struct {
+	// Group represents the API group of the resource (e.g., "apps" for Deployments).
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind represents the Kubernetes resource kind (e.g., "Deployment", "Service").
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the namespace where the resource exists.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// Events holds the drifts of the resource, oldest first and newest last
+	Events []ResourceDriftEvent `json:"events,omitempty" protobuf:"bytes,5,rep,name=events"`
+}
+
+// ResourceDriftEvent describes a drift of the live state of a resource from its desired state
+type ResourceDriftEvent struct {
+	// DetectedAt indicates when the live state was first seen diverging from the desired state
+	DetectedAt metav1.Time `json:"detectedAt" protobuf:"bytes,1,opt,name=detectedAt"`
+	// ResolvedAt indicates when the live state matched the desired state again. It is nil while the drift is ongoing.
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty" protobuf:"bytes,2,opt,name=resolvedAt"`
+	// FieldPaths holds the paths of the fields which diverged from the desired state
+	FieldPaths []string `json:"fieldPaths,omitempty" protobuf:"bytes,3,rep,name=fieldPaths"`
+	// Managers holds the field managers which own the diverging fields in the live state, according to its managedFields
+	Managers []string `json:"managers,omitempty" protobuf:"bytes,4,rep,name=managers"`
+	// SelfHealed indicates whether the drift was reverted by an automated self-heal sync
+	SelfHealed bool `json:"selfHealed,omitempty" protobuf:"varint,5,opt,name=selfHealed"`
+}
+
+// FullName returns full name of the resource in the format "group/kind/namespace/name"
+// For cluster-scoped resources, namespace will be the empty string.
+func (h *ResourceDriftHistory) FullName() string {
+	return fmt.Sprintf("%s/%s/%s/%s", h.Group, h.Kind, h.Namespace, h.Name)
+}
+
+// OngoingDrift returns the drift event which has not been resolved yet, or nil if there is none
+func (h *ResourceDriftHistory) OngoingDrift() *ResourceDriftEvent {
+	if len(h.Events) == 0 || h.Events[len(h.Events)-1].ResolvedAt != nil {
+		return nil
+	}
+	return &h.Events[len(h.Events)-1]
+}
+
+// AddDrift appends the given event to the drift history, and truncates the history to the given limit
+func (h *ResourceDriftHistory) AddDrift(event ResourceDriftEvent, limit int) {
+	events := append(h.Events, event)
+	if limit < 1 {
+		limit = 1
+	}
+	if i := len(events) - limit; i > 0 {
+		events = events[i:]
+	}
+	h.Events = events
+}
+
 // ConnectionStatus represents the status indicator for a connection to a remote resource
 type ConnectionStatus = string
 
@@ -2269,6 +2733,9 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// SyncConcurrencyLimit is the maximum number of concurrent sync operations to the cluster. Overrides the
+	// application.sync.clusterConcurrencyLimit setting if specified. 0 means unlimited.
+	SyncConcurrencyLimit *int64 `json:"syncConcurrencyLimit,omitempty" protobuf:"bytes,14,opt,name=syncConcurrencyLimit"`
 
 	// The embedded metav1.ObjectMeta field is purely here to please the informer when converting from a v1.Secret to a Cluster.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
@@ -2603,6 +3070,7 @@ var validActions = map[string]bool{
 	rbac.ActionUpdate:   true,
 	rbac.ActionDelete:   true,
 	rbac.ActionSync:     true,
+	rbac.ActionApprove:  true,
 	rbac.ActionOverride: true,
 	"*":                 true,
 }
@@ -2757,6 +3225,34 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// SyncTimeout is the maximum amount of time a sync operation of the apps in this project may run before it is
+	// terminated, unless the app sets its own. Default unit is seconds, but could also be a duration (e.g. "2m", "1h").
+	SyncTimeout string `json:"syncTimeout,omitempty" protobuf:"bytes,15,opt,name=syncTimeout"`
+	// PruneThreshold is the maximum number (e.g. "10") or percentage (e.g. "50%") of the resources of an app in this
+	// project a sync may prune without confirmation, unless the app sets its own
+	PruneThreshold string `json:"pruneThreshold,omitempty" protobuf:"bytes,16,opt,name=pruneThreshold"`
+}
+
+// GetPruneThreshold returns the maximum number or percentage of the resources of an app in this project a sync may
+// prune without confirmation, or nil if the project doesn't limit it
+func (spec AppProjectSpec) GetPruneThreshold() (*intstr.IntOrString, error) {
+	return ParsePruneThreshold(spec.PruneThreshold)
+}
+
+// GetSyncTimeout returns the maximum amount of time a sync operation of the apps in this project may run, or 0 if the
+// project doesn't limit it
+func (spec AppProjectSpec) GetSyncTimeout() (time.Duration, error) {
+	if spec.SyncTimeout == "" {
+		return 0, nil
+	}
+	timeout, err := parseStringToDuration(spec.SyncTimeout)
+	if err != nil {
+		return 0, err
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("sync timeout %s must not be negative", spec.SyncTimeout)
+	}
+	return timeout, nil
 }
 
 // ClusterResourceRestrictionItem is a cluster resource that is restricted by the project's whitelist or blacklist
@@ -3339,6 +3835,11 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// GetRefreshSource returns what requested the refresh of an application
+func (app *Application) GetRefreshSource() RefreshSource {
+	return RefreshSource(app.GetAnnotations()[AnnotationKeyRefreshSource])
+}
+
 // IsHydrateRequested returns whether hydration has been requested for an application
 func (app *Application) IsHydrateRequested() bool {
 	annotations := app.GetAnnotations()
@@ -3866,6 +4367,22 @@ func (app *Application) InstanceName(defaultNs string) string {
 	return app.Namespace + "_" + app.Name
 }
 
+// GetDependsOn returns the applications the application depends on, with their namespace defaulted to the namespace of
+// the application
+func (app *Application) GetDependsOn() []ApplicationDependency {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	deps := make([]ApplicationDependency, len(app.Spec.DependsOn))
+	for i, dep := range app.Spec.DependsOn {
+		if dep.Namespace == "" {
+			dep.Namespace = app.Namespace
+		}
+		deps[i] = dep
+	}
+	return deps
+}
+
 // QualifiedName returns the full qualified name of the application, including
 // the name of the namespace it is created in delimited by a forward slash,
 // i.e. <namespace>/<appname>
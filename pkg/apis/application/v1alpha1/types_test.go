@@ -14,6 +14,7 @@ import (
 
 	argocdcommon "github.com/argoproj/argo-cd/v3/common"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -819,6 +820,28 @@ func TestAppProject_ValidateGroupName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateSyncTimeout(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncTimeout = "1h"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.SyncTimeout = "soon"
+	err := p.ValidateProject()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "syncTimeout has an invalid format")
+}
+
+func TestAppProject_ValidatePruneThreshold(t *testing.T) {
+	p := newTestProject()
+	p.Spec.PruneThreshold = "50%"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.PruneThreshold = "half"
+	err := p.ValidateProject()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pruneThreshold has an invalid format")
+}
+
 func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	t.Run("WorkingSyncWindow", func(t *testing.T) {
 		p := newTestProjectWithSyncWindows()
@@ -1438,6 +1461,14 @@ func TestSyncStrategy_Force(t *testing.T) {
 	}
 }
 
+func TestSyncOperation_IsWaveApproved(t *testing.T) {
+	o := &SyncOperation{Approvals: []SyncWaveApproval{{Phase: common.SyncPhaseSync, Wave: 1}}}
+	assert.True(t, o.IsWaveApproved(common.SyncPhaseSync, 1))
+	assert.False(t, o.IsWaveApproved(common.SyncPhaseSync, 2))
+	assert.False(t, o.IsWaveApproved(common.SyncPhasePreSync, 1))
+	assert.False(t, (&SyncOperation{}).IsWaveApproved(common.SyncPhaseSync, 1))
+}
+
 func TestSyncOperation_IsApplyStrategy(t *testing.T) {
 	type fields struct {
 		SyncStrategy *SyncStrategy
@@ -3436,6 +3467,107 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{Timeout: "5m"}).IsZero())
+	assert.False(t, (&SyncPolicy{PruneThreshold: "50%"}).IsZero())
+	assert.False(t, (&SyncPolicy{AutoRollback: &SyncPolicyAutoRollback{}}).IsZero())
+}
+
+func TestSyncPolicyAutoRollback_GetWindow(t *testing.T) {
+	window, err := (&SyncPolicyAutoRollback{}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAutoRollbackWindow, window)
+
+	window, err = (&SyncPolicyAutoRollback{Window: "120"}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, window)
+
+	window, err = (&SyncPolicyAutoRollback{Window: "10m"}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, window)
+
+	_, err = (&SyncPolicyAutoRollback{Window: "soon"}).GetWindow()
+	require.EqualError(t, err, "unable to parse soon as a duration")
+
+	_, err = (&SyncPolicyAutoRollback{Window: "0"}).GetWindow()
+	require.EqualError(t, err, "window 0 must be positive")
+}
+
+func TestSyncPolicy_GetTimeout(t *testing.T) {
+	var nilPolicy *SyncPolicy
+	timeout, err := nilPolicy.GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	timeout, err = (&SyncPolicy{Timeout: "90"}).GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	timeout, err = (&SyncPolicy{Timeout: "1h"}).GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, timeout)
+
+	_, err = (&SyncPolicy{Timeout: "forever"}).GetTimeout()
+	require.EqualError(t, err, "unable to parse forever as a duration")
+
+	_, err = (&SyncPolicy{Timeout: "-1m"}).GetTimeout()
+	require.EqualError(t, err, "timeout -1m must not be negative")
+}
+
+func TestParsePruneThreshold(t *testing.T) {
+	threshold, err := ParsePruneThreshold("")
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	threshold, err = ParsePruneThreshold("10")
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromInt32(10), *threshold)
+
+	threshold, err = ParsePruneThreshold("50%")
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromString("50%"), *threshold)
+
+	_, err = ParsePruneThreshold("-1")
+	require.EqualError(t, err, "prune threshold -1 must not be negative")
+
+	_, err = ParsePruneThreshold("many")
+	require.EqualError(t, err, "prune threshold many must be a number of resources or a percentage")
+
+	_, err = ParsePruneThreshold("150%")
+	require.EqualError(t, err, "prune threshold 150% must be a percentage between 0% and 100%")
+}
+
+func TestSyncPolicy_GetPruneThreshold(t *testing.T) {
+	var nilPolicy *SyncPolicy
+	threshold, err := nilPolicy.GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	threshold, err = (&SyncPolicy{PruneThreshold: "25%"}).GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromString("25%"), *threshold)
+}
+
+func TestAppProjectSpec_GetPruneThreshold(t *testing.T) {
+	threshold, err := AppProjectSpec{}.GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	threshold, err = AppProjectSpec{PruneThreshold: "5"}.GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromInt32(5), *threshold)
+}
+
+func TestAppProjectSpec_GetSyncTimeout(t *testing.T) {
+	timeout, err := AppProjectSpec{}.GetSyncTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	timeout, err = AppProjectSpec{SyncTimeout: "30m"}.GetSyncTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+
+	_, err = AppProjectSpec{SyncTimeout: "-30"}.GetSyncTimeout()
+	require.EqualError(t, err, "sync timeout -30 must not be negative")
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -3457,6 +3589,60 @@ func TestSyncOptions_RemoveOption(t *testing.T) {
 	assert.Empty(t, options.RemoveOption("a=1").RemoveOption("a=1"))
 }
 
+func TestSourceHydratorStatus_AddHydrateHistory(t *testing.T) {
+	status := SourceHydratorStatus{}
+	status.AddHydrateHistory(HydrateHistory{ID: 42, DrySHA: "a"}, 2)
+	assert.Equal(t, HydrateHistories{{ID: 0, DrySHA: "a"}}, status.History)
+
+	status.AddHydrateHistory(HydrateHistory{DrySHA: "b"}, 2)
+	status.AddHydrateHistory(HydrateHistory{DrySHA: "c"}, 2)
+	assert.Equal(t, HydrateHistories{{ID: 1, DrySHA: "b"}, {ID: 2, DrySHA: "c"}}, status.History)
+
+	status.AddHydrateHistory(HydrateHistory{DrySHA: "d"}, -1)
+	assert.Empty(t, status.History)
+}
+
+func TestSourceHydratorStatus_FindHydrateHistory(t *testing.T) {
+	status := SourceHydratorStatus{History: HydrateHistories{{ID: 1, DrySHA: "a"}, {ID: 2, DrySHA: "b"}}}
+	assert.Equal(t, &HydrateHistory{ID: 2, DrySHA: "b"}, status.FindHydrateHistory(2))
+	assert.Nil(t, status.FindHydrateHistory(3))
+}
+
+func TestResourceDriftHistory_AddDrift(t *testing.T) {
+	history := ResourceDriftHistory{}
+	assert.Nil(t, history.OngoingDrift())
+
+	history.AddDrift(ResourceDriftEvent{FieldPaths: []string{".spec.replicas"}}, 2)
+	assert.Equal(t, []string{".spec.replicas"}, history.OngoingDrift().FieldPaths)
+
+	now := metav1.Now()
+	history.OngoingDrift().ResolvedAt = &now
+	assert.Nil(t, history.OngoingDrift())
+
+	history.AddDrift(ResourceDriftEvent{FieldPaths: []string{".data"}}, 2)
+	history.AddDrift(ResourceDriftEvent{FieldPaths: []string{".spec"}}, 2)
+	assert.Equal(t, []ResourceDriftEvent{{FieldPaths: []string{".data"}}, {FieldPaths: []string{".spec"}}}, history.Events)
+
+	history.AddDrift(ResourceDriftEvent{FieldPaths: []string{".metadata"}}, 0)
+	assert.Equal(t, []ResourceDriftEvent{{FieldPaths: []string{".metadata"}}}, history.Events)
+}
+
+func TestRevisionHistories_LastHealthyRevisionHistory(t *testing.T) {
+	source := ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"}
+	assert.Nil(t, RevisionHistories{}.LastHealthyRevisionHistory())
+	// The last item is the deployed revision, which is never returned
+	assert.Nil(t, RevisionHistories{{ID: 1, Source: source, HealthStatus: health.HealthStatusHealthy}}.LastHealthyRevisionHistory())
+
+	history := RevisionHistories{
+		{ID: 1, Source: source, HealthStatus: health.HealthStatusHealthy},
+		{ID: 2, Sources: ApplicationSources{source}, HealthStatus: health.HealthStatusHealthy},
+		{ID: 3, HealthStatus: health.HealthStatusHealthy},
+		{ID: 4, Source: source, HealthStatus: health.HealthStatusDegraded},
+		{ID: 5, Source: source, HealthStatus: health.HealthStatusHealthy},
+	}
+	assert.Equal(t, int64(2), history.LastHealthyRevisionHistory().ID)
+}
+
 func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(1))
 	assert.Len(t, RevisionHistories{{}}.Trunc(1), 1)
@@ -4843,6 +5029,46 @@ func TestSanitized(t *testing.T) {
 	}, cluster.Sanitized())
 }
 
+func TestSourceHydrator_GetSyncSource(t *testing.T) {
+	t.Parallel()
+
+	hydrator := SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: "guestbook"},
+		SyncSource: SyncSource{TargetBranch: "env-dev", Path: "guestbook"},
+	}
+	assert.Equal(t, ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "env-dev", Path: "guestbook"}, hydrator.GetSyncSource())
+
+	hydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+	assert.Equal(t, ApplicationSource{RepoURL: "oci://registry.example.com/hydrated", TargetRevision: "env-dev", Path: "guestbook"}, hydrator.GetSyncSource())
+	spec := ApplicationSpec{SourceHydrator: &hydrator}
+	assert.Equal(t, "oci://registry.example.com/hydrated", spec.GetHydrateToSource().RepoURL)
+}
+
+func TestSourceHydrator_GetDrySources(t *testing.T) {
+	t.Parallel()
+
+	hydrator := SourceHydrator{
+		DrySource: DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Ref: "values"},
+		DrySources: []DrySource{{
+			RepoURL:        "https://charts.example.com",
+			TargetRevision: "1.2.3",
+			Chart:          "guestbook",
+			Helm:           &ApplicationSourceHelm{ValueFiles: []string{"$values/guestbook/values.yaml"}},
+		}},
+	}
+	assert.False(t, SourceHydrator{}.HasMultipleDrySources())
+	assert.True(t, hydrator.HasMultipleDrySources())
+	assert.Equal(t, ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Ref: "values"},
+		{
+			RepoURL:        "https://charts.example.com",
+			TargetRevision: "1.2.3",
+			Chart:          "guestbook",
+			Helm:           &ApplicationSourceHelm{ValueFiles: []string{"$values/guestbook/values.yaml"}},
+		},
+	}, hydrator.GetDrySources())
+}
+
 func TestSourceHydrator_Equals(t *testing.T) {
 	t.Parallel()
 
@@ -4854,6 +5080,9 @@ func TestSourceHydrator_Equals(t *testing.T) {
 	}{
 		{"different SourceHydrators", SourceHydrator{}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, false},
 		{"equal SourceHydrators", SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, true},
+		{"different manifest layouts", SourceHydrator{}, SourceHydrator{ManifestLayout: HydratedManifestLayoutPerResource}, false},
+		{"different dry sources", SourceHydrator{DrySources: []DrySource{{Chart: "a"}}}, SourceHydrator{DrySources: []DrySource{{Chart: "b"}}}, false},
+		{"equal dry sources", SourceHydrator{DrySources: []DrySource{{Chart: "a", Helm: &ApplicationSourceHelm{ValueFiles: []string{"$values/a.yaml"}}}}}, SourceHydrator{DrySources: []DrySource{{Chart: "a", Helm: &ApplicationSourceHelm{ValueFiles: []string{"$values/a.yaml"}}}}}, true},
 	}
 
 	for _, testCase := range tests {
@@ -4865,3 +5094,27 @@ func TestSourceHydrator_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceHydrator_Record(t *testing.T) {
+	t.Parallel()
+
+	hydrator := SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: "guestbook"},
+		SyncSource: SyncSource{TargetBranch: "env/test", Path: "guestbook"},
+	}
+	record := hydrator.Record()
+	assert.Equal(t, hydrator.DrySource, record.DrySource)
+	assert.Equal(t, hydrator.SyncSource, record.SyncSource)
+	assert.Empty(t, record.DrySourcesHash)
+	assert.True(t, record.DeepEquals(hydrator.Record()))
+
+	// The additional dry sources are only recorded as a hash, which changes with them.
+	hydrator.DrySources = []DrySource{{RepoURL: "https://charts.example.com", TargetRevision: "1.2.3", Chart: "guestbook"}}
+	withDrySources := hydrator.Record()
+	assert.NotEmpty(t, withDrySources.DrySourcesHash)
+	assert.False(t, record.DeepEquals(withDrySources))
+	assert.True(t, withDrySources.DeepEquals(hydrator.Record()))
+
+	hydrator.DrySources[0].Helm = &ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}}
+	assert.False(t, withDrySources.DeepEquals(hydrator.Record()))
+}
@@ -283,6 +283,22 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -1377,6 +1393,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1668,6 +1689,11 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 			(*out)[key] = val
 		}
 	}
+	if in.SyncConcurrencyLimit != nil {
+		in, out := &in.SyncConcurrencyLimit, &out.SyncConcurrencyLimit
+		*out = new(int64)
+		**out = **in
+	}
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	return
 }
@@ -2328,6 +2354,61 @@ func (in *HostResourceInfo) DeepCopy() *HostResourceInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in HydrateHistories) DeepCopyInto(out *HydrateHistories) {
+	{
+		in := &in
+		*out = make(HydrateHistories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateHistories.
+func (in HydrateHistories) DeepCopy() HydrateHistories {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateHistories)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydrateHistory) DeepCopyInto(out *HydrateHistory) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.RollbackID != nil {
+		in, out := &in.RollbackID, &out.RollbackID
+		*out = new(int64)
+		**out = **in
+	}
+	out.InitiatedBy = in.InitiatedBy
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateHistory.
+func (in *HydrateHistory) DeepCopy() *HydrateHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
 	*out = *in
@@ -2337,6 +2418,11 @@ func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
 		*out = (*in).DeepCopy()
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2350,9 +2436,30 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePullRequestStatus) DeepCopyInto(out *HydratePullRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePullRequestStatus.
+func (in *HydratePullRequestStatus) DeepCopy() *HydratePullRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePullRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydrateToPullRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -2366,6 +2473,22 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydrateToPullRequest) DeepCopyInto(out *HydrateToPullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateToPullRequest.
+func (in *HydrateToPullRequest) DeepCopy() *HydrateToPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateToPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -2921,6 +3044,11 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.PendingApproval != nil {
+		in, out := &in.PendingApproval, &out.PendingApproval
+		*out = new(SyncWaveApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3728,6 +3856,60 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDriftEvent) DeepCopyInto(out *ResourceDriftEvent) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.ResolvedAt != nil {
+		in, out := &in.ResolvedAt, &out.ResolvedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FieldPaths != nil {
+		in, out := &in.FieldPaths, &out.FieldPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDriftEvent.
+func (in *ResourceDriftEvent) DeepCopy() *ResourceDriftEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDriftEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDriftHistory) DeepCopyInto(out *ResourceDriftHistory) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]ResourceDriftEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDriftHistory.
+func (in *ResourceDriftHistory) DeepCopy() *ResourceDriftHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDriftHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -4024,6 +4206,11 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.AutoRollbackFrom != nil {
+		in, out := &in.AutoRollbackFrom, &out.AutoRollbackFrom
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -4415,7 +4602,14 @@ func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrySources != nil {
+		in, out := &in.DrySources, &out.DrySources
+		*out = make([]DrySource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -4430,6 +4624,29 @@ func (in *SourceHydrator) DeepCopy() *SourceHydrator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceHydratorRecord) DeepCopyInto(out *SourceHydratorRecord) {
+	*out = *in
+	in.DrySource.DeepCopyInto(&out.DrySource)
+	out.SyncSource = in.SyncSource
+	if in.HydrateTo != nil {
+		in, out := &in.HydrateTo, &out.HydrateTo
+		*out = new(HydrateTo)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceHydratorRecord.
+func (in *SourceHydratorRecord) DeepCopy() *SourceHydratorRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceHydratorRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 	*out = *in
@@ -4443,6 +4660,18 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequestStatus)
+		**out = **in
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make(HydrateHistories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4460,6 +4689,11 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4513,6 +4747,18 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoRollbackFrom != nil {
+		in, out := &in.AutoRollbackFrom, &out.AutoRollbackFrom
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]SyncWaveApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4630,6 +4876,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(SyncPolicyAutoRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -4643,6 +4894,22 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyAutoRollback) DeepCopyInto(out *SyncPolicyAutoRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyAutoRollback.
+func (in *SyncPolicyAutoRollback) DeepCopy() *SyncPolicyAutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyAutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 	*out = *in
@@ -4761,6 +5028,26 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWaveApproval) DeepCopyInto(out *SyncWaveApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWaveApproval.
+func (in *SyncWaveApproval) DeepCopy() *SyncWaveApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWaveApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -711,10 +711,12 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// ToolVersions holds the versions of the tools used to generate the manifests, keyed by tool name
+	ToolVersions         map[string]string `protobuf:"bytes,9,rep,name=toolVersions,proto3" json:"toolVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -799,6 +801,13 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetToolVersions() map[string]string {
+	if m != nil {
+		return m.ToolVersions
+	}
+	return nil
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -2440,6 +2449,7 @@ func init() {
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ManifestResponse.ToolVersionsEntry")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2478,158 +2488,160 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
+	// 2442 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	0x51, 0xf3, 0x92, 0x66, 0x52, 0xef, 0xb2, 0x2d, 0xb7, 0xc7, 0xb6, 0xd0, 0x36, 0xd8, 0xe1, 0xb5,
+	0x77, 0x47, 0x61, 0x3b, 0x76, 0x0d, 0xde, 0x65, 0x37, 0xb4, 0xb2, 0x2d, 0x69, 0x6d, 0xd9, 0xa2,
+	0xad, 0x5d, 0xc2, 0x60, 0x20, 0x6a, 0x7a, 0x4a, 0x3d, 0xbd, 0xea, 0x47, 0xb9, 0xbb, 0x5a, 0x8b,
+	0x1c, 0xc1, 0x05, 0x08, 0x2e, 0x5c, 0x38, 0xed, 0x81, 0x2b, 0xdf, 0x40, 0x70, 0xe4, 0x44, 0xc0,
+	0x91, 0xe0, 0xc2, 0x85, 0x08, 0x08, 0xff, 0x04, 0x57, 0xa2, 0x1e, 0xfd, 0x9c, 0x9e, 0x91, 0xec,
+	0xb1, 0xb5, 0xc0, 0x45, 0xea, 0xca, 0xca, 0xca, 0xcc, 0xca, 0xca, 0xcc, 0xca, 0xcc, 0x1a, 0xb8,
+	0x1c, 0x10, 0xea, 0x87, 0x24, 0x38, 0x20, 0xc1, 0xaa, 0xf8, 0xb4, 0x99, 0x1f, 0x1c, 0x66, 0x3e,
+	0x3b, 0x34, 0xf0, 0x99, 0x8f, 0x20, 0x85, 0xb4, 0x1f, 0x58, 0x36, 0xeb, 0x47, 0xdd, 0x8e, 0xe9,
+	0xbb, 0xab, 0x38, 0xb0, 0x7c, 0x1a, 0xf8, 0x5f, 0x88, 0x8f, 0x77, 0xcd, 0xde, 0xea, 0xc1, 0xcd,
+	0x55, 0xba, 0x6f, 0xad, 0x62, 0x6a, 0x87, 0xab, 0x98, 0x52, 0xc7, 0x36, 0x31, 0xb3, 0x7d, 0x6f,
+	0xf5, 0xe0, 0x3a, 0x76, 0x68, 0x1f, 0x5f, 0x5f, 0xb5, 0x88, 0x47, 0x02, 0xcc, 0x48, 0x4f, 0x52,
+	0x6e, 0x9f, 0xb7, 0x7c, 0xdf, 0x72, 0xc8, 0xaa, 0x18, 0x75, 0xa3, 0xbd, 0x55, 0xe2, 0x52, 0xa6,
+	0xd8, 0xea, 0xff, 0x98, 0x85, 0xf9, 0x6d, 0xec, 0xd9, 0x7b, 0x24, 0x64, 0x06, 0x79, 0x16, 0x91,
+	0x90, 0xa1, 0xa7, 0x50, 0xe7, 0xc2, 0x68, 0x95, 0x95, 0xca, 0x95, 0xe9, 0x1b, 0x9b, 0x9d, 0x54,
+	0x9a, 0x4e, 0x2c, 0x8d, 0xf8, 0xf8, 0x89, 0xd9, 0xeb, 0x1c, 0xdc, 0xec, 0xd0, 0x7d, 0xab, 0xc3,
+	0xa5, 0xe9, 0x64, 0xa4, 0xe9, 0xc4, 0xd2, 0x74, 0x8c, 0x64, 0x5b, 0x86, 0xa0, 0x8a, 0xda, 0xd0,
+	0x0c, 0xc8, 0x81, 0x1d, 0xda, 0xbe, 0xa7, 0x55, 0x57, 0x2a, 0x57, 0x5a, 0x46, 0x32, 0x46, 0x1a,
+	0x4c, 0x79, 0xfe, 0x3a, 0x36, 0xfb, 0x44, 0xab, 0xad, 0x54, 0xae, 0x34, 0x8d, 0x78, 0x88, 0x56,
+	0x60, 0x1a, 0x53, 0xfa, 0x00, 0x77, 0x89, 0x73, 0x9f, 0x1c, 0x6a, 0x75, 0xb1, 0x30, 0x0b, 0xe2,
+	0x6b, 0x31, 0xa5, 0x0f, 0xb1, 0x4b, 0xb4, 0x86, 0x98, 0x8d, 0x87, 0xe8, 0x02, 0xb4, 0x3c, 0xec,
+	0x92, 0x90, 0x62, 0x93, 0x68, 0x4d, 0x31, 0x97, 0x02, 0xd0, 0xcf, 0x60, 0x31, 0x23, 0xf8, 0x63,
+	0x3f, 0x0a, 0x4c, 0xa2, 0x81, 0xd8, 0xfa, 0xa3, 0xf1, 0xb6, 0xbe, 0x56, 0x24, 0x6b, 0x0c, 0x72,
+	0x42, 0x3f, 0x86, 0x86, 0x38, 0x79, 0x6d, 0x7a, 0xa5, 0xf6, 0x5a, 0xb5, 0x2d, 0xc9, 0x22, 0x0f,
+	0xa6, 0xa8, 0x13, 0x59, 0xb6, 0x17, 0x6a, 0x33, 0x82, 0xc3, 0xee, 0x78, 0x1c, 0xd6, 0x7d, 0x6f,
+	0xcf, 0xb6, 0xb6, 0xb1, 0x87, 0x2d, 0xe2, 0x12, 0x8f, 0xed, 0x08, 0xe2, 0x46, 0xcc, 0x04, 0x3d,
+	0x87, 0x85, 0xfd, 0x28, 0x64, 0xbe, 0x6b, 0x3f, 0x27, 0x8f, 0x28, 0x5f, 0x1b, 0x6a, 0xb3, 0x42,
+	0x9b, 0x0f, 0xc7, 0x63, 0x7c, 0xbf, 0x40, 0xd5, 0x18, 0xe0, 0xc3, 0x8d, 0x64, 0x3f, 0xea, 0x92,
+	0xcf, 0x49, 0x20, 0xac, 0x6b, 0x4e, 0x1a, 0x49, 0x06, 0x24, 0xcd, 0xc8, 0x56, 0xa3, 0x50, 0x9b,
+	0x5f, 0xa9, 0x49, 0x33, 0x4a, 0x40, 0xe8, 0x0a, 0xcc, 0x1f, 0x90, 0xc0, 0xde, 0x3b, 0x7c, 0x6c,
+	0x5b, 0x1e, 0x66, 0x51, 0x40, 0xb4, 0x05, 0x61, 0x8a, 0x45, 0x30, 0x72, 0x61, 0xb6, 0x4f, 0x1c,
+	0x97, 0xab, 0x7c, 0x3d, 0x20, 0xbd, 0x50, 0x5b, 0x14, 0xfa, 0xdd, 0x18, 0xff, 0x04, 0x05, 0x39,
+	0x23, 0x4f, 0x9d, 0x0b, 0xe6, 0xf9, 0x86, 0xf2, 0x14, 0xe9, 0x23, 0x48, 0x0a, 0x56, 0x00, 0xa3,
+	0xcb, 0x30, 0xc7, 0x02, 0x6c, 0xee, 0xdb, 0x9e, 0xb5, 0x4d, 0x58, 0xdf, 0xef, 0x69, 0xa7, 0x84,
+	0x26, 0x0a, 0x50, 0x64, 0x02, 0x22, 0x1e, 0xee, 0x3a, 0xa4, 0x27, 0x6d, 0x71, 0xf7, 0x90, 0x92,
+	0x50, 0x3b, 0x2d, 0x76, 0x71, 0xb3, 0x93, 0x89, 0x50, 0x85, 0x00, 0xd1, 0xb9, 0x3b, 0xb0, 0xea,
+	0xae, 0xc7, 0x82, 0x43, 0xa3, 0x84, 0x1c, 0xda, 0x87, 0x69, 0xbe, 0x8f, 0xd8, 0x14, 0xce, 0x08,
+	0x53, 0xd8, 0x1a, 0x4f, 0x47, 0x9b, 0x29, 0x41, 0x23, 0x4b, 0x1d, 0x75, 0x00, 0xf5, 0x71, 0xb8,
+	0x1d, 0x39, 0xcc, 0xa6, 0x0e, 0x91, 0x62, 0x84, 0xda, 0x92, 0x50, 0x53, 0xc9, 0x0c, 0xba, 0x0f,
+	0x10, 0x90, 0xbd, 0x18, 0xef, 0xac, 0xd8, 0xf9, 0xb5, 0x51, 0x3b, 0x37, 0x12, 0x6c, 0xb9, 0xe3,
+	0xcc, 0x72, 0xce, 0x9c, 0x6f, 0x83, 0x98, 0x4c, 0x79, 0xbb, 0x70, 0x6b, 0x4d, 0x98, 0x58, 0xc9,
+	0x0c, 0xb7, 0x45, 0x05, 0x15, 0x41, 0xeb, 0x9c, 0xb4, 0xd6, 0x0c, 0x08, 0x6d, 0xc2, 0x37, 0xb0,
+	0xe7, 0xf9, 0x4c, 0x6c, 0x3f, 0x16, 0x65, 0x43, 0x85, 0xf7, 0x1d, 0xcc, 0xfa, 0xa1, 0xd6, 0x16,
+	0xab, 0x8e, 0x42, 0xe3, 0x26, 0x61, 0x7b, 0x21, 0xc3, 0x8e, 0x23, 0x90, 0xb6, 0xee, 0x68, 0xe7,
+	0xa5, 0x49, 0xe4, 0xa1, 0xed, 0xbb, 0x70, 0x76, 0xc8, 0xe1, 0xa2, 0x05, 0xa8, 0xed, 0x93, 0x43,
+	0x71, 0x29, 0xb4, 0x0c, 0xfe, 0x89, 0x4e, 0x43, 0xe3, 0x00, 0x3b, 0x11, 0x11, 0x61, 0xbc, 0x69,
+	0xc8, 0xc1, 0xed, 0xea, 0xb7, 0x2b, 0xed, 0x5f, 0x55, 0x60, 0xbe, 0xa0, 0xaa, 0x92, 0xf5, 0x3f,
+	0xca, 0xae, 0x7f, 0x0d, 0x8e, 0xb3, 0xb7, 0x8b, 0x03, 0x8b, 0xb0, 0x8c, 0x20, 0xfa, 0xdf, 0x2a,
+	0xa0, 0x15, 0xce, 0xf0, 0xfb, 0x36, 0xeb, 0xdf, 0xb3, 0x1d, 0x12, 0xa2, 0x5b, 0x30, 0x15, 0x48,
+	0x98, 0xba, 0xea, 0xce, 0x8f, 0x38, 0xfa, 0xcd, 0x09, 0x23, 0xc6, 0x46, 0x1f, 0x41, 0xd3, 0x25,
+	0x0c, 0xf7, 0x30, 0xc3, 0x4a, 0xf6, 0x95, 0xb2, 0x95, 0x9c, 0xcb, 0xb6, 0xc2, 0xdb, 0x9c, 0x30,
+	0x92, 0x35, 0xe8, 0x3d, 0x68, 0x98, 0xfd, 0xc8, 0xdb, 0x17, 0x97, 0xdc, 0xf4, 0x8d, 0x8b, 0xc3,
+	0x16, 0xaf, 0x73, 0xa4, 0xcd, 0x09, 0x43, 0x62, 0x7f, 0x32, 0x09, 0x75, 0x8a, 0x03, 0xa6, 0xdf,
+	0x83, 0xd3, 0x65, 0x2c, 0xf8, 0xcd, 0x6a, 0xf6, 0x89, 0xb9, 0x1f, 0x46, 0xae, 0x52, 0x73, 0x32,
+	0x46, 0x08, 0xea, 0xa1, 0xfd, 0x5c, 0xaa, 0xba, 0x66, 0x88, 0x6f, 0xfd, 0x6d, 0x58, 0x1c, 0xe0,
+	0xc6, 0x0f, 0x55, 0xca, 0xc6, 0x29, 0xcc, 0x28, 0xd6, 0x7a, 0x04, 0x67, 0x76, 0x85, 0x2e, 0x92,
+	0xeb, 0xe5, 0x24, 0x72, 0x05, 0x7d, 0x13, 0x96, 0x8a, 0x6c, 0x43, 0xea, 0x7b, 0x21, 0xe1, 0xce,
+	0x26, 0xe2, 0xb1, 0x4d, 0x7a, 0xe9, 0xac, 0x90, 0xa2, 0x69, 0x94, 0xcc, 0xe8, 0xbf, 0xab, 0xc2,
+	0x92, 0x41, 0x42, 0xdf, 0x39, 0x20, 0x71, 0xb0, 0x3c, 0x99, 0x74, 0xe7, 0x87, 0x50, 0xc3, 0x94,
+	0x2a, 0x33, 0xd9, 0x7a, 0x6d, 0x09, 0x85, 0xc1, 0xa9, 0xa2, 0x77, 0x60, 0x11, 0xbb, 0x5d, 0xdb,
+	0x8a, 0xfc, 0x28, 0x8c, 0xb7, 0x25, 0x8c, 0xaa, 0x65, 0x0c, 0x4e, 0xf0, 0x80, 0x13, 0x0a, 0x8f,
+	0xdc, 0xf2, 0x7a, 0xe4, 0xa7, 0x22, 0x87, 0xaa, 0x19, 0x59, 0x90, 0x6e, 0xc2, 0xd9, 0x01, 0x25,
+	0x29, 0x85, 0x67, 0xd3, 0xb6, 0x4a, 0x21, 0x6d, 0x2b, 0x15, 0xa3, 0x3a, 0x44, 0x0c, 0xfd, 0xdf,
+	0x55, 0x58, 0x48, 0x9d, 0x4b, 0x91, 0xbf, 0x00, 0x2d, 0x57, 0xc1, 0x42, 0xad, 0x22, 0x62, 0x66,
+	0x0a, 0xc8, 0x67, 0x70, 0xd5, 0x62, 0x06, 0xb7, 0x04, 0x93, 0x32, 0xc1, 0x56, 0x5b, 0x57, 0xa3,
+	0x9c, 0xc8, 0xf5, 0x82, 0xc8, 0xcb, 0x00, 0x61, 0x12, 0xe1, 0xb4, 0x49, 0x31, 0x9b, 0x81, 0x20,
+	0x1d, 0x66, 0xe4, 0x7d, 0x6f, 0x90, 0x30, 0x72, 0x98, 0x36, 0x25, 0x30, 0x72, 0x30, 0xe1, 0x6f,
+	0xbe, 0xeb, 0x62, 0xaf, 0x17, 0x6a, 0x4d, 0x21, 0x72, 0x32, 0x46, 0x06, 0xcc, 0x30, 0xdf, 0x77,
+	0x92, 0x4c, 0xa3, 0x25, 0xee, 0x96, 0x4e, 0x79, 0x80, 0x91, 0x3a, 0xe8, 0xec, 0x66, 0x16, 0xc8,
+	0xeb, 0x25, 0x47, 0xa3, 0xfd, 0x31, 0x2c, 0x0e, 0xa0, 0x1c, 0x15, 0x96, 0x5b, 0xd9, 0x68, 0xe8,
+	0xc3, 0xfc, 0x03, 0x9b, 0x33, 0xdc, 0x0b, 0x4f, 0xc6, 0x7f, 0xdf, 0x87, 0x3a, 0x67, 0xc6, 0x35,
+	0xd5, 0x0d, 0xb0, 0x67, 0xf6, 0x49, 0x7c, 0xb8, 0xc9, 0x98, 0x47, 0x26, 0x86, 0xad, 0x50, 0xab,
+	0x0a, 0xb8, 0xf8, 0xd6, 0xff, 0x50, 0x95, 0x92, 0xae, 0x51, 0x1a, 0x7e, 0xfd, 0x55, 0x49, 0x79,
+	0x9e, 0x54, 0x1b, 0xcc, 0x93, 0x0a, 0x22, 0xbf, 0x4c, 0x9e, 0xf4, 0x9a, 0x6e, 0x5e, 0x3d, 0x82,
+	0xa9, 0x35, 0x4a, 0xb9, 0x20, 0xe8, 0x3a, 0xd4, 0x31, 0xa5, 0x52, 0xe1, 0x85, 0x4b, 0x46, 0xa1,
+	0xf0, 0xff, 0x4a, 0x24, 0x81, 0xda, 0xbe, 0x05, 0xad, 0x04, 0xf4, 0x52, 0x96, 0xb5, 0x02, 0x20,
+	0x0b, 0x81, 0x2d, 0x6f, 0xcf, 0xe7, 0x47, 0xca, 0xbd, 0x53, 0x2d, 0x15, 0xdf, 0xfa, 0xed, 0x18,
+	0x43, 0xc8, 0xf6, 0x0e, 0x34, 0x6c, 0x46, 0xdc, 0x58, 0xb8, 0xa5, 0xac, 0x70, 0x29, 0x21, 0x43,
+	0x22, 0xe9, 0x7f, 0x6e, 0xc2, 0x39, 0x7e, 0x62, 0x8f, 0x85, 0x5f, 0xaf, 0x51, 0x7a, 0x87, 0x30,
+	0x6c, 0x3b, 0xe1, 0xf7, 0x22, 0x12, 0x1c, 0xbe, 0x61, 0xc3, 0xb0, 0x60, 0x52, 0x86, 0x05, 0x15,
+	0xc2, 0x5f, 0x7b, 0x4d, 0xa8, 0xc8, 0xa7, 0x85, 0x60, 0xed, 0xcd, 0x14, 0x82, 0x65, 0x85, 0x59,
+	0xfd, 0x84, 0x0a, 0xb3, 0xe1, 0xb5, 0x79, 0xa6, 0xe2, 0x9f, 0xcc, 0x57, 0xfc, 0x25, 0xf5, 0xce,
+	0xd4, 0x71, 0xeb, 0x9d, 0x66, 0x69, 0xbd, 0xe3, 0x96, 0xfa, 0xb1, 0x8c, 0xcc, 0xdf, 0xcd, 0x5a,
+	0xe0, 0x50, 0x5b, 0x1b, 0xa7, 0xf2, 0x81, 0x37, 0x5a, 0xf9, 0x7c, 0x96, 0xab, 0x64, 0x64, 0x2f,
+	0xe1, 0xbd, 0xe3, 0xed, 0x69, 0x44, 0x4d, 0xf3, 0x7f, 0x57, 0x0f, 0xfc, 0x52, 0xa4, 0x81, 0xd4,
+	0x4f, 0x75, 0x90, 0x64, 0x20, 0xfc, 0x1e, 0xe2, 0xb9, 0x80, 0x0a, 0x5a, 0xfc, 0x1b, 0x5d, 0x83,
+	0x3a, 0x57, 0xb2, 0xca, 0xd3, 0xcf, 0x66, 0xf5, 0xc9, 0x4f, 0x62, 0x8d, 0xd2, 0xc7, 0x94, 0x98,
+	0x86, 0x40, 0x42, 0xb7, 0xa1, 0x95, 0x18, 0xbe, 0xf2, 0xac, 0x0b, 0xd9, 0x15, 0x89, 0x9f, 0xc4,
+	0xcb, 0x52, 0x74, 0xbe, 0xb6, 0x67, 0x07, 0xc4, 0x14, 0x59, 0x6c, 0x63, 0x70, 0xed, 0x9d, 0x78,
+	0x32, 0x59, 0x9b, 0xa0, 0xa3, 0xeb, 0x30, 0x29, 0x9b, 0x2f, 0xc2, 0x83, 0xa6, 0x6f, 0x9c, 0x1b,
+	0x0c, 0xa6, 0xf1, 0x2a, 0x85, 0xa8, 0xff, 0xa9, 0x02, 0x6f, 0xa5, 0x06, 0x11, 0x7b, 0x53, 0x5c,
+	0x48, 0x7c, 0xfd, 0x37, 0xee, 0x65, 0x98, 0x13, 0x95, 0x4b, 0xda, 0x83, 0x91, 0xed, 0xc0, 0x02,
+	0x54, 0xff, 0x7d, 0x05, 0x2e, 0x0d, 0xee, 0x63, 0xbd, 0x8f, 0x03, 0x96, 0x1c, 0xef, 0x49, 0xec,
+	0x25, 0xbe, 0xf0, 0xaa, 0xe9, 0x85, 0x97, 0xdb, 0x5f, 0x2d, 0xbf, 0x3f, 0xfd, 0x8f, 0x55, 0x98,
+	0xce, 0x18, 0x50, 0xd9, 0x85, 0xc9, 0x33, 0x54, 0x61, 0xb7, 0xa2, 0x56, 0x15, 0x97, 0x42, 0xcb,
+	0xc8, 0x40, 0xd0, 0x3e, 0x00, 0xc5, 0x01, 0x76, 0x09, 0x23, 0x01, 0x8f, 0xe4, 0xdc, 0xe3, 0xef,
+	0x8f, 0x1f, 0x5d, 0x76, 0x62, 0x9a, 0x46, 0x86, 0x3c, 0x4f, 0xb1, 0x05, 0xeb, 0x50, 0xc5, 0x6f,
+	0x35, 0x42, 0x5f, 0xc2, 0xdc, 0x9e, 0xed, 0x90, 0x9d, 0x54, 0x90, 0x49, 0x21, 0xc8, 0xa3, 0xf1,
+	0x05, 0xb9, 0x97, 0xa5, 0x6b, 0x14, 0xd8, 0xe8, 0x57, 0x61, 0xa1, 0xe8, 0x4f, 0x5c, 0x48, 0xdb,
+	0xc5, 0x56, 0xa2, 0x2d, 0x35, 0xd2, 0x11, 0x2c, 0x14, 0xfd, 0x47, 0xff, 0x67, 0x15, 0xce, 0x24,
+	0xe4, 0xd6, 0x3c, 0xcf, 0x8f, 0x3c, 0x53, 0xf4, 0x33, 0x4b, 0xcf, 0xe2, 0x34, 0x34, 0x98, 0xcd,
+	0x9c, 0x24, 0xf1, 0x11, 0x03, 0x7e, 0x77, 0xf1, 0xfc, 0x9c, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94,
+	0x67, 0xff, 0x2c, 0xb2, 0x03, 0xd2, 0x13, 0x91, 0xa0, 0x69, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a,
+	0x51, 0x77, 0x48, 0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x99,
+	0x14, 0xa0, 0xa2, 0xe2, 0x61, 0x81, 0xed, 0x59, 0xaa, 0x2e, 0x51, 0x23, 0x2e, 0x27, 0x0e, 0x02,
+	0x7c, 0xa8, 0xca, 0x11, 0x39, 0x40, 0x1f, 0x42, 0xcd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1,
+	0xa1, 0x4c, 0x03, 0x9d, 0x6d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0xb5, 0xdf, 0x87, 0x66, 0x0c, 0x78,
+	0xa9, 0x94, 0xf0, 0x0b, 0x98, 0xcd, 0x05, 0x1f, 0xf4, 0x04, 0x96, 0x52, 0x8b, 0xca, 0x32, 0x54,
+	0x49, 0xe0, 0x5b, 0x47, 0x4a, 0x66, 0x0c, 0x21, 0xa0, 0x3f, 0x83, 0x45, 0x6e, 0x32, 0xc2, 0xf1,
+	0x4f, 0xa8, 0xb4, 0xf9, 0x00, 0x5a, 0x09, 0xcb, 0x52, 0x9b, 0x69, 0x43, 0xf3, 0x20, 0xae, 0xfe,
+	0x64, 0x6d, 0x93, 0x8c, 0xf5, 0x35, 0x40, 0x59, 0x79, 0xd5, 0x0d, 0x74, 0x2d, 0x9f, 0x14, 0x9f,
+	0x29, 0x5e, 0x37, 0x02, 0x3d, 0xce, 0x89, 0xff, 0x5e, 0x85, 0xf9, 0x0d, 0x5b, 0x34, 0x6e, 0x4e,
+	0x28, 0xc8, 0x5d, 0x85, 0x85, 0x30, 0xea, 0xba, 0x7e, 0x2f, 0x72, 0x88, 0x4a, 0x0a, 0xd4, 0x4d,
+	0x3f, 0x00, 0x1f, 0x15, 0xfc, 0xb8, 0xb2, 0x28, 0x66, 0x7d, 0x55, 0x92, 0x8b, 0x6f, 0xf4, 0x21,
+	0x9c, 0x7b, 0x48, 0xbe, 0x54, 0xfb, 0xd9, 0x70, 0xfc, 0x6e, 0xd7, 0xf6, 0xac, 0x98, 0x49, 0x43,
+	0x30, 0x19, 0x8e, 0x50, 0x96, 0x2a, 0x4e, 0x96, 0xa7, 0x8a, 0x49, 0x59, 0xbf, 0xee, 0xbb, 0xae,
+	0xcd, 0x54, 0x46, 0x99, 0x83, 0xe9, 0xbf, 0xa8, 0xc0, 0x42, 0xaa, 0x59, 0x75, 0x36, 0xb7, 0xa4,
+	0x0f, 0xc9, 0x93, 0xb9, 0x94, 0x3d, 0x99, 0x22, 0xea, 0xab, 0xbb, 0xcf, 0x4c, 0xd6, 0x7d, 0x7e,
+	0x5d, 0x85, 0x33, 0x1b, 0x36, 0x8b, 0x03, 0x97, 0xfd, 0xbf, 0x76, 0xca, 0x25, 0x67, 0x52, 0x3f,
+	0xde, 0x99, 0x34, 0x4a, 0xce, 0xa4, 0x03, 0x4b, 0x45, 0x65, 0xa8, 0x83, 0x39, 0x0d, 0x0d, 0x2a,
+	0x3a, 0xe1, 0xb2, 0xaf, 0x20, 0x07, 0xfa, 0xcf, 0xa7, 0xe0, 0xe2, 0x67, 0xb4, 0x87, 0x59, 0xd2,
+	0xc8, 0xba, 0xe7, 0x07, 0xa2, 0x15, 0x7e, 0x32, 0x5a, 0x2c, 0x3c, 0x57, 0x56, 0x47, 0x3e, 0x57,
+	0xd6, 0x46, 0x3c, 0x57, 0xd6, 0x8f, 0xf5, 0x5c, 0xd9, 0x38, 0xb1, 0xe7, 0xca, 0xc1, 0x5a, 0x6b,
+	0xb2, 0xb4, 0xd6, 0x7a, 0x92, 0xab, 0x47, 0xa6, 0x84, 0xdb, 0x7c, 0x27, 0xeb, 0x36, 0x23, 0x4f,
+	0x67, 0xe4, 0x3b, 0x4b, 0xe1, 0x95, 0xaf, 0x79, 0xe4, 0x2b, 0x5f, 0x6b, 0xf0, 0x95, 0xaf, 0xfc,
+	0xa1, 0x08, 0x86, 0x3e, 0x14, 0x5d, 0x86, 0xb9, 0xf0, 0xd0, 0x33, 0x49, 0x2f, 0x69, 0x6f, 0x4e,
+	0xcb, 0x6d, 0xe7, 0xa1, 0x39, 0x8f, 0x98, 0x29, 0x78, 0x44, 0x62, 0xa9, 0xb3, 0x19, 0x4b, 0x2d,
+	0xf3, 0x93, 0xb9, 0xa1, 0x65, 0x6e, 0xe1, 0x0d, 0x67, 0xbe, 0xf4, 0x0d, 0xe7, 0xbf, 0xa6, 0xd8,
+	0xfa, 0x1c, 0x96, 0x87, 0x9d, 0xb2, 0x72, 0x5e, 0x0d, 0xa6, 0xcc, 0x3e, 0xf6, 0x2c, 0xd1, 0x16,
+	0x14, 0xd5, 0xbf, 0x1a, 0x8e, 0xaa, 0x0e, 0x6e, 0x7c, 0x35, 0x03, 0x8b, 0x69, 0xd6, 0xcf, 0xff,
+	0xda, 0x26, 0x41, 0x8f, 0x60, 0x21, 0x7e, 0xf3, 0x8a, 0x3b, 0xab, 0x68, 0xd4, 0x83, 0x4e, 0xfb,
+	0xc2, 0xa8, 0x66, 0xac, 0x3e, 0x81, 0x4c, 0x38, 0x57, 0x24, 0x98, 0xbe, 0x1d, 0x7d, 0x6b, 0x04,
+	0xe5, 0x04, 0xeb, 0x28, 0x16, 0x57, 0x2a, 0xe8, 0x09, 0xcc, 0xe5, 0x5f, 0x38, 0x50, 0x2e, 0x0d,
+	0x2a, 0x7d, 0x74, 0x69, 0xeb, 0xa3, 0x50, 0x12, 0xf9, 0x9f, 0x72, 0x33, 0xc8, 0x35, 0xf3, 0x91,
+	0x9e, 0xef, 0x08, 0x94, 0x3d, 0x87, 0xb4, 0xbf, 0x39, 0x12, 0x27, 0xa1, 0xfe, 0x01, 0x34, 0xe3,
+	0x5e, 0x72, 0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x7b, 0x21, 0x4f, 0x6f, 0x2f, 0xd4, 0x27, 0xd0, 0x47,
+	0x30, 0xcd, 0xd1, 0x1e, 0xad, 0x6f, 0xed, 0x62, 0xeb, 0x95, 0xd6, 0x37, 0xe3, 0x5e, 0xeb, 0xe0,
+	0xe2, 0x4c, 0x07, 0xb6, 0x7d, 0xaa, 0xa4, 0xeb, 0xa9, 0x4f, 0xa0, 0x8f, 0x25, 0xff, 0x1d, 0xf5,
+	0x9b, 0x85, 0xa5, 0x8e, 0xfc, 0x89, 0x4c, 0x27, 0xfe, 0x89, 0x4c, 0xe7, 0xae, 0x4b, 0xd9, 0x61,
+	0xbb, 0xa4, 0x2d, 0xa9, 0x08, 0x3c, 0x85, 0xd9, 0x0d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac,
+	0x5e, 0x4b, 0x5b, 0x2f, 0xa2, 0x0d, 0x36, 0x22, 0xf4, 0x09, 0xf4, 0x55, 0x05, 0x4e, 0x6d, 0x10,
+	0x56, 0xac, 0xcb, 0xd1, 0xbb, 0xe5, 0x4c, 0x86, 0xd4, 0xef, 0xed, 0x87, 0xe3, 0xfa, 0x74, 0x9e,
+	0xac, 0x3e, 0x81, 0x7e, 0x53, 0x81, 0xb9, 0x0d, 0xc2, 0xcf, 0x2d, 0x91, 0xe9, 0xfa, 0x68, 0x99,
+	0x4a, 0x6a, 0xf1, 0xf6, 0x98, 0x3d, 0xb0, 0x0c, 0x77, 0x7d, 0x02, 0xfd, 0xb6, 0x02, 0x67, 0x33,
+	0xba, 0xca, 0xf2, 0x7b, 0x15, 0xd9, 0x3e, 0x1d, 0xf3, 0xd7, 0x31, 0x19, 0x92, 0xfa, 0x04, 0xda,
+	0x11, 0x66, 0x92, 0xa6, 0xfa, 0xe8, 0x62, 0x69, 0x4e, 0x9f, 0x70, 0x5f, 0x1e, 0x36, 0x9d, 0x98,
+	0xc6, 0xa7, 0x30, 0xbd, 0x41, 0x58, 0x9c, 0x73, 0xe6, 0x8d, 0xbf, 0x50, 0x0e, 0xe4, 0xa3, 0x4f,
+	0x31, 0x4d, 0x15, 0x46, 0xbc, 0x28, 0x69, 0x65, 0xf2, 0xaa, 0x7c, 0xf8, 0x29, 0x4d, 0x40, 0xf3,
+	0x46, 0x5c, 0x9e, 0x96, 0xe9, 0x13, 0xe8, 0x19, 0x2c, 0x95, 0x47, 0x7f, 0xf4, 0xf6, 0xb1, 0xf3,
+	0x80, 0xf6, 0xd5, 0xe3, 0xa0, 0xc6, 0x2c, 0x3f, 0x59, 0xfb, 0xcb, 0x8b, 0xe5, 0xca, 0x5f, 0x5f,
+	0x2c, 0x57, 0xfe, 0xf5, 0x62, 0xb9, 0xf2, 0x83, 0x9b, 0x47, 0xfc, 0x8a, 0x2e, 0xf3, 0xc3, 0x3c,
+	0x4c, 0x6d, 0xd3, 0xb1, 0x89, 0xc7, 0xba, 0x93, 0x22, 0x04, 0xdc, 0xfc, 0x4f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xa0, 0xd6, 0xbb, 0x22, 0xb7, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4005,6 +4017,25 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ToolVersions) > 0 {
+		for k := range m.ToolVersions {
+			v := m.ToolVersions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -5916,6 +5947,14 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.ToolVersions) > 0 {
+		for k, v := range m.ToolVersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8618,6 +8657,133 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToolVersions == nil {
+				m.ToolVersions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ToolVersions[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
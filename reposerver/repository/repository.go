@@ -1513,12 +1513,14 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	}
 
 	var commands []string
+	toolVersions := map[string]string{}
 
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
 		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
 		commands = append(commands, command)
+		toolVersions["helm"] = getToolVersion("helm", helm.Version)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
 		kustomizeBinary, err = settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
@@ -1535,6 +1537,9 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			KubeVersion: kubeVersion,
 			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
 		})
+		toolVersions["kustomize"] = getToolVersion("kustomize:"+kustomizeBinary, func() (string, error) {
+			return kustomize.VersionWithBinaryPath(kustomizeBinary)
+		})
 	case v1alpha1.ApplicationSourceTypePlugin:
 		pluginName := ""
 		if q.ApplicationSource.Plugin != nil {
@@ -1545,6 +1550,10 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if err != nil {
 			err = fmt.Errorf("CMP processing failed for application %q: %w", q.AppName, err)
 		}
+		if pluginName != "" {
+			// The name of a plugin includes its version, if it has one.
+			toolVersions["plugin"] = pluginName
+		}
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
 		if directory = q.ApplicationSource.Directory; directory == nil {
@@ -1599,9 +1608,10 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	}
 
 	return &apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
-		Commands:   commands,
+		Manifests:    manifests,
+		SourceType:   string(appSourceType),
+		Commands:     commands,
+		ToolVersions: nonEmptyValues(toolVersions),
 	}, nil
 }
 
@@ -538,12 +538,13 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, &apiclient.ManifestResponse{
-		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
-		Namespace:  "",
-		Server:     "",
-		Revision:   "1.1.0",
-		SourceType: "Helm",
-		Commands:   []string{`helm template . --name-template "" --include-crds`},
+		Manifests:    []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
+		Namespace:    "",
+		Server:       "",
+		Revision:     "1.1.0",
+		SourceType:   "Helm",
+		ToolVersions: helmToolVersions(),
+		Commands:     []string{`helm template . --name-template "" --include-crds`},
 	}, response)
 	mockCache.mockCache.AssertCacheCalledTimes(t, &repositorymocks.CacheCallCounts{
 		ExternalSets: 1,
@@ -552,6 +553,11 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	gitMocks.AssertNotCalled(t, "LsRemote", mock.Anything)
 }
 
+// helmToolVersions returns the tool versions of a manifest response generated with the local Helm binary.
+func helmToolVersions() map[string]string {
+	return nonEmptyValues(map[string]string{"helm": getToolVersion("helm", helm.Version)})
+}
+
 func TestHelmChartReferencingExternalValues(t *testing.T) {
 	service := newService(t, ".")
 	spec := v1alpha1.ApplicationSpec{
@@ -576,12 +582,13 @@ func TestHelmChartReferencingExternalValues(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, &apiclient.ManifestResponse{
-		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
-		Namespace:  "",
-		Server:     "",
-		Revision:   "1.1.0",
-		SourceType: "Helm",
-		Commands:   []string{`helm template . --name-template "" --values ./testdata/my-chart/my-chart-values.yaml --include-crds`},
+		Manifests:    []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
+		Namespace:    "",
+		Server:       "",
+		Revision:     "1.1.0",
+		SourceType:   "Helm",
+		ToolVersions: helmToolVersions(),
+		Commands:     []string{`helm template . --name-template "" --values ./testdata/my-chart/my-chart-values.yaml --include-crds`},
 	}, response)
 }
 
@@ -1315,12 +1322,13 @@ func TestHelmManifestFromChartRepoWithValueFile(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, &apiclient.ManifestResponse{
-		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
-		Namespace:  "",
-		Server:     "",
-		Revision:   "1.1.0",
-		SourceType: "Helm",
-		Commands:   []string{`helm template . --name-template "" --values ./testdata/my-chart/my-chart-values.yaml --include-crds`},
+		Manifests:    []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
+		Namespace:    "",
+		Server:       "",
+		Revision:     "1.1.0",
+		SourceType:   "Helm",
+		ToolVersions: helmToolVersions(),
+		Commands:     []string{`helm template . --name-template "" --values ./testdata/my-chart/my-chart-values.yaml --include-crds`},
 	}, response)
 }
 
@@ -1956,9 +1964,9 @@ func TestService_newHelmClientResolveRevision(t *testing.T) {
 
 func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 	t.Run("No app name set and app specific file exists", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "multi", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -1970,9 +1978,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("No app specific override", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-global", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-global", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -1985,9 +1993,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("Only app specific override", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-app-only", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2000,9 +2008,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("App specific override", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "multi", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2015,9 +2023,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("App specific overrides containing non-mergeable field", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "multi", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2030,9 +2038,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("Broken app-specific overrides", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "multi", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			_, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2048,34 +2056,28 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 // There are unit test that will use kustomize set and by that modify the
 // kustomization.yaml. For proper testing, we need to copy the testdata to a
 // temporary path, run the tests, and then throw the copy away again.
-func mkTempParameters(ctx context.Context, source string) string {
-	tempDir, err := os.MkdirTemp("./testdata", "app-parameters")
-	if err != nil {
-		panic(err)
-	}
-	cmd := exec.CommandContext(ctx, "cp", "-R", source, tempDir)
-	err = cmd.Run()
-	if err != nil {
-		os.RemoveAll(tempDir)
-		panic(err)
-	}
+func mkTempParameters(t *testing.T, source string) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	cmd := exec.CommandContext(t.Context(), "cp", "-R", source, tempDir)
+	require.NoError(t, cmd.Run())
 	return tempDir
 }
 
 // Simple wrapper run a test with a temporary copy of the testdata, because
-// the test would modify the data when run.
-func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, path string)) {
+// the test would modify the data when run. The runner is given the root of
+// the copy, and the path of the test data relative to it.
+func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, root, path string)) {
 	t.Helper()
-	tempDir := mkTempParameters(t.Context(), "./testdata/app-parameters")
-	runner(t, filepath.Join(tempDir, "app-parameters", path))
-	os.RemoveAll(tempDir)
+	tempDir := mkTempParameters(t, "./testdata/app-parameters")
+	runner(t, tempDir, filepath.Join("app-parameters", path))
 }
 
 func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	t.Run("Single global override", func(t *testing.T) {
-		runWithTempTestdata(t, "single-global", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-global", func(t *testing.T, root, path string) {
 			t.Helper()
-			service := newService(t, ".")
+			service := newService(t, root)
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2103,9 +2105,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Single global override Helm", func(t *testing.T) {
-		runWithTempTestdata(t, "single-global-helm", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-global-helm", func(t *testing.T, root, path string) {
 			t.Helper()
-			service := newService(t, ".")
+			service := newService(t, root)
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2133,9 +2135,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Application specific override", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-app-only", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2164,9 +2166,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Multi-source with source as ref only does not generate manifests", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-app-only", func(t *testing.T, _ string) {
+		runWithTempTestdata(t, "single-app-only", func(t *testing.T, root, _ string) {
 			t.Helper()
+			service := newService(t, root)
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2186,9 +2188,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Application specific override for other app", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-app-only", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2217,9 +2219,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Override info does not appear in cache key", func(t *testing.T) {
-		service := newService(t, ".")
-		runWithTempTestdata(t, "single-global", func(t *testing.T, path string) {
+		runWithTempTestdata(t, "single-global", func(t *testing.T, root, path string) {
 			t.Helper()
+			service := newService(t, root)
 			source := &v1alpha1.ApplicationSource{
 				Path: path,
 			}
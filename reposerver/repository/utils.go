@@ -3,6 +3,7 @@ package repository
 import (
 	"path/filepath"
 	"strings"
+	"sync"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
 	log "github.com/sirupsen/logrus"
@@ -83,3 +84,36 @@ func getPaths(q *apiclient.ManifestRequest, appPath, repoPath string) []string {
 	}
 	return paths
 }
+
+// toolVersions caches the versions of the tools used to generate manifests, keyed by binary, since they don't change
+// during the lifetime of the repo server.
+var toolVersions sync.Map
+
+// getToolVersion returns the version of the given binary, calling getVersion only the first time. Since the versions
+// are only informational, errors are logged and result in an empty version.
+func getToolVersion(binary string, getVersion func() (string, error)) string {
+	if version, ok := toolVersions.Load(binary); ok {
+		return version.(string)
+	}
+	version, err := getVersion()
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get the version of %s", binary)
+	}
+	toolVersions.Store(binary, version)
+	return version
+}
+
+// nonEmptyValues returns the entries of m with a non-empty value, or nil if there are none.
+func nonEmptyValues(m map[string]string) map[string]string {
+	var result map[string]string
+	for k, v := range m {
+		if v == "" {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"reflect"
 	"slices"
@@ -38,9 +39,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 
@@ -92,6 +96,7 @@ type Server struct {
 	appInformer            cache.SharedIndexInformer
 	appBroadcaster         Broadcaster
 	repoClientset          apiclient.Clientset
+	commitClientset        commitclient.Clientset
 	kubectl                kube.Kubectl
 	db                     db.ArgoDB
 	enf                    *rbac.Enforcer
@@ -102,6 +107,7 @@ type Server struct {
 	projInformer           cache.SharedIndexInformer
 	enabledNamespaces      []string
 	syncWithReplaceAllowed bool
+	hydratorEnabled        bool
 }
 
 // NewServer returns a new instance of the Application service
@@ -113,6 +119,7 @@ func NewServer(
 	appInformer cache.SharedIndexInformer,
 	appBroadcaster Broadcaster,
 	repoClientset apiclient.Clientset,
+	commitClientset commitclient.Clientset,
 	cache *servercache.Cache,
 	kubectl kube.Kubectl,
 	db db.ArgoDB,
@@ -123,6 +130,7 @@ func NewServer(
 	enabledNamespaces []string,
 	enableK8sEvent []string,
 	syncWithReplaceAllowed bool,
+	hydratorEnabled bool,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	if appBroadcaster == nil {
 		appBroadcaster = &broadcasterHandler{}
@@ -141,6 +149,7 @@ func NewServer(
 		cache:                  cache,
 		db:                     db,
 		repoClientset:          repoClientset,
+		commitClientset:        commitClientset,
 		kubectl:                kubectl,
 		enf:                    enf,
 		projectLock:            projectLock,
@@ -149,6 +158,7 @@ func NewServer(
 		projInformer:           projInformer,
 		enabledNamespaces:      enabledNamespaces,
 		syncWithReplaceAllowed: syncWithReplaceAllowed,
+		hydratorEnabled:        hydratorEnabled,
 	}
 	return s, s.getAppResources
 }
@@ -798,7 +808,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	})
 	defer unsubscribe()
 
-	app, err := argo.RefreshApp(appIf, appName, refreshType, true)
+	app, err := argo.RefreshAppWithSource(appIf, appName, refreshType, true, v1alpha1.RefreshSourceUser)
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing the app: %w", err)
 	}
@@ -1337,6 +1347,14 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
+	if len(app.Spec.DependsOn) > 0 {
+		depApp := app.DeepCopy()
+		depApp.Namespace = appNs
+		if err := argo.ValidateDependencies(depApp, s.appLister); err != nil {
+			return status.Errorf(codes.InvalidArgument, "application dependencies of %s are invalid: %s", app.Name, err.Error())
+		}
+	}
+
 	var conditions []v1alpha1.ApplicationCondition
 
 	if validate {
@@ -1420,20 +1438,28 @@ func (s *Server) getAppResources(ctx context.Context, a *v1alpha1.Application) (
 	return &tree, nil
 }
 
-func (s *Server) getAppLiveResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*v1alpha1.ResourceNode, *rest.Config, *v1alpha1.Application, error) {
+// getApplicationEnforceResourceRBACInformer gets the application and enforces the given action on one of its resources.
+// The delete and update actions are enforced with the fine-grained resource policy, which is inherited from the
+// application-level policy unless fine-grained inheritance is disabled.
+func (s *Server) getApplicationEnforceResourceRBACInformer(ctx context.Context, action, project, appNamespace, appName, group, kind, namespace, resourceName string) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
 	fineGrainedInheritanceDisabled, err := s.settingsMgr.ApplicationFineGrainedRBACInheritanceDisabled()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	if fineGrainedInheritanceDisabled && (action == rbac.ActionDelete || action == rbac.ActionUpdate) {
-		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
+		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, group, kind, namespace, resourceName)
 	}
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, action, project, appNamespace, appName)
 	if !fineGrainedInheritanceDisabled && err != nil && errors.Is(err, argocommon.PermissionDeniedAPIError) && (action == rbac.ActionDelete || action == rbac.ActionUpdate) {
-		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
-		a, _, err = s.getApplicationEnforceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName())
+		action = fmt.Sprintf("%s/%s/%s/%s/%s", action, group, kind, namespace, resourceName)
+		a, proj, err = s.getApplicationEnforceRBACInformer(ctx, action, project, appNamespace, appName)
 	}
+	return a, proj, err
+}
+
+func (s *Server) getAppLiveResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*v1alpha1.ResourceNode, *rest.Config, *v1alpha1.Application, error) {
+	a, _, err := s.getApplicationEnforceResourceRBACInformer(ctx, action, q.GetProject(), q.GetAppNamespace(), q.GetName(), q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -1571,6 +1597,105 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	return &application.ApplicationResponse{}, nil
 }
 
+// AdoptResources adopts orphaned resources into an application by setting its tracking label or annotation on them,
+// and returns their manifests stripped of their status and server-populated fields. Exporting the manifests with a dry
+// run requires the get permission on the application, while adopting requires the update permission on every resource.
+func (s *Server) AdoptResources(ctx context.Context, q *application.ApplicationAdoptResourcesRequest) (*application.ApplicationAdoptResourcesResponse, error) {
+	if len(q.GetResources()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one resource must be specified")
+	}
+	var a *v1alpha1.Application
+	var proj *v1alpha1.AppProject
+	var err error
+	if q.GetDryRun() {
+		a, proj, err = s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, r := range q.GetResources() {
+			a, proj, err = s.getApplicationEnforceResourceRBACInformer(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), r.Group, r.Kind, r.Namespace, r.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	// all the resources are validated before adopting any of them
+	nodes := make([]v1alpha1.ResourceNode, len(q.GetResources()))
+	for i, r := range q.GetResources() {
+		found := false
+		for _, node := range tree.OrphanedNodes {
+			if node.Group == r.Group && node.Kind == r.Kind && node.Namespace == r.Namespace && node.Name == r.Name {
+				nodes[i] = node
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "%s %s %s/%s is not an orphaned resource of application %s", r.Kind, r.Group, r.Namespace, r.Name, a.Name)
+		}
+		if !proj.IsGroupKindNamePermitted(schema.GroupKind{Group: r.Group, Kind: r.Kind}, r.Name, r.Namespace != "") {
+			return nil, status.Errorf(codes.PermissionDenied, "%s %s %s/%s is not permitted in project %s", r.Kind, r.Group, r.Namespace, r.Name, proj.Name)
+		}
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	appLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+	installationID, err := s.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+
+	res := &application.ApplicationAdoptResourcesResponse{}
+	for _, node := range nodes {
+		live, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error getting resource: %w", err)
+		}
+		manifest, err := s.replaceSecretValues(argo.ExportManifest(live))
+		if err != nil {
+			return nil, fmt.Errorf("error replacing secret values: %w", err)
+		}
+		data, err := json.Marshal(manifest.Object)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling manifest object: %w", err)
+		}
+		res.Manifests = append(res.Manifests, string(data))
+		if q.GetDryRun() {
+			continue
+		}
+
+		patch, err := argo.AdoptionPatch(live, appLabelKey, a.InstanceName(s.ns), a.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
+		if err != nil {
+			return nil, fmt.Errorf("error creating adoption patch: %w", err)
+		}
+		if _, err = s.kubectl.PatchResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace, types.MergePatchType, patch); err != nil {
+			// don't expose real error for secrets since it might contain secret data
+			if node.Kind == kube.SecretKind && node.Group == "" {
+				return nil, fmt.Errorf("failed to adopt Secret %s/%s", node.Namespace, node.Name)
+			}
+			return nil, fmt.Errorf("error patching resource: %w", err)
+		}
+		s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("adopted resource %s/%s '%s'", node.Group, node.Kind, node.Name))
+	}
+	return res, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
@@ -1785,6 +1910,26 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+func (s *Server) ResourceDriftHistory(ctx context.Context, q *application.ResourcesQuery) (*application.ResourceDriftHistoryResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1alpha1.ResourceDriftHistory, 0)
+	err = s.cache.GetAppDriftHistory(a.InstanceName(s.ns), &items)
+	if err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		log.Warnf("error getting cached drift history for %s/%s, cache unavailable, returning empty drift history: %v", a.Namespace, a.Name, err)
+	}
+	res := &application.ResourceDriftHistoryResponse{Items: []*v1alpha1.ResourceDriftHistory{}}
+	for _, item := range items {
+		if isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
+			res.Items = append(res.Items, item)
+		}
+	}
+	return res, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -2215,36 +2360,14 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if deploymentInfo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
 	}
-	if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		// As multi source doesn't use app.Source, we need to check to the Sources length
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
-	}
-
-	var syncOptions v1alpha1.SyncOptions
-	if a.Spec.SyncPolicy != nil {
-		syncOptions = a.Spec.SyncPolicy.SyncOptions
-	}
-
-	// Rollback is just a convenience around Sync
-	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
-			DryRun:       rollbackReq.GetDryRun(),
-			Prune:        rollbackReq.GetPrune(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
-		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+	op, err := argo.NewRollbackOperation(a, deploymentInfo, rollbackReq.GetDryRun(), rollbackReq.GetPrune(), v1alpha1.OperationInitiator{Username: session.Username(ctx)})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, err = argo.SetAppOperation(appIf, appName, op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -2463,6 +2586,52 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// ApproveSync approves the sync wave the currently running operation is waiting for
+func (s *Server) ApproveSync(ctx context.Context, approveReq *application.ApplicationApproveSyncRequest) (*v1alpha1.Application, error) {
+	appName := approveReq.GetName()
+	appNs := s.appNamespaceOrDefault(approveReq.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionApprove, approveReq.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if a.Operation == nil || a.Operation.Sync == nil || a.Status.OperationState == nil ||
+			a.Status.OperationState.Phase != common.OperationWaitingForApproval || a.Status.OperationState.PendingApproval == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "Unable to approve sync. No operation is waiting for approval")
+		}
+		pending := a.Status.OperationState.PendingApproval
+		if (approveReq.Phase != nil && common.SyncPhase(approveReq.GetPhase()) != pending.Phase) || (approveReq.Wave != nil && approveReq.GetWave() != pending.Wave) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to approve sync. The operation is waiting for approval of wave %d of phase %s", pending.Wave, pending.Phase)
+		}
+		if !a.Operation.Sync.IsWaveApproved(pending.Phase, pending.Wave) {
+			now := metav1.Now()
+			a.Operation.Sync.Approvals = append(a.Operation.Sync.Approvals, v1alpha1.SyncWaveApproval{
+				Phase:      pending.Phase,
+				Wave:       pending.Wave,
+				ApprovedBy: session.Username(ctx),
+				ApprovedAt: &now,
+			})
+		}
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.waitSync(updated)
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("approved wave %d of phase %s", pending.Wave, pending.Phase))
+			return updated, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to approve sync of app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to approve sync. Too many conflicts")
+}
+
 func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -2822,6 +2991,48 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	return res, nil
 }
 
+// GetDependencyGraph returns the application and the applications it transitively depends on
+func (s *Server) GetDependencyGraph(ctx context.Context, q *application.ApplicationDependencyGraphQuery) (*application.ApplicationDependencyGraphResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := argo.GetDependencyGraph(a, s.appLister)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dependency graph: %w", err)
+	}
+
+	res := &application.ApplicationDependencyGraphResponse{}
+	for _, key := range slices.Sorted(maps.Keys(graph)) {
+		depApp := graph[key]
+		if depApp == nil || !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, depApp.RBACName(s.ns)) {
+			name, namespace := argo.ParseFromQualifiedName(key, "")
+			res.Nodes = append(res.Nodes, &application.ApplicationDependencyNode{
+				Name:      ptr.To(name),
+				Namespace: ptr.To(namespace),
+				Found:     ptr.To(false),
+				Ready:     ptr.To(false),
+			})
+			continue
+		}
+		var dependsOn []*v1alpha1.ApplicationDependency
+		for _, dep := range depApp.GetDependsOn() {
+			dependsOn = append(dependsOn, &dep)
+		}
+		res.Nodes = append(res.Nodes, &application.ApplicationDependencyNode{
+			Name:         ptr.To(depApp.Name),
+			Namespace:    ptr.To(depApp.Namespace),
+			DependsOn:    dependsOn,
+			SyncStatus:   ptr.To(string(depApp.Status.Sync.Status)),
+			HealthStatus: ptr.To(string(depApp.Status.Health.Status)),
+			Found:        ptr.To(true),
+			Ready:        ptr.To(argo.IsDependencyReady(depApp)),
+		})
+	}
+	return res, nil
+}
+
 func (s *Server) inferResourcesStatusHealth(app *v1alpha1.Application) {
 	if app.Status.ResourceHealthSource == v1alpha1.ResourceHealthLocationAppTree {
 		tree := &v1alpha1.ApplicationTree{}
@@ -3064,3 +3275,108 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 		Modified: &modified,
 	}, nil
 }
+
+// HydratePreview hydrates the application at the requested dry revision and returns the diff of each hydrated file
+// against the current contents of the hydrated branch. Nothing is committed.
+func (s *Server) HydratePreview(ctx context.Context, q *application.ApplicationHydratePreviewQuery) (*application.ApplicationHydratePreviewResponse, error) {
+	if !s.hydratorEnabled || s.commitClientset == nil {
+		return nil, status.Error(codes.Unimplemented, "hydrator is disabled")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s does not use the source hydrator", a.QualifiedName())
+	}
+
+	previewer := hydrator.NewPreviewer(newHydratorDependencies(s), s.commitClientset, s.repoClientset, s.db)
+	drySHA, diff, err := previewer.Preview(ctx, a, proj, q.GetRevision(), s.settingsMgr.GetSensitiveAnnotations())
+	if err != nil {
+		return nil, fmt.Errorf("error previewing hydration: %w", err)
+	}
+
+	resp := &application.ApplicationHydratePreviewResponse{
+		DrySha:        ptr.To(drySHA),
+		Files:         make([]*application.HydratedFileDiff, 0, len(diff.Files)),
+		OrphanedPaths: diff.OrphanedPaths,
+	}
+	for _, file := range diff.Files {
+		resp.Files = append(resp.Files, &application.HydratedFileDiff{
+			Path: ptr.To(file.Path),
+			Diff: ptr.To(file.Diff),
+		})
+	}
+	return resp, nil
+}
+
+// HydrateRollback restores the hydrated manifests of an application to the ones of a previous hydrate operation, and
+// records the rollback in the hydrate history of the application.
+func (s *Server) HydrateRollback(ctx context.Context, q *application.ApplicationHydrateRollbackRequest) (*v1alpha1.Application, error) {
+	if !s.hydratorEnabled || s.commitClientset == nil {
+		return nil, status.Error(codes.Unimplemented, "hydrator is disabled")
+	}
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s does not use the source hydrator", a.QualifiedName())
+	}
+	history := a.Status.SourceHydrator.FindHydrateHistory(q.GetId())
+	if history == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have hydrate history with id %v", a.QualifiedName(), q.GetId())
+	}
+	if history.Phase != v1alpha1.HydrateOperationPhaseHydrated {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot roll back to hydrate history %d since it did not succeed", q.GetId())
+	}
+	if _, ok := a.GetAnnotations()[v1alpha1.AnnotationKeyHydrateRollback]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "the previous hydrate rollback of application %s has not been recorded yet", a.QualifiedName())
+	}
+
+	rollbacker := hydrator.NewRollbacker(newHydratorDependencies(s), s.commitClientset)
+	record, err := rollbacker.Rollback(ctx, a, history, session.Username(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error rolling back hydrated manifests: %w", err)
+	}
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling hydrate rollback: %w", err)
+	}
+
+	// The rollback is recorded in the hydrate history by the app controller, which persists the status of the
+	// hydrator. Writing the history from here would race with a concurrent hydration, which replaces the history.
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace)
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest, err := appIf.Get(ctx, a.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := latest.GetAnnotations()[v1alpha1.AnnotationKeyHydrateRollback]; ok {
+			return status.Errorf(codes.Aborted, "application %s was rolled back concurrently", a.QualifiedName())
+		}
+		if latest.Annotations == nil {
+			latest.Annotations = make(map[string]string)
+		}
+		latest.Annotations[v1alpha1.AnnotationKeyHydrateRollback] = string(recordJSON)
+		_, err = appIf.Update(ctx, latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error recording hydrate rollback: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("rolled back hydrated manifests to hydrate history %d", history.ID))
+
+	// Refresh the application so that it picks up the new commit of its sync branch.
+	a, err = argo.RefreshAppWithSource(appIf, a.Name, v1alpha1.RefreshTypeNormal, false, v1alpha1.RefreshSourceUser)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing the app: %w", err)
+	}
+	return a, nil
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"io"
@@ -41,7 +42,10 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitmocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
@@ -255,6 +259,11 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		if ok {
 			err := appStateCache.SetAppManagedResources(app.Name, []*v1alpha1.ResourceDiff{})
 			require.NoError(t, err)
+			err = appStateCache.SetAppDriftHistory(app.Name, []*v1alpha1.ResourceDriftHistory{
+				{Kind: "ConfigMap", Namespace: "default", Name: "my-map", Events: []v1alpha1.ResourceDriftEvent{{FieldPaths: []string{".data.foo"}}}},
+				{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "my-deploy", Events: []v1alpha1.ResourceDriftEvent{{FieldPaths: []string{".spec.replicas"}}}},
+			})
+			require.NoError(t, err)
 
 			// Pre-populate the resource tree based on the app's resources.
 			nodes := make([]v1alpha1.ResourceNode, len(app.Status.Resources))
@@ -298,6 +307,7 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		appInformer,
 		broadcaster,
 		mockRepoClient,
+		nil,
 		appCache,
 		kubectl,
 		db,
@@ -308,6 +318,7 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		[]string{},
 		testEnableEventList,
 		true,
+		false,
 	)
 	return server.(*Server)
 }
@@ -461,6 +472,7 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		appInformer,
 		broadcaster,
 		mockRepoClient,
+		nil,
 		appCache,
 		kubectl,
 		db,
@@ -471,6 +483,7 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		[]string{},
 		testEnableEventList,
 		true,
+		false,
 	)
 	return server.(*Server)
 }
@@ -951,6 +964,22 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ResourceDriftHistory", func(t *testing.T) {
+		res, err := appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.NoError(t, err)
+		assert.Len(t, res.Items, 2)
+		res, err = appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test"), Kind: ptr.To("Deployment")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "my-deploy", res.Items[0].Name)
+		_, err = appServer.ResourceDriftHistory(noRoleCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("Sync", func(t *testing.T) {
 		_, err := appServer.Sync(adminCtx, &application.ApplicationSyncRequest{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -2593,6 +2622,92 @@ func TestSyncRBACOverrideNotRequired_DiffRevisionWithAutosyncPrevented(t *testin
 		"should not be able to sync to different revision with auto-sync enabled, multi-source app")
 }
 
+func TestApproveSync(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newTestApp()})
+	require.NoError(t, err)
+
+	_, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &app.Name})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = Unable to approve sync. No operation is waiting for approval")
+
+	// set status.operationState to pretend that the controller is waiting for approval
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:       *app.Operation,
+		Phase:           synccommon.OperationWaitingForApproval,
+		StartedAt:       metav1.NewTime(time.Now()),
+		PendingApproval: &v1alpha1.SyncWaveApproval{Phase: synccommon.SyncPhaseSync, Wave: 2},
+	}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(ctx, app, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &app.Name, Wave: ptr.To(int64(1))})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = Unable to approve sync. The operation is waiting for approval of wave 2 of phase Sync")
+
+	app, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &app.Name, Phase: ptr.To("Sync"), Wave: ptr.To(int64(2))})
+	require.NoError(t, err)
+	require.Len(t, app.Operation.Sync.Approvals, 1)
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSync), app.Operation.Sync.Approvals[0].Phase)
+	assert.Equal(t, int64(2), app.Operation.Sync.Approvals[0].Wave)
+	assert.NotNil(t, app.Operation.Sync.Approvals[0].ApprovedAt)
+
+	// approving the same wave again is a no-op
+	app, err = appServer.ApproveSync(ctx, &application.ApplicationApproveSyncRequest{Name: &app.Name})
+	require.NoError(t, err)
+	assert.Len(t, app.Operation.Sync.Approvals, 1)
+}
+
+func TestGetDependencyGraph(t *testing.T) {
+	ctx := t.Context()
+	db := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "db"
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+		app.Status.Health.Status = health.HealthStatusHealthy
+	})
+	backend := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "backend"
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "db"}, {Name: "queue"}}
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+		app.Status.Health.Status = health.HealthStatusHealthy
+	})
+	appServer := newTestAppServer(t, db, backend)
+
+	graph, err := appServer.GetDependencyGraph(ctx, &application.ApplicationDependencyGraphQuery{Name: ptr.To("backend")})
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+	assert.Equal(t, "backend", graph.Nodes[0].GetName())
+	assert.Equal(t, []*v1alpha1.ApplicationDependency{{Name: "db", Namespace: testNamespace}, {Name: "queue", Namespace: testNamespace}}, graph.Nodes[0].DependsOn)
+	assert.Equal(t, "OutOfSync", graph.Nodes[0].GetSyncStatus())
+	assert.False(t, graph.Nodes[0].GetReady())
+	assert.Equal(t, "db", graph.Nodes[1].GetName())
+	assert.True(t, graph.Nodes[1].GetFound())
+	assert.True(t, graph.Nodes[1].GetReady())
+	assert.Equal(t, "queue", graph.Nodes[2].GetName())
+	assert.Equal(t, testNamespace, graph.Nodes[2].GetNamespace())
+	assert.False(t, graph.Nodes[2].GetFound())
+}
+
+func TestCreateAppWithDependencyCycle(t *testing.T) {
+	ctx := t.Context()
+	db := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "db"
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "backend"}}
+	})
+	appServer := newTestAppServer(t, db)
+
+	backend := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "backend"
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "db"}}
+	})
+	_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: backend})
+	require.EqualError(t, err, "error while validating and normalizing app: rpc error: code = InvalidArgument desc = application dependencies of backend are invalid: dependency cycle detected: default/backend -> default/db -> default/backend")
+
+	backend.Spec.DependsOn = nil
+	_, err = appServer.Create(ctx, &application.ApplicationCreateRequest{Application: backend})
+	require.NoError(t, err)
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -2726,6 +2841,158 @@ func TestGetManifests_WithNoCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHydratePreview(t *testing.T) {
+	hydratorApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "hydrator-app"
+		app.Spec.Source = nil
+		app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{
+				RepoURL:        fakeRepoURL,
+				TargetRevision: "main",
+				Path:           "base",
+			},
+			SyncSource: v1alpha1.SyncSource{
+				TargetBranch: "env/test",
+				Path:         "test",
+			},
+		}
+	})
+
+	t.Run("hydrator disabled", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+
+		_, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewQuery{Name: &hydratorApp.Name})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("app without source hydrator", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.hydratorEnabled = true
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: commitmocks.NewCommitServiceClient(t)}
+
+		_, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewQuery{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("returns the diff of the hydrated files", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+		appServer.hydratorEnabled = true
+
+		mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+		mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+			return mr.ApplicationSource.Path == "base" && mr.Revision == "feature" && mr.NoCache
+		})).Return(&apiclient.ManifestResponse{
+			Revision:  "sha123",
+			Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`},
+		}, nil)
+		mockRepoServiceClient.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+		mockCommitServiceClient := commitmocks.NewCommitServiceClient(t)
+		mockCommitServiceClient.EXPECT().DiffHydratedManifests(mock.Anything, mock.MatchedBy(func(r *commitclient.CommitHydratedManifestsRequest) bool {
+			return r.DrySha == "sha123" && r.SyncBranch == "env/test" && len(r.Paths) == 1 && r.Paths[0].Path == "test" && slices.Equal(r.ActivePaths, []string{"test"})
+		})).Return(&commitclient.DiffHydratedManifestsResponse{
+			Files:         []*commitclient.HydratedFileDiff{{Path: "test/manifest.yaml", Diff: "diff"}},
+			OrphanedPaths: []string{"deleted"},
+		}, nil)
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: mockCommitServiceClient}
+
+		resp, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewQuery{
+			Name:     &hydratorApp.Name,
+			Revision: ptr.To("feature"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sha123", resp.GetDrySha())
+		require.Len(t, resp.Files, 1)
+		assert.Equal(t, "test/manifest.yaml", resp.Files[0].GetPath())
+		assert.Equal(t, "diff", resp.Files[0].GetDiff())
+		assert.Equal(t, []string{"deleted"}, resp.OrphanedPaths)
+	})
+}
+
+func TestHydrateRollback(t *testing.T) {
+	hydratorApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "hydrator-app"
+		app.Spec.Source = nil
+		app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{
+				RepoURL:        fakeRepoURL,
+				TargetRevision: "main",
+				Path:           "base",
+			},
+			SyncSource: v1alpha1.SyncSource{
+				TargetBranch: "env/test",
+				Path:         "test",
+			},
+		}
+		app.Status.SourceHydrator.History = v1alpha1.HydrateHistories{
+			{ID: 0, Phase: v1alpha1.HydrateOperationPhaseHydrated, DrySHA: "dry1", HydratedSHA: "hydrated1"},
+			{ID: 1, Phase: v1alpha1.HydrateOperationPhaseFailed, DrySHA: "dry2"},
+			{ID: 2, Phase: v1alpha1.HydrateOperationPhaseHydrated, DrySHA: "dry3", HydratedSHA: "hydrated3"},
+		}
+	})
+
+	t.Run("hydrator disabled", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+
+		_, err := appServer.HydrateRollback(t.Context(), &application.ApplicationHydrateRollbackRequest{Name: &hydratorApp.Name, Id: ptr.To(int64(0))})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("unknown history", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+		appServer.hydratorEnabled = true
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: commitmocks.NewCommitServiceClient(t)}
+
+		_, err := appServer.HydrateRollback(t.Context(), &application.ApplicationHydrateRollbackRequest{Name: &hydratorApp.Name, Id: ptr.To(int64(5))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("failed hydration", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+		appServer.hydratorEnabled = true
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: commitmocks.NewCommitServiceClient(t)}
+
+		_, err := appServer.HydrateRollback(t.Context(), &application.ApplicationHydrateRollbackRequest{Name: &hydratorApp.Name, Id: ptr.To(int64(1))})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("previous rollback not recorded yet", func(t *testing.T) {
+		pendingApp := hydratorApp.DeepCopy()
+		pendingApp.Annotations = map[string]string{v1alpha1.AnnotationKeyHydrateRollback: "{}"}
+		appServer := newTestAppServer(t, pendingApp)
+		appServer.hydratorEnabled = true
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: commitmocks.NewCommitServiceClient(t)}
+
+		_, err := appServer.HydrateRollback(t.Context(), &application.ApplicationHydrateRollbackRequest{Name: &hydratorApp.Name, Id: ptr.To(int64(0))})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("restores the hydrated manifests and hands the rollback over to the controller", func(t *testing.T) {
+		appServer := newTestAppServer(t, hydratorApp)
+		appServer.hydratorEnabled = true
+
+		mockCommitServiceClient := commitmocks.NewCommitServiceClient(t)
+		mockCommitServiceClient.EXPECT().RevertHydratedManifests(mock.Anything, mock.MatchedBy(func(r *commitclient.RevertHydratedManifestsRequest) bool {
+			return r.TargetBranch == "env/test" && r.Path == "test" && r.HydratedSha == "hydrated1"
+		})).Return(&commitclient.RevertHydratedManifestsResponse{HydratedSha: "revert1"}, nil)
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: mockCommitServiceClient}
+
+		app, err := appServer.HydrateRollback(t.Context(), &application.ApplicationHydrateRollbackRequest{Name: &hydratorApp.Name, Id: ptr.To(int64(0))})
+		require.NoError(t, err)
+		// The history is left to the controller, which persists the status of the hydrator.
+		assert.Len(t, app.Status.SourceHydrator.History, 3)
+		var record hydrator.RollbackRecord
+		require.NoError(t, json.Unmarshal([]byte(app.Annotations[v1alpha1.AnnotationKeyHydrateRollback]), &record))
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, record.History.Phase)
+		assert.Equal(t, "dry1", record.History.DrySHA)
+		assert.Equal(t, "revert1", record.History.HydratedSHA)
+		assert.Equal(t, ptr.To(int64(0)), record.History.RollbackID)
+		assert.Equal(t, string(v1alpha1.RefreshTypeNormal), app.Annotations[v1alpha1.AnnotationKeyRefresh])
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -3566,6 +3833,136 @@ func TestRunNewStyleResourceAction(t *testing.T) {
 	})
 }
 
+func TestAdoptResources(t *testing.T) {
+	testApp := newTestApp()
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":            "my-map",
+			"namespace":       testNamespace,
+			"uid":             "123",
+			"resourceVersion": "42",
+		},
+		"data": map[string]any{"foo": "bar"},
+	}}
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "my-secret", "namespace": testNamespace},
+		"data":       map[string]any{"password": "c2VjcmV0"},
+	}}
+	appServer := newTestAppServer(t, testApp, configMap, secret)
+	appStateCache := appstate.NewCache(appServer.cache.GetCache(), time.Hour)
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: testNamespace, Name: "my-map"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Secret", Namespace: testNamespace, Name: "my-secret"}},
+	}})
+	require.NoError(t, err)
+
+	newRequest := func(resources ...*v1alpha1.SyncOperationResource) *application.ApplicationAdoptResourcesRequest {
+		return &application.ApplicationAdoptResourcesRequest{
+			Name:         &testApp.Name,
+			AppNamespace: &testApp.Namespace,
+			Resources:    resources,
+			DryRun:       ptr.To(true),
+		}
+	}
+
+	t.Run("ExportsManifests", func(t *testing.T) {
+		res, err := appServer.AdoptResources(t.Context(), newRequest(
+			&v1alpha1.SyncOperationResource{Kind: "ConfigMap", Namespace: testNamespace, Name: "my-map"},
+			&v1alpha1.SyncOperationResource{Kind: "Secret", Namespace: testNamespace, Name: "my-secret"},
+		))
+		require.NoError(t, err)
+		require.Len(t, res.Manifests, 2)
+		assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-map","namespace":"default"},"data":{"foo":"bar"}}`, res.Manifests[0])
+		// secret values are masked
+		assert.NotContains(t, res.Manifests[1], "c2VjcmV0")
+	})
+
+	t.Run("Adopts", func(t *testing.T) {
+		req := newRequest(&v1alpha1.SyncOperationResource{Kind: "ConfigMap", Namespace: testNamespace, Name: "my-map"})
+		req.DryRun = ptr.To(false)
+		res, err := appServer.AdoptResources(t.Context(), req)
+		require.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+	})
+
+	t.Run("NotOrphaned", func(t *testing.T) {
+		_, err := appServer.AdoptResources(t.Context(), newRequest(&v1alpha1.SyncOperationResource{Kind: "ConfigMap", Namespace: testNamespace, Name: "other-map"}))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("NoResources", func(t *testing.T) {
+		_, err := appServer.AdoptResources(t.Context(), newRequest())
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("NotPermittedInProject", func(t *testing.T) {
+		restrictedProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted-proj", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:                []string{"*"},
+				Destinations:               []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+			},
+		}
+		restrictedApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Name = "restricted-app"
+			app.Spec.Project = restrictedProj.Name
+		})
+		restrictedAppServer := newTestAppServer(t, restrictedApp, restrictedProj, secret)
+		err := appstate.NewCache(restrictedAppServer.cache.GetCache(), time.Hour).SetAppResourcesTree(restrictedApp.Name, &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Secret", Namespace: testNamespace, Name: "my-secret"}},
+		}})
+		require.NoError(t, err)
+		req := newRequest(&v1alpha1.SyncOperationResource{Kind: "Secret", Namespace: testNamespace, Name: "my-secret"})
+		req.Name = &restrictedApp.Name
+		_, err = restrictedAppServer.AdoptResources(t.Context(), req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
+		configMapResource := &v1alpha1.SyncOperationResource{Kind: "ConfigMap", Namespace: testNamespace, Name: "my-map"}
+		secretResource := &v1alpha1.SyncOperationResource{Kind: "Secret", Namespace: testNamespace, Name: "my-secret"}
+		adoptRequest := func(resources ...*v1alpha1.SyncOperationResource) *application.ApplicationAdoptResourcesRequest {
+			req := newRequest(resources...)
+			req.DryRun = ptr.To(false)
+			return req
+		}
+
+		_ = appServer.enf.SetBuiltinPolicy(``)
+		_, err := appServer.AdoptResources(ctx, newRequest(configMapResource))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "exporting requires the get permission")
+
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+`)
+		_, err = appServer.AdoptResources(ctx, newRequest(configMapResource))
+		require.NoError(t, err, "exporting only requires the get permission")
+		_, err = appServer.AdoptResources(ctx, adoptRequest(configMapResource))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, update, default/test-app, allow
+`)
+		_, err = appServer.AdoptResources(ctx, adoptRequest(configMapResource))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "the application update permission is not inherited by its resources")
+
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, update/*/ConfigMap/*, default/test-app, allow
+`)
+		_, err = appServer.AdoptResources(ctx, adoptRequest(configMapResource))
+		require.NoError(t, err)
+		_, err = appServer.AdoptResources(ctx, adoptRequest(configMapResource, secretResource))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "every adopted resource requires the update permission")
+	})
+}
+
 func TestRunOldStyleResourceAction(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	hydratorutil "github.com/argoproj/argo-cd/v3/util/hydrator"
+)
+
+/**
+This file implements the hydrator.ManifestDependencies interface for the API server, which is used to preview hydration.
+
+Hydration logic does not belong in this file. The methods here should mirror the ones the app controller provides to the
+hydrator, so that previews render exactly the manifests that would be committed.
+*/
+
+// hydratorDependencies provides the hydrator with access to the repo server and settings of the API server.
+type hydratorDependencies struct {
+	s                 *Server
+	manifestGenerator *hydratorutil.ManifestGenerator
+}
+
+// newHydratorDependencies returns the hydrator dependencies of the given server. Manifests are generated by the same
+// manifest generator as in the app controller.
+func newHydratorDependencies(s *Server) *hydratorDependencies {
+	return &hydratorDependencies{s: s, manifestGenerator: hydratorutil.NewManifestGenerator(s.db, s.settingsMgr, s.repoClientset, s.ns)}
+}
+
+// GetRepoObjs generates the manifests of the given dry sources at the given revisions, exactly like the app controller
+// does for hydration.
+func (d *hydratorDependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, drySources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	return d.manifestGenerator.GetRepoObjs(ctx, app, drySources, revisions, project)
+}
+
+func (d *hydratorDependencies) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*v1alpha1.Repository, error) {
+	return d.s.db.GetWriteRepository(ctx, repoURL, project)
+}
+
+// GetAllApps returns the applications in the namespaces enabled for the API server. Unlike the other methods, it isn't
+// limited to the applications the user may access, because all applications hydrating to a branch determine which of
+// its paths are orphaned.
+func (d *hydratorDependencies) GetAllApps() (*v1alpha1.ApplicationList, error) {
+	apps, err := d.s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps: %w", err)
+	}
+	list := &v1alpha1.ApplicationList{}
+	for _, app := range apps {
+		if d.s.isNamespaceEnabled(app.Namespace) {
+			list.Items = append(list.Items, *app)
+		}
+	}
+	return list, nil
+}
+
+func (d *hydratorDependencies) GetHydratorPruneOrphanedPaths() (bool, error) {
+	prune, err := d.s.settingsMgr.GetSourceHydratorPruneOrphanedPaths()
+	if err != nil {
+		return false, fmt.Errorf("failed to get sourceHydrator prune orphaned paths setting: %w", err)
+	}
+	return prune, nil
+}
+
+func (d *hydratorDependencies) GetHydratorCommitMessageTemplate() (string, error) {
+	sourceHydratorCommitMessageKey, err := d.s.settingsMgr.GetSourceHydratorCommitMessageTemplate()
+	if err != nil {
+		return "", fmt.Errorf("failed to get sourceHydrator commit message template key: %w", err)
+	}
+
+	return sourceHydratorCommitMessageKey, nil
+}
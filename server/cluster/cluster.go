@@ -279,6 +279,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"shard": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Shard = existing.Shard
 	},
+	"syncConcurrencyLimit": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.SyncConcurrencyLimit = existing.SyncConcurrencyLimit
+	},
 	"clusterResources": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.ClusterResources = existing.ClusterResources
 	},
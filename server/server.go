@@ -68,6 +68,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
@@ -231,6 +232,7 @@ type ArgoCDServerOpts struct {
 	KubeClientset           kubernetes.Interface
 	AppClientset            appclientset.Interface
 	RepoClientset           repoapiclient.Clientset
+	CommitClientset         commitclient.Clientset
 	Cache                   *servercache.Cache
 	RepoServerCache         *repocache.Cache
 	RedisClient             *redis.Client
@@ -1038,6 +1040,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.appInformer,
 		nil,
 		a.RepoClientset,
+		a.CommitClientset,
 		a.Cache,
 		kubectl,
 		a.db,
@@ -1048,6 +1051,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ApplicationNamespaces,
 		a.EnableK8sEvent,
 		a.SyncWithReplaceAllowed,
+		a.HydratorEnabled,
 	)
 
 	applicationSetService := applicationset.NewServer(
@@ -1222,6 +1222,8 @@ const getOperationStateTitle = (app: appModels.Application) => {
             switch (appOperationState.phase) {
                 case 'Running':
                     return 'Syncing';
+                case 'WaitingForApproval':
+                    return 'Waiting for approval';
                 case 'Error':
                     return 'Sync error';
                 case 'Failed':
@@ -1240,7 +1242,12 @@ export const OperationState = ({app, quiet, isButton}: {app: appModels.Applicati
     if (appOperationState === undefined) {
         return null;
     }
-    if (quiet && [appModels.OperationPhases.Running, appModels.OperationPhases.Failed, appModels.OperationPhases.Error].indexOf(appOperationState.phase) === -1) {
+    if (
+        quiet &&
+        [appModels.OperationPhases.Running, appModels.OperationPhases.WaitingForApproval, appModels.OperationPhases.Failed, appModels.OperationPhases.Error].indexOf(
+            appOperationState.phase
+        ) === -1
+    ) {
         return null;
     }
 
@@ -65,10 +65,11 @@ export interface Operation {
     initiatedBy: OperationInitiator;
 }
 
-export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
+export type OperationPhase = 'Running' | 'WaitingForApproval' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
 
 export const OperationPhases = {
     Running: 'Running' as OperationPhase,
+    WaitingForApproval: 'WaitingForApproval' as OperationPhase,
     Failed: 'Failed' as OperationPhase,
     Error: 'Error' as OperationPhase,
     Succeeded: 'Succeeded' as OperationPhase,
@@ -88,6 +89,14 @@ export interface OperationState {
     syncResult: SyncOperationResult;
     startedAt: models.Time;
     finishedAt: models.Time;
+    pendingApproval?: SyncWaveApproval;
+}
+
+export interface SyncWaveApproval {
+    phase: string;
+    wave: number;
+    approvedBy?: string;
+    approvedAt?: models.Time;
 }
 
 export type HookType = 'PreSync' | 'Sync' | 'PostSync' | 'SyncFail' | 'Skip';
@@ -340,6 +349,12 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name: string;
+    namespace?: string;
 }
 
 export interface ResourceIgnoreDifferences {
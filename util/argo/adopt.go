@@ -0,0 +1,64 @@
+package argo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// serverPopulatedMetadataFields are the metadata fields populated by the Kubernetes API server, which must not be part
+// of the manifests committed to a source repository
+var serverPopulatedMetadataFields = []string{
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+	"managedFields",
+	"selfLink",
+}
+
+// AdoptionPatch returns a merge patch which sets the tracking label or annotation of the given application on the live
+// resource, so that the resource becomes part of the application
+func AdoptionPatch(live *unstructured.Unstructured, appLabelKey, appName, namespace string, trackingMethod v1alpha1.TrackingMethod, installationID string) ([]byte, error) {
+	adopted := live.DeepCopy()
+	if err := NewResourceTracking().SetAppInstance(adopted, appLabelKey, appName, namespace, trackingMethod, installationID); err != nil {
+		return nil, fmt.Errorf("error setting app instance: %w", err)
+	}
+	liveBytes, err := json.Marshal(live.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling live resource: %w", err)
+	}
+	adoptedBytes, err := json.Marshal(adopted.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling adopted resource: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(liveBytes, adoptedBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge patch: %w", err)
+	}
+	return patch, nil
+}
+
+// ExportManifest returns a copy of the given live resource stripped of its status and of the fields populated by the
+// Kubernetes API server, so that it can be committed to the source repository of an application
+func ExportManifest(live *unstructured.Unstructured) *unstructured.Unstructured {
+	manifest := live.DeepCopy()
+	unstructured.RemoveNestedField(manifest.Object, "status")
+	for _, field := range serverPopulatedMetadataFields {
+		unstructured.RemoveNestedField(manifest.Object, "metadata", field)
+	}
+	annotations := manifest.GetAnnotations()
+	delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(manifest.Object, "metadata", "annotations")
+	} else {
+		manifest.SetAnnotations(annotations)
+	}
+	return manifest
+}
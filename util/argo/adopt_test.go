@@ -0,0 +1,61 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newAdoptTestConfigMap() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":              "my-map",
+			"namespace":         "default",
+			"uid":               "123",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2024-01-02T03:04:05Z",
+			"managedFields":     []any{map[string]any{"manager": "kubectl-create"}},
+			"labels":            map[string]any{"app": "guestbook"},
+			"annotations":       map[string]any{"kubectl.kubernetes.io/last-applied-configuration": "{}"},
+		},
+		"data":   map[string]any{"foo": "bar"},
+		"status": map[string]any{"phase": "Active"},
+	}}
+}
+
+func TestAdoptionPatch(t *testing.T) {
+	t.Run("Label", func(t *testing.T) {
+		patch, err := AdoptionPatch(newAdoptTestConfigMap(), common.LabelKeyAppInstance, "my-app", "default", v1alpha1.TrackingMethodLabel, "")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"metadata":{"labels":{"app.kubernetes.io/instance":"my-app"}}}`, string(patch))
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		patch, err := AdoptionPatch(newAdoptTestConfigMap(), common.LabelKeyAppInstance, "my-app", "default", v1alpha1.TrackingMethodAnnotation, "")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"metadata":{"annotations":{"argocd.argoproj.io/tracking-id":"my-app:/ConfigMap:default/my-map"}}}`, string(patch))
+	})
+}
+
+func TestExportManifest(t *testing.T) {
+	live := newAdoptTestConfigMap()
+	manifest := ExportManifest(live)
+	assert.Equal(t, map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      "my-map",
+			"namespace": "default",
+			"labels":    map[string]any{"app": "guestbook"},
+		},
+		"data": map[string]any{"foo": "bar"},
+	}, manifest.Object)
+	// the live resource is left untouched
+	assert.Equal(t, "42", live.GetResourceVersion())
+}
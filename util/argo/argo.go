@@ -239,18 +239,31 @@ func FilterByNameP(apps []*argoappv1.Application, name string) []*argoappv1.Appl
 	return items
 }
 
-// RefreshApp updates the refresh annotation of an application to coerce the controller to process it
+// RefreshApp updates the refresh annotation of an application to coerce the controller to process it, without
+// prioritizing the refresh
 func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, hydrate bool) (*argoappv1.Application, error) {
+	return RefreshAppWithSource(appIf, name, refreshType, hydrate, argoappv1.RefreshSourceDefault)
+}
+
+// RefreshAppWithSource updates the refresh annotation of an application to coerce the controller to process it, along
+// with the source of the refresh the controller prioritizes it by.
+func RefreshAppWithSource(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, hydrate bool, source argoappv1.RefreshSource) (*argoappv1.Application, error) {
+	annotations := map[string]any{
+		argoappv1.AnnotationKeyRefresh: string(refreshType),
+		// removes the source of a pending refresh unless one is given
+		argoappv1.AnnotationKeyRefreshSource: nil,
+	}
+	if source != argoappv1.RefreshSourceDefault {
+		annotations[argoappv1.AnnotationKeyRefreshSource] = string(source)
+	}
+	if hydrate {
+		annotations[argoappv1.AnnotationKeyHydrate] = string(argoappv1.HydrateTypeNormal)
+	}
 	metadata := map[string]any{
 		"metadata": map[string]any{
-			"annotations": map[string]string{
-				argoappv1.AnnotationKeyRefresh: string(refreshType),
-			},
+			"annotations": annotations,
 		},
 	}
-	if hydrate {
-		metadata["metadata"].(map[string]any)["annotations"].(map[string]string)[argoappv1.AnnotationKeyHydrate] = string(argoappv1.HydrateTypeNormal)
-	}
 
 	var err error
 	patch, err := json.Marshal(metadata)
@@ -483,7 +496,7 @@ func validateRepo(ctx context.Context,
 	// If using the source hydrator, check the dry source instead of the sync source, since the sync source branch may
 	// not exist yet.
 	if app.Spec.SourceHydrator != nil {
-		sources = []argoappv1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}
+		sources = app.Spec.SourceHydrator.GetDrySources()
 	}
 
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{})
@@ -597,6 +610,32 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "spec.sourceHydrator.drySource.repoURL is required",
 		})
 	}
+	if hydrator.DrySource.Chart != "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.drySource must be a git source, use spec.sourceHydrator.drySources to hydrate Helm charts",
+		})
+	} else if hydrator.DrySource.Path == "" && hydrator.DrySource.Ref == "" {
+		// The path is optional in the schema, since DrySource is also the type of the drySources, which may be charts.
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.drySource.path is required, use '.' for the repository root",
+		})
+	}
+	for i, source := range hydrator.DrySources {
+		if source.RepoURL == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.drySources[%d].repoURL is required", i),
+			})
+		}
+		if source.Path == "" && source.Chart == "" && source.Ref == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.drySources[%d] requires a path, a chart or a ref", i),
+			})
+		}
+	}
 	if hydrator.SyncSource.TargetBranch == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -609,6 +648,24 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.path is required",
 		})
 	}
+	if hydrator.SyncSource.RepoURL != "" {
+		if !hydrator.SyncSource.IsOCI() {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.syncSource.repoURL must be an OCI repository URL (oci://...)",
+			})
+		} else if hydrator.HydrateTo != nil && hydrator.HydrateTo.PullRequest != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.hydrateTo.pullRequest is not supported when hydrating to an OCI repository",
+			})
+		} else if hydrator.HasMultipleDrySources() {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.drySources is not supported when hydrating to an OCI repository",
+			})
+		}
+	}
 	return conditions
 }
 
@@ -623,10 +680,18 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			conditions = append(conditions, condition...)
 			return conditions, nil
 		}
-		if !proj.IsSourcePermitted(spec.SourceHydrator.GetDrySource()) {
+		for _, source := range spec.SourceHydrator.GetDrySources() {
+			if !proj.IsSourcePermitted(source) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name),
+				})
+			}
+		}
+		if spec.SourceHydrator.SyncSource.IsOCI() && !proj.IsSourcePermitted(spec.SourceHydrator.GetSyncSource()) {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
+				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.SyncSource.RepoURL, proj.Name),
 			})
 		}
 	case spec.HasMultipleSources():
@@ -658,6 +723,28 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	if _, err := spec.SyncPolicy.GetTimeout(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.syncPolicy.timeout is invalid: %v", err),
+		})
+	}
+
+	if _, err := spec.SyncPolicy.GetPruneThreshold(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.syncPolicy.pruneThreshold is invalid: %v", err),
+		})
+	}
+	if spec.SyncPolicy != nil {
+		if _, err := spec.SyncPolicy.AutoRollback.GetWindow(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.autoRollback.window is invalid: %v", err),
+			})
+		}
+	}
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -877,7 +964,7 @@ func verifyGenerateManifests(
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enableGenerateManifests,
 			NoRevisionCache:                 true,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              app.Spec.HasMultipleSources() || len(sources) > 1,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -929,6 +1016,37 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// NewRollbackOperation returns the sync operation rolling the given application back to the given revision history item.
+// Rollback is just a convenience around Sync: the revisions and sources of the history item are synced with the apply
+// strategy.
+func NewRollbackOperation(app *argoappv1.Application, deploymentInfo *argoappv1.RevisionHistory, dryRun bool, prune bool, initiatedBy argoappv1.OperationInitiator) (*argoappv1.Operation, error) {
+	if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
+		// Since source type was introduced to history starting with v0.12, and is now required for
+		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+		// As multi source doesn't use app.Source, we need to check to the Sources length
+		return nil, errors.New("cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+	}
+
+	var syncOptions argoappv1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+
+	return &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{
+			Revision:     deploymentInfo.Revision,
+			Revisions:    deploymentInfo.Revisions,
+			DryRun:       dryRun,
+			Prune:        prune,
+			SyncOptions:  syncOptions,
+			SyncStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+			Source:       &deploymentInfo.Source,
+			Sources:      deploymentInfo.Sources,
+		},
+		InitiatedBy: initiatedBy,
+	}, nil
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
@@ -784,6 +784,93 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Invalid sync timeout result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{Timeout: "-5m"},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "spec.syncPolicy.timeout is invalid: timeout -5m must not be negative", conditions[0].Message)
+	})
+
+	t.Run("Invalid auto-rollback window result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{AutoRollback: &argoappv1.SyncPolicyAutoRollback{Window: "-5m"}},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "spec.syncPolicy.autoRollback.window is invalid: window -5m must be positive", conditions[0].Message)
+	})
+
+	t.Run("Invalid prune threshold result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{PruneThreshold: "200%"},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "spec.syncPolicy.pruneThreshold is invalid: prune threshold 200% must be a percentage between 0% and 100%", conditions[0].Message)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
@@ -950,6 +1037,28 @@ func TestValidatePermissions(t *testing.T) {
 	})
 }
 
+func TestNewRollbackOperation(t *testing.T) {
+	app := &argoappv1.Application{
+		Spec: argoappv1.ApplicationSpec{
+			SyncPolicy: &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"CreateNamespace=true"}},
+		},
+	}
+	source := argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"}
+
+	op, err := NewRollbackOperation(app, &argoappv1.RevisionHistory{ID: 1, Revision: "aaa", Source: source}, false, true, argoappv1.OperationInitiator{Username: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, "aaa", op.Sync.Revision)
+	assert.Equal(t, &source, op.Sync.Source)
+	assert.True(t, op.Sync.Prune)
+	assert.False(t, op.Sync.DryRun)
+	assert.True(t, op.Sync.IsApplyStrategy())
+	assert.Equal(t, argoappv1.SyncOptions{"CreateNamespace=true"}, op.Sync.SyncOptions)
+	assert.Equal(t, "admin", op.InitiatedBy.Username)
+
+	_, err = NewRollbackOperation(app, &argoappv1.RevisionHistory{ID: 1, Revision: "aaa"}, false, false, argoappv1.OperationInitiator{})
+	require.ErrorContains(t, err, "cannot rollback to revision deployed with Argo CD v0.11 or lower")
+}
+
 func TestSetAppOperations(t *testing.T) {
 	t.Run("Application not existing", func(t *testing.T) {
 		appIf := appclientset.NewSimpleClientset().ArgoprojV1alpha1().Applications("default")
@@ -1486,6 +1595,95 @@ func Test_GetRefSources(t *testing.T) {
 	})
 }
 
+func TestValidateSourceHydrator_OCI(t *testing.T) {
+	newHydrator := func() *argoappv1.SourceHydrator {
+		return &argoappv1.SourceHydrator{
+			DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+			SyncSource: argoappv1.SyncSource{TargetBranch: "env-dev", Path: "guestbook", RepoURL: "oci://registry.example.com/hydrated"},
+		}
+	}
+
+	t.Run("valid OCI repo URL", func(t *testing.T) {
+		assert.Empty(t, validateSourceHydrator(newHydrator()))
+	})
+
+	t.Run("git repo URL", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.SyncSource.RepoURL = "https://github.com/argoproj/hydrated"
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.syncSource.repoURL must be an OCI repository URL (oci://...)", conditions[0].Message)
+	})
+
+	t.Run("pull request", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.HydrateTo = &argoappv1.HydrateTo{TargetBranch: "env-dev-next", PullRequest: &argoappv1.HydrateToPullRequest{Provider: argoappv1.PullRequestProviderGitHub}}
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.hydrateTo.pullRequest is not supported when hydrating to an OCI repository", conditions[0].Message)
+	})
+
+	t.Run("additional dry sources", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.DrySources = []argoappv1.DrySource{{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.2.3"}}
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.drySources is not supported when hydrating to an OCI repository", conditions[0].Message)
+	})
+}
+
+func TestValidateSourceHydrator_DrySources(t *testing.T) {
+	newHydrator := func() *argoappv1.SourceHydrator {
+		return &argoappv1.SourceHydrator{
+			DrySource: argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Ref: "values"},
+			DrySources: []argoappv1.DrySource{{
+				RepoURL:        "https://charts.example.com",
+				Chart:          "guestbook",
+				TargetRevision: "1.2.3",
+				Helm:           &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"$values/guestbook/values.yaml"}},
+			}},
+			SyncSource: argoappv1.SyncSource{TargetBranch: "env-dev", Path: "guestbook"},
+		}
+	}
+
+	t.Run("valid dry sources", func(t *testing.T) {
+		assert.Empty(t, validateSourceHydrator(newHydrator()))
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.DrySource.Ref = ""
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.drySource.path is required, use '.' for the repository root", conditions[0].Message)
+	})
+
+	t.Run("dry source without path, chart or ref", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.DrySources[0].Chart = ""
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.drySources[0] requires a path, a chart or a ref", conditions[0].Message)
+	})
+
+	t.Run("missing repo URL", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.DrySources[0].RepoURL = ""
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.drySources[0].repoURL is required", conditions[0].Message)
+	})
+
+	t.Run("Helm chart dry source", func(t *testing.T) {
+		hydrator := newHydrator()
+		hydrator.DrySource = hydrator.DrySources[0]
+		hydrator.DrySources = nil
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.sourceHydrator.drySource must be a git source, use spec.sourceHydrator.drySources to hydrate Helm charts", conditions[0].Message)
+	})
+}
+
 func TestValidatePermissionsMultipleSources(t *testing.T) {
 	t.Run("Empty Repo URL result in condition", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
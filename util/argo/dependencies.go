@@ -0,0 +1,98 @@
+package argo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+// GetDependencyGraph returns the application and the applications it transitively depends on, keyed by their
+// qualified name. Applications which do not exist are mapped to nil.
+func GetDependencyGraph(app *argoappv1.Application, appLister applicationsv1.ApplicationLister) (map[string]*argoappv1.Application, error) {
+	graph := map[string]*argoappv1.Application{app.QualifiedName(): app}
+	queue := []*argoappv1.Application{app}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dep := range current.GetDependsOn() {
+			key := dep.QualifiedName()
+			if _, ok := graph[key]; ok {
+				continue
+			}
+			depApp, err := appLister.Applications(dep.Namespace).Get(dep.Name)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("error getting application %s: %w", key, err)
+				}
+				graph[key] = nil
+				continue
+			}
+			graph[key] = depApp
+			queue = append(queue, depApp)
+		}
+	}
+	return graph, nil
+}
+
+// ValidateDependencies returns an error if the applications the application transitively depends on contain a cycle,
+// e.g. because one of them depends on the application itself.
+func ValidateDependencies(app *argoappv1.Application, appLister applicationsv1.ApplicationLister) error {
+	graph, err := GetDependencyGraph(app, appLister)
+	if err != nil {
+		return err
+	}
+	if cycle := findDependencyCycle(graph, app.QualifiedName(), nil, map[string]bool{}); cycle != nil {
+		return fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// findDependencyCycle walks the dependencies of the given application depth first and returns the first cycle found.
+func findDependencyCycle(graph map[string]*argoappv1.Application, key string, path []string, visited map[string]bool) []string {
+	if i := slices.Index(path, key); i >= 0 {
+		return append(slices.Clone(path[i:]), key)
+	}
+	app := graph[key]
+	if app == nil || visited[key] {
+		return nil
+	}
+	visited[key] = true
+	path = append(path, key)
+	for _, dep := range app.GetDependsOn() {
+		if cycle := findDependencyCycle(graph, dep.QualifiedName(), path, visited); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// IsDependencyReady returns whether the application is synced and healthy, so applications depending on it may sync.
+func IsDependencyReady(app *argoappv1.Application) bool {
+	return app.Status.Sync.Status == argoappv1.SyncStatusCodeSynced && app.Status.Health.Status == health.HealthStatusHealthy
+}
+
+// GetDependenciesNotReady returns a description of each application the application directly depends on which is not
+// synced and healthy.
+func GetDependenciesNotReady(app *argoappv1.Application, appLister applicationsv1.ApplicationLister) ([]string, error) {
+	var notReady []string
+	for _, dep := range app.GetDependsOn() {
+		depApp, err := appLister.Applications(dep.Namespace).Get(dep.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting application %s: %w", dep.QualifiedName(), err)
+			}
+			notReady = append(notReady, dep.QualifiedName()+" (not found)")
+			continue
+		}
+		if !IsDependencyReady(depApp) {
+			notReady = append(notReady, fmt.Sprintf("%s (%s, %s)", dep.QualifiedName(), depApp.Status.Sync.Status, depApp.Status.Health.Status))
+		}
+	}
+	return notReady, nil
+}
@@ -0,0 +1,95 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+func newDependencyApp(name string, sync argoappv1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *argoappv1.Application {
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Status: argoappv1.ApplicationStatus{
+			Sync:   argoappv1.SyncStatus{Status: sync},
+			Health: argoappv1.AppHealthStatus{Status: healthStatus},
+		},
+	}
+	for _, dep := range dependsOn {
+		app.Spec.DependsOn = append(app.Spec.DependsOn, argoappv1.ApplicationDependency{Name: dep})
+	}
+	return app
+}
+
+func newDependencyLister(t *testing.T, apps ...*argoappv1.Application) applicationsv1.ApplicationLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		require.NoError(t, indexer.Add(app))
+	}
+	return applicationsv1.NewApplicationLister(indexer)
+}
+
+func TestGetDependencyGraph(t *testing.T) {
+	db := newDependencyApp("db", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	backend := newDependencyApp("backend", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "db", "cache")
+	frontend := newDependencyApp("frontend", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+
+	graph, err := GetDependencyGraph(frontend, newDependencyLister(t, db, backend, frontend))
+	require.NoError(t, err)
+	assert.Len(t, graph, 4)
+	assert.Equal(t, frontend, graph["argocd/frontend"])
+	assert.Equal(t, backend, graph["argocd/backend"])
+	assert.Equal(t, db, graph["argocd/db"])
+	assert.Contains(t, graph, "argocd/cache")
+	assert.Nil(t, graph["argocd/cache"])
+}
+
+func TestValidateDependencies(t *testing.T) {
+	t.Run("No cycle", func(t *testing.T) {
+		db := newDependencyApp("db", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		redis := newDependencyApp("redis", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "db")
+		backend := newDependencyApp("backend", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "db", "redis")
+		require.NoError(t, ValidateDependencies(backend, newDependencyLister(t, db, redis)))
+	})
+	t.Run("Depends on itself", func(t *testing.T) {
+		app := newDependencyApp("app", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "app")
+		err := ValidateDependencies(app, newDependencyLister(t))
+		require.EqualError(t, err, "dependency cycle detected: argocd/app -> argocd/app")
+	})
+	t.Run("Cycle through other applications", func(t *testing.T) {
+		db := newDependencyApp("db", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "frontend")
+		backend := newDependencyApp("backend", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "db")
+		frontend := newDependencyApp("frontend", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "backend")
+		// the updated spec of the application is validated rather than the one in the lister
+		stored := frontend.DeepCopy()
+		stored.Spec.DependsOn = nil
+		err := ValidateDependencies(frontend, newDependencyLister(t, db, backend, stored))
+		require.EqualError(t, err, "dependency cycle detected: argocd/frontend -> argocd/backend -> argocd/db -> argocd/frontend")
+	})
+}
+
+func TestGetDependenciesNotReady(t *testing.T) {
+	db := newDependencyApp("db", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	redis := newDependencyApp("redis", argoappv1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+	queue := newDependencyApp("queue", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	lister := newDependencyLister(t, db, redis, queue)
+
+	notReady, err := GetDependenciesNotReady(newDependencyApp("backend", "", "", "db"), lister)
+	require.NoError(t, err)
+	assert.Empty(t, notReady)
+
+	notReady, err = GetDependenciesNotReady(newDependencyApp("backend", "", "", "db", "redis", "queue", "missing"), lister)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"argocd/redis (Synced, Progressing)",
+		"argocd/queue (OutOfSync, Healthy)",
+		"argocd/missing (not found)",
+	}, notReady)
+}
@@ -3,6 +3,7 @@ package managedfields
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,6 +86,54 @@ func normalize(mf metav1.ManagedFieldsEntry, tr *typedResults) error {
 	return nil
 }
 
+// Drift holds the fields of a live resource which diverge from its desired state
+type Drift struct {
+	// FieldPaths holds the paths of the diverging fields
+	FieldPaths []string
+	// Managers holds the field managers which own the diverging fields in the live resource
+	Managers []string
+}
+
+// ComputeDrift will compare the live and config states and return the
+// paths of the fields which differ, along with the managers owning those
+// fields according to the given managedFields of the live resource. Fields
+// which are missing in the live resource have no manager. If pt is nil,
+// the comparison will use a deduced parseable type.
+func ComputeDrift(live, config *unstructured.Unstructured, managedFields []metav1.ManagedFieldsEntry, pt *typed.ParseableType) (*Drift, error) {
+	if pt == nil {
+		pt = &typed.DeducedParseableType
+	}
+	results, err := newTypedResults(live, config, pt)
+	if err != nil {
+		return nil, err
+	}
+	drifted := results.comparison.Modified.Union(results.comparison.Added).Union(results.comparison.Removed)
+	drift := &Drift{}
+	drifted.Iterate(func(path fieldpath.Path) {
+		drift.FieldPaths = append(drift.FieldPaths, path.String())
+	})
+	sort.Strings(drift.FieldPaths)
+
+	// fields added by the config are not part of the live resource, so they can't be owned by any manager
+	owned := results.comparison.Modified.Union(results.comparison.Removed)
+	managers := map[string]bool{}
+	for _, mf := range managedFields {
+		if mf.FieldsV1 == nil || managers[mf.Manager] {
+			continue
+		}
+		mfs := &fieldpath.Set{}
+		if err := mfs.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error parsing fields of manager %s: %w", mf.Manager, err)
+		}
+		if !mfs.Intersection(owned).Empty() {
+			managers[mf.Manager] = true
+			drift.Managers = append(drift.Managers, mf.Manager)
+		}
+	}
+	sort.Strings(drift.Managers)
+	return drift, nil
+}
+
 type typedResults struct {
 	live       *typed.TypedValue
 	config     *typed.TypedValue
@@ -177,3 +177,35 @@ func StrToUnstructured(jsonStr string) *unstructured.Unstructured {
 	}
 	return &unstructured.Unstructured{Object: obj}
 }
+
+func TestComputeDrift(t *testing.T) {
+	parser := scheme.StaticParser()
+	pt := parser.Type("io.k8s.api.apps.v1.Deployment")
+	t.Run("will return the drifted fields and their managers", func(t *testing.T) {
+		// given
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desiredState := liveState.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(desiredState.Object, int64(3), "spec", "replicas"))
+		require.NoError(t, unstructured.SetNestedField(desiredState.Object, int64(10), "spec", "minReadySeconds"))
+
+		// when
+		drift, err := managedfields.ComputeDrift(liveState, desiredState, liveState.GetManagedFields(), &pt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{".spec.minReadySeconds", ".spec.replicas"}, drift.FieldPaths)
+		assert.Equal(t, []string{"argocd", "kube-controller-manager"}, drift.Managers)
+	})
+	t.Run("will return no field if the states are equal", func(t *testing.T) {
+		// given
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+
+		// when
+		drift, err := managedfields.ComputeDrift(liveState, liveState.DeepCopy(), liveState.GetManagedFields(), nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, drift.FieldPaths)
+		assert.Empty(t, drift.Managers)
+	})
+}
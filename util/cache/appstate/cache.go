@@ -20,6 +20,8 @@ var (
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// the drift history is only written when it changes, so it outlives the other app state items
+	driftHistoryCacheExpiration = 7 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -71,6 +73,22 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+func appDriftHistoryKey(appName string) string {
+	return "app|drift-history|" + appName
+}
+
+func (c *Cache) GetAppDriftHistory(appName string, res *[]*appv1.ResourceDriftHistory) error {
+	err := c.GetItem(appDriftHistoryKey(appName), &res)
+	return err
+}
+
+func (c *Cache) SetAppDriftHistory(appName string, driftHistory []*appv1.ResourceDriftHistory) error {
+	sort.Slice(driftHistory, func(i, j int) bool {
+		return driftHistory[i].FullName() < driftHistory[j].FullName()
+	})
+	return c.SetItem(appDriftHistoryKey(appName), driftHistory, driftHistoryCacheExpiration, driftHistory == nil)
+}
+
 func appResourcesTreeKey(appName string, shard int64) string {
 	key := "app|resources-tree|" + appName
 	if shard > 0 {
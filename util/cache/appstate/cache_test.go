@@ -41,6 +41,29 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppDriftHistory(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]*ResourceDriftHistory{}
+	err := cache.GetAppDriftHistory("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppDriftHistory("my-appname", []*ResourceDriftHistory{{Name: "b"}, {Name: "a"}})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppDriftHistory("other-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppDriftHistory("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]*ResourceDriftHistory{{Name: "a"}, {Name: "b"}}, value)
+	// delete
+	err = cache.SetAppDriftHistory("my-appname", nil)
+	require.NoError(t, err)
+	err = cache.GetAppDriftHistory("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
@@ -372,6 +372,9 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if c.ClusterResources {
 		data["clusterResources"] = []byte("true")
 	}
+	if c.SyncConcurrencyLimit != nil {
+		data["syncConcurrencyLimit"] = []byte(strconv.FormatInt(*c.SyncConcurrencyLimit, 10))
+	}
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
@@ -429,6 +432,14 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 			shard = ptr.To(int64(val))
 		}
 	}
+	var syncConcurrencyLimit *int64
+	if limitStr := s.Data["syncConcurrencyLimit"]; limitStr != nil {
+		if val, err := strconv.ParseInt(string(limitStr), 10, 64); err != nil {
+			log.Warnf("Error while parsing sync concurrency limit in cluster secret '%s': %v", s.Name, err)
+		} else {
+			syncConcurrencyLimit = ptr.To(val)
+		}
+	}
 
 	// copy labels and annotations excluding system ones
 	labels := map[string]string{}
@@ -445,17 +456,18 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 	}
 
 	cluster := appv1.Cluster{
-		ID:                 string(s.UID),
-		Server:             strings.TrimRight(string(s.Data["server"]), "/"),
-		Name:               string(s.Data["name"]),
-		Namespaces:         namespaces,
-		ClusterResources:   string(s.Data["clusterResources"]) == "true",
-		Config:             config,
-		RefreshRequestedAt: refreshRequestedAt,
-		Shard:              shard,
-		Project:            string(s.Data["project"]),
-		Labels:             labels,
-		Annotations:        annotations,
+		ID:                   string(s.UID),
+		Server:               strings.TrimRight(string(s.Data["server"]), "/"),
+		Name:                 string(s.Data["name"]),
+		Namespaces:           namespaces,
+		ClusterResources:     string(s.Data["clusterResources"]) == "true",
+		Config:               config,
+		RefreshRequestedAt:   refreshRequestedAt,
+		Shard:                shard,
+		SyncConcurrencyLimit: syncConcurrencyLimit,
+		Project:              string(s.Data["project"]),
+		Labels:               labels,
+		Annotations:          annotations,
 	}
 	return &cluster, nil
 }
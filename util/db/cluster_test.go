@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -117,6 +118,27 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
 
+func TestClusterToSecret_SyncConcurrencyLimit(t *testing.T) {
+	cluster := &v1alpha1.Cluster{
+		Server:               "http://mycluster",
+		Name:                 "test",
+		SyncConcurrencyLimit: ptr.To(int64(10)),
+	}
+	s := &corev1.Secret{}
+	err := clusterToSecret(cluster, s)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("10"), s.Data["syncConcurrencyLimit"])
+
+	converted, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(int64(10)), converted.SyncConcurrencyLimit)
+
+	s.Data["syncConcurrencyLimit"] = []byte("many")
+	converted, err = SecretToCluster(s)
+	require.NoError(t, err)
+	assert.Nil(t, converted.SyncConcurrencyLimit)
+}
+
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	cluster := &v1alpha1.Cluster{
 		Server:      "server",
@@ -146,6 +146,9 @@ type Client interface {
 	CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error)
 	// RemoveContents removes all files from the given paths in the git repository.
 	RemoveContents(paths []string) (string, error)
+	// RestoreContents restores the given paths in the git repository to their contents at the given revision. Files
+	// added to the paths since the revision are removed.
+	RestoreContents(revision string, paths []string) (string, error)
 	// CommitAndPush commits and pushes changes to the target branch.
 	CommitAndPush(branch, message string) (string, error)
 	// GetCommitNote gets the note associated with the DRY sha stored in the specific namespace
@@ -154,6 +157,9 @@ type Client interface {
 	AddAndPushNote(sha string, namespace string, note string) error
 	// HasFileChanged returns the outout of git diff considering whether it is tracked or un-tracked
 	HasFileChanged(filePath string) (bool, error)
+	// DiffWorkingTree stages all changes in the working tree, including untracked files, and returns the unified diff
+	// of each changed file against HEAD, keyed by file path.
+	DiffWorkingTree() (map[string]string, error)
 }
 
 type EventHandlers struct {
@@ -186,6 +192,8 @@ type nativeGitClient struct {
 	noProxy string
 	// git configuration environment variables
 	gitConfigEnv []string
+	// signingOpts configures the key used to sign commits, if any
+	signingOpts *CommitSigningOpts
 }
 
 type runOpts struct {
@@ -249,6 +257,30 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// CommitSigningFormat is the format of the signatures added to commits, i.e. the value of git's gpg.format option.
+type CommitSigningFormat string
+
+const (
+	// CommitSigningFormatOpenPGP signs commits with a GnuPG key from Argo CD's GnuPG keyring.
+	CommitSigningFormatOpenPGP CommitSigningFormat = "openpgp"
+	// CommitSigningFormatSSH signs commits with an SSH private key.
+	CommitSigningFormatSSH CommitSigningFormat = "ssh"
+)
+
+// CommitSigningOpts configures the key used to sign commits.
+type CommitSigningOpts struct {
+	Format CommitSigningFormat
+	// Key is the ID of the GnuPG key, or the path to the SSH private key, used to sign commits.
+	Key string
+}
+
+// WithCommitSigning makes the git client sign the commits and notes it creates
+func WithCommitSigning(opts *CommitSigningOpts) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.signingOpts = opts
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile(`([/:])`)
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -1090,6 +1122,24 @@ func (m *nativeGitClient) RemoveContents(paths []string) (string, error) {
 	return "", nil
 }
 
+// RestoreContents restores the given paths of the git repository to their contents at the given revision.
+func (m *nativeGitClient) RestoreContents(revision string, paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+	// Clear the paths first, so that files which didn't exist at the revision are removed as well.
+	out, err := m.RemoveContents(paths)
+	if err != nil {
+		return out, err
+	}
+	args := append([]string{"checkout", revision, "--"}, paths...)
+	out, err = m.runCmd(context.Background(), args...)
+	if err != nil {
+		return out, fmt.Errorf("failed to restore paths %v from revision %s: %w", paths, revision, err)
+	}
+	return "", nil
+}
+
 // CommitAndPush commits and pushes changes to the target branch.
 func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error) {
 	ctx := context.Background()
@@ -1098,7 +1148,11 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 		return out, fmt.Errorf("failed to add files: %w", err)
 	}
 
-	out, err = m.runCmd(ctx, "commit", "-m", message)
+	args := []string{"commit", "-m", message}
+	if m.signingOpts != nil {
+		args = append(args, "--gpg-sign")
+	}
+	out, err = m.runSigningCmd(ctx, args...)
 	if err != nil {
 		if strings.Contains(out, "nothing to commit, working tree clean") {
 			return out, nil
@@ -1152,6 +1206,12 @@ func (m *nativeGitClient) AddAndPushNote(sha string, namespace string, note stri
 	if err != nil {
 		return fmt.Errorf("failed to push: %w", err)
 	}
+	if m.signingOpts != nil {
+		err = m.signNotesCommit(ctx, "refs/notes/"+namespace)
+		if err != nil {
+			return fmt.Errorf("failed to sign note: %w", err)
+		}
+	}
 	if m.OnPush != nil {
 		done := m.OnPush(m.repoURL)
 		defer done()
@@ -1165,6 +1225,36 @@ func (m *nativeGitClient) AddAndPushNote(sha string, namespace string, note stri
 	return nil
 }
 
+// signNotesCommit replaces the commit at the tip of the given notes ref with a signed copy, since git notes always
+// creates unsigned commits.
+func (m *nativeGitClient) signNotesCommit(ctx context.Context, notesRef string) error {
+	tree, err := m.runCmd(ctx, "rev-parse", notesRef+"^{tree}")
+	if err != nil {
+		return fmt.Errorf("failed to get notes tree: %w", err)
+	}
+	message, err := m.runCmd(ctx, "log", "-1", "--format=%B", notesRef)
+	if err != nil {
+		return fmt.Errorf("failed to get notes commit message: %w", err)
+	}
+	args := []string{"commit-tree", "--gpg-sign", "-m", message}
+	// The first note added to a namespace creates a commit without a parent.
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", notesRef+"^")
+	parent, err := m.runCmdOutput(cmd, runOpts{SkipErrorLogging: true})
+	if err == nil && strings.TrimSpace(parent) != "" {
+		args = append(args, "-p", strings.TrimSpace(parent))
+	}
+	args = append(args, strings.TrimSpace(tree))
+	sha, err := m.runSigningCmd(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create signed notes commit: %w", err)
+	}
+	_, err = m.runCmd(ctx, "update-ref", notesRef, strings.TrimSpace(sha))
+	if err != nil {
+		return fmt.Errorf("failed to update notes ref: %w", err)
+	}
+	return nil
+}
+
 // HasFileChanged returns the outout of git diff considering whether it is tracked or un-tracked
 func (m *nativeGitClient) HasFileChanged(filePath string) (bool, error) {
 	// Step 1: Is it UNTRACKED? (file is new to git)
@@ -1186,6 +1276,32 @@ func (m *nativeGitClient) HasFileChanged(filePath string) (bool, error) {
 	return false, fmt.Errorf("git diff failed: %w", err)
 }
 
+// DiffWorkingTree stages all changes in the working tree, including untracked files, and returns the unified diff
+// of each changed file against HEAD, keyed by file path.
+func (m *nativeGitClient) DiffWorkingTree() (map[string]string, error) {
+	ctx := context.Background()
+	_, err := m.runCmd(ctx, "add", "--all")
+	if err != nil {
+		return nil, fmt.Errorf("failed to stage changes: %w", err)
+	}
+	out, err := m.runCmd(ctx, "diff", "--cached", "--name-only")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changed files: %w", err)
+	}
+	diffs := make(map[string]string)
+	if out == "" {
+		return diffs, nil
+	}
+	for _, file := range strings.Split(out, "\n") {
+		diff, err := m.runCmd(ctx, "diff", "--cached", "--no-color", "--", file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", file, err)
+		}
+		diffs[file] = diff
+	}
+	return diffs, nil
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(ctx context.Context, wrapper string, args ...string) (string, error) {
 	cmd := exec.CommandContext(ctx, wrapper, args...)
@@ -1199,6 +1315,17 @@ func (m *nativeGitClient) runCmd(ctx context.Context, args ...string) (string, e
 	return m.runCmdOutput(cmd, runOpts{})
 }
 
+// runSigningCmd is a convenience function to run a git command that signs a commit with the configured signing key
+func (m *nativeGitClient) runSigningCmd(ctx context.Context, args ...string) (string, error) {
+	if m.signingOpts == nil {
+		return m.runCmd(ctx, args...)
+	}
+	args = append([]string{"-c", "gpg.format=" + string(m.signingOpts.Format), "-c", "user.signingKey=" + m.signingOpts.Key}, args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(cmd.Env, "GNUPGHOME="+common.GetGnuPGHomePath(), "LANG=C")
+	return m.runCmdOutput(cmd, runOpts{})
+}
+
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(ctx context.Context, args ...string) error {
 	closer, environ, err := m.creds.Environ()
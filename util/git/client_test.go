@@ -861,6 +861,62 @@ func Test_nativeGitClient_RemoveContents_SpecificPath(t *testing.T) {
 	require.Equal(t, "README.md", strings.TrimSpace(string(ls)))
 }
 
+func Test_nativeGitClient_RestoreContents(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	_, err = client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err)
+
+	err = os.MkdirAll(filepath.Join(client.Root(), "app"), 0o755)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "app", "manifest.yaml"), []byte("first version\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "README.md"), []byte("first version\n"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "add", "--all")
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "commit", "-m", "First version")
+	require.NoError(t, err)
+	firstSha, err := client.CommitSHA()
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "app", "manifest.yaml"), []byte("second version\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "app", "added.yaml"), []byte("added\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "README.md"), []byte("second version\n"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "add", "--all")
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "commit", "-m", "Second version")
+	require.NoError(t, err)
+
+	// when: restore only the "app" directory to the first commit
+	out, err := client.RestoreContents(firstSha, []string{"app"})
+	require.NoError(t, err, "error output: ", out)
+
+	// then: "app" is at its first version, files added since are gone, and other files are untouched
+	manifest, err := os.ReadFile(filepath.Join(client.Root(), "app", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "first version\n", string(manifest))
+	_, err = os.Stat(filepath.Join(client.Root(), "app", "added.yaml"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	readme, err := os.ReadFile(filepath.Join(client.Root(), "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "second version\n", string(readme))
+
+	_, err = client.RestoreContents(firstSha, []string{"missing"})
+	require.ErrorContains(t, err, "failed to restore paths [missing]")
+}
+
 func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	ctx := t.Context()
 	tempDir, err := _createEmptyGitRepo(ctx)
@@ -909,6 +965,71 @@ func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	require.Equal(t, expectedCommitHash, actualCommitHash)
 }
 
+func Test_nativeGitClient_CommitAndPush_Signed(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	err = runCmd(ctx, tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	// Sign with an SSH key, which doesn't need a GnuPG keyring.
+	keyDir := t.TempDir()
+	keyPath := filepath.Join(keyDir, "signing-key")
+	err = runCmd(ctx, keyDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "test@example.com", "-f", keyPath)
+	require.NoError(t, err)
+	publicKey, err := os.ReadFile(keyPath + ".pub")
+	require.NoError(t, err)
+	allowedSignersPath := filepath.Join(keyDir, "allowed_signers")
+	err = os.WriteFile(allowedSignersPath, []byte("test@example.com "+string(publicKey)), 0o644)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "", WithCommitSigning(&CommitSigningOpts{
+		Format: CommitSigningFormatSSH,
+		Key:    keyPath,
+	}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	err = client.Fetch(branch, 0)
+	require.NoError(t, err)
+
+	out, err = client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	err = runCmd(ctx, client.Root(), "touch", "README.md")
+	require.NoError(t, err)
+
+	out, err = client.CommitAndPush(branch, "docs: README")
+	require.NoError(t, err, "error output: %s", out)
+
+	sha, err := client.CommitSHA()
+	require.NoError(t, err)
+
+	// The note is added twice so that the second signed notes commit has a parent.
+	err = client.AddAndPushNote(sha, "", "first note")
+	require.NoError(t, err)
+	err = client.AddAndPushNote(sha, "", "second note")
+	require.NoError(t, err)
+
+	for _, revision := range []string{"HEAD", "refs/notes/commit", "refs/notes/commit^"} {
+		err = runCmd(ctx, tempDir, "git", "-c", "gpg.ssh.allowedSignersFile="+allowedSignersPath, "verify-commit", revision)
+		require.NoError(t, err, "expected %s to be signed", revision)
+	}
+	outBytes, err := outputCmd(ctx, tempDir, "git", "notes", "--ref=commit", "show", sha)
+	require.NoError(t, err)
+	require.Equal(t, "second note", strings.TrimSpace(string(outBytes)))
+}
+
 func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	tokenprovider := new(mocks.TokenProvider)
 	tokenprovider.EXPECT().GetToken(azureDevopsEntraResourceId).Return(&workloadidentity.Token{AccessToken: "accessToken"}, nil).Maybe()
@@ -1458,3 +1579,52 @@ func Test_nativeGitClient_HasFileChanged(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, changed, "expected modified file to be reported as changed")
 }
+
+func Test_nativeGitClient_DiffWorkingTree(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	err = runCmd(ctx, tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	err = client.Fetch(branch, 0)
+	require.NoError(t, err)
+
+	out, err = client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "modified.txt"), []byte("first version\n"), 0o644)
+	require.NoError(t, err)
+	out, err = client.CommitAndPush(branch, "add modified.txt")
+	require.NoError(t, err, "error output: %s", out)
+
+	diffs, err := client.DiffWorkingTree()
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "modified.txt"), []byte("second version\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "untracked.txt"), []byte("new file\n"), 0o644)
+	require.NoError(t, err)
+
+	diffs, err = client.DiffWorkingTree()
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	assert.Contains(t, diffs["modified.txt"], "-first version\n+second version")
+	assert.Contains(t, diffs["untracked.txt"], "new file mode")
+	assert.Contains(t, diffs["untracked.txt"], "+new file")
+}
@@ -490,6 +490,61 @@ func (_c *Client_CommitSHA_Call) RunAndReturn(run func() (string, error)) *Clien
 	return _c
 }
 
+// DiffWorkingTree provides a mock function for the type Client
+func (_mock *Client) DiffWorkingTree() (map[string]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffWorkingTree")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (map[string]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_DiffWorkingTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffWorkingTree'
+type Client_DiffWorkingTree_Call struct {
+	*mock.Call
+}
+
+// DiffWorkingTree is a helper method to define mock.On call
+func (_e *Client_Expecter) DiffWorkingTree() *Client_DiffWorkingTree_Call {
+	return &Client_DiffWorkingTree_Call{Call: _e.mock.On("DiffWorkingTree")}
+}
+
+func (_c *Client_DiffWorkingTree_Call) Run(run func()) *Client_DiffWorkingTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_DiffWorkingTree_Call) Return(stringToString map[string]string, err error) *Client_DiffWorkingTree_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *Client_DiffWorkingTree_Call) RunAndReturn(run func() (map[string]string, error)) *Client_DiffWorkingTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type Client
 func (_mock *Client) Fetch(revision string, depth int64) error {
 	ret := _mock.Called(revision, depth)
@@ -1117,6 +1172,72 @@ func (_c *Client_RemoveContents_Call) RunAndReturn(run func(paths []string) (str
 	return _c
 }
 
+// RestoreContents provides a mock function for the type Client
+func (_mock *Client) RestoreContents(revision string, paths []string) (string, error) {
+	ret := _mock.Called(revision, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreContents")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, []string) (string, error)); ok {
+		return returnFunc(revision, paths)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, []string) string); ok {
+		r0 = returnFunc(revision, paths)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = returnFunc(revision, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_RestoreContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreContents'
+type Client_RestoreContents_Call struct {
+	*mock.Call
+}
+
+// RestoreContents is a helper method to define mock.On call
+//   - revision string
+//   - paths []string
+func (_e *Client_Expecter) RestoreContents(revision interface{}, paths interface{}) *Client_RestoreContents_Call {
+	return &Client_RestoreContents_Call{Call: _e.mock.On("RestoreContents", revision, paths)}
+}
+
+func (_c *Client_RestoreContents_Call) Run(run func(revision string, paths []string)) *Client_RestoreContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_RestoreContents_Call) Return(s string, err error) *Client_RestoreContents_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_RestoreContents_Call) RunAndReturn(run func(revision string, paths []string) (string, error)) *Client_RestoreContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevisionMetadata provides a mock function for the type Client
 func (_mock *Client) RevisionMetadata(revision string) (*git.RevisionMetadata, error) {
 	ret := _mock.Called(revision)
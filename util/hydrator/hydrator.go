@@ -24,6 +24,44 @@ type HydratorCommitMetadata struct {
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
 	Body       string                    `json:"body,omitempty"`
 	References []appv1.RevisionReference `json:"references,omitempty"`
+	// Files lists the manifest files written to the hydrated path, relative to that path. It is only set for the
+	// per-resource manifest layouts, and used to remove the files of resources which no longer exist.
+	Files []string `json:"files,omitempty"`
+	// DrySources lists all the dry sources the manifests of the hydrated path were rendered from, along with their
+	// resolved revisions. It is only set if the path was hydrated from additional dry sources.
+	DrySources []DrySourceMetadata `json:"drySources,omitempty"`
+}
+
+// DrySourceMetadata describes a dry source the manifests of a hydrated path were rendered from.
+type DrySourceMetadata struct {
+	RepoURL string `json:"repoURL"`
+	Path    string `json:"path,omitempty"`
+	Chart   string `json:"chart,omitempty"`
+	Ref     string `json:"ref,omitempty"`
+	// Revision is the resolved revision of the source: a git SHA, a Helm chart version or an OCI digest.
+	Revision string `json:"revision"`
+}
+
+// GetDrySourcesMetadata returns the metadata of the given dry sources, resolved to the given revisions. It returns nil
+// unless there is one revision per source.
+func GetDrySourcesMetadata(drySources []*appv1.DrySource, revisions []string) []DrySourceMetadata {
+	if len(drySources) == 0 || len(drySources) != len(revisions) {
+		return nil
+	}
+	metadata := make([]DrySourceMetadata, 0, len(drySources))
+	for i, source := range drySources {
+		if source == nil {
+			return nil
+		}
+		metadata = append(metadata, DrySourceMetadata{
+			RepoURL:  source.RepoURL,
+			Path:     source.Path,
+			Chart:    source.Chart,
+			Ref:      source.Ref,
+			Revision: revisions[i],
+		})
+	}
+	return metadata
 }
 
 // GetCommitMetadata takes repo, drySha and commitMetadata and returns a HydratorCommitMetadata which is a
@@ -0,0 +1,180 @@
+package hydrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// ManifestGenerator generates the manifests of the dry sources of applications for hydration. It is shared by the app
+// controller, which hydrates the applications, and the API server, which previews their hydration, so that previews
+// render exactly the manifests that would be committed.
+type ManifestGenerator struct {
+	db            db.ArgoDB
+	settingsMgr   *settings.SettingsManager
+	repoClientset apiclient.Clientset
+	// namespace is the control plane namespace, used to determine the instance name of the applications
+	namespace string
+}
+
+// NewManifestGenerator returns a manifest generator which generates the manifests using the given repo server.
+func NewManifestGenerator(db db.ArgoDB, settingsMgr *settings.SettingsManager, repoClientset apiclient.Clientset, namespace string) *ManifestGenerator {
+	return &ManifestGenerator{
+		db:            db,
+		settingsMgr:   settingsMgr,
+		repoClientset: repoClientset,
+		namespace:     namespace,
+	}
+}
+
+// GetRepoObjs generates the manifests of the given dry sources of the application at the given revisions. The manifests
+// are generated without the runtime state of the destination cluster and without any cache, and the tracking metadata is
+// removed from them. It returns the generated manifests along with the responses of the repo server for each dry source.
+func (g *ManifestGenerator) GetRepoObjs(ctx context.Context, app *appv1.Application, drySources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	// The revisions are defaulted below, so don't modify the caller's slice.
+	dryRevisions := slices.Clone(revisions)
+
+	helmRepos, err := g.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list Helm repositories: %w", err)
+	}
+	permittedHelmRepos, err := argo.GetPermittedRepos(project, helmRepos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get permitted Helm repositories for project %q: %w", project.Name, err)
+	}
+	ociRepos, err := g.db.ListOCIRepositories(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list OCI repositories: %w", err)
+	}
+	permittedOCIRepos, err := argo.GetPermittedRepos(project, ociRepos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get permitted OCI repositories for project %q: %w", project.Name, err)
+	}
+	helmRepositoryCredentials, err := g.db.GetAllHelmRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get Helm credentials: %w", err)
+	}
+	permittedHelmCredentials, err := argo.GetPermittedReposCredentials(project, helmRepositoryCredentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get permitted Helm credentials for project %q: %w", project.Name, err)
+	}
+	ociRepositoryCredentials, err := g.db.GetAllOCIRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get OCI credentials: %w", err)
+	}
+	permittedOCICredentials, err := argo.GetPermittedReposCredentials(project, ociRepositoryCredentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get permitted OCI credentials for project %q: %w", project.Name, err)
+	}
+
+	appLabelKey, err := g.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
+	}
+	enabledSourceTypes, err := g.settingsMgr.GetEnabledSourceTypes()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get enabled source types: %w", err)
+	}
+	kustomizeSettings, err := g.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get Kustomize settings: %w", err)
+	}
+	helmOptions, err := g.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get Helm settings: %w", err)
+	}
+	trackingMethod, err := g.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tracking method: %w", err)
+	}
+	installationID, err := g.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get installation ID: %w", err)
+	}
+
+	conn, repoClient, err := g.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to repo server: %w", err)
+	}
+	defer utilio.Close(conn)
+
+	refSources, err := argo.GetRefSources(ctx, drySources, app.Spec.Project, g.db.GetRepository, dryRevisions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get ref sources: %w", err)
+	}
+
+	// The dry sources of an application are rendered like the sources of a multi-source application, so that they can
+	// refer to each other.
+	hasMultipleSources := app.Spec.HasMultipleSources() || len(drySources) > 1
+
+	objs := make([]*unstructured.Unstructured, 0)
+	resps := make([]*apiclient.ManifestResponse, 0, len(drySources))
+	for i, source := range drySources {
+		if len(dryRevisions) < len(drySources) || dryRevisions[i] == "" {
+			dryRevisions[i] = source.TargetRevision
+		}
+		repo, err := g.db.GetRepository(ctx, source.RepoURL, project.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get repo %q: %w", source.RepoURL, err)
+		}
+
+		repos := permittedHelmRepos
+		helmRepoCreds := permittedHelmCredentials
+		// If the source is OCI, there is a potential for an OCI image to be a Helm chart and that said chart in
+		// turn would have OCI dependencies. To ensure that those dependencies can be resolved, add them to the repos
+		// list.
+		if source.IsOCI() {
+			repos = slices.Concat(permittedHelmRepos, permittedOCIRepos)
+			helmRepoCreds = slices.Concat(permittedHelmCredentials, permittedOCICredentials)
+		}
+
+		log.Debugf("Generating manifests for hydration of source %s revision %s", source, dryRevisions[i])
+		// The manifest generate paths annotation is not passed, because the synced revision it is compared to is likely
+		// on the hydrated branch, not the dry branch.
+		resp, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			Repo:               repo,
+			Repos:              repos,
+			Revision:           dryRevisions[i],
+			NoCache:            true,
+			NoRevisionCache:    true,
+			AppLabelKey:        appLabelKey,
+			AppName:            app.InstanceName(g.namespace),
+			ApplicationSource:  &source,
+			KustomizeOptions:   kustomizeSettings,
+			HelmRepoCreds:      helmRepoCreds,
+			TrackingMethod:     trackingMethod,
+			EnabledSourceTypes: enabledSourceTypes,
+			HelmOptions:        helmOptions,
+			HasMultipleSources: hasMultipleSources,
+			RefSources:         refSources,
+			ProjectName:        project.Name,
+			ProjectSourceRepos: project.Spec.SourceRepos,
+			InstallationID:     installationID,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(drySources), err)
+		}
+		for _, manifest := range resp.Manifests {
+			obj, err := appv1.UnmarshalToUnstructured(manifest)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to unmarshal manifests for source %d of %d: %w", i+1, len(drySources), err)
+			}
+			if err := argo.NewResourceTracking().RemoveAppInstance(obj, trackingMethod); err != nil {
+				return nil, nil, fmt.Errorf("failed to remove the app instance value: %w", err)
+			}
+			objs = append(objs, obj)
+		}
+		resps = append(resps, resp)
+	}
+	return objs, resps, nil
+}
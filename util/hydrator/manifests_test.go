@@ -0,0 +1,89 @@
+package hydrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const configMapManifest = `{
+	"apiVersion": "v1",
+	"kind": "ConfigMap",
+	"metadata": {
+		"name": "my-map",
+		"annotations": {
+			"custom-annotation": "custom-value",
+			"argocd.argoproj.io/installation-id": "id",
+			"argocd.argoproj.io/tracking-id": "my-app:/ConfigMap:default/my-map"
+		}
+	}
+}`
+
+func TestManifestGenerator_GetRepoObjs(t *testing.T) {
+	kubeClient := fake.NewClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+	argoDB := db.NewDB(test.FakeArgoCDNamespace, settingsMgr, kubeClient)
+
+	var requests []*apiclient.ManifestRequest
+	repoClient := mocks.NewRepoServerServiceClient(t)
+	repoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req *apiclient.ManifestRequest, _ ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+		requests = append(requests, req)
+		return &apiclient.ManifestResponse{Manifests: []string{configMapManifest}, Revision: req.Revision}, nil
+	}).Times(2)
+	generator := NewManifestGenerator(argoDB, settingsMgr, &mocks.Clientset{RepoServerServiceClient: repoClient}, test.FakeArgoCDNamespace)
+
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "app-ns"},
+		Spec:       appv1.ApplicationSpec{Project: "default"},
+	}
+	project := &appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec:       appv1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}
+	drySources := []appv1.ApplicationSource{
+		{RepoURL: "https://example.com/repo.git", Path: "base", TargetRevision: "main"},
+		{RepoURL: "https://example.com/repo.git", Path: "overlay", TargetRevision: "main"},
+	}
+	revisions := []string{"abc123", ""}
+
+	objs, resps, err := generator.GetRepoObjs(t.Context(), app, drySources, revisions, project)
+	require.NoError(t, err)
+	require.Len(t, resps, 2)
+	require.Len(t, objs, 2)
+	// the revisions of the caller are not modified
+	assert.Equal(t, []string{"abc123", ""}, revisions)
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "abc123", requests[0].Revision)
+	assert.Equal(t, "main", requests[1].Revision)
+	for _, req := range requests {
+		assert.True(t, req.NoCache)
+		assert.True(t, req.NoRevisionCache)
+		assert.True(t, req.HasMultipleSources)
+		assert.Equal(t, "app-ns_my-app", req.AppName)
+		assert.Empty(t, req.Namespace)
+		assert.Empty(t, req.AnnotationManifestGeneratePaths)
+	}
+
+	// only the tracking metadata set by Argo CD is removed
+	for _, obj := range objs {
+		annotations := obj.GetAnnotations()
+		assert.Equal(t, "custom-value", annotations["custom-annotation"])
+		assert.NotContains(t, annotations, common.AnnotationInstallationID)
+		assert.NotContains(t, annotations, common.AnnotationKeyAppInstance)
+	}
+}
@@ -0,0 +1,292 @@
+package hydrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// ProvenanceFile is the name of the file holding the provenance of the manifests of a hydrated path.
+	ProvenanceFile = "hydrator.provenance"
+	// StatementType is the type of the in-toto statement written to the provenance file.
+	StatementType = "https://in-toto.io/Statement/v1"
+	// ProvenancePredicateType is the type of the predicate of the in-toto statement written to the provenance file.
+	ProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// ProvenanceBuildType describes how the source hydrator turns the external parameters into manifests.
+	ProvenanceBuildType = "https://argo-cd.readthedocs.io/en/stable/user-guide/source-hydrator/#provenance"
+	// ProvenanceBuilderID identifies the source hydrator as the builder of the manifests.
+	ProvenanceBuilderID = "https://github.com/argoproj/argo-cd/commit-server"
+
+	gitCommitDigest = "gitCommit"
+	sha256Digest    = "sha256"
+)
+
+// Statement is an in-toto statement attesting the SLSA provenance of the manifests of a hydrated path. Its subjects are
+// the manifest files, named relative to the hydrated path. It holds no timestamps, so that hydrating the same dry
+// commit with the same tools always produces the same statement.
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     Provenance           `json:"predicate"`
+}
+
+// ResourceDescriptor describes a file or a dependency of the hydration by its digests. Dependencies without a digest,
+// like Helm charts, carry their version in the annotations instead.
+type ResourceDescriptor struct {
+	Name        string            `json:"name,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Provenance is the SLSA provenance predicate of the statement.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition describes the inputs of the hydration.
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   ExternalParameters   `json:"externalParameters"`
+	InternalParameters   InternalParameters   `json:"internalParameters"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies"`
+}
+
+// ExternalParameters holds the parameters of the hydration controlled by the Application.
+type ExternalParameters struct {
+	// DrySource holds the rendering parameters of the hydrated path.
+	DrySource *appv1.DrySource `json:"drySource,omitempty"`
+	// DrySources holds the rendering parameters of the additional dry sources of the hydrated path.
+	DrySources []*appv1.DrySource `json:"drySources,omitempty"`
+}
+
+// InternalParameters holds the parameters of the hydration chosen by Argo CD.
+type InternalParameters struct {
+	// Commands are the commands the repo-server ran to render the manifests.
+	Commands []string `json:"commands,omitempty"`
+}
+
+// RunDetails describes the hydration run.
+type RunDetails struct {
+	Builder Builder `json:"builder"`
+}
+
+// Builder identifies the builder of the manifests.
+type Builder struct {
+	ID string `json:"id"`
+	// Version holds the version of Argo CD and of the tools used to render the manifests, e.g. Helm or Kustomize.
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// NewProvenance returns the provenance statement of the given manifest files of a hydrated path, hydrated from the dry
+// source and commit of the given metadata, and from the given additional dry sources at the revisions recorded in the
+// metadata.
+func NewProvenance(metadata HydratorCommitMetadata, drySource *appv1.DrySource, drySources []*appv1.DrySource, toolVersions map[string]string, subjects []ResourceDescriptor) Statement {
+	versions := map[string]string{"argocd": common.GetVersion().Version}
+	for tool, version := range toolVersions {
+		versions[tool] = version
+	}
+	return Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: ProvenancePredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType:            ProvenanceBuildType,
+				ExternalParameters:   ExternalParameters{DrySource: drySource, DrySources: drySources},
+				InternalParameters:   InternalParameters{Commands: metadata.Commands},
+				ResolvedDependencies: resolvedDependencies(metadata),
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{ID: ProvenanceBuilderID, Version: versions},
+			},
+		},
+	}
+}
+
+// resolvedDependencies returns the descriptors of the dry sources of the given metadata. The dry source holding the
+// dry commit always comes first.
+func resolvedDependencies(metadata HydratorCommitMetadata) []ResourceDescriptor {
+	if len(metadata.DrySources) == 0 {
+		return []ResourceDescriptor{{
+			URI:    "git+" + metadata.RepoURL,
+			Digest: map[string]string{gitCommitDigest: metadata.DrySHA},
+		}}
+	}
+	dependencies := make([]ResourceDescriptor, 0, len(metadata.DrySources))
+	for _, source := range metadata.DrySources {
+		s := appv1.ApplicationSource{RepoURL: source.RepoURL, Chart: source.Chart}
+		switch {
+		case s.IsOCI():
+			dependency := ResourceDescriptor{Name: source.Chart, URI: source.RepoURL}
+			if algorithm, digest, ok := strings.Cut(source.Revision, ":"); ok && algorithm == sha256Digest {
+				dependency.Digest = map[string]string{sha256Digest: digest}
+			} else {
+				dependency.Annotations = map[string]string{"version": source.Revision}
+			}
+			dependencies = append(dependencies, dependency)
+		case s.IsHelm():
+			dependencies = append(dependencies, ResourceDescriptor{
+				Name:        source.Chart,
+				URI:         source.RepoURL,
+				Annotations: map[string]string{"version": source.Revision},
+			})
+		default:
+			dependencies = append(dependencies, ResourceDescriptor{
+				URI:    "git+" + source.RepoURL,
+				Digest: map[string]string{gitCommitDigest: source.Revision},
+			})
+		}
+	}
+	return dependencies
+}
+
+// DryRevision returns the URL of the dry source repository and the dry SHA the statement attests the hydration of.
+func (s *Statement) DryRevision() (repoURL string, drySHA string) {
+	for _, dependency := range s.Predicate.BuildDefinition.ResolvedDependencies {
+		if sha, ok := dependency.Digest[gitCommitDigest]; ok {
+			return strings.TrimPrefix(dependency.URI, "git+"), sha
+		}
+	}
+	return "", ""
+}
+
+// DigestFiles returns the descriptors of the given files of the directory dirPath, relative to root, with their SHA256
+// digests. The files are named relative to dirPath.
+func DigestFiles(root *os.Root, dirPath string, files []string) ([]ResourceDescriptor, error) {
+	subjects := make([]ResourceDescriptor, 0, len(files))
+	for _, file := range files {
+		digest, err := digestFile(root, filepath.Join(dirPath, file))
+		if err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, ResourceDescriptor{
+			Name:   filepath.ToSlash(file),
+			Digest: map[string]string{sha256Digest: digest},
+		})
+	}
+	return subjects, nil
+}
+
+// WriteProvenance writes the statement to the provenance file of the directory dirPath, relative to root.
+func WriteProvenance(root *os.Root, dirPath string, statement Statement) error {
+	f, err := root.Create(filepath.Join(dirPath, ProvenanceFile))
+	if err != nil {
+		return fmt.Errorf("failed to create hydrator provenance file: %w", err)
+	}
+	defer utilio.Close(f)
+	e := json.NewEncoder(f)
+	e.SetIndent("", "  ")
+	e.SetEscapeHTML(false)
+	err = e.Encode(statement)
+	if err != nil {
+		return fmt.Errorf("failed to encode hydrator provenance: %w", err)
+	}
+	return nil
+}
+
+// ReadProvenance reads the statement from the provenance file of the directory dirPath, relative to root.
+func ReadProvenance(root *os.Root, dirPath string) (*Statement, error) {
+	data, err := root.ReadFile(filepath.Join(dirPath, ProvenanceFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hydrator provenance: %w", err)
+	}
+	var statement Statement
+	err = json.Unmarshal(data, &statement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal hydrator provenance: %w", err)
+	}
+	if statement.Type != StatementType || statement.PredicateType != ProvenancePredicateType {
+		return nil, fmt.Errorf("unsupported hydrator provenance: statement type %q, predicate type %q", statement.Type, statement.PredicateType)
+	}
+	return &statement, nil
+}
+
+// VerifyProvenance verifies the manifest files of the hydrated directory dirPath, relative to root, against its
+// provenance file. It returns the statement and the problems found: subjects which are missing or whose digest doesn't
+// match, and YAML files next to the subjects which aren't subjects. An error is returned if the provenance can't be
+// read or the files can't be checked.
+func VerifyProvenance(root *os.Root, dirPath string) (*Statement, []string, error) {
+	statement, err := ReadProvenance(root, dirPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var problems []string
+	subjects := map[string]bool{}
+	dirs := map[string]bool{}
+	for _, subject := range statement.Subject {
+		if !filepath.IsLocal(filepath.FromSlash(subject.Name)) {
+			return nil, nil, fmt.Errorf("hydrator provenance has an invalid subject %q", subject.Name)
+		}
+		subjects[subject.Name] = true
+		dirs[path.Dir(subject.Name)] = true
+
+		expected, ok := subject.Digest[sha256Digest]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: no %s digest", subject.Name, sha256Digest))
+			continue
+		}
+		actual, err := digestFile(root, filepath.Join(dirPath, filepath.FromSlash(subject.Name)))
+		if errors.Is(err, fs.ErrNotExist) {
+			problems = append(problems, subject.Name+": missing")
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if actual != expected {
+			problems = append(problems, fmt.Sprintf("%s: %s digest %s does not match %s", subject.Name, sha256Digest, actual, expected))
+		}
+	}
+
+	// Look for manifests which were added to the directories of the subjects after the hydration.
+	for dir := range dirs {
+		entries, err := fs.ReadDir(root.FS(), filepath.ToSlash(filepath.Join(dirPath, dir)))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read directory %q: %w", dir, err)
+		}
+		for _, entry := range entries {
+			name := path.Join(dir, entry.Name())
+			if entry.IsDir() || subjects[name] || (!strings.HasSuffix(name, ".yaml") && !strings.HasSuffix(name, ".yml")) {
+				continue
+			}
+			problems = append(problems, name+": not in provenance")
+		}
+	}
+	slices.Sort(problems)
+	return statement, problems, nil
+}
+
+func digestFile(root *os.Root, filePath string) (string, error) {
+	f, err := root.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %q: %w", filePath, err)
+	}
+	defer utilio.Close(f)
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %q: %w", filePath, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,120 @@
+package hydrator
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func writeHydratedPath(t *testing.T) *os.Root {
+	t.Helper()
+
+	root, err := os.OpenRoot(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = root.Close() })
+
+	require.NoError(t, root.MkdirAll("app/configmap", 0o755))
+	require.NoError(t, root.WriteFile("app/configmap/cm.yaml", []byte("kind: ConfigMap\n"), 0o644))
+	require.NoError(t, root.WriteFile("app/deployment.yaml", []byte("kind: Deployment\n"), 0o644))
+	require.NoError(t, root.WriteFile("app/README.md", []byte("# Manifest Hydration\n"), 0o644))
+
+	subjects, err := DigestFiles(root, "app", []string{"configmap/cm.yaml", "deployment.yaml"})
+	require.NoError(t, err)
+	metadata := HydratorCommitMetadata{
+		RepoURL:  "https://github.com/argoproj/argocd-example-apps",
+		DrySHA:   "3ff41cc5247197a6caf50216c4c76cc29d78a97d",
+		Commands: []string{"helm template ."},
+	}
+	drySource := &appv1.DrySource{RepoURL: metadata.RepoURL, Path: "guestbook", TargetRevision: "HEAD"}
+	statement := NewProvenance(metadata, drySource, nil, map[string]string{"helm": "v3.18.4"}, subjects)
+	require.NoError(t, WriteProvenance(root, "app", statement))
+	return root
+}
+
+func TestNewProvenance(t *testing.T) {
+	root := writeHydratedPath(t)
+
+	statement, err := ReadProvenance(root, "app")
+	require.NoError(t, err)
+	assert.Equal(t, StatementType, statement.Type)
+	assert.Equal(t, ProvenancePredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 2)
+	assert.Equal(t, "configmap/cm.yaml", statement.Subject[0].Name)
+	assert.Len(t, statement.Subject[0].Digest["sha256"], 64)
+
+	repoURL, drySHA := statement.DryRevision()
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", repoURL)
+	assert.Equal(t, "3ff41cc5247197a6caf50216c4c76cc29d78a97d", drySHA)
+
+	definition := statement.Predicate.BuildDefinition
+	assert.Equal(t, "guestbook", definition.ExternalParameters.DrySource.Path)
+	assert.Equal(t, []string{"helm template ."}, definition.InternalParameters.Commands)
+	assert.Equal(t, map[string]string{
+		"argocd": common.GetVersion().Version,
+		"helm":   "v3.18.4",
+	}, statement.Predicate.RunDetails.Builder.Version)
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		root := writeHydratedPath(t)
+
+		_, problems, err := VerifyProvenance(root, "app")
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		root := writeHydratedPath(t)
+		require.NoError(t, root.WriteFile("app/deployment.yaml", []byte("kind: Pod\n"), 0o644))
+		require.NoError(t, root.Remove("app/configmap/cm.yaml"))
+		require.NoError(t, root.WriteFile("app/configmap/secret.yaml", []byte("kind: Secret\n"), 0o644))
+
+		_, problems, err := VerifyProvenance(root, "app")
+		require.NoError(t, err)
+		require.Len(t, problems, 3)
+		assert.Equal(t, "configmap/cm.yaml: missing", problems[0])
+		assert.Equal(t, "configmap/secret.yaml: not in provenance", problems[1])
+		assert.Contains(t, problems[2], "deployment.yaml: sha256 digest")
+	})
+
+	t.Run("invalid subject", func(t *testing.T) {
+		root := writeHydratedPath(t)
+		statement := NewProvenance(HydratorCommitMetadata{}, nil, nil, nil, []ResourceDescriptor{{Name: "../outside.yaml"}})
+		require.NoError(t, WriteProvenance(root, "app", statement))
+
+		_, _, err := VerifyProvenance(root, "app")
+		require.EqualError(t, err, `hydrator provenance has an invalid subject "../outside.yaml"`)
+	})
+
+	t.Run("missing provenance", func(t *testing.T) {
+		root := writeHydratedPath(t)
+		require.NoError(t, root.Remove("app/hydrator.provenance"))
+
+		_, _, err := VerifyProvenance(root, "app")
+		require.ErrorContains(t, err, "failed to read hydrator provenance")
+	})
+}
+
+func Test_resolvedDependencies(t *testing.T) {
+	t.Parallel()
+
+	metadata := HydratorCommitMetadata{RepoURL: "https://github.com/example/repo", DrySHA: "abc123"}
+	assert.Equal(t, []ResourceDescriptor{{URI: "git+https://github.com/example/repo", Digest: map[string]string{"gitCommit": "abc123"}}}, resolvedDependencies(metadata))
+
+	metadata.DrySources = []DrySourceMetadata{
+		{RepoURL: "https://github.com/example/repo", Ref: "values", Revision: "abc123"},
+		{RepoURL: "https://charts.example.com", Chart: "guestbook", Revision: "1.2.3"},
+		{RepoURL: "oci://registry.example.com/charts/guestbook", Revision: "sha256:def456"},
+	}
+	assert.Equal(t, []ResourceDescriptor{
+		{URI: "git+https://github.com/example/repo", Digest: map[string]string{"gitCommit": "abc123"}},
+		{Name: "guestbook", URI: "https://charts.example.com", Annotations: map[string]string{"version": "1.2.3"}},
+		{URI: "oci://registry.example.com/charts/guestbook", Digest: map[string]string{"sha256": "def456"}},
+	}, resolvedDependencies(metadata))
+}
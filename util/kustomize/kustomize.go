@@ -483,6 +483,12 @@ func Version() (string, error) {
 	return versionWithBinaryPath(context.Background(), &kustomize{})
 }
 
+// VersionWithBinaryPath returns the version of the kustomize binary at the given path, or of the kustomize binary on
+// the PATH if the path is empty.
+func VersionWithBinaryPath(binaryPath string) (string, error) {
+	return versionWithBinaryPath(context.Background(), &kustomize{binaryPath: binaryPath})
+}
+
 func versionWithBinaryPath(ctx context.Context, k *kustomize) (string, error) {
 	executable := k.getBinaryPath()
 	cmd := exec.CommandContext(ctx, executable, "version", "--short")
@@ -0,0 +1,53 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// HydratedManifestsArtifactType is the artifact type of the OCI artifacts the source hydrator pushes hydrated manifests
+// as.
+const HydratedManifestsArtifactType = "application/vnd.argoproj.argo-cd.hydrated-manifests.v1"
+
+// PushDirectory packs the contents of dir into a single tar+gzip layer and pushes it to the target as an OCI artifact of
+// the given artifact type, with the given manifest annotations. The artifact is tagged with each of the given tags. It
+// returns the digest of the artifact's manifest.
+//
+// The layer uses a media type the repo-server allows by default, so the artifact can be synced like any other OCI
+// source.
+func PushDirectory(ctx context.Context, target oras.Target, dir, artifactType string, annotations map[string]string, tags ...string) (string, error) {
+	var layerBytes bytes.Buffer
+	if _, err := files.Tgz(dir, nil, nil, &layerBytes); err != nil {
+		return "", fmt.Errorf("failed to compress %s: %w", dir, err)
+	}
+
+	layer := content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, layerBytes.Bytes())
+	err := target.Push(ctx, layer, bytes.NewReader(layerBytes.Bytes()))
+	if err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		return "", fmt.Errorf("failed to push layer: %w", err)
+	}
+
+	manifest, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, artifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layer},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to push manifest: %w", err)
+	}
+
+	for _, tag := range tags {
+		if err = target.Tag(ctx, manifest, tag); err != nil {
+			return "", fmt.Errorf("failed to tag manifest with %q: %w", tag, err)
+		}
+	}
+	return manifest.Digest.String(), nil
+}
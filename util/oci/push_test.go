@@ -0,0 +1,57 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func TestPushDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+
+	store := memory.New()
+	annotations := map[string]string{imagev1.AnnotationRevision: "abc123"}
+	digest, err := PushDirectory(t.Context(), store, dir, HydratedManifestsArtifactType, annotations, "abc123", "env-dev")
+	require.NoError(t, err)
+
+	var desc imagev1.Descriptor
+	for _, tag := range []string{"abc123", "env-dev"} {
+		desc, err = store.Resolve(t.Context(), tag)
+		require.NoError(t, err)
+		assert.Equal(t, digest, desc.Digest.String())
+	}
+
+	manifestBytes, err := content.FetchAll(t.Context(), store, desc)
+	require.NoError(t, err)
+	var manifest imagev1.Manifest
+	require.NoError(t, json.Unmarshal(manifestBytes, &manifest))
+	assert.Equal(t, HydratedManifestsArtifactType, manifest.ArtifactType)
+	assert.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+
+	// The artifact can be extracted like any other OCI source. Unlike a registry, the memory store only resolves tags.
+	require.NoError(t, store.Tag(t.Context(), desc, digest))
+	c := newClientWithLock("example.com/hydrated", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, []string{imagev1.MediaTypeImageLayerGzip},
+		WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithManifestMaxExtractedSize(1024*1024),
+		WithEventHandlers(fakeEventHandlers(t, "example.com/hydrated")))
+	path, closer, err := c.Extract(t.Context(), digest)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	data, err := os.ReadFile(filepath.Join(path, "app", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+}
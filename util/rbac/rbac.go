@@ -76,6 +76,7 @@ const (
 	ActionUpdate   = "update"
 	ActionDelete   = "delete"
 	ActionSync     = "sync"
+	ActionApprove  = "approve"
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
@@ -103,6 +104,7 @@ var (
 		ActionUpdate,
 		ActionDelete,
 		ActionSync,
+		ActionApprove,
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	informersv1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -509,6 +510,8 @@ const (
 	settingsBinaryUrlsKey = "help.download"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsSourceHydratorCommitMessageTemplateKey = "sourceHydrator.commitMessageTemplate"
+	// settingsSourceHydratorPruneOrphanedPathsKey designates the key for whether the source hydrator removes hydrated paths no longer hydrated by any application
+	settingsSourceHydratorPruneOrphanedPathsKey = "sourceHydrator.pruneOrphanedPaths"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
@@ -552,6 +555,10 @@ const (
 	impersonationEnabledKey = "application.sync.impersonation.enabled"
 	// requireOverridePrivilegeForRevisionSyncKey is the key to configure whether giving an external revision during sync is considered an override
 	requireOverridePrivilegeForRevisionSyncKey = "application.sync.requireOverridePrivilegeForRevisionSync"
+	// pruneThresholdKey is the key to configure the maximum number or percentage of the resources of an application a sync may prune without confirmation
+	pruneThresholdKey = "application.sync.pruneThreshold"
+	// clusterSyncConcurrencyLimitKey is the key to configure the maximum number of concurrent sync operations per destination cluster
+	clusterSyncConcurrencyLimitKey = "application.sync.clusterConcurrencyLimit"
 )
 
 const (
@@ -1042,6 +1049,19 @@ func (mgr *SettingsManager) GetSourceHydratorCommitMessageTemplate() (string, er
 	return argoCDCM.Data[settingsSourceHydratorCommitMessageTemplateKey], nil
 }
 
+// GetSourceHydratorPruneOrphanedPaths returns whether the source hydrator removes the hydrated paths which are no longer
+// hydrated by any application. Defaults to true.
+func (mgr *SettingsManager) GetSourceHydratorPruneOrphanedPaths() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[settingsSourceHydratorPruneOrphanedPathsKey] == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[settingsSourceHydratorPruneOrphanedPathsKey])
+}
+
 func addStatusOverrideToGK(resourceOverrides map[string]v1alpha1.ResourceOverride, groupKind string) {
 	if val, ok := resourceOverrides[groupKind]; ok {
 		val.IgnoreDifferences.JSONPointers = append(val.IgnoreDifferences.JSONPointers, "/status")
@@ -1298,6 +1318,41 @@ func (mgr *SettingsManager) RequireOverridePrivilegeForRevisionSync() (bool, err
 	return maybeBooleanFlagValue, nil
 }
 
+// GetPruneThreshold returns the maximum number or percentage of the resources of an application a sync may prune
+// without confirmation, unless the application or its project sets their own. It returns nil if it is not limited.
+func (mgr *SettingsManager) GetPruneThreshold() (*intstr.IntOrString, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := v1alpha1.ParsePruneThreshold(argoCDCM.Data[pruneThresholdKey])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s value: %w", pruneThresholdKey, err)
+	}
+	return threshold, nil
+}
+
+// GetClusterSyncConcurrencyLimit returns the maximum number of concurrent sync operations to a destination cluster,
+// unless the cluster sets its own. It returns 0 if the number is not limited.
+func (mgr *SettingsManager) GetClusterSyncConcurrencyLimit() (int, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, err
+	}
+	value := argoCDCM.Data[clusterSyncConcurrencyLimitKey]
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %s value: %w", clusterSyncConcurrencyLimitKey, err)
+	}
+	if limit < 0 {
+		return 0, fmt.Errorf("error parsing %s value: %d must not be negative", clusterSyncConcurrencyLimitKey, limit)
+	}
+	return limit, nil
+}
+
 // GetSettings retrieves settings from the ArgoCDConfigMap and secret.
 func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -293,6 +294,26 @@ func TestGetIsIgnoreResourceUpdatesEnabledFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetSourceHydratorPruneOrphanedPaths(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil)
+	pruneOrphanedPaths, err := settingsManager.GetSourceHydratorPruneOrphanedPaths()
+	require.NoError(t, err)
+	assert.True(t, pruneOrphanedPaths)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"sourceHydrator.pruneOrphanedPaths": "false",
+	})
+	pruneOrphanedPaths, err = settingsManager.GetSourceHydratorPruneOrphanedPaths()
+	require.NoError(t, err)
+	assert.False(t, pruneOrphanedPaths)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"sourceHydrator.pruneOrphanedPaths": "maybe",
+	})
+	_, err = settingsManager.GetSourceHydratorPruneOrphanedPaths()
+	require.Error(t, err)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},
@@ -2168,6 +2189,46 @@ func TestIsImpersonationEnabled(t *testing.T) {
 		"when user enables the flag in argocd-cm config map, IsImpersonationEnabled() must not return any error")
 }
 
+func TestGetPruneThreshold(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{})
+	threshold, err := settingsManager.GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"application.sync.pruneThreshold": "30%",
+	})
+	threshold, err = settingsManager.GetPruneThreshold()
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromString("30%"), *threshold)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"application.sync.pruneThreshold": "most",
+	})
+	_, err = settingsManager.GetPruneThreshold()
+	require.EqualError(t, err, "error parsing application.sync.pruneThreshold value: prune threshold most must be a number of resources or a percentage")
+}
+
+func TestGetClusterSyncConcurrencyLimit(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{})
+	limit, err := settingsManager.GetClusterSyncConcurrencyLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 0, limit)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"application.sync.clusterConcurrencyLimit": "20",
+	})
+	limit, err = settingsManager.GetClusterSyncConcurrencyLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 20, limit)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"application.sync.clusterConcurrencyLimit": "-1",
+	})
+	_, err = settingsManager.GetClusterSyncConcurrencyLimit()
+	require.EqualError(t, err, "error parsing application.sync.clusterConcurrencyLimit value: -1 must not be negative")
+}
+
 func TestRequireOverridePrivilegeForRevisionSyncNoConfigMap(t *testing.T) {
 	// When there is no argocd-cm itself,
 	// Then RequireOverridePrivilegeForRevisionSync() must return false (default value) and an error with appropriate error message.
@@ -367,16 +367,18 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 		}
 		for _, app := range filteredApps {
 			if app.Spec.SourceHydrator != nil {
-				drySource := app.Spec.SourceHydrator.GetDrySource()
-				if sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp) {
-					refreshPaths := path.GetAppRefreshPaths(&app)
-					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
-						namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-						log.Infof("webhook trigger refresh app to hydrate '%s'", app.Name)
-						_, err = argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true)
-						if err != nil {
-							log.Warnf("Failed to hydrate app '%s' for controller reprocessing: %v", app.Name, err)
-							continue
+				for _, drySource := range app.Spec.SourceHydrator.GetDrySources() {
+					if sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp) {
+						refreshPaths := path.GetAppRefreshPaths(&app)
+						if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
+							namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+							log.Infof("webhook trigger refresh app to hydrate '%s'", app.Name)
+							_, err = argo.RefreshAppWithSource(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true, v1alpha1.RefreshSourceWebhook)
+							if err != nil {
+								log.Warnf("Failed to hydrate app '%s' for controller reprocessing: %v", app.Name, err)
+							}
+							// No need to hydrate multiple times if multiple dry sources match.
+							break
 						}
 					}
 				}
@@ -387,7 +389,7 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 					refreshPaths := path.GetAppRefreshPaths(&app)
 					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
 						namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-						_, err = argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true)
+						_, err = argo.RefreshAppWithSource(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true, v1alpha1.RefreshSourceWebhook)
 						if err != nil {
 							log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.Name, err)
 							continue